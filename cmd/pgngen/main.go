@@ -255,6 +255,8 @@ func (conv *canboatConverter) write() {
 		t := template.Must(template.New("pgninfo").Funcs(sprig.TxtFuncMap()).Funcs(template.FuncMap{
 			"convertFieldType":     convertFieldType,
 			"getFieldDeserializer": getFieldDeserializer,
+			"getFieldSerializer":   getFieldSerializer,
+			"isFullyMatched":       isFullyMatched,
 			"fieldByteCount":       fieldByteCount,
 			"concat":               func(strs ...string) string { return strings.Join(strs, "") },
 			"toNumber":             toNumber,
@@ -681,6 +683,111 @@ func getFieldDeserializer(pgn PGN, field PGNField) [2]string {
 	}
 }
 
+// getFieldSerializer returns a string that when evaluated writes value to the output stream.
+// It mirrors getFieldDeserializer, and the returned expression evaluates to an error.
+// Fields with a Match value always write the matched value, since the struct type implies it.
+// Used by template.
+func getFieldSerializer(pgn PGN, field PGNField, value string) string {
+	if field.Match != nil {
+		return fmt.Sprintf("stream.writeLookupField(%d, %d)", field.BitLength, *field.Match)
+	}
+	switch field.FieldType {
+	case "LOOKUP", "BITLOOKUP", "INDIRECT_LOOKUP", "FIELDTYPE_LOOKUP":
+		if field.BitLength > 32 {
+			panic("No serializer for " + field.FieldType + " with bitlength > 32")
+		}
+		return fmt.Sprintf("stream.writeLookupField(%d, uint64(%s))", field.BitLength, value)
+	case "FIELD_INDEX":
+		return fmt.Sprintf("stream.writeUInt8(%d, %s)", field.BitLength, value)
+	case "NUMBER", "TIME", "DATE", "MMSI":
+		resolution := float32(1.0)
+		if field.Resolution != nil {
+			resolution = *field.Resolution
+		}
+		if unitType, unitName := getUnitType(field.Unit); unitType != "" {
+			// Units are always written through the float path, which handles the rounding for us
+			if resolution <= resolution64BitCutoff {
+				panic("No serializer for unit field with resolution below cutoff: " + field.Id)
+			}
+			unitValue := fmt.Sprintf("nullableUnitValue(%s, units.%s, units.%s.Convert)", value, unitName, unitType)
+			if field.Signed {
+				return fmt.Sprintf("stream.writeSignedResolution(%d, %g, %s)", field.BitLength, resolution, unitValue)
+			}
+			return fmt.Sprintf("stream.writeUnsignedResolution(%d, %g, %s)", field.BitLength, resolution, unitValue)
+		}
+
+		if field.Signed {
+			switch {
+			case resolution <= resolution64BitCutoff:
+				return fmt.Sprintf("stream.writeSignedResolution64Override(%d, %g, %s)", field.BitLength, resolution, value)
+			case resolution != 1.0:
+				return fmt.Sprintf("stream.writeSignedResolution(%d, %g, %s)", field.BitLength, resolution, value)
+			case field.BitLength > 32:
+				return fmt.Sprintf("stream.writeInt64(%d, %s)", field.BitLength, value)
+			case field.BitLength > 16:
+				return fmt.Sprintf("stream.writeInt32(%d, %s)", field.BitLength, value)
+			case field.BitLength > 8:
+				return fmt.Sprintf("stream.writeInt16(%d, %s)", field.BitLength, value)
+			default:
+				return fmt.Sprintf("stream.writeInt8(%d, %s)", field.BitLength, value)
+			}
+		}
+		switch {
+		case resolution != 1.0:
+			return fmt.Sprintf("stream.writeUnsignedResolution(%d, %g, %s)", field.BitLength, resolution, value)
+		case field.BitLength > 32:
+			return fmt.Sprintf("stream.writeUInt64(%d, %s)", field.BitLength, value)
+		case field.BitLength > 16:
+			return fmt.Sprintf("stream.writeUInt32(%d, %s)", field.BitLength, value)
+		case field.BitLength > 8:
+			return fmt.Sprintf("stream.writeUInt16(%d, %s)", field.BitLength, value)
+		default:
+			return fmt.Sprintf("stream.writeUInt8(%d, %s)", field.BitLength, value)
+		}
+	case "FLOAT":
+		if field.BitLength != 32 {
+			panic("No serializer for IEEE Float with bitlength non-32")
+		}
+		return fmt.Sprintf("stream.writeFloat32(%s)", value)
+	case "DECIMAL":
+		return fmt.Sprintf("stream.writeBinaryData(%d, %s)", field.BitLength, value)
+	case "STRING_VAR":
+		return fmt.Sprintf("stream.writeStringStartStopByte(%s)", value)
+	case "STRING_LAU":
+		return fmt.Sprintf("stream.writeStringWithLengthAndControl(%s)", value)
+	case "STRING_FIX":
+		return fmt.Sprintf("stream.writeFixedString(%d, %s)", field.BitLength, value)
+	case "STRING_LZ":
+		return fmt.Sprintf("stream.writeStringWithLength(%s)", value)
+	case "BINARY":
+		if field.BitLength > 0 {
+			return fmt.Sprintf("stream.writeBinaryData(%d, %s)", field.BitLength, value)
+		}
+		return fmt.Sprintf("stream.writeBinaryData(binaryLength, %s)", value)
+	case "VARIABLE":
+		return fmt.Sprintf("stream.writeVariableData(*val.Pgn, manufacturer, fieldIndex, %s)", value)
+	case "KEY_VALUE":
+		return fmt.Sprintf("stream.writeBinaryData(valueLength, %s)", value)
+	default:
+		panic("No serializer for type: " + field.FieldType)
+	}
+}
+
+// isFullyMatched returns true if every field of the PGN is reserved or has a Match value.
+// Encoders for such PGNs never reference the struct's values.
+// Used by template.
+func isFullyMatched(pgn PGN) bool {
+	if pgn.RepeatingFieldSet1Size > 0 || pgn.RepeatingFieldSet2Size > 0 {
+		return false
+	}
+	for _, field := range pgn.Fields {
+		if field.FieldType != "RESERVED" && field.FieldType != "SPARE" && field.Match == nil {
+			return false
+		}
+	}
+	return true
+}
+
 // matchManufacturer returns the required Match value of the Manufacturer Code as a string.
 // Used by template.
 func matchManufacturer(pgn PGN) string {
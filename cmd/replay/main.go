@@ -2,15 +2,16 @@ package main
 
 import (
 	//	"context"
+	"bufio"
 	"context"
 	"flag"
 	"os"
 	"strings"
-
-	//	"time"
+	"time"
 
 	"github.com/boatkit-io/n2k/pkg/adapter/canadapter"
 	"github.com/boatkit-io/n2k/pkg/endpoint/n2kfileendpoint"
+	"github.com/boatkit-io/n2k/pkg/export"
 	"github.com/boatkit-io/n2k/pkg/pgn"
 	"github.com/boatkit-io/n2k/pkg/pkt"
 	"github.com/boatkit-io/n2k/pkg/subscribe"
@@ -29,6 +30,16 @@ func main() {
 	flag.StringVar(&replayFile, "replayFile", "", "An optional replay file to run")
 	var dumpPgns bool
 	flag.BoolVar(&dumpPgns, "dumpPgns", false, "Debug spew all PGNs coming down the pipe")
+	var speed float64
+	flag.Float64Var(&speed, "speed", 1, "Replay speed as a multiple of real time, or 0 for as fast as possible")
+	var start time.Duration
+	flag.DurationVar(&start, "start", 0, "Offset into the replay file to start at, e.g. 2h30m")
+	var stop time.Duration
+	flag.DurationVar(&stop, "stop", 0, "Offset into the replay file to stop at, or 0 for the end")
+	var interactive bool
+	flag.BoolVar(&interactive, "interactive", false, "Pause and resume the replay by pressing Enter")
+	var format string
+	flag.StringVar(&format, "format", "", "Write each PGN to stdout as json, jsonl, csv or canboat")
 	flag.Parse()
 
 	log := logrus.StandardLogger()
@@ -45,15 +56,34 @@ func main() {
 
 	ps := pkt.NewPacketStruct()
 	ps.SetOutput(subs)
+	var handler canadapter.PacketHandler = ps
+
+	if len(format) > 0 {
+		writer, err := export.NewWriter(os.Stdout, export.Format(format))
+		if err != nil {
+			log.Error(err)
+			exitCode = 2
+			return
+		}
+		defer writer.Close()
+		// packets rather than structs, so canboat output has the raw values
+		handler = &packetExporter{writer: writer, next: ps, log: log}
+	}
 
 	//	ctx, cancel := context.WithCancel(context.Background())
 	//	defer cancel()
 	if len(replayFile) > 0 && strings.HasSuffix(replayFile, ".n2k") {
 		ca := canadapter.NewCANAdapter(log)
-		ca.SetOutput(ps)
+		ca.SetOutput(handler)
 
 		ep := n2kfileendpoint.NewN2kFileEndpoint(replayFile, log)
 		ep.SetOutput(ca)
+		ep.SetSpeed(speed)
+		ep.SetStart(start)
+		ep.SetStop(stop)
+		if interactive {
+			go togglePause(ep, log)
+		}
 
 		ctx := context.Background()
 		err := ep.Run(ctx)
@@ -63,3 +93,32 @@ func main() {
 		}
 	}
 }
+
+// packetExporter writes each packet it handles, then sends it on.
+type packetExporter struct {
+	writer *export.Writer
+	next   canadapter.PacketHandler
+	log    *logrus.Logger
+}
+
+// HandlePacket method writes the packet and sends it on
+func (e *packetExporter) HandlePacket(p pkt.Packet) {
+	if err := e.writer.WritePacket(p); err != nil {
+		e.log.Warnf("Writing PGN: %v", err)
+	}
+	e.next.HandlePacket(p)
+}
+
+// togglePause pauses or resumes the replay each time Enter is pressed.
+func togglePause(ep *n2kfileendpoint.N2kFileEndpoint, log *logrus.Logger) {
+	scanner := bufio.NewScanner(os.Stdin)
+	for scanner.Scan() {
+		if ep.Paused() {
+			log.Infof("Resuming at %s", ep.Position())
+			ep.Resume()
+		} else {
+			ep.Pause()
+			log.Infof("Paused at %s", ep.Position())
+		}
+	}
+}
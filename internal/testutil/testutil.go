@@ -0,0 +1,81 @@
+// Package testutil contains fixtures and handlers shared by the package tests.
+package testutil
+
+import (
+	"sync"
+	"time"
+
+	"github.com/boatkit-io/n2k/pkg/adapter"
+	"github.com/boatkit-io/n2k/pkg/pgn"
+	"github.com/boatkit-io/n2k/pkg/pkt"
+)
+
+// Time is when test messages are sent, unless a test needs another time.
+var Time = time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+// Ptr returns a pointer to v, for the optional fields of PGN structs.
+func Ptr[T any](v T) *T {
+	return &v
+}
+
+// Info returns a MessageInfo from source, sent offset after Time.
+func Info(source uint8, offset time.Duration) pgn.MessageInfo {
+	return pgn.MessageInfo{SourceId: source, Timestamp: Time.Add(offset)}
+}
+
+// MessageCollector is an endpoint MessageHandler that saves the messages it receives.
+// It can be read while an endpoint's goroutine is writing to it.
+type MessageCollector struct {
+	mu       sync.Mutex
+	messages []adapter.Message
+}
+
+// HandleMessage method saves the message
+func (c *MessageCollector) HandleMessage(msg adapter.Message) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.messages = append(c.messages, msg)
+}
+
+// Messages method returns a copy of the messages received
+func (c *MessageCollector) Messages() []adapter.Message {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]adapter.Message(nil), c.messages...)
+}
+
+// Count method returns the number of messages received
+func (c *MessageCollector) Count() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.messages)
+}
+
+// PacketCollector is a PacketHandler, as used by the adapters, that saves the packets it receives.
+type PacketCollector struct {
+	Packets []pkt.Packet
+}
+
+// HandlePacket method saves the packet
+func (c *PacketCollector) HandlePacket(p pkt.Packet) {
+	c.Packets = append(c.Packets, p)
+}
+
+// StructCollector is a StructHandler that saves the structs it receives.
+type StructCollector struct {
+	Structs []any
+}
+
+// HandleStruct method saves the struct
+func (c *StructCollector) HandleStruct(p any) {
+	c.Structs = append(c.Structs, p)
+}
+
+// NameResolver is a subscribe.NameResolver with a fixed NAME for each source address.
+type NameResolver map[uint8]uint64
+
+// NameFor method returns the NAME of the message's source
+func (r NameResolver) NameFor(info pgn.MessageInfo) (uint64, bool) {
+	name, ok := r[info.SourceId]
+	return name, ok
+}
@@ -2,6 +2,28 @@
 // Implement a type satisfying the interface for a specific NMEA gateway/endpoint.
 package adapter
 
+import "time"
+
 // Message is a generic type for messages passed between and endpoint and an adapter.
 type Message interface {
 }
+
+// TimestampedMessage wraps a Message with the time it was captured, for endpoints that know it: log files
+// recorded with timestamps, or interfaces with hardware timestamps. Adapters use the Timestamp in place of
+// the time they receive the message.
+type TimestampedMessage struct {
+	Timestamp time.Time
+	Message   Message
+}
+
+// Unwrap returns the Message inside a TimestampedMessage (or *TimestampedMessage) and its timestamp.
+// Other messages are returned as is, with a zero timestamp.
+func Unwrap(message Message) (Message, time.Time) {
+	switch m := message.(type) {
+	case TimestampedMessage:
+		return m.Message, m.Timestamp
+	case *TimestampedMessage:
+		return m.Message, m.Timestamp
+	}
+	return message, time.Time{}
+}
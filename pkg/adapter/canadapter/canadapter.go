@@ -2,6 +2,8 @@
 package canadapter
 
 import (
+	"time"
+
 	"github.com/brutella/can"
 	"github.com/sirupsen/logrus"
 
@@ -11,8 +13,9 @@ import (
 
 // CANAdapter instances read canbus frames from its input and outputs complete Packets.
 type CANAdapter struct {
-	multi *MultiBuilder // combines multiple frames into a complete Packet.
-	log   *logrus.Logger
+	multi     *MultiBuilder     // combines multiple frames into a complete Packet.
+	transport *TransportBuilder // reassembles ISO Transport Protocol sessions into a complete Packet.
+	log       *logrus.Logger
 
 	handler PacketHandler
 }
@@ -25,22 +28,43 @@ type PacketHandler interface {
 // NewCANAdapter instantiates a new CanAdapter
 func NewCANAdapter(log *logrus.Logger) *CANAdapter {
 	return &CANAdapter{
-		multi: NewMultiBuilder(log),
-		log:   log,
+		multi:     NewMultiBuilder(log),
+		transport: NewTransportBuilder(log),
+		log:       log,
 	}
 }
 
+// SetSequenceTimeout sets how long a fast packet sequence has to complete before it's output as incomplete.
+func (c *CANAdapter) SetSequenceTimeout(timeout time.Duration) {
+	c.multi.SetTimeout(timeout)
+}
+
 // SetOutput assigns a handler for any ready packets
 func (c *CANAdapter) SetOutput(ph PacketHandler) {
 	c.handler = ph
 }
 
 // HandleMessage is how you tell CanAdapter to start processing a new message into a packet
+// Frames wrapped in an adapter.TimestampedMessage are given its timestamp.
 func (c *CANAdapter) HandleMessage(message adapter.Message) {
+	message, timestamp := adapter.Unwrap(message)
 	switch f := message.(type) {
 	case *can.Frame:
 		pInfo := NewPacketInfo(f)
-		packet := pkt.NewPacket(pInfo, f.Data[:])
+		if !timestamp.IsZero() {
+			pInfo.Timestamp = timestamp
+		}
+		for _, expired := range c.multi.Sweep(pInfo.Timestamp) {
+			c.packetReady(expired)
+		}
+		if IsTransportPgn(pInfo.PGN) {
+			// The frame is passed on below too, so subscribers still see the transport structs
+			if tp := c.transport.Add(pInfo, f.Data[:f.Length]); tp != nil {
+				tp.AddDecoders()
+				c.packetReady(tp)
+			}
+		}
+		packet := pkt.NewPacket(pInfo, f.Data[:f.Length])
 
 		// https://endige.com/2050/nmea-2000-pgns-deciphered/
 
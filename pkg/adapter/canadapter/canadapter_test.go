@@ -2,7 +2,12 @@ package canadapter
 
 import (
 	"testing"
+	"time"
 
+	"github.com/sirupsen/logrus"
+
+	"github.com/boatkit-io/n2k/internal/testutil"
+	"github.com/boatkit-io/n2k/pkg/adapter"
 	"github.com/boatkit-io/n2k/pkg/pgn"
 	"github.com/boatkit-io/n2k/pkg/pkt"
 
@@ -24,3 +29,20 @@ func TestPgn127501(t *testing.T) {
 	assert.Nil(t, err)
 	assert.IsType(t, pgn.BinarySwitchBankStatus{}, ret)
 }
+
+func TestTimestampedMessage(t *testing.T) {
+	a := NewCANAdapter(logrus.StandardLogger())
+	c := &testutil.PacketCollector{}
+	a.SetOutput(c)
+
+	f := CanFrameFromRaw("2023-01-21T00:04:17Z,3,127501,224,0,8,00,03,c0,ff,ff,ff,ff,ff")
+	captured := time.Date(2023, 1, 21, 0, 4, 17, 0, time.UTC)
+	a.HandleMessage(adapter.TimestampedMessage{Timestamp: captured, Message: &f})
+	a.HandleMessage(&adapter.TimestampedMessage{Timestamp: captured, Message: &f})
+	a.HandleMessage(&f)
+
+	assert.Len(t, c.Packets, 3)
+	assert.Equal(t, captured, c.Packets[0].Info.Timestamp)
+	assert.Equal(t, captured, c.Packets[1].Info.Timestamp)
+	assert.WithinDuration(t, time.Now(), c.Packets[2].Info.Timestamp, time.Second)
+}
@@ -0,0 +1,92 @@
+package canadapter
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/brutella/can"
+
+	"github.com/boatkit-io/n2k/pkg/pgn"
+	"github.com/boatkit-io/n2k/pkg/pkt"
+)
+
+// MaxFastPacketLength is the maximum data length of a fast packet: 6 bytes in frame 0, plus 7 in each of 31 continuation frames.
+const MaxFastPacketLength = 6 + 7*MaxFrameNum
+
+// Fragmenter splits complete packets into the canbus frames needed to transmit them.
+// It's the reverse of MultiBuilder and sequence.
+// Fast packets from each source|PGN get their own rotating sequence id (0-7), so receivers can tell
+// consecutive messages apart.
+type Fragmenter struct {
+	mu     sync.Mutex
+	seqIds map[uint8]map[uint32]uint8
+}
+
+// NewFragmenter creates a new instance.
+func NewFragmenter() *Fragmenter {
+	return &Fragmenter{
+		seqIds: make(map[uint8]map[uint32]uint8),
+	}
+}
+
+// FragmentPacket method returns the frames for a complete Packet.
+// Packets for fast PGNs, or longer than a single frame, are split into fast packet frames.
+func (f *Fragmenter) FragmentPacket(p *pkt.Packet) ([]*can.Frame, error) {
+	return f.Fragment(p.Info, p.Data, p.Fast || len(p.Data) > 8)
+}
+
+// Fragment method returns the frames for the message data described by info.
+// If fast is false the data must fit in a single frame.
+// Fast packet frames are padded at the end with 0xFF.
+func (f *Fragmenter) Fragment(info pgn.MessageInfo, data []uint8, fast bool) ([]*can.Frame, error) {
+	id := CanIdFromInfo(info)
+
+	if !fast {
+		if len(data) > 8 {
+			return nil, fmt.Errorf("data length (%d) too long for a single frame, PGN: %d", len(data), info.PGN)
+		}
+		frame := can.Frame{
+			ID:     id,
+			Length: uint8(len(data)),
+		}
+		copy(frame.Data[:], data)
+		return []*can.Frame{&frame}, nil
+	}
+
+	if len(data) > MaxFastPacketLength {
+		return nil, fmt.Errorf("data length (%d) too long for a fast packet, PGN: %d", len(data), info.PGN)
+	}
+
+	seqId := f.nextSeqId(info.SourceId, info.PGN)
+	frames := make([]*can.Frame, 0, (len(data)+7)/7)
+	for frameNum, offset := uint8(0), 0; frameNum == 0 || offset < len(data); frameNum++ {
+		frame := can.Frame{
+			ID:     id,
+			Length: 8,
+			Data:   [8]uint8{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF},
+		}
+		frame.Data[0] = seqId<<5 | frameNum
+		if frameNum == 0 {
+			frame.Data[1] = uint8(len(data))
+			offset += copy(frame.Data[2:], data)
+		} else {
+			offset += copy(frame.Data[1:], data[offset:])
+		}
+		frames = append(frames, &frame)
+	}
+
+	return frames, nil
+}
+
+// nextSeqId method returns the sequence id to use for the next fast packet from source|PGN.
+func (f *Fragmenter) nextSeqId(source uint8, pgn uint32) uint8 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, t := f.seqIds[source]; !t {
+		f.seqIds[source] = make(map[uint32]uint8)
+	}
+	seqId := f.seqIds[source][pgn]
+	f.seqIds[source][pgn] = (seqId + 1) & 0x7
+	return seqId
+}
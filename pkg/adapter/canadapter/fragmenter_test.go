@@ -0,0 +1,130 @@
+package canadapter
+
+import (
+	"testing"
+
+	"github.com/brutella/can"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/boatkit-io/n2k/internal/testutil"
+	"github.com/boatkit-io/n2k/pkg/pgn"
+	"github.com/boatkit-io/n2k/pkg/pkt"
+)
+
+func TestCanIdFromInfo(t *testing.T) {
+	infos := []pgn.MessageInfo{
+		{PGN: 130820, SourceId: 10, Priority: 1, TargetId: 0},
+		{PGN: 59904, SourceId: 22, Priority: 6, TargetId: 35},
+		{PGN: 126208, SourceId: 0, Priority: 3, TargetId: 255},
+		{PGN: 127501, SourceId: 224, Priority: 3},
+	}
+	for _, info := range infos {
+		id := CanIdFromInfo(info)
+		got := NewPacketInfo(&can.Frame{ID: id})
+		got.Timestamp = info.Timestamp
+		assert.Equal(t, info, got)
+	}
+	assert.Equal(t, uint32(0x18EAFF16), CanIdFromInfo(pgn.MessageInfo{PGN: 59904, SourceId: 0x16, Priority: 6, TargetId: 0xFF}))
+}
+
+func TestFragment(t *testing.T) {
+	f := NewFragmenter()
+
+	// single frame
+	info := pgn.MessageInfo{PGN: 127501, SourceId: 224, Priority: 3}
+	frames, err := f.Fragment(info, []uint8{0, 3, 0xc0, 0xff, 0xff, 0xff, 0xff, 0xff}, false)
+	assert.NoError(t, err)
+	assert.Len(t, frames, 1)
+	assert.Equal(t, uint8(8), frames[0].Length)
+	assert.Equal(t, uint32(0x0DF20DE0), frames[0].ID)
+	_, err = f.Fragment(info, make([]uint8, 9), false)
+	assert.Error(t, err)
+
+	// fast packet, padded with 0xFF
+	info = pgn.MessageInfo{PGN: 126996, SourceId: 5, Priority: 6}
+	data := make([]uint8, 20)
+	for i := range data {
+		data[i] = uint8(i)
+	}
+	frames, err = f.Fragment(info, data, true)
+	assert.NoError(t, err)
+	assert.Len(t, frames, 3)
+	assert.Equal(t, [8]uint8{0x00, 20, 0, 1, 2, 3, 4, 5}, frames[0].Data)
+	assert.Equal(t, [8]uint8{0x01, 6, 7, 8, 9, 10, 11, 12}, frames[1].Data)
+	assert.Equal(t, [8]uint8{0x02, 13, 14, 15, 16, 17, 18, 19}, frames[2].Data)
+
+	frames, err = f.Fragment(info, data[:14], true)
+	assert.NoError(t, err)
+	assert.Len(t, frames, 3)
+	assert.Equal(t, [8]uint8{0x20, 14, 0, 1, 2, 3, 4, 5}, frames[0].Data)
+	assert.Equal(t, [8]uint8{0x22, 13, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, frames[2].Data)
+
+	// short fast packets still use fast framing
+	frames, err = f.Fragment(info, data[:3], true)
+	assert.NoError(t, err)
+	assert.Len(t, frames, 1)
+	assert.Equal(t, [8]uint8{0x40, 3, 0, 1, 2, 0xff, 0xff, 0xff}, frames[0].Data)
+
+	// too long
+	_, err = f.Fragment(info, make([]uint8, MaxFastPacketLength+1), true)
+	assert.Error(t, err)
+	frames, err = f.Fragment(info, make([]uint8, MaxFastPacketLength), true)
+	assert.NoError(t, err)
+	assert.Len(t, frames, MaxFrameNum+1)
+}
+
+func TestFragmentSeqIds(t *testing.T) {
+	f := NewFragmenter()
+	info := pgn.MessageInfo{PGN: 126996, SourceId: 5, Priority: 6}
+	other := pgn.MessageInfo{PGN: 126996, SourceId: 6, Priority: 6}
+	for i := 0; i < 10; i++ {
+		frames, err := f.Fragment(info, make([]uint8, 10), true)
+		assert.NoError(t, err)
+		assert.Equal(t, uint8(i%8), frames[0].Data[0]>>5)
+		assert.Equal(t, uint8(i%8), frames[1].Data[0]>>5)
+	}
+	// sequence ids are tracked per source|PGN
+	frames, err := f.Fragment(other, make([]uint8, 10), true)
+	assert.NoError(t, err)
+	assert.Equal(t, uint8(0), frames[0].Data[0]>>5)
+}
+
+func TestFragmentRoundTrip(t *testing.T) {
+	log := logrus.StandardLogger()
+	f := NewFragmenter()
+	c := NewCANAdapter(log)
+	collector := &testutil.PacketCollector{}
+	c.SetOutput(collector)
+
+	cog := float32(1.2)
+	msgs := []any{
+		pgn.ProductInformation{
+			Info:                pgn.MessageInfo{SourceId: 12, Priority: 6},
+			ModelId:             "n2k",
+			SoftwareVersionCode: "1.0",
+			ModelVersion:        "a",
+			ModelSerialCode:     "12345",
+		},
+		pgn.CogSogRapidUpdate{
+			Info: pgn.MessageInfo{SourceId: 12, Priority: 2},
+			Cog:  &cog,
+		},
+	}
+
+	for _, m := range msgs {
+		info, data, err := pgn.Encode(m)
+		assert.NoError(t, err)
+		frames, err := f.FragmentPacket(pkt.NewPacket(info, data))
+		assert.NoError(t, err)
+		for _, frame := range frames {
+			c.HandleMessage(frame)
+		}
+		assert.NotEmpty(t, collector.Packets)
+		p := collector.Packets[len(collector.Packets)-1]
+		assert.Empty(t, p.ParseErrors)
+		assert.Equal(t, info.PGN, p.Info.PGN)
+		assert.Equal(t, data, p.Data)
+	}
+	assert.Len(t, collector.Packets, len(msgs))
+}
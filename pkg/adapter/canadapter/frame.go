@@ -53,3 +53,13 @@ func NewPacketInfo(message *can.Frame) pgn.MessageInfo {
 	}
 	return p
 }
+
+// CanIdFromInfo returns the 29 bit canbus frame ID for a message, the reverse of NewPacketInfo.
+// For targeted (PDU1) PGNs the TargetId is encoded in the lower byte of the PGN.
+func CanIdFromInfo(info pgn.MessageInfo) uint32 {
+	pgnBits := info.PGN & 0x3FFFF
+	if (pgnBits&0xFF00)>>8 < 240 {
+		pgnBits = (pgnBits & 0x3FF00) | uint32(info.TargetId)
+	}
+	return uint32(info.Priority&0x7)<<26 | pgnBits<<8 | uint32(info.SourceId)
+}
@@ -1,11 +1,16 @@
 package canadapter
 
 import (
+	"time"
+
 	"github.com/sirupsen/logrus"
 
 	"github.com/boatkit-io/n2k/pkg/pkt"
 )
 
+// DefaultSequenceTimeout is how long a sequence has to complete, from its first frame, before it's swept.
+const DefaultSequenceTimeout = 750 * time.Millisecond
+
 // MultiBuilder assembles a sequence of packets into a comple Packet.
 // Manages the list of sequences used to combine multipacket PGNs
 // Instantiated by PGNBuilder
@@ -13,9 +18,12 @@ import (
 // we track sequences separately for each nmea source
 // sequence ids are 0-7, so each source|PGN can have 8 sequences in simultaneous transmission
 // sequences map[sourceid]map[pgn]map[SeqId]sequence
+// A lost frame would leave its sequence incomplete forever, and a later message reusing the sequence id would
+// have its data spliced into the old one, so sequences that don't complete within the timeout are swept.
 type MultiBuilder struct {
 	log       *logrus.Logger
 	sequences map[uint8]map[uint32]map[uint8]*sequence
+	timeout   time.Duration
 }
 
 // NewMultiBuilder creates a new instance.
@@ -23,15 +31,48 @@ func NewMultiBuilder(log *logrus.Logger) *MultiBuilder {
 	mBuilder := MultiBuilder{
 		log:       log,
 		sequences: make(map[uint8]map[uint32]map[uint8]*sequence),
+		timeout:   DefaultSequenceTimeout,
 	}
 	return &mBuilder
 }
 
+// SetTimeout method sets how long a sequence has to complete before it's swept.
+func (m *MultiBuilder) SetTimeout(timeout time.Duration) {
+	m.timeout = timeout
+}
+
+// Sweep method removes the sequences that have expired as of now (based on the packets' timestamps).
+// It returns an incomplete packet, with the data received so far and a ParseError, for each expired
+// sequence that received its frame 0.
+func (m *MultiBuilder) Sweep(now time.Time) []*pkt.Packet {
+	var expired []*pkt.Packet
+	for _, pgns := range m.sequences {
+		for _, seqs := range pgns {
+			for seqId, seq := range seqs {
+				if !seq.expired(now, m.timeout) {
+					continue
+				}
+				if p := seq.incomplete(); p != nil {
+					m.log.Debugf("Fast sequence timed out. Source: %d PGN: %d Sequence #: %d", p.Info.SourceId, p.Info.PGN, seqId)
+					expired = append(expired, p)
+				}
+				delete(seqs, seqId)
+			}
+		}
+	}
+	return expired
+}
+
 // Add method adds a packet to a (new or existing) sequence.
 // if the sequence (and resulting packet) is now complete, delete the sequence.
 func (m *MultiBuilder) Add(p *pkt.Packet) {
 	p.GetSeqFrame()
 	seq := m.SeqFor(p)
+	if seq.expired(p.Info.Timestamp, m.timeout) {
+		// Sweep wasn't called, so don't let the stale frames mix with the new ones.
+		m.log.Debugf("Fast sequence expired. Resetting Source: %d PGN: %d Sequence #: %d", p.Info.SourceId, p.Info.PGN, p.SeqId)
+		seq.reset()
+	}
 	seq.add(p)
 	if seq.complete(p) {
 		delete(m.sequences[p.Info.SourceId][p.Info.PGN], p.SeqId)
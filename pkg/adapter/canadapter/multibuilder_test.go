@@ -3,7 +3,9 @@ package canadapter
 import (
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/boatkit-io/n2k/internal/testutil"
 	"github.com/boatkit-io/n2k/pkg/pkt"
 	"github.com/brutella/can"
 	"github.com/sirupsen/logrus"
@@ -215,3 +217,57 @@ func TestFastPacket(t *testing.T) {
 	assert.NotEqual(t, 32, len(p.Data))
 	assert.NotEqual(t, comp, p.Data)
 }
+
+func TestSequenceTimeout(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	frame := func(offset time.Duration, data []uint8) *pkt.Packet {
+		pInfo := NewPacketInfo(&can.Frame{ID: CanIdFromData(130820, 10, 1, 0), Length: 8})
+		pInfo.Timestamp = start.Add(offset)
+		return pkt.NewPacket(pInfo, data)
+	}
+
+	m := NewMultiBuilder(log)
+	m.Add(frame(0, []uint8{0x40, 10, 1, 2, 3, 4, 5, 6}))
+	assert.Empty(t, m.Sweep(start.Add(DefaultSequenceTimeout)))
+	assert.Len(t, m.sequences[10][130820], 1)
+
+	expired := m.Sweep(start.Add(DefaultSequenceTimeout + time.Millisecond))
+	assert.Len(t, expired, 1)
+	assert.False(t, expired[0].Complete)
+	assert.Equal(t, []uint8{1, 2, 3, 4, 5, 6}, expired[0].Data)
+	assert.Len(t, expired[0].ParseErrors, 1)
+	assert.Empty(t, m.sequences[10][130820])
+
+	// a stale sequence isn't spliced into a new one reusing its id, even without a sweep
+	m.SetTimeout(100 * time.Millisecond)
+	m.Add(frame(time.Second, []uint8{0x40, 10, 1, 2, 3, 4, 5, 6}))
+	p := frame(2*time.Second, []uint8{0x41, 7, 8, 9, 10, 0xff, 0xff, 0xff})
+	m.Add(p)
+	assert.False(t, p.Complete)
+	m.Add(frame(2*time.Second, []uint8{0x40, 10, 11, 12, 13, 14, 15, 16}))
+	p = frame(2*time.Second+time.Millisecond, []uint8{0x41, 17, 18, 19, 20, 0xff, 0xff, 0xff})
+	m.Add(p)
+	assert.True(t, p.Complete)
+	assert.Equal(t, []uint8{11, 12, 13, 14, 15, 16, 17, 18, 19, 20}, p.Data)
+
+	// frames without a frame 0 are swept without output
+	m.Add(frame(3*time.Second, []uint8{0x62, 7, 8, 9, 10, 0xff, 0xff, 0xff}))
+	assert.Empty(t, m.Sweep(start.Add(4*time.Second)))
+	assert.Empty(t, m.sequences[10][130820])
+}
+
+func TestAdapterSequenceTimeout(t *testing.T) {
+	c := NewCANAdapter(log)
+	collector := &testutil.PacketCollector{}
+	c.SetOutput(collector)
+	c.SetSequenceTimeout(0)
+
+	c.HandleMessage(&can.Frame{ID: CanIdFromData(130820, 10, 1, 0), Length: 8, Data: [8]uint8{0x40, 10, 1, 2, 3, 4, 5, 6}})
+	assert.Empty(t, collector.Packets)
+	time.Sleep(time.Millisecond)
+	c.HandleMessage(&can.Frame{ID: CanIdFromData(127501, 20, 1, 0), Length: 8, Data: [8]uint8{0, 3, 0xc0, 0xff, 0xff, 0xff, 0xff, 0xff}})
+	assert.Len(t, collector.Packets, 2)
+	assert.Equal(t, uint32(130820), collector.Packets[0].Info.PGN)
+	assert.NotEmpty(t, collector.Packets[0].ParseErrors)
+	assert.Equal(t, uint32(127501), collector.Packets[1].Info.PGN)
+}
@@ -2,6 +2,8 @@ package canadapter
 
 import (
 	"fmt"
+	"time"
+
 	"github.com/boatkit-io/n2k/pkg/pkt"
 	"github.com/sirupsen/logrus"
 )
@@ -24,6 +26,7 @@ const MaxFrameNum = 31
 type sequence struct {
 	log      *logrus.Logger
 	zero     *pkt.Packet // packet 0 of sequence
+	started  time.Time   // timestamp of the first frame, or zero if no frames have been added
 	expected uint8
 	received uint8
 	contents [MaxFrameNum + 1][]uint8 // need arrays since packets can be received out of order
@@ -56,6 +59,9 @@ func (s *sequence) add(p *pkt.Packet) {
 			s.received += 7
 		}
 	}
+	if s.started.IsZero() {
+		s.started = p.Info.Timestamp
+	}
 }
 
 // complete method tests if all of the expected data has been received.
@@ -87,10 +93,34 @@ func (s *sequence) complete(p *pkt.Packet) bool {
 	return false
 }
 
+// expired method returns true if the sequence started more than timeout before now.
+func (s *sequence) expired(now time.Time, timeout time.Duration) bool {
+	return !s.started.IsZero() && now.Sub(s.started) > timeout
+}
+
+// incomplete method returns a packet with the contiguous data received so far, marked with a ParseError.
+// It returns nil if frame 0 wasn't received.
+func (s *sequence) incomplete() *pkt.Packet {
+	if s.zero == nil {
+		return nil
+	}
+	p := *s.zero
+	p.Data = make([]uint8, 0, s.received)
+	for _, d := range s.contents {
+		if d == nil {
+			break
+		}
+		p.Data = append(p.Data, d...)
+	}
+	p.ParseErrors = append(p.ParseErrors, fmt.Errorf("fast sequence timed out with %d of %d bytes received", s.received, s.expected))
+	return &p
+}
+
 // reset method clears the sequence to try again.
 // Called if we receive a duplicate packet, assuming it belongs to a new sequence.
 func (s *sequence) reset() {
 	s.zero = nil
+	s.started = time.Time{}
 	s.expected = 0
 	s.received = 0
 	for i := range s.contents {
@@ -0,0 +1,47 @@
+package canadapter
+
+import (
+	"context"
+
+	"github.com/boatkit-io/n2k/pkg/endpoint"
+	"github.com/boatkit-io/n2k/pkg/pgn"
+	"github.com/boatkit-io/n2k/pkg/pkt"
+)
+
+// StructWriter encodes golang PGN structs and writes the resulting canbus frames to an endpoint.
+// It's the transmit counterpart to CANAdapter and PacketStruct.
+type StructWriter struct {
+	fragmenter *Fragmenter
+	writer     endpoint.MessageWriter
+}
+
+// NewStructWriter creates a new instance writing to w.
+func NewStructWriter(w endpoint.MessageWriter) *StructWriter {
+	return &StructWriter{
+		fragmenter: NewFragmenter(),
+		writer:     w,
+	}
+}
+
+// WriteStruct method encodes p and writes it. The struct's Info supplies the source, target and priority.
+func (s *StructWriter) WriteStruct(ctx context.Context, p any) error {
+	info, data, err := pgn.Encode(p)
+	if err != nil {
+		return err
+	}
+	return s.WriteData(ctx, info, data)
+}
+
+// WriteData method writes already encoded message data.
+func (s *StructWriter) WriteData(ctx context.Context, info pgn.MessageInfo, data []uint8) error {
+	frames, err := s.fragmenter.FragmentPacket(pkt.NewPacket(info, data))
+	if err != nil {
+		return err
+	}
+	for _, frame := range frames {
+		if err := s.writer.WriteMessage(ctx, frame); err != nil {
+			return err
+		}
+	}
+	return nil
+}
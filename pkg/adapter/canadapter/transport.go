@@ -0,0 +1,195 @@
+package canadapter
+
+import (
+	"encoding/binary"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/boatkit-io/n2k/pkg/pgn"
+	"github.com/boatkit-io/n2k/pkg/pkt"
+)
+
+const (
+	// TransportConnectionManagementPgn is the PGN of ISO Transport Protocol connection management (TP.CM) frames.
+	TransportConnectionManagementPgn = 60416
+	// TransportDataTransferPgn is the PGN of ISO Transport Protocol data transfer (TP.DT) frames.
+	TransportDataTransferPgn = 60160
+
+	// MaxTransportLength is the maximum data length of an ISO Transport Protocol message: 255 frames of 7 bytes.
+	MaxTransportLength = 1785
+
+	// BAMTimeout is how long a broadcast (BAM) session waits for its next data frame before it's dropped.
+	BAMTimeout = 750 * time.Millisecond
+	// RTSTimeout is how long a connection mode (RTS/CTS) session waits for its next frame before it's dropped.
+	RTSTimeout = 1250 * time.Millisecond
+)
+
+// TP.CM control bytes
+const (
+	tpRequestToSend     = 16
+	tpClearToSend       = 17
+	tpEndOfMessageAck   = 19
+	tpBroadcastAnnounce = 32
+	tpAbort             = 255
+)
+
+// transportKey identifies an ISO Transport Protocol session: a sender can only have one session open to each destination.
+type transportKey struct {
+	source      uint8
+	destination uint8
+}
+
+// transportSession collects the data frames of a single ISO Transport Protocol message.
+type transportSession struct {
+	info     pgn.MessageInfo // describes the embedded PGN
+	size     uint16
+	frames   [][]uint8
+	received uint8
+	lastSeen time.Time
+	timeout  time.Duration
+}
+
+// TransportBuilder reassembles ISO Transport Protocol (TP.CM/TP.DT) sessions into complete Packets.
+// It handles both broadcast (BAM) and connection mode (RTS/CTS) sessions, but only listens:
+// it doesn't send CTS frames, so a connection mode session only completes if its destination takes part.
+// Sessions that go quiet for longer than their timeout are dropped the next time a frame arrives.
+type TransportBuilder struct {
+	log      *logrus.Logger
+	sessions map[transportKey]*transportSession
+}
+
+// NewTransportBuilder creates a new instance.
+func NewTransportBuilder(log *logrus.Logger) *TransportBuilder {
+	return &TransportBuilder{
+		log:      log,
+		sessions: make(map[transportKey]*transportSession),
+	}
+}
+
+// IsTransportPgn returns true if the PGN is one of the ISO Transport Protocol PGNs.
+func IsTransportPgn(pgn uint32) bool {
+	return pgn == TransportConnectionManagementPgn || pgn == TransportDataTransferPgn
+}
+
+// Add method processes a TP.CM or TP.DT frame. If it completes a session it returns the reassembled Packet.
+func (t *TransportBuilder) Add(info pgn.MessageInfo, data []uint8) *pkt.Packet {
+	t.expire(info.Timestamp)
+	if len(data) < 8 {
+		t.log.Debugf("ISO transport frame too short: %d bytes, PGN: %d Source: %d", len(data), info.PGN, info.SourceId)
+		return nil
+	}
+
+	switch info.PGN {
+	case TransportConnectionManagementPgn:
+		t.handleConnectionManagement(info, data)
+	case TransportDataTransferPgn:
+		return t.handleDataTransfer(info, data)
+	}
+	return nil
+}
+
+// handleConnectionManagement opens, refreshes or closes sessions.
+func (t *TransportBuilder) handleConnectionManagement(info pgn.MessageInfo, data []uint8) {
+	switch data[0] {
+	case tpRequestToSend, tpBroadcastAnnounce:
+		key := transportKey{source: info.SourceId, destination: info.TargetId}
+		timeout := RTSTimeout
+		if data[0] == tpBroadcastAnnounce {
+			key.destination = 255
+			timeout = BAMTimeout
+		}
+		if _, exists := t.sessions[key]; exists {
+			t.log.Debugf("ISO transport session replaced before completion. Source: %d Destination: %d", key.source, key.destination)
+		}
+		size := binary.LittleEndian.Uint16(data[1:3])
+		numFrames := int(data[3])
+		if size < 9 || size > MaxTransportLength || numFrames != (int(size)+6)/7 {
+			t.log.Debugf("ISO transport session with bad size: %d bytes in %d frames. Source: %d", size, numFrames, key.source)
+			delete(t.sessions, key)
+			return
+		}
+		t.sessions[key] = &transportSession{
+			info: pgn.MessageInfo{
+				Timestamp: info.Timestamp,
+				Priority:  info.Priority,
+				PGN:       embeddedPgn(data),
+				SourceId:  key.source,
+				TargetId:  key.destination,
+			},
+			size:     size,
+			frames:   make([][]uint8, numFrames),
+			lastSeen: info.Timestamp,
+			timeout:  timeout,
+		}
+	case tpClearToSend:
+		// sent by the destination to the source
+		if s, exists := t.sessions[transportKey{source: info.TargetId, destination: info.SourceId}]; exists {
+			s.lastSeen = info.Timestamp
+		}
+	case tpAbort:
+		// can be sent by either end
+		key := transportKey{source: info.SourceId, destination: info.TargetId}
+		if _, exists := t.sessions[key]; !exists {
+			key = transportKey{source: info.TargetId, destination: info.SourceId}
+		}
+		if s, exists := t.sessions[key]; exists {
+			t.log.Debugf("ISO transport session aborted, reason: %d. Source: %d Destination: %d PGN: %d", data[1], key.source, key.destination, s.info.PGN)
+			delete(t.sessions, key)
+		}
+	case tpEndOfMessageAck:
+		// nothing to do, we've already output the packet
+	default:
+		t.log.Debugf("Unknown ISO transport control byte: %d. Source: %d", data[0], info.SourceId)
+	}
+}
+
+// handleDataTransfer adds the data frame to its session, returning the reassembled Packet if it's complete.
+func (t *TransportBuilder) handleDataTransfer(info pgn.MessageInfo, data []uint8) *pkt.Packet {
+	key := transportKey{source: info.SourceId, destination: info.TargetId}
+	s, exists := t.sessions[key]
+	if !exists {
+		t.log.Debugf("ISO transport data without a session. Source: %d Destination: %d", key.source, key.destination)
+		return nil
+	}
+
+	seq := int(data[0])
+	if seq == 0 || seq > len(s.frames) {
+		t.log.Debugf("ISO transport data with bad sequence number: %d. Source: %d", seq, key.source)
+		return nil
+	}
+	s.lastSeen = info.Timestamp
+	if s.frames[seq-1] == nil {
+		// retransmits are allowed in connection mode, so only count the first
+		s.received++
+	}
+	s.frames[seq-1] = append([]uint8(nil), data[1:8]...)
+	if int(s.received) < len(s.frames) {
+		return nil
+	}
+
+	delete(t.sessions, key)
+	results := make([]uint8, 0, len(s.frames)*7)
+	for _, f := range s.frames {
+		results = append(results, f...)
+	}
+	s.info.Timestamp = info.Timestamp
+	p := pkt.NewPacket(s.info, results[:s.size])
+	p.Complete = true
+	return p
+}
+
+// expire drops sessions that have timed out as of now.
+func (t *TransportBuilder) expire(now time.Time) {
+	for key, s := range t.sessions {
+		if now.Sub(s.lastSeen) > s.timeout {
+			t.log.Debugf("ISO transport session timed out. Source: %d Destination: %d PGN: %d", key.source, key.destination, s.info.PGN)
+			delete(t.sessions, key)
+		}
+	}
+}
+
+// embeddedPgn returns the PGN carried in the last 3 bytes of a TP.CM frame.
+func embeddedPgn(data []uint8) uint32 {
+	return uint32(data[5]) | uint32(data[6])<<8 | uint32(data[7])<<16
+}
@@ -0,0 +1,122 @@
+package canadapter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/brutella/can"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/boatkit-io/n2k/internal/testutil"
+	"github.com/boatkit-io/n2k/pkg/pgn"
+)
+
+// transportFrames returns the TP.CM (BAM or RTS) and TP.DT frames to send data as embedded.
+func transportFrames(embedded uint32, source, destination uint8, data []uint8) []*can.Frame {
+	numFrames := uint8((len(data) + 6) / 7)
+	control := uint8(tpRequestToSend)
+	if destination == 255 {
+		control = tpBroadcastAnnounce
+	}
+	cm := &can.Frame{
+		ID:     CanIdFromInfo(pgn.MessageInfo{PGN: TransportConnectionManagementPgn, SourceId: source, TargetId: destination, Priority: 7}),
+		Length: 8,
+		Data:   [8]uint8{control, uint8(len(data)), uint8(len(data) >> 8), numFrames, 0xFF, uint8(embedded), uint8(embedded >> 8), uint8(embedded >> 16)},
+	}
+	frames := []*can.Frame{cm}
+	for i := uint8(0); i < numFrames; i++ {
+		dt := &can.Frame{
+			ID:     CanIdFromInfo(pgn.MessageInfo{PGN: TransportDataTransferPgn, SourceId: source, TargetId: destination, Priority: 7}),
+			Length: 8,
+			Data:   [8]uint8{i + 1, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF},
+		}
+		copy(dt.Data[1:], data[int(i)*7:])
+		frames = append(frames, dt)
+	}
+	return frames
+}
+
+func TestTransportBAM(t *testing.T) {
+	c := NewCANAdapter(logrus.StandardLogger())
+	collector := &testutil.PacketCollector{}
+	c.SetOutput(collector)
+
+	info, data, err := pgn.Encode(pgn.ProductInformation{ModelId: "Engine", SoftwareVersionCode: "1.2.3"})
+	assert.NoError(t, err)
+	frames := transportFrames(info.PGN, 0x20, 255, data)
+	assert.Len(t, frames, 21)
+	for _, f := range frames {
+		c.HandleMessage(f)
+	}
+
+	// the transport frames pass through, plus the reassembled packet
+	assert.Len(t, collector.Packets, 22)
+	p := collector.Packets[len(collector.Packets)-2]
+	assert.Equal(t, uint32(126996), p.Info.PGN)
+	assert.Equal(t, uint8(0x20), p.Info.SourceId)
+	assert.Equal(t, uint8(255), p.Info.TargetId)
+	assert.Equal(t, data, p.Data)
+	assert.True(t, p.Complete)
+	assert.NotEmpty(t, p.Decoders)
+	assert.Equal(t, uint32(TransportDataTransferPgn), collector.Packets[len(collector.Packets)-1].Info.PGN)
+}
+
+func TestTransportSessions(t *testing.T) {
+	b := NewTransportBuilder(logrus.StandardLogger())
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	data := make([]uint8, MaxTransportLength)
+	for i := range data {
+		data[i] = uint8(i)
+	}
+
+	// add feeds frames to the builder, one per 10ms, returning the last packet
+	add := func(frames []*can.Frame) (result []uint8) {
+		for _, f := range frames {
+			info := NewPacketInfo(f)
+			info.Timestamp = start
+			start = start.Add(10 * time.Millisecond)
+			if p := b.Add(info, f.Data[:]); p != nil {
+				result = p.Data
+			}
+		}
+		return
+	}
+
+	// RTS/CTS, maximum length, with frames out of order and a retransmit
+	frames := transportFrames(65280, 1, 2, data)
+	assert.Len(t, frames, 256)
+	frames[5], frames[6] = frames[6], frames[5]
+	frames = append(frames[:100], append([]*can.Frame{frames[99]}, frames[100:]...)...)
+	assert.Equal(t, data, add(frames))
+
+	// too long
+	frames = transportFrames(65280, 1, 2, append(data, 1))
+	assert.Nil(t, add(frames[:1]))
+	assert.Empty(t, b.sessions)
+
+	// aborted by the destination
+	frames = transportFrames(65280, 1, 2, data[:20])
+	assert.Nil(t, add(frames[:2]))
+	abort := &can.Frame{
+		ID:     CanIdFromInfo(pgn.MessageInfo{PGN: TransportConnectionManagementPgn, SourceId: 2, TargetId: 1}),
+		Length: 8,
+		Data:   [8]uint8{tpAbort, 3, 0xFF, 0xFF, 0xFF, 0x00, 0xFF, 0x00},
+	}
+	assert.Nil(t, add([]*can.Frame{abort}))
+	assert.Nil(t, add(frames[2:]))
+
+	// timed out
+	frames = transportFrames(65280, 3, 255, data[:20])
+	assert.Nil(t, add(frames[:2]))
+	start = start.Add(BAMTimeout)
+	assert.Nil(t, add(frames[2:]))
+	assert.Empty(t, b.sessions)
+
+	// concurrent sessions from different sources
+	a := transportFrames(65280, 4, 255, data[:10])
+	c := transportFrames(65280, 5, 255, data[10:30])
+	assert.Nil(t, add([]*can.Frame{a[0], c[0], a[1], c[1], c[2]}))
+	assert.Equal(t, data[10:30], add(c[3:]))
+	assert.Equal(t, data[:10], add(a[2:]))
+}
@@ -0,0 +1,78 @@
+// Package pgnadapter implements the adapter interface for endpoints that deliver complete PGNs.
+// Gateways like the Actisense NGT-1 reassemble fast packets themselves, so there are no frames to combine.
+package pgnadapter
+
+import (
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/boatkit-io/n2k/pkg/adapter"
+	"github.com/boatkit-io/n2k/pkg/pgn"
+	"github.com/boatkit-io/n2k/pkg/pkt"
+)
+
+// Message is a complete PGN payload and its context, as delivered by an endpoint.
+type Message struct {
+	Info pgn.MessageInfo
+	Data []uint8
+}
+
+// PGNAdapter instances read complete PGN Messages from its input and outputs complete Packets.
+type PGNAdapter struct {
+	log *logrus.Logger
+
+	handler PacketHandler
+}
+
+// PacketHandler is an interface for the output handler for a PGNAdapter
+type PacketHandler interface {
+	HandlePacket(pkt.Packet)
+}
+
+// NewPGNAdapter instantiates a new PGNAdapter
+func NewPGNAdapter(log *logrus.Logger) *PGNAdapter {
+	return &PGNAdapter{
+		log: log,
+	}
+}
+
+// SetOutput assigns a handler for any ready packets
+func (a *PGNAdapter) SetOutput(ph PacketHandler) {
+	a.handler = ph
+}
+
+// HandleMessage is how you tell PGNAdapter to start processing a new message into a packet
+// Messages wrapped in an adapter.TimestampedMessage are given its timestamp, and messages without one the current time.
+func (a *PGNAdapter) HandleMessage(message adapter.Message) {
+	message, timestamp := adapter.Unwrap(message)
+	var m Message
+	switch msg := message.(type) {
+	case *Message:
+		m = *msg
+	case Message:
+		m = msg
+	default:
+		a.log.Warnf("PGNAdapter expected *pgnadapter.Message, received: %T", message)
+		return
+	}
+	if !timestamp.IsZero() {
+		m.Info.Timestamp = timestamp
+	} else if m.Info.Timestamp.IsZero() {
+		m.Info.Timestamp = time.Now()
+	}
+
+	packet := pkt.NewPacket(m.Info, m.Data)
+	if len(packet.ParseErrors) == 0 {
+		packet.Complete = true
+		packet.AddDecoders()
+	}
+	a.packetReady(packet)
+}
+
+// packetReady is a helper for fanning out completed packets to the handler
+func (a *PGNAdapter) packetReady(packet *pkt.Packet) {
+	if a.handler != nil {
+		a.handler.HandlePacket(*packet)
+	}
+}
@@ -0,0 +1,73 @@
+package pgnadapter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/boatkit-io/n2k/internal/testutil"
+	"github.com/boatkit-io/n2k/pkg/adapter"
+	"github.com/boatkit-io/n2k/pkg/pgn"
+	"github.com/boatkit-io/n2k/pkg/pkt"
+)
+
+// heading is the data of a VesselHeading (127250)
+var heading = []uint8{0xFF, 0x10, 0x27, 0xFF, 0x7F, 0xFF, 0x7F, 0xFD}
+
+func TestHandleMessage(t *testing.T) {
+	a := NewPGNAdapter(logrus.StandardLogger())
+	c := &testutil.PacketCollector{}
+	a.SetOutput(c)
+
+	info := testutil.Info(1, 0)
+	info.PGN = 127250
+	a.HandleMessage(Message{Info: info, Data: heading})
+	a.HandleMessage(&Message{Info: info, Data: heading})
+	later := testutil.Time.Add(time.Minute)
+	a.HandleMessage(adapter.TimestampedMessage{Timestamp: later, Message: &Message{Info: info, Data: heading}})
+	a.HandleMessage("not a message")
+	assert.Len(t, c.Packets, 3)
+
+	for _, p := range c.Packets {
+		assert.True(t, p.Complete)
+		assert.Equal(t, uint32(127250), p.Info.PGN)
+		assert.Equal(t, heading, p.Data)
+		assert.NotEmpty(t, p.Decoders)
+	}
+	assert.Equal(t, testutil.Time, c.Packets[0].Info.Timestamp)
+	assert.Equal(t, later, c.Packets[2].Info.Timestamp)
+
+	// decoded as PacketStruct would
+	s := &testutil.StructCollector{}
+	ps := pkt.NewPacketStruct()
+	ps.SetOutput(s)
+	ps.HandlePacket(c.Packets[0])
+	assert.IsType(t, pgn.VesselHeading{}, s.Structs[0])
+}
+
+func TestTimestamp(t *testing.T) {
+	a := NewPGNAdapter(logrus.StandardLogger())
+	c := &testutil.PacketCollector{}
+	a.SetOutput(c)
+
+	// messages without a timestamp are given the current time
+	before := time.Now()
+	a.HandleMessage(Message{Info: pgn.MessageInfo{PGN: 127250, SourceId: 1}, Data: heading})
+	assert.Len(t, c.Packets, 1)
+	assert.False(t, c.Packets[0].Info.Timestamp.Before(before))
+	assert.False(t, c.Packets[0].Info.Timestamp.After(time.Now()))
+}
+
+func TestUnknownPGN(t *testing.T) {
+	a := NewPGNAdapter(logrus.StandardLogger())
+	c := &testutil.PacketCollector{}
+	a.SetOutput(c)
+
+	a.HandleMessage(Message{Info: pgn.MessageInfo{PGN: 130000, SourceId: 1}, Data: []uint8{1, 2, 3}})
+	assert.Len(t, c.Packets, 1)
+	assert.False(t, c.Packets[0].Complete)
+	assert.NotEmpty(t, c.Packets[0].ParseErrors)
+	assert.Equal(t, []uint8{1, 2, 3}, c.Packets[0].UnknownPGN().Data)
+}
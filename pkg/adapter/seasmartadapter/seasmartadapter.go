@@ -0,0 +1,209 @@
+// Package seasmartadapter implements the adapter interface for SeaSmart.Net ($PCDIN) and MiniPlex ($MXPGN)
+// NMEA 0183 sentences, which wrap NMEA 2000 messages as hex text.
+package seasmartadapter
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/brutella/can"
+	"github.com/sirupsen/logrus"
+
+	"github.com/boatkit-io/n2k/pkg/adapter"
+	"github.com/boatkit-io/n2k/pkg/adapter/canadapter"
+	"github.com/boatkit-io/n2k/pkg/pgn"
+	"github.com/boatkit-io/n2k/pkg/pkt"
+)
+
+// seaSmartEpoch is the zero point of $PCDIN timestamps.
+var seaSmartEpoch = time.Date(2010, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// Sentence is a single $PCDIN or $MXPGN sentence, without its line ending.
+type Sentence string
+
+// SeaSmartAdapter instances read Sentences from its input and outputs complete Packets.
+// $PCDIN sentences carry a complete PGN along with the gateway's timestamp and the source address.
+// $MXPGN sentences carry a single canbus frame, so they're passed through a CANAdapter to combine fast packets.
+type SeaSmartAdapter struct {
+	log    *logrus.Logger
+	frames *canadapter.CANAdapter
+
+	handler canadapter.PacketHandler
+}
+
+// NewSeaSmartAdapter instantiates a new SeaSmartAdapter
+func NewSeaSmartAdapter(log *logrus.Logger) *SeaSmartAdapter {
+	return &SeaSmartAdapter{
+		log:    log,
+		frames: canadapter.NewCANAdapter(log),
+	}
+}
+
+// SetOutput assigns a handler for any ready packets
+func (a *SeaSmartAdapter) SetOutput(ph canadapter.PacketHandler) {
+	a.handler = ph
+	a.frames.SetOutput(ph)
+}
+
+// HandleMessage is how you tell SeaSmartAdapter to start processing a new message into a packet
+func (a *SeaSmartAdapter) HandleMessage(message adapter.Message) {
+	var s Sentence
+	switch msg := message.(type) {
+	case Sentence:
+		s = msg
+	case *Sentence:
+		s = *msg
+	default:
+		a.log.Warnf("SeaSmartAdapter expected seasmartadapter.Sentence, received: %T", message)
+		return
+	}
+
+	switch {
+	case strings.HasPrefix(string(s), "$PCDIN,"):
+		info, data, err := ParsePCDIN(string(s))
+		if err != nil {
+			a.log.Debugf("Discarding $PCDIN sentence %q: %v", s, err)
+			return
+		}
+		packet := pkt.NewPacket(info, data)
+		if len(packet.ParseErrors) == 0 {
+			packet.Complete = true
+			packet.AddDecoders()
+		}
+		if a.handler != nil {
+			a.handler.HandlePacket(*packet)
+		}
+	case strings.HasPrefix(string(s), "$MXPGN,"):
+		frame, err := ParseMXPGN(string(s))
+		if err != nil {
+			a.log.Debugf("Discarding $MXPGN sentence %q: %v", s, err)
+			return
+		}
+		a.frames.HandleMessage(frame)
+	default:
+		a.log.Debugf("Discarding unknown sentence %q", s)
+	}
+}
+
+// ParsePCDIN parses a sentence like "$PCDIN,01F119,00000000,0F,2AAF00D1067414FF*59" into the message's info and data.
+// The fields are the PGN, the gateway's timestamp, the source address and the data, all in hex.
+// The timestamp counts 1/1024 seconds since 2010 in base 32 digits; if it's zero the current time is used.
+// The sentence doesn't carry the priority or target, so they're left zero.
+func ParsePCDIN(sentence string) (pgn.MessageInfo, []uint8, error) {
+	fields, err := splitSentence(sentence, "$PCDIN", 4)
+	if err != nil {
+		return pgn.MessageInfo{}, nil, err
+	}
+
+	pgnNum, err := strconv.ParseUint(fields[0], 16, 32)
+	if err != nil {
+		return pgn.MessageInfo{}, nil, fmt.Errorf("invalid PGN: %q", fields[0])
+	}
+	ticks, err := strconv.ParseUint(fields[1], 32, 64)
+	if err != nil {
+		return pgn.MessageInfo{}, nil, fmt.Errorf("invalid timestamp: %q", fields[1])
+	}
+	source, err := strconv.ParseUint(fields[2], 16, 8)
+	if err != nil {
+		return pgn.MessageInfo{}, nil, fmt.Errorf("invalid source: %q", fields[2])
+	}
+	data, err := hex.DecodeString(fields[3])
+	if err != nil {
+		return pgn.MessageInfo{}, nil, fmt.Errorf("invalid data: %q", fields[3])
+	}
+
+	info := pgn.MessageInfo{
+		Timestamp: time.Now(),
+		PGN:       uint32(pgnNum),
+		SourceId:  uint8(source),
+	}
+	if ticks != 0 {
+		info.Timestamp = seaSmartEpoch.Add(time.Duration(ticks) * time.Second / 1024)
+	}
+	return info, data, nil
+}
+
+// ParseMXPGN parses a sentence like "$MXPGN,01F801,2801,C1308AC40C5DE343*19" into a canbus frame.
+// The fields are the PGN, an attribute word and the data, all in hex. The attribute word holds a send flag,
+// the priority, the data length and an address, which is the source for received frames and the
+// destination for frames being sent. The data bytes are in reverse order.
+func ParseMXPGN(sentence string) (*can.Frame, error) {
+	fields, err := splitSentence(sentence, "$MXPGN", 3)
+	if err != nil {
+		return nil, err
+	}
+
+	pgnNum, err := strconv.ParseUint(fields[0], 16, 32)
+	if err != nil || pgnNum > 0x3FFFF {
+		return nil, fmt.Errorf("invalid PGN: %q", fields[0])
+	}
+	attributes, err := strconv.ParseUint(fields[1], 16, 16)
+	if err != nil {
+		return nil, fmt.Errorf("invalid attributes: %q", fields[1])
+	}
+	data, err := hex.DecodeString(fields[2])
+	if err != nil {
+		return nil, fmt.Errorf("invalid data: %q", fields[2])
+	}
+	length := int((attributes >> 8) & 0xF)
+	if length > 8 || length != len(data) {
+		return nil, fmt.Errorf("data length %d doesn't match attributes: %d", len(data), length)
+	}
+
+	priority := uint32((attributes >> 12) & 0x7)
+	address := uint32(attributes & 0xFF)
+	frame := can.Frame{
+		ID:     priority<<26 | uint32(pgnNum)<<8,
+		Length: uint8(length),
+	}
+	if attributes&0x8000 == 0 {
+		frame.ID |= address
+	} else if (pgnNum&0xFF00)>>8 < 240 {
+		frame.ID = frame.ID&^0xFF00 | address<<8
+	}
+	for i := 0; i < length; i++ {
+		frame.Data[i] = data[length-1-i]
+	}
+	return &frame, nil
+}
+
+// Checksum returns the NMEA 0183 checksum of a sentence: the XOR of the characters between the '$' and the '*'.
+func Checksum(sentence string) uint8 {
+	sentence = strings.TrimPrefix(sentence, "$")
+	if i := strings.IndexByte(sentence, '*'); i >= 0 {
+		sentence = sentence[:i]
+	}
+	var cs uint8
+	for i := 0; i < len(sentence); i++ {
+		cs ^= sentence[i]
+	}
+	return cs
+}
+
+// splitSentence checks the sentence's type and checksum, returning its fields after the type.
+func splitSentence(sentence string, sentenceType string, numFields int) ([]string, error) {
+	sentence = strings.TrimSpace(sentence)
+	star := strings.LastIndexByte(sentence, '*')
+	if star < 0 {
+		return nil, fmt.Errorf("missing checksum")
+	}
+	cs, err := strconv.ParseUint(sentence[star+1:], 16, 8)
+	if err != nil {
+		return nil, fmt.Errorf("invalid checksum: %q", sentence[star+1:])
+	}
+	if uint8(cs) != Checksum(sentence) {
+		return nil, fmt.Errorf("checksum mismatch: %02X, calculated %02X", cs, Checksum(sentence))
+	}
+
+	fields := strings.Split(sentence[:star], ",")
+	if fields[0] != sentenceType {
+		return nil, fmt.Errorf("expected %s sentence, found %s", sentenceType, fields[0])
+	}
+	if len(fields) != numFields+1 {
+		return nil, fmt.Errorf("expected %d fields, found %d", numFields, len(fields)-1)
+	}
+	return fields[1:], nil
+}
@@ -0,0 +1,92 @@
+package seasmartadapter
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/boatkit-io/n2k/internal/testutil"
+)
+
+// withChecksum appends the checksum to a sentence.
+func withChecksum(s string) string {
+	return fmt.Sprintf("%s*%02X", s, Checksum(s))
+}
+
+func TestChecksum(t *testing.T) {
+	assert.Equal(t, uint8(0x59), Checksum("$PCDIN,01F119,00000000,0F,2AAF00D1067414FF*59"))
+}
+
+func TestParsePCDIN(t *testing.T) {
+	info, data, err := ParsePCDIN("$PCDIN,01F119,00000000,0F,2AAF00D1067414FF*59")
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(127257), info.PGN)
+	assert.Equal(t, uint8(15), info.SourceId)
+	assert.Equal(t, []uint8{0x2A, 0xAF, 0x00, 0xD1, 0x06, 0x74, 0x14, 0xFF}, data)
+	assert.WithinDuration(t, time.Now(), info.Timestamp, time.Second)
+
+	// 1024 ticks per second since 2010, in base 32: 0x400 is "100"
+	info, _, err = ParsePCDIN(withChecksum("$PCDIN,01F119,00000100,0F,2AAF00D1067414FF"))
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2010, 1, 1, 0, 0, 1, 0, time.UTC), info.Timestamp.UTC())
+
+	for _, bad := range []string{
+		"$PCDIN,01F119,00000000,0F,2AAF00D1067414FF*58",
+		"$PCDIN,01F119,00000000,0F,2AAF00D1067414FF",
+		withChecksum("$PCDIN,01F119,00000000,0F"),
+		withChecksum("$PCDIN,01F119,00000000,0F,2AAF0"),
+		withChecksum("$PCDIN,01G119,00000000,0F,2AAF00D1067414FF"),
+		withChecksum("$MXPGN,01F119,00000000,0F,2AAF00D1067414FF"),
+	} {
+		_, _, err = ParsePCDIN(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestParseMXPGN(t *testing.T) {
+	frame, err := ParseMXPGN("$MXPGN,01F801,2801,C1308AC40C5DE343*19")
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(0x09F80101), frame.ID)
+	assert.Equal(t, uint8(8), frame.Length)
+	assert.Equal(t, [8]uint8{0x43, 0xE3, 0x5D, 0x0C, 0xC4, 0x8A, 0x30, 0xC1}, frame.Data)
+
+	// a frame being sent to address 0x23
+	frame, err = ParseMXPGN(withChecksum("$MXPGN,00EA00,E323,01F014"))
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(0x18EA2300), frame.ID)
+	assert.Equal(t, uint8(3), frame.Length)
+
+	for _, bad := range []string{
+		withChecksum("$MXPGN,01F801,2701,C1308AC40C5DE343"),
+		withChecksum("$MXPGN,01F801,2801"),
+		withChecksum("$MXPGN,01F801,2X01,C1308AC40C5DE343"),
+	} {
+		_, err = ParseMXPGN(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestAdapter(t *testing.T) {
+	a := NewSeaSmartAdapter(logrus.StandardLogger())
+	c := &testutil.PacketCollector{}
+	a.SetOutput(c)
+
+	a.HandleMessage(Sentence("$PCDIN,01F119,00000000,0F,2AAF00D1067414FF*59"))
+	a.HandleMessage(Sentence("$MXPGN,01F801,2801,C1308AC40C5DE343*19"))
+	a.HandleMessage(Sentence("$PCDIN,01F119,00000000,0F,2AAF00D1067414FF*00"))
+	a.HandleMessage(Sentence("$GPGLL,4916.45,N,12311.12,W,225444,A*1D"))
+	a.HandleMessage("not a sentence")
+
+	assert.Len(t, c.Packets, 2)
+	assert.Equal(t, uint32(127257), c.Packets[0].Info.PGN)
+	assert.Equal(t, uint8(15), c.Packets[0].Info.SourceId)
+	assert.True(t, c.Packets[0].Complete)
+	assert.NotEmpty(t, c.Packets[0].Decoders)
+	assert.Equal(t, uint32(129025), c.Packets[1].Info.PGN)
+	assert.Equal(t, uint8(1), c.Packets[1].Info.SourceId)
+	assert.Equal(t, uint8(2), c.Packets[1].Info.Priority)
+	assert.True(t, c.Packets[1].Complete)
+}
@@ -0,0 +1,291 @@
+// Package addressclaim implements ISO 11783 address claiming, which a device must complete before it transmits on an NMEA 2000 network.
+package addressclaim
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/boatkit-io/n2k/pkg/adapter/canadapter"
+	"github.com/boatkit-io/n2k/pkg/endpoint"
+	"github.com/boatkit-io/n2k/pkg/pgn"
+)
+
+const (
+	// isoAddressClaimPgn is the PGN of IsoAddressClaim messages.
+	isoAddressClaimPgn = 60928
+	// claimPriority is the priority used for address claims and requests.
+	claimPriority = 6
+
+	// MaxAddress is the highest address a device can claim.
+	MaxAddress = 251
+	// NullAddress is the source address used by a device without an address.
+	NullAddress = 254
+	// GlobalAddress is the target address for broadcast messages.
+	GlobalAddress = 255
+
+	// ClaimDelay is how long a claim must go uncontested before the address can be used.
+	ClaimDelay = 250 * time.Millisecond
+)
+
+// State describes the progress of a Claimer.
+type State uint8
+
+const (
+	// Idle means Run hasn't been called.
+	Idle State = iota
+	// Claiming means an address claim has been sent, but ClaimDelay hasn't passed.
+	Claiming
+	// Claimed means the address has been claimed, and can be used to transmit.
+	Claimed
+	// Failed means no address could be claimed.
+	Failed
+)
+
+// Claimer claims and defends a source address for a device with the specified Name.
+// Send it the decoded structs from the network with HandleStruct (for example by subscribing it to all structs).
+// It responds to IsoAddressClaim, IsoRequest (for PGN 60928) and IsoCommandedAddress messages.
+// If it loses its address to a device with a lower (higher priority) Name it moves to the next free address,
+// unless its Name isn't arbitrary address capable.
+type Claimer struct {
+	log    *logrus.Logger
+	name   Name
+	writer *canadapter.StructWriter
+
+	mu         sync.Mutex
+	ctx        context.Context
+	state      State
+	address    uint8
+	claimDelay time.Duration
+	timer      *time.Timer
+	others     map[uint8]Name // addresses claimed by other devices
+	handler    func(address uint8, state State)
+}
+
+// NewClaimer creates a new instance that will claim preferredAddress for name, writing its messages to w.
+func NewClaimer(log *logrus.Logger, name Name, preferredAddress uint8, w endpoint.MessageWriter) *Claimer {
+	return &Claimer{
+		log:        log,
+		name:       name,
+		writer:     canadapter.NewStructWriter(w),
+		address:    preferredAddress,
+		claimDelay: ClaimDelay,
+		others:     make(map[uint8]Name),
+	}
+}
+
+// SetOutput assigns a callback for changes to the address or state.
+// It's called with the Claimer locked, so it mustn't call Claimer methods.
+func (c *Claimer) SetOutput(handler func(address uint8, state State)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.handler = handler
+}
+
+// Name method returns the Name being claimed.
+func (c *Claimer) Name() Name {
+	return c.name
+}
+
+// Address method returns the current address, and true if it's been claimed and can be used to transmit.
+func (c *Claimer) Address() (uint8, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.address, c.state == Claimed
+}
+
+// State method returns the current state.
+func (c *Claimer) State() State {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.state
+}
+
+// Run requests the claims of the other devices on the network, claims the preferred address, and then
+// defends it until the context is done.
+func (c *Claimer) Run(ctx context.Context) error {
+	c.mu.Lock()
+	c.ctx = ctx
+	c.mu.Unlock()
+
+	requested := uint32(isoAddressClaimPgn)
+	request := pgn.IsoRequest{
+		Info: pgn.MessageInfo{Priority: claimPriority, SourceId: NullAddress, TargetId: GlobalAddress},
+		Pgn:  &requested,
+	}
+	if err := c.writer.WriteStruct(ctx, request); err != nil {
+		return fmt.Errorf("requesting address claims: %w", err)
+	}
+
+	c.mu.Lock()
+	err := c.claim(c.address)
+	c.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	<-ctx.Done()
+
+	c.mu.Lock()
+	if c.timer != nil {
+		c.timer.Stop()
+	}
+	c.mu.Unlock()
+	return nil
+}
+
+// WriteStruct method sends p from the claimed address.
+// It returns an error if no address has been claimed.
+func (c *Claimer) WriteStruct(ctx context.Context, p any) error {
+	address, claimed := c.Address()
+	if !claimed {
+		return fmt.Errorf("no address claimed")
+	}
+
+	info, data, err := pgn.Encode(p)
+	if err != nil {
+		return err
+	}
+	info.SourceId = address
+	return c.writer.WriteData(ctx, info, data)
+}
+
+// HandleStruct method processes structs received from the network.
+func (c *Claimer) HandleStruct(p any) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.ctx == nil {
+		return
+	}
+
+	var err error
+	switch m := p.(type) {
+	case pgn.IsoAddressClaim:
+		err = c.handleClaim(m)
+	case pgn.IsoRequest:
+		if m.Pgn != nil && *m.Pgn == isoAddressClaimPgn && (m.Info.TargetId == GlobalAddress || m.Info.TargetId == c.address) {
+			err = c.sendClaim()
+		}
+	case pgn.IsoCommandedAddress:
+		err = c.handleCommandedAddress(m)
+	}
+	if err != nil {
+		c.log.Warnf("Address claim failed: %v", err)
+	}
+}
+
+// handleClaim responds to another device's claim, defending or giving up our address as needed.
+func (c *Claimer) handleClaim(m pgn.IsoAddressClaim) error {
+	other, err := NameFromClaim(m)
+	if err != nil {
+		return err
+	}
+	source := m.Info.SourceId
+	if other == c.name || source > MaxAddress {
+		// our own claim echoed back, or a device that couldn't claim an address
+		return nil
+	}
+	c.others[source] = other
+	for address, name := range c.others {
+		if name == other && address != source {
+			// the device moved
+			delete(c.others, address)
+		}
+	}
+
+	if source != c.address || c.state == Idle || c.state == Failed {
+		return nil
+	}
+	if c.name < other {
+		// we win, so reassert our claim
+		return c.sendClaim()
+	}
+
+	c.log.Infof("Lost address %d to NAME %016X", c.address, uint64(other))
+	if !c.name.ArbitraryAddressCapable() {
+		return c.fail()
+	}
+	next, ok := c.nextFreeAddress(c.address)
+	if !ok {
+		return c.fail()
+	}
+	return c.claim(next)
+}
+
+// handleCommandedAddress moves to a new address if the command is for our Name.
+func (c *Claimer) handleCommandedAddress(m pgn.IsoCommandedAddress) error {
+	name, err := NameFromCommandedAddress(m)
+	if err != nil {
+		return err
+	}
+	// the arbitrary address bit is reserved in the command, so it isn't compared
+	if (name^c.name)&^(1<<63) != 0 || m.NewSourceAddress == nil || *m.NewSourceAddress > MaxAddress {
+		return nil
+	}
+	c.log.Infof("Commanded to address %d", *m.NewSourceAddress)
+	return c.claim(*m.NewSourceAddress)
+}
+
+// nextFreeAddress returns the first address after from that hasn't been claimed by another device.
+func (c *Claimer) nextFreeAddress(from uint8) (uint8, bool) {
+	for i := 1; i <= MaxAddress+1; i++ {
+		address := uint8((int(from) + i) % (MaxAddress + 1))
+		if _, taken := c.others[address]; !taken {
+			return address, true
+		}
+	}
+	return 0, false
+}
+
+// claim sends a claim for address, and marks it claimed after claimDelay unless it's lost first.
+// Call with the lock held.
+func (c *Claimer) claim(address uint8) error {
+	c.address = address
+	c.setState(Claiming)
+	if c.timer != nil {
+		c.timer.Stop()
+	}
+	c.timer = time.AfterFunc(c.claimDelay, func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		if c.state == Claiming && c.address == address {
+			c.setState(Claimed)
+		}
+	})
+	return c.sendClaim()
+}
+
+// fail gives up claiming and sends a "cannot claim" message from the null address.
+// Call with the lock held.
+func (c *Claimer) fail() error {
+	if c.timer != nil {
+		c.timer.Stop()
+	}
+	c.address = NullAddress
+	c.setState(Failed)
+	return c.sendClaim()
+}
+
+// sendClaim writes an IsoAddressClaim for our Name from the current address.
+// Call with the lock held.
+func (c *Claimer) sendClaim() error {
+	info := pgn.MessageInfo{
+		PGN:      isoAddressClaimPgn,
+		Priority: claimPriority,
+		SourceId: c.address,
+		TargetId: GlobalAddress,
+	}
+	return c.writer.WriteData(c.ctx, info, c.name.Data())
+}
+
+// setState updates the state, and calls the handler.
+// Call with the lock held.
+func (c *Claimer) setState(state State) {
+	c.state = state
+	if c.handler != nil {
+		c.handler(c.address, state)
+	}
+}
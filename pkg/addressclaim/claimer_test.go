@@ -0,0 +1,247 @@
+package addressclaim
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brutella/can"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/boatkit-io/n2k/pkg/adapter/canadapter"
+	"github.com/boatkit-io/n2k/pkg/endpoint/captureendpoint"
+	"github.com/boatkit-io/n2k/pkg/pgn"
+)
+
+// testName returns a Name with the specified unique number
+func testName(unique uint32, arbitrary bool) Name {
+	return NameFields{
+		UniqueNumber:            unique,
+		ManufacturerCode:        pgn.ManufacturerCodeConst(2046),
+		DeviceFunction:          pgn.DeviceFunctionConst(130),
+		DeviceClass:             pgn.DeviceClassConst(25),
+		IndustryGroup:           pgn.IndustryCodeConst(4),
+		ArbitraryAddressCapable: arbitrary,
+	}.Name()
+}
+
+// claimFrom returns the IsoAddressClaim struct that would be decoded from name claiming address
+func claimFrom(t *testing.T, name Name, address uint8) pgn.IsoAddressClaim {
+	ret, err := pgn.DecodeIsoAddressClaim(pgn.MessageInfo{PGN: 60928, SourceId: address, TargetId: 255}, pgn.NewPgnDataStream(name.Data()))
+	assert.NoError(t, err)
+	return ret.(pgn.IsoAddressClaim)
+}
+
+// sent returns the info and data of the messages captured by ep, and resets it
+func sent(ep *captureendpoint.CaptureEndpoint) ([]pgn.MessageInfo, [][]uint8) {
+	var infos []pgn.MessageInfo
+	var datas [][]uint8
+	for _, m := range ep.Messages() {
+		f := m.(*can.Frame)
+		infos = append(infos, canadapter.NewPacketInfo(f))
+		datas = append(datas, append([]uint8(nil), f.Data[:f.Length]...))
+	}
+	ep.Reset()
+	return infos, datas
+}
+
+// waitForState polls until the claimer reaches state, or fails the test
+func waitForState(t *testing.T, c *Claimer, state State) {
+	assert.Eventually(t, func() bool { return c.State() == state }, time.Second, time.Millisecond)
+}
+
+// startClaimer runs a new claimer and waits until it's claimed its address
+func startClaimer(t *testing.T, name Name, preferred uint8) (*Claimer, *captureendpoint.CaptureEndpoint, context.CancelFunc) {
+	ep := captureendpoint.NewCaptureEndpoint()
+	c := NewClaimer(logrus.StandardLogger(), name, preferred, ep)
+	c.claimDelay = 10 * time.Millisecond
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() { _ = c.Run(ctx) }()
+	waitForState(t, c, Claimed)
+	return c, ep, cancel
+}
+
+func TestName(t *testing.T) {
+	fields := NameFields{
+		UniqueNumber:            0x1ABCDE,
+		ManufacturerCode:        pgn.ManufacturerCodeConst(135),
+		DeviceInstanceLower:     2,
+		DeviceInstanceUpper:     5,
+		DeviceFunction:          pgn.DeviceFunctionConst(140),
+		DeviceClass:             pgn.DeviceClassConst(60),
+		SystemInstance:          3,
+		IndustryGroup:           pgn.IndustryCodeConst(4),
+		ArbitraryAddressCapable: true,
+	}
+	n := fields.Name()
+	assert.Equal(t, fields, n.Fields())
+	assert.True(t, n.ArbitraryAddressCapable())
+
+	// matches the encoded claim
+	claim := claimFrom(t, n, 10)
+	assert.Equal(t, uint32(0x1ABCDE), *claim.UniqueNumber)
+	assert.Equal(t, pgn.DeviceClassConst(60), claim.DeviceClass)
+	fromClaim, err := NameFromClaim(claim)
+	assert.NoError(t, err)
+	assert.Equal(t, n, fromClaim)
+
+	lower := uint8(2)
+	upper := uint8(5)
+	system := uint8(3)
+	address := uint8(40)
+	commanded := pgn.IsoCommandedAddress{
+		UniqueNumber:        []uint8{0xDE, 0xBC, 0x1A},
+		ManufacturerCode:    fields.ManufacturerCode,
+		DeviceInstanceLower: &lower,
+		DeviceInstanceUpper: &upper,
+		DeviceFunction:      fields.DeviceFunction,
+		DeviceClass:         fields.DeviceClass,
+		SystemInstance:      &system,
+		IndustryCode:        fields.IndustryGroup,
+		NewSourceAddress:    &address,
+	}
+	fromCommand, err := NameFromCommandedAddress(commanded)
+	assert.NoError(t, err)
+	// the commanded address has no arbitrary address bit
+	assert.Equal(t, n&^(1<<63), fromCommand&^(1<<63))
+}
+
+func TestClaim(t *testing.T) {
+	name := testName(100, true)
+	c, ep, cancel := startClaimer(t, name, 35)
+	defer cancel()
+
+	infos, datas := sent(ep)
+	assert.Len(t, infos, 2)
+	// request for claims from the null address, then our claim
+	assert.Equal(t, uint32(59904), infos[0].PGN)
+	assert.Equal(t, uint8(NullAddress), infos[0].SourceId)
+	assert.Equal(t, uint8(GlobalAddress), infos[0].TargetId)
+	assert.Equal(t, []uint8{0x00, 0xEE, 0x00}, datas[0])
+	assert.Equal(t, uint32(60928), infos[1].PGN)
+	assert.Equal(t, uint8(35), infos[1].SourceId)
+	assert.Equal(t, name.Data(), datas[1])
+
+	address, claimed := c.Address()
+	assert.True(t, claimed)
+	assert.Equal(t, uint8(35), address)
+
+	// requests for claims are answered
+	requested := uint32(60928)
+	c.HandleStruct(pgn.IsoRequest{Info: pgn.MessageInfo{PGN: 59904, SourceId: 1, TargetId: 35}, Pgn: &requested})
+	c.HandleStruct(pgn.IsoRequest{Info: pgn.MessageInfo{PGN: 59904, SourceId: 1, TargetId: 36}, Pgn: &requested})
+	infos, datas = sent(ep)
+	assert.Len(t, infos, 1)
+	assert.Equal(t, uint8(35), infos[0].SourceId)
+	assert.Equal(t, name.Data(), datas[0])
+
+	// a higher NAME claiming our address loses, and we reassert our claim
+	c.HandleStruct(claimFrom(t, testName(200, true), 35))
+	infos, _ = sent(ep)
+	assert.Len(t, infos, 1)
+	assert.Equal(t, uint8(35), infos[0].SourceId)
+	assert.Equal(t, Claimed, c.State())
+
+	// a lower NAME wins, so we move to the next free address
+	c.HandleStruct(claimFrom(t, testName(7, true), 36))
+	c.HandleStruct(claimFrom(t, testName(50, true), 35))
+	infos, datas = sent(ep)
+	assert.Len(t, infos, 1)
+	assert.Equal(t, uint8(37), infos[0].SourceId)
+	assert.Equal(t, name.Data(), datas[0])
+	address, claimed = c.Address()
+	assert.False(t, claimed)
+	assert.Equal(t, uint8(37), address)
+	waitForState(t, c, Claimed)
+}
+
+func TestClaimFailure(t *testing.T) {
+	name := testName(100, false)
+	c, ep, cancel := startClaimer(t, name, 35)
+	defer cancel()
+	ep.Reset()
+
+	// not arbitrary address capable, so losing means we can't claim
+	c.HandleStruct(claimFrom(t, testName(50, false), 35))
+	infos, datas := sent(ep)
+	assert.Len(t, infos, 1)
+	assert.Equal(t, uint8(NullAddress), infos[0].SourceId)
+	assert.Equal(t, name.Data(), datas[0])
+	assert.Equal(t, Failed, c.State())
+	assert.Error(t, c.WriteStruct(context.Background(), pgn.IsoRequest{}))
+}
+
+func TestCommandedAddress(t *testing.T) {
+	name := testName(100, true)
+	c, ep, cancel := startClaimer(t, name, 35)
+	defer cancel()
+	ep.Reset()
+
+	fields := name.Fields()
+	newAddress := uint8(80)
+	commanded := pgn.IsoCommandedAddress{
+		UniqueNumber:     []uint8{100, 0, 0},
+		ManufacturerCode: fields.ManufacturerCode,
+		DeviceFunction:   fields.DeviceFunction,
+		DeviceClass:      fields.DeviceClass,
+		IndustryCode:     fields.IndustryGroup,
+		NewSourceAddress: &newAddress,
+	}
+	zero := uint8(0)
+	commanded.DeviceInstanceLower = &zero
+	commanded.DeviceInstanceUpper = &zero
+	commanded.SystemInstance = &zero
+
+	// commands for other NAMEs are ignored
+	other := commanded
+	other.UniqueNumber = []uint8{101, 0, 0}
+	c.HandleStruct(other)
+	infos, _ := sent(ep)
+	assert.Empty(t, infos)
+
+	c.HandleStruct(commanded)
+	infos, _ = sent(ep)
+	assert.Len(t, infos, 1)
+	assert.Equal(t, uint8(80), infos[0].SourceId)
+	waitForState(t, c, Claimed)
+
+	// once claimed, messages are sent from the address
+	assert.NoError(t, c.WriteStruct(context.Background(), pgn.IsoRequest{Info: pgn.MessageInfo{SourceId: 3, TargetId: 255}}))
+	infos, _ = sent(ep)
+	assert.Len(t, infos, 1)
+	assert.Equal(t, uint8(80), infos[0].SourceId)
+}
+
+func TestCommandedAddressNotArbitrary(t *testing.T) {
+	// the command's reserved arbitrary address bit is set whatever the device's NAME
+	name := testName(100, false)
+	c, ep, cancel := startClaimer(t, name, 35)
+	defer cancel()
+	ep.Reset()
+
+	fields := name.Fields()
+	zero := uint8(0)
+	newAddress := uint8(80)
+	commanded := pgn.IsoCommandedAddress{
+		UniqueNumber:        []uint8{100, 0, 0},
+		ManufacturerCode:    fields.ManufacturerCode,
+		DeviceInstanceLower: &zero,
+		DeviceInstanceUpper: &zero,
+		DeviceFunction:      fields.DeviceFunction,
+		DeviceClass:         fields.DeviceClass,
+		SystemInstance:      &zero,
+		IndustryCode:        fields.IndustryGroup,
+		NewSourceAddress:    &newAddress,
+	}
+	fromCommand, err := NameFromCommandedAddress(commanded)
+	assert.NoError(t, err)
+	assert.NotEqual(t, name, fromCommand)
+
+	c.HandleStruct(commanded)
+	infos, datas := sent(ep)
+	assert.Len(t, infos, 1)
+	assert.Equal(t, uint8(80), infos[0].SourceId)
+	assert.Equal(t, name.Data(), datas[0])
+	waitForState(t, c, Claimed)
+}
@@ -0,0 +1,90 @@
+package addressclaim
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/boatkit-io/n2k/pkg/pgn"
+)
+
+// Name is the 64 bit ISO 11783 NAME that uniquely identifies a device on the network.
+// It's the data of an IsoAddressClaim, read as a little endian number.
+// When two devices claim the same address, the device with the lower NAME keeps it.
+type Name uint64
+
+// NameFields holds the components of a Name.
+type NameFields struct {
+	UniqueNumber            uint32 // 21 bits
+	ManufacturerCode        pgn.ManufacturerCodeConst
+	DeviceInstanceLower     uint8 // 3 bits
+	DeviceInstanceUpper     uint8 // 5 bits
+	DeviceFunction          pgn.DeviceFunctionConst
+	DeviceClass             pgn.DeviceClassConst
+	SystemInstance          uint8 // 4 bits
+	IndustryGroup           pgn.IndustryCodeConst
+	ArbitraryAddressCapable bool
+}
+
+// Name method packs the fields into a Name. Fields are truncated to their bit lengths.
+func (f NameFields) Name() Name {
+	n := uint64(f.UniqueNumber & 0x1FFFFF)
+	n |= uint64(f.ManufacturerCode&0x7FF) << 21
+	n |= uint64(f.DeviceInstanceLower&0x7) << 32
+	n |= uint64(f.DeviceInstanceUpper&0x1F) << 35
+	n |= uint64(f.DeviceFunction&0xFF) << 40
+	n |= 1 << 48 // reserved
+	n |= uint64(f.DeviceClass&0x7F) << 49
+	n |= uint64(f.SystemInstance&0xF) << 56
+	n |= uint64(f.IndustryGroup&0x7) << 60
+	if f.ArbitraryAddressCapable {
+		n |= 1 << 63
+	}
+	return Name(n)
+}
+
+// Fields method unpacks the Name into its components.
+func (n Name) Fields() NameFields {
+	return NameFields{
+		UniqueNumber:            uint32(n & 0x1FFFFF),
+		ManufacturerCode:        pgn.ManufacturerCodeConst((n >> 21) & 0x7FF),
+		DeviceInstanceLower:     uint8((n >> 32) & 0x7),
+		DeviceInstanceUpper:     uint8((n >> 35) & 0x1F),
+		DeviceFunction:          pgn.DeviceFunctionConst((n >> 40) & 0xFF),
+		DeviceClass:             pgn.DeviceClassConst((n >> 49) & 0x7F),
+		SystemInstance:          uint8((n >> 56) & 0xF),
+		IndustryGroup:           pgn.IndustryCodeConst((n >> 60) & 0x7),
+		ArbitraryAddressCapable: n>>63 == 1,
+	}
+}
+
+// ArbitraryAddressCapable method returns true if the device can move to another address after losing a claim.
+func (n Name) ArbitraryAddressCapable() bool {
+	return n>>63 == 1
+}
+
+// Data method returns the Name as the data of an IsoAddressClaim.
+func (n Name) Data() []uint8 {
+	return binary.LittleEndian.AppendUint64(nil, uint64(n))
+}
+
+// NameFromClaim returns the Name claimed by an IsoAddressClaim.
+func NameFromClaim(c pgn.IsoAddressClaim) (Name, error) {
+	return nameFromStruct(c)
+}
+
+// NameFromCommandedAddress returns the Name of the device targeted by an IsoCommandedAddress.
+func NameFromCommandedAddress(c pgn.IsoCommandedAddress) (Name, error) {
+	return nameFromStruct(c)
+}
+
+// nameFromStruct encodes p and returns the first 8 bytes of the result as a Name.
+func nameFromStruct(p any) (Name, error) {
+	_, data, err := pgn.Encode(p)
+	if err != nil {
+		return 0, err
+	}
+	if len(data) < 8 {
+		return 0, fmt.Errorf("encoded %T too short for a NAME: %d bytes", p, len(data))
+	}
+	return Name(binary.LittleEndian.Uint64(data)), nil
+}
@@ -0,0 +1,133 @@
+package ais
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// earthRadius is the mean radius of the earth in meters.
+const earthRadius = 6371000
+
+// Approach describes how close a target will come to own ship, if both hold their course and speed.
+type Approach struct {
+	Target Target
+	// Range is the distance to the target now, in meters.
+	Range float64
+	// Bearing is the direction of the target from own ship now, in radians from true north.
+	Bearing float64
+	// CPA is the distance at the closest point of approach, in meters.
+	CPA float64
+	// TCPA is the time until the closest point of approach, negative if it's passed.
+	TCPA time.Duration
+}
+
+// Approach returns the closest point of approach of the target with MMSI mmsi. It needs own ship's and the target's
+// positions; a missing course or speed is taken as stationary. Own ship's position and COG/SOG mustn't be older than
+// the own ship maximum age (see SetOwnShipMaxAge).
+func (t *Tracker) Approach(mmsi uint32) (Approach, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	target, ok := t.targets[mmsi]
+	if !ok || t.lost(target) {
+		return Approach{}, false
+	}
+	return t.approach(target)
+}
+
+// Approaches returns the closest points of approach of all the targets that have them, soonest first, with those
+// already passed last.
+func (t *Tracker) Approaches() []Approach {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	ret := make([]Approach, 0, len(t.targets))
+	for _, target := range t.targets {
+		if t.lost(target) {
+			continue
+		}
+		if a, ok := t.approach(target); ok {
+			ret = append(ret, a)
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if (ret[i].TCPA < 0) != (ret[j].TCPA < 0) {
+			return ret[i].TCPA >= 0
+		}
+		if ret[i].TCPA != ret[j].TCPA {
+			return ret[i].TCPA < ret[j].TCPA
+		}
+		return ret[i].Target.MMSI < ret[j].Target.MMSI
+	})
+	return ret
+}
+
+// Dangerous returns the approaches that will come within maxCPA meters within maxTCPA, soonest first.
+// Targets already within maxCPA, but moving away, are included too.
+func (t *Tracker) Dangerous(maxCPA float64, maxTCPA time.Duration) []Approach {
+	var ret []Approach
+	for _, a := range t.Approaches() {
+		if a.CPA <= maxCPA && (a.TCPA >= 0 && a.TCPA <= maxTCPA || a.Range <= maxCPA) {
+			ret = append(ret, a)
+		}
+	}
+	return ret
+}
+
+// approach returns the target's closest point of approach, now. The mutex must be held.
+func (t *Tracker) approach(target *Target) (Approach, bool) {
+	if !target.HasPosition() || t.own.latitude == nil || t.own.longitude == nil {
+		return Approach{}, false
+	}
+	now := t.now()
+	hasMotion := t.own.cog != nil || t.own.sog != nil
+	if now.Sub(t.own.positionTime) > t.ownAge || (hasMotion && now.Sub(t.own.motionTime) > t.ownAge) {
+		// own ship's moved on since, by an unknown amount
+		return Approach{}, false
+	}
+
+	// a flat earth centered on own ship is accurate enough at AIS ranges
+	lat0 := *t.own.latitude * math.Pi / 180
+	x := (*target.Longitude - *t.own.longitude) * math.Pi / 180 * math.Cos(lat0) * earthRadius
+	y := (*target.Latitude - *t.own.latitude) * math.Pi / 180 * earthRadius
+	if math.Abs(*target.Longitude-*t.own.longitude) > 180 {
+		// across the antimeridian
+		x -= math.Copysign(2*math.Pi*math.Cos(lat0)*earthRadius, x)
+	}
+
+	// project both positions forward to now
+	ovx, ovy := components(t.own.cog, t.own.sog)
+	tvx, tvy := components(target.COG, target.SOG)
+	ownAge := now.Sub(t.own.positionTime).Seconds()
+	targetAge := now.Sub(target.PositionTime).Seconds()
+	x += tvx*targetAge - ovx*ownAge
+	y += tvy*targetAge - ovy*ownAge
+
+	a := Approach{
+		Target:  *target,
+		Range:   math.Hypot(x, y),
+		Bearing: math.Mod(math.Atan2(x, y)+2*math.Pi, 2*math.Pi),
+	}
+
+	// relative velocity
+	vx, vy := tvx-ovx, tvy-ovy
+	speed2 := vx*vx + vy*vy
+	if speed2 < 1e-9 {
+		// not moving relative to each other, so it's as close as it gets
+		a.CPA = a.Range
+		return a, true
+	}
+	tcpa := -(x*vx + y*vy) / speed2
+	a.CPA = math.Hypot(x+vx*tcpa, y+vy*tcpa)
+	a.TCPA = time.Duration(tcpa * float64(time.Second))
+	return a, true
+}
+
+// components returns the east and north components of a course and speed, or zero if either is missing.
+func components(cog, sog *float64) (float64, float64) {
+	if cog == nil || sog == nil {
+		return 0, 0
+	}
+	return *sog * math.Sin(*cog), *sog * math.Cos(*cog)
+}
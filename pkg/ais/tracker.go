@@ -0,0 +1,320 @@
+// Package ais tracks AIS targets, merging their position and static reports, and computes their closest point of
+// approach to own ship.
+package ais
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/boatkit-io/tugboat/pkg/units"
+
+	"github.com/boatkit-io/n2k/pkg/pgn"
+	"github.com/boatkit-io/n2k/pkg/subscribe"
+)
+
+// DefaultMaxAge is how long a target is kept without a report before it's lost, unless set with SetMaxAge.
+// Class B targets at anchor only report every 3 minutes, so it's longer than that.
+const DefaultMaxAge = 6 * time.Minute
+
+// DefaultOwnShipMaxAge is how old own ship's position and COG/SOG can be before approaches can't be computed, unless
+// set with SetOwnShipMaxAge. GPS units send them several times a second.
+const DefaultOwnShipMaxAge = 10 * time.Second
+
+// Class is the kind of AIS station a target is.
+type Class int
+
+const (
+	// ClassUnknown is a target only seen in reports that don't say, like Class B static data.
+	ClassUnknown Class = iota
+	// ClassA is a Class A transponder, on larger vessels.
+	ClassA
+	// ClassB is a Class B transponder.
+	ClassB
+	// AtoN is an aid to navigation.
+	AtoN
+)
+
+// String method returns the class's name.
+func (c Class) String() string {
+	switch c {
+	case ClassUnknown:
+		return "Unknown"
+	case ClassA:
+		return "Class A"
+	case ClassB:
+		return "Class B"
+	case AtoN:
+		return "AtoN"
+	default:
+		return fmt.Sprintf("Class(%d)", int(c))
+	}
+}
+
+// Target is an AIS station, with what's been reported about it. Values that haven't been reported are nil or empty.
+// Angles are in radians from true north, speeds in m/s and dimensions in meters.
+type Target struct {
+	MMSI  uint32
+	Class Class
+
+	Name        string
+	Callsign    string
+	Destination string
+	ShipType    pgn.ShipTypeConst
+	NavStatus   *pgn.NavStatusConst // Class A only
+	Length      *float64
+	Beam        *float64
+
+	Latitude  *float64
+	Longitude *float64
+	COG       *float64
+	SOG       *float64
+	Heading   *float64
+
+	// PositionTime is the timestamp of the last position report.
+	PositionTime time.Time
+	// FirstSeen is the timestamp of the first report.
+	FirstSeen time.Time
+	// LastSeen is the timestamp of the most recent report.
+	LastSeen time.Time
+}
+
+// HasPosition method returns true if the target's position has been reported.
+func (t Target) HasPosition() bool {
+	return t.Latitude != nil && t.Longitude != nil
+}
+
+// ownShip is own ship's position and motion.
+type ownShip struct {
+	latitude, longitude *float64
+	positionTime        time.Time
+	cog, sog            *float64
+	motionTime          time.Time
+}
+
+// Tracker tracks AIS targets by MMSI from the structs it receives, and own ship from PositionRapidUpdate and
+// CogSogRapidUpdate (referenced to true north).
+// Feed it all structs, either by subscribing it (see Subscribe) or calling HandleStruct directly.
+type Tracker struct {
+	mu      sync.Mutex
+	targets map[uint32]*Target
+	own     ownShip
+	ownAge  time.Duration
+	maxAge  time.Duration
+	now     func() time.Time
+	handler func(Target)
+}
+
+// NewTracker returns a new Tracker without any targets.
+func NewTracker() *Tracker {
+	return &Tracker{
+		targets: make(map[uint32]*Target),
+		ownAge:  DefaultOwnShipMaxAge,
+		maxAge:  DefaultMaxAge,
+		now:     time.Now,
+	}
+}
+
+// SetMaxAge sets how long a target is kept without a report before it's lost.
+func (t *Tracker) SetMaxAge(maxAge time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.maxAge = maxAge
+}
+
+// SetOwnShipMaxAge sets how old own ship's position and COG/SOG can be before approaches can't be computed.
+func (t *Tracker) SetOwnShipMaxAge(maxAge time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.ownAge = maxAge
+}
+
+// SetClock replaces the clock used for targets' ages and to project positions forward, which is time.Now by default.
+// Replays should use a clock that follows the replay.
+func (t *Tracker) SetClock(now func() time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.now = now
+}
+
+// SetOutput assigns a callback for when a target is added or updated.
+func (t *Tracker) SetOutput(handler func(Target)) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.handler = handler
+}
+
+// Subscribe subscribes the tracker to all structs from subs.
+func (t *Tracker) Subscribe(subs *subscribe.SubscribeManager) (subscribe.SubscriptionId, error) {
+	return subs.SubscribeToAllStructs(t.HandleStruct)
+}
+
+// HandleStruct updates the tracker from a struct received from the network.
+func (t *Tracker) HandleStruct(p any) {
+	t.mu.Lock()
+	var target *Target
+	switch m := p.(type) {
+	case pgn.PositionRapidUpdate:
+		if m.Latitude != nil && m.Longitude != nil {
+			t.own.latitude, t.own.longitude = m.Latitude, m.Longitude
+			t.own.positionTime = m.Info.Timestamp
+		}
+	case pgn.CogSogRapidUpdate:
+		if m.CogReference == pgn.True {
+			t.own.cog, t.own.sog = pgn.Float64(m.Cog), pgn.MetersPerSecond(m.Sog)
+			t.own.motionTime = m.Info.Timestamp
+		}
+	case pgn.AisClassAPositionReport:
+		target = t.target(m.UserId, ClassA, m.Info)
+		if target != nil {
+			target.setPosition(m.Info, m.Latitude, m.Longitude, m.Cog, m.Sog, m.Heading)
+			status := m.NavStatus
+			target.NavStatus = &status
+		}
+	case pgn.AisClassBPositionReport:
+		target = t.target(m.UserId, ClassB, m.Info)
+		if target != nil {
+			target.setPosition(m.Info, m.Latitude, m.Longitude, m.Cog, m.Sog, m.Heading)
+		}
+	case pgn.AisClassBExtendedPositionReport:
+		target = t.target(m.UserId, ClassB, m.Info)
+		if target != nil {
+			target.setPosition(m.Info, m.Latitude, m.Longitude, m.Cog, m.Sog, m.TrueHeading)
+			target.setName(m.Name)
+			target.ShipType = m.TypeOfShip
+			target.setDimensions(m.Length, m.Beam)
+		}
+	case pgn.AisClassAStaticAndVoyageRelatedData:
+		target = t.target(m.UserId, ClassA, m.Info)
+		if target != nil {
+			target.setName(m.Name)
+			target.Callsign = pgn.CleanString(m.Callsign)
+			target.Destination = pgn.CleanString(m.Destination)
+			target.ShipType = m.TypeOfShip
+			target.setDimensions(m.Length, m.Beam)
+		}
+	case pgn.AisClassBStaticDataMsg24PartA:
+		target = t.target(m.UserId, ClassUnknown, m.Info)
+		if target != nil {
+			target.setName(m.Name)
+		}
+	case pgn.AisClassBStaticDataMsg24PartB:
+		target = t.target(m.UserId, ClassUnknown, m.Info)
+		if target != nil {
+			target.Callsign = pgn.CleanString(m.Callsign)
+			target.ShipType = m.TypeOfShip
+			target.setDimensions(m.Length, m.Beam)
+		}
+	case pgn.AisAidsToNavigationAtonReport:
+		target = t.target(m.UserId, AtoN, m.Info)
+		if target != nil {
+			target.setPosition(m.Info, m.Latitude, m.Longitude, nil, nil, nil)
+			target.setName(m.AtonName)
+			target.setDimensions(m.LengthDiameter, m.BeamDiameter)
+		}
+	}
+	var copied Target
+	handler := t.handler
+	if target != nil {
+		copied = *target
+	}
+	t.mu.Unlock()
+
+	if target != nil && handler != nil {
+		handler(copied)
+	}
+}
+
+// target returns the target with MMSI mmsi, adding it if it's new, or nil if mmsi is missing. The mutex must be held.
+func (t *Tracker) target(mmsi *uint32, class Class, info pgn.MessageInfo) *Target {
+	if mmsi == nil {
+		return nil
+	}
+	target := t.targets[*mmsi]
+	if target == nil {
+		target = &Target{MMSI: *mmsi, FirstSeen: info.Timestamp}
+		t.targets[*mmsi] = target
+	}
+	if class != ClassUnknown {
+		target.Class = class
+	}
+	target.LastSeen = info.Timestamp
+	return target
+}
+
+// Targets returns the targets that aren't lost, ordered by MMSI.
+func (t *Tracker) Targets() []Target {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	ret := make([]Target, 0, len(t.targets))
+	for _, target := range t.targets {
+		if !t.lost(target) {
+			ret = append(ret, *target)
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].MMSI < ret[j].MMSI })
+	return ret
+}
+
+// Target returns the target with MMSI mmsi, if it's not lost.
+func (t *Tracker) Target(mmsi uint32) (Target, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	target, ok := t.targets[mmsi]
+	if !ok || t.lost(target) {
+		return Target{}, false
+	}
+	return *target, true
+}
+
+// Prune removes lost targets, returning them.
+func (t *Tracker) Prune() []Target {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var lost []Target
+	for mmsi, target := range t.targets {
+		if t.lost(target) {
+			lost = append(lost, *target)
+			delete(t.targets, mmsi)
+		}
+	}
+	sort.Slice(lost, func(i, j int) bool { return lost[i].MMSI < lost[j].MMSI })
+	return lost
+}
+
+// lost returns true if the target hasn't reported for longer than the maximum age. The mutex must be held.
+func (t *Tracker) lost(target *Target) bool {
+	return t.now().Sub(target.LastSeen) > t.maxAge
+}
+
+// setPosition method records a position report.
+func (target *Target) setPosition(info pgn.MessageInfo, latitude, longitude *float64, cog *float32, sog *units.Velocity, heading *float32) {
+	if latitude != nil && longitude != nil {
+		target.Latitude, target.Longitude = latitude, longitude
+		target.PositionTime = info.Timestamp
+	}
+	target.COG = pgn.Float64(cog)
+	target.SOG = pgn.MetersPerSecond(sog)
+	target.Heading = pgn.Float64(heading)
+}
+
+// setName method records the target's name, if it's been reported.
+func (target *Target) setName(name string) {
+	if name = pgn.CleanString(name); name != "" {
+		target.Name = name
+	}
+}
+
+// setDimensions method records the target's size, if it's been reported.
+func (target *Target) setDimensions(length, beam *units.Distance) {
+	if l := pgn.Meters(length); l != nil {
+		target.Length = l
+	}
+	if b := pgn.Meters(beam); b != nil {
+		target.Beam = b
+	}
+}
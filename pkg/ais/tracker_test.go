@@ -0,0 +1,197 @@
+package ais
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/boatkit-io/tugboat/pkg/units"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/boatkit-io/n2k/internal/testutil"
+	"github.com/boatkit-io/n2k/pkg/pgn"
+	"github.com/boatkit-io/n2k/pkg/subscribe"
+)
+
+// newTracker returns a Tracker with its clock at testutil.Time, and a function to move it.
+func newTracker() (*Tracker, func(time.Duration)) {
+	tr := NewTracker()
+	now := testutil.Time
+	tr.SetClock(func() time.Time { return now })
+	return tr, func(d time.Duration) { now = now.Add(d) }
+}
+
+func TestTracker(t *testing.T) {
+	tr, advance := newTracker()
+	var updates []Target
+	tr.SetOutput(func(target Target) { updates = append(updates, target) })
+	subs := subscribe.New()
+	_, err := tr.Subscribe(subs)
+	assert.NoError(t, err)
+
+	subs.HandleStruct(pgn.AisClassAPositionReport{Info: testutil.Info(0, 0), UserId: testutil.Ptr(uint32(244060807)),
+		Latitude: testutil.Ptr(52.0), Longitude: testutil.Ptr(4.0), Cog: testutil.Ptr(float32(1.5)),
+		Sog: testutil.Ptr(units.NewVelocity(units.Knots, 10)), Heading: testutil.Ptr(float32(1.4)),
+		NavStatus: pgn.UnderWayUsingEngine})
+	subs.HandleStruct(pgn.AisClassAStaticAndVoyageRelatedData{Info: testutil.Info(0, 1*time.Second),
+		UserId: testutil.Ptr(uint32(244060807)), Name: "NORTHERN STAR@@@@", Callsign: "PD1234 ",
+		Destination: "ROTTERDAM", TypeOfShip: pgn.ShipTypeConst(70),
+		Length: testutil.Ptr(units.NewDistance(units.Meter, 120)),
+		Beam:   testutil.Ptr(units.NewDistance(units.Meter, 18))})
+	subs.HandleStruct(pgn.AisClassBStaticDataMsg24PartA{Info: testutil.Info(0, 2*time.Second),
+		UserId: testutil.Ptr(uint32(244123456)), Name: "SEA BREEZE"})
+	subs.HandleStruct(pgn.AisClassBPositionReport{Info: testutil.Info(0, 3*time.Second),
+		UserId: testutil.Ptr(uint32(244123456)), Latitude: testutil.Ptr(52.1), Longitude: testutil.Ptr(4.1)})
+	subs.HandleStruct(pgn.AisAidsToNavigationAtonReport{Info: testutil.Info(0, 4*time.Second),
+		UserId: testutil.Ptr(uint32(992446000)), Latitude: testutil.Ptr(52.2), Longitude: testutil.Ptr(4.2),
+		AtonName: "BUOY 1"})
+	subs.HandleStruct(pgn.AisClassBPositionReport{Info: testutil.Info(0, 5*time.Second)})
+	assert.Len(t, updates, 5)
+
+	targets := tr.Targets()
+	assert.Len(t, targets, 3)
+	a := targets[0]
+	assert.Equal(t, uint32(244060807), a.MMSI)
+	assert.Equal(t, ClassA, a.Class)
+	assert.Equal(t, "NORTHERN STAR", a.Name)
+	assert.Equal(t, "PD1234", a.Callsign)
+	assert.Equal(t, "ROTTERDAM", a.Destination)
+	assert.Equal(t, pgn.UnderWayUsingEngine, *a.NavStatus)
+	assert.Equal(t, 120.0, *a.Length)
+	assert.InDelta(t, 5.144, *a.SOG, 0.001)
+	assert.Equal(t, testutil.Time, a.PositionTime)
+	assert.Equal(t, testutil.Time.Add(time.Second), a.LastSeen)
+
+	b, ok := tr.Target(244123456)
+	assert.True(t, ok)
+	assert.Equal(t, ClassB, b.Class)
+	assert.Equal(t, "SEA BREEZE", b.Name)
+	assert.True(t, b.HasPosition())
+	assert.Equal(t, testutil.Time.Add(2*time.Second), b.FirstSeen)
+	assert.Equal(t, AtoN, targets[2].Class)
+	assert.Equal(t, "BUOY 1", targets[2].Name)
+
+	// targets are lost when they stop reporting
+	advance(DefaultMaxAge + 5*time.Second)
+	subs.HandleStruct(pgn.AisClassBPositionReport{Info: pgn.MessageInfo{Timestamp: testutil.Time.Add(DefaultMaxAge)},
+		UserId: testutil.Ptr(uint32(244123456)), Latitude: testutil.Ptr(52.1), Longitude: testutil.Ptr(4.1)})
+	_, ok = tr.Target(244060807)
+	assert.False(t, ok)
+	assert.Len(t, tr.Targets(), 1)
+	lost := tr.Prune()
+	assert.Len(t, lost, 2)
+	assert.Equal(t, uint32(244060807), lost[0].MMSI)
+	assert.Len(t, tr.targets, 1)
+}
+
+func TestApproach(t *testing.T) {
+	tr, advance := newTracker()
+
+	// a degree of latitude
+	degree := earthRadius * math.Pi / 180
+	north := func(meters float64) *float64 { return testutil.Ptr(meters / degree) }
+	east := func(meters float64) *float64 { return testutil.Ptr(meters / degree) } // at the equator
+
+	tr.HandleStruct(pgn.PositionRapidUpdate{Info: testutil.Info(0, 0), Latitude: testutil.Ptr(0.0),
+		Longitude: testutil.Ptr(0.0)})
+	tr.HandleStruct(pgn.CogSogRapidUpdate{Info: testutil.Info(0, 0), CogReference: pgn.True,
+		Cog: testutil.Ptr(float32(0)), Sog: testutil.Ptr(units.NewVelocity(units.MetersPerSecond, 5))})
+
+	// head on, 2km ahead
+	tr.HandleStruct(pgn.AisClassAPositionReport{Info: testutil.Info(0, 0), UserId: testutil.Ptr(uint32(1)),
+		Latitude: north(2000), Longitude: testutil.Ptr(0.0), Cog: testutil.Ptr(float32(math.Pi)),
+		Sog: testutil.Ptr(units.NewVelocity(units.MetersPerSecond, 5))})
+	// crossing from starboard, passing 500m ahead
+	tr.HandleStruct(pgn.AisClassAPositionReport{Info: testutil.Info(0, 0), UserId: testutil.Ptr(uint32(2)),
+		Latitude: north(1500), Longitude: east(1000), Cog: testutil.Ptr(float32(3 * math.Pi / 2)),
+		Sog: testutil.Ptr(units.NewVelocity(units.MetersPerSecond, 5))})
+	// moving away astern
+	tr.HandleStruct(pgn.AisClassBPositionReport{Info: testutil.Info(0, 0), UserId: testutil.Ptr(uint32(3)),
+		Latitude: north(-300), Longitude: testutil.Ptr(0.0), Cog: testutil.Ptr(float32(math.Pi)),
+		Sog: testutil.Ptr(units.NewVelocity(units.MetersPerSecond, 2))})
+	// no position
+	tr.HandleStruct(pgn.AisClassAStaticAndVoyageRelatedData{Info: testutil.Info(0, 0), UserId: testutil.Ptr(uint32(4))})
+
+	a, ok := tr.Approach(1)
+	assert.True(t, ok)
+	assert.InDelta(t, 2000, a.Range, 0.1)
+	assert.InDelta(t, 0, a.Bearing, 0.001)
+	assert.InDelta(t, 0, a.CPA, 0.1)
+	assert.InDelta(t, 200, a.TCPA.Seconds(), 0.1)
+
+	a, ok = tr.Approach(2)
+	assert.True(t, ok)
+	assert.InDelta(t, math.Hypot(1000, 1500), a.Range, 0.1)
+	// relative motion is (-5, -5), so the closest approach is where it crosses the line x = y
+	assert.InDelta(t, 500/math.Sqrt2, a.CPA, 0.1)
+	assert.InDelta(t, 250, a.TCPA.Seconds(), 0.1)
+
+	a, ok = tr.Approach(3)
+	assert.True(t, ok)
+	assert.InDelta(t, 300, a.Range, 0.1)
+	assert.InDelta(t, math.Pi, a.Bearing, 0.001)
+	assert.Less(t, a.TCPA, time.Duration(0))
+
+	_, ok = tr.Approach(4)
+	assert.False(t, ok)
+	_, ok = tr.Approach(5)
+	assert.False(t, ok)
+
+	approaches := tr.Approaches()
+	assert.Len(t, approaches, 3)
+	assert.Equal(t, uint32(1), approaches[0].Target.MMSI)
+	assert.Equal(t, uint32(3), approaches[2].Target.MMSI)
+
+	dangerous := tr.Dangerous(400, 10*time.Minute)
+	assert.Len(t, dangerous, 3)
+	assert.Equal(t, uint32(3), dangerous[2].Target.MMSI)
+	assert.Len(t, tr.Dangerous(100, 3*time.Minute), 0)
+
+	// positions are projected forward
+	tr.SetOwnShipMaxAge(2 * time.Minute)
+	advance(100 * time.Second)
+	a, _ = tr.Approach(1)
+	assert.InDelta(t, 1000, a.Range, 0.1)
+	assert.InDelta(t, 100, a.TCPA.Seconds(), 0.1)
+
+	// but not once own ship's data is too old
+	advance(time.Minute)
+	_, ok = tr.Approach(1)
+	assert.False(t, ok)
+	assert.Len(t, tr.Approaches(), 0)
+}
+
+func TestOwnShipMaxAge(t *testing.T) {
+	tr, advance := newTracker()
+	cogSog := pgn.CogSogRapidUpdate{Info: testutil.Info(0, 0), CogReference: pgn.True, Cog: testutil.Ptr(float32(0)),
+		Sog: testutil.Ptr(units.NewVelocity(units.MetersPerSecond, 5))}
+	tr.HandleStruct(pgn.PositionRapidUpdate{Info: testutil.Info(0, 0), Latitude: testutil.Ptr(0.0),
+		Longitude: testutil.Ptr(0.0)})
+	tr.HandleStruct(cogSog)
+	tr.HandleStruct(pgn.AisClassAPositionReport{Info: testutil.Info(0, 0), UserId: testutil.Ptr(uint32(1)),
+		Latitude: testutil.Ptr(0.01), Longitude: testutil.Ptr(0.0)})
+
+	advance(DefaultOwnShipMaxAge)
+	_, ok := tr.Approach(1)
+	assert.True(t, ok)
+
+	// the position is still current, but COG/SOG isn't
+	advance(5 * time.Second)
+	tr.HandleStruct(pgn.PositionRapidUpdate{Info: testutil.Info(0, 15*time.Second), Latitude: testutil.Ptr(0.0),
+		Longitude: testutil.Ptr(0.0)})
+	_, ok = tr.Approach(1)
+	assert.False(t, ok)
+
+	cogSog.Info = testutil.Info(0, 15*time.Second)
+	tr.HandleStruct(cogSog)
+	_, ok = tr.Approach(1)
+	assert.True(t, ok)
+
+	// the position isn't
+	tr.SetOwnShipMaxAge(time.Second)
+	advance(2 * time.Second)
+	cogSog.Info = testutil.Info(0, 17*time.Second)
+	tr.HandleStruct(cogSog)
+	_, ok = tr.Approach(1)
+	assert.False(t, ok)
+}
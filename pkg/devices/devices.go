@@ -0,0 +1,284 @@
+// Package devices maintains a registry of the devices on an NMEA 2000 network.
+package devices
+
+import (
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/boatkit-io/n2k/pkg/addressclaim"
+	"github.com/boatkit-io/n2k/pkg/pgn"
+	"github.com/boatkit-io/n2k/pkg/subscribe"
+)
+
+// Device describes a device seen on the network.
+// Devices are identified by their Name, since a device's address can change when it (re)claims one.
+type Device struct {
+	// Name is the device's NAME from its address claim, or 0 if it hasn't been seen.
+	Name addressclaim.Name
+	// Address is the device's current source address, or addressclaim.NullAddress if it's lost its address.
+	Address uint8
+	// Manufacturer is from the device's NAME.
+	Manufacturer pgn.ManufacturerCodeConst
+
+	// ProductInformation is the device's most recent ProductInformation, if seen.
+	ProductInformation *pgn.ProductInformation
+	// ConfigurationInformation is the device's most recent ConfigurationInformation, if seen.
+	ConfigurationInformation *pgn.ConfigurationInformation
+	// Heartbeat is the device's most recent Heartbeat, if seen.
+	Heartbeat *pgn.Heartbeat
+	// TransmitPgns lists the PGNs the device reports it transmits.
+	TransmitPgns []uint32
+	// ReceivePgns lists the PGNs the device reports it receives.
+	ReceivePgns []uint32
+
+	// FirstSeen is the timestamp of the first message from the device.
+	FirstSeen time.Time
+	// LastSeen is the timestamp of the most recent message from the device.
+	LastSeen time.Time
+}
+
+// Model method returns the device's model id, or "" if unknown.
+func (d Device) Model() string {
+	if d.ProductInformation == nil {
+		return ""
+	}
+	return d.ProductInformation.ModelId
+}
+
+// SoftwareVersion method returns the device's software version, or "" if unknown.
+func (d Device) SoftwareVersion() string {
+	if d.ProductInformation == nil {
+		return ""
+	}
+	return d.ProductInformation.SoftwareVersionCode
+}
+
+// Registry tracks the devices on the network from the structs it receives.
+// Feed it all structs, either by subscribing it (see Subscribe) or calling HandleStruct directly.
+type Registry struct {
+	mu        sync.Mutex
+	devices   []*Device
+	byAddress map[uint8]*Device
+	byName    map[addressclaim.Name]*Device
+	handler   func(Device)
+}
+
+// NewRegistry returns a new, empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		byAddress: make(map[uint8]*Device),
+		byName:    make(map[addressclaim.Name]*Device),
+	}
+}
+
+// SetOutput assigns a callback for when a device is added, or its address or information changes.
+// It's not called for changes to LastSeen alone.
+func (r *Registry) SetOutput(handler func(Device)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.handler = handler
+}
+
+// Subscribe subscribes the registry to all structs from subs.
+func (r *Registry) Subscribe(subs *subscribe.SubscribeManager) (subscribe.SubscriptionId, error) {
+	return subs.SubscribeToAllStructs(r.HandleStruct)
+}
+
+// Devices returns a copy of the devices seen, ordered by address.
+func (r *Registry) Devices() []Device {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ret := make([]Device, 0, len(r.devices))
+	for _, d := range r.devices {
+		ret = append(ret, d.copy())
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].Address < ret[j].Address })
+	return ret
+}
+
+// ForAddress returns the device currently using address.
+func (r *Registry) ForAddress(address uint8) (Device, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if d, ok := r.byAddress[address]; ok {
+		return d.copy(), true
+	}
+	return Device{}, false
+}
+
+// ForName returns the device with the specified Name.
+func (r *Registry) ForName(name addressclaim.Name) (Device, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if d, ok := r.byName[name]; ok {
+		return d.copy(), true
+	}
+	return Device{}, false
+}
+
+// NameFor returns the Name of the device that sent a message, if its address claim has been seen.
+// It returns a uint64 so the Registry is a subscribe.NameResolver.
+func (r *Registry) NameFor(info pgn.MessageInfo) (uint64, bool) {
+	d, ok := r.ForAddress(info.SourceId)
+	if !ok || d.Name == 0 {
+		return 0, false
+	}
+	return uint64(d.Name), true
+}
+
+// HandleStruct updates the registry from a struct received from the network.
+func (r *Registry) HandleStruct(p any) {
+	info, ok := pgn.InfoOf(p)
+	if !ok || info.SourceId > addressclaim.MaxAddress {
+		return
+	}
+
+	r.mu.Lock()
+	changed := false
+	var d *Device
+	if claim, isClaim := p.(pgn.IsoAddressClaim); isClaim {
+		d, changed = r.handleClaim(info, claim)
+		if d == nil {
+			r.mu.Unlock()
+			return
+		}
+	} else {
+		d = r.byAddress[info.SourceId]
+		if d == nil {
+			d = r.add(info)
+			changed = true
+		}
+		changed = d.update(p) || changed
+	}
+	d.LastSeen = info.Timestamp
+	var handler func(Device)
+	var copied Device
+	if changed && r.handler != nil {
+		handler = r.handler
+		copied = d.copy()
+	}
+	r.mu.Unlock()
+
+	if handler != nil {
+		handler(copied)
+	}
+}
+
+// handleClaim updates the registry for an address claim, following devices that change address.
+// It returns the claiming device, and true if anything changed, or nil if the claim's NAME can't be decoded.
+func (r *Registry) handleClaim(info pgn.MessageInfo, claim pgn.IsoAddressClaim) (*Device, bool) {
+	name, err := addressclaim.NameFromClaim(claim)
+	if err != nil {
+		return nil, false
+	}
+
+	current := r.byAddress[info.SourceId]
+	if current != nil && current.Name == name {
+		return current, false
+	}
+
+	d := r.byName[name]
+	if current != nil && current.Name == 0 {
+		// we've seen messages from the address, but not its claim
+		if d == nil {
+			d = current
+		} else {
+			// from a known device that's changed address, so keep what it's sent since
+			d.merge(current)
+			r.devices = slices.DeleteFunc(r.devices, func(other *Device) bool { return other == current })
+		}
+		current = nil
+	}
+	if current != nil {
+		// another device had the address, so it's lost it
+		current.Address = addressclaim.NullAddress
+	}
+	if d == nil {
+		d = r.add(info)
+	} else if d.Address != info.SourceId && r.byAddress[d.Address] == d {
+		delete(r.byAddress, d.Address)
+	}
+
+	d.Name = name
+	d.Manufacturer = name.Fields().ManufacturerCode
+	d.Address = info.SourceId
+	r.byName[name] = d
+	r.byAddress[info.SourceId] = d
+	return d, true
+}
+
+// add creates a new device for the message's source.
+func (r *Registry) add(info pgn.MessageInfo) *Device {
+	d := &Device{
+		Address:   info.SourceId,
+		FirstSeen: info.Timestamp,
+	}
+	r.devices = append(r.devices, d)
+	r.byAddress[info.SourceId] = d
+	return d
+}
+
+// update method records the information in p, returning true if it's one of the tracked structs.
+func (d *Device) update(p any) bool {
+	switch m := p.(type) {
+	case pgn.ProductInformation:
+		d.ProductInformation = &m
+	case pgn.ConfigurationInformation:
+		d.ConfigurationInformation = &m
+	case pgn.Heartbeat:
+		d.Heartbeat = &m
+	case pgn.PgnListTransmitAndReceive:
+		pgns := make([]uint32, 0, len(m.Repeating1))
+		for _, rep := range m.Repeating1 {
+			if rep.Pgn != nil {
+				pgns = append(pgns, *rep.Pgn)
+			}
+		}
+		switch m.FunctionCode {
+		case pgn.TransmitPGNList:
+			d.TransmitPgns = pgns
+		case pgn.ReceivePGNList:
+			d.ReceivePgns = pgns
+		default:
+			return false
+		}
+	default:
+		return false
+	}
+	return true
+}
+
+// merge method records the information in other, a more recent record of the same device.
+func (d *Device) merge(other *Device) {
+	if other.ProductInformation != nil {
+		d.ProductInformation = other.ProductInformation
+	}
+	if other.ConfigurationInformation != nil {
+		d.ConfigurationInformation = other.ConfigurationInformation
+	}
+	if other.Heartbeat != nil {
+		d.Heartbeat = other.Heartbeat
+	}
+	if other.TransmitPgns != nil {
+		d.TransmitPgns = other.TransmitPgns
+	}
+	if other.ReceivePgns != nil {
+		d.ReceivePgns = other.ReceivePgns
+	}
+	if other.LastSeen.After(d.LastSeen) {
+		d.LastSeen = other.LastSeen
+	}
+}
+
+// copy method returns a copy of the device that doesn't share slices with it.
+func (d *Device) copy() Device {
+	c := *d
+	c.TransmitPgns = append([]uint32(nil), d.TransmitPgns...)
+	c.ReceivePgns = append([]uint32(nil), d.ReceivePgns...)
+	return c
+}
@@ -0,0 +1,131 @@
+package devices
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/boatkit-io/n2k/pkg/addressclaim"
+	"github.com/boatkit-io/n2k/pkg/pgn"
+	"github.com/boatkit-io/n2k/pkg/subscribe"
+)
+
+// claimFrom returns the IsoAddressClaim struct that would be decoded from name claiming address
+func claimFrom(t *testing.T, name addressclaim.Name, address uint8, ts time.Time) pgn.IsoAddressClaim {
+	info := pgn.MessageInfo{PGN: 60928, SourceId: address, TargetId: 255, Timestamp: ts}
+	ret, err := pgn.DecodeIsoAddressClaim(info, pgn.NewPgnDataStream(name.Data()))
+	assert.NoError(t, err)
+	return ret.(pgn.IsoAddressClaim)
+}
+
+func TestRegistry(t *testing.T) {
+	r := NewRegistry()
+	subs := subscribe.New()
+	_, err := r.Subscribe(subs)
+	assert.NoError(t, err)
+	var changes []Device
+	r.SetOutput(func(d Device) { changes = append(changes, d) })
+
+	t0 := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	gps := addressclaim.NameFields{UniqueNumber: 1, ManufacturerCode: pgn.ManufacturerCodeConst(135), ArbitraryAddressCapable: true}.Name()
+	plotter := addressclaim.NameFields{UniqueNumber: 2, ManufacturerCode: pgn.ManufacturerCodeConst(1855), ArbitraryAddressCapable: true}.Name()
+
+	subs.HandleStruct(claimFrom(t, gps, 10, t0))
+	subs.HandleStruct(claimFrom(t, plotter, 20, t0))
+	subs.HandleStruct(pgn.ProductInformation{
+		Info:                pgn.MessageInfo{SourceId: 10, Timestamp: t0.Add(time.Second)},
+		ModelId:             "GPS 9000",
+		SoftwareVersionCode: "2.1",
+	})
+	subs.HandleStruct(pgn.PgnListTransmitAndReceive{
+		Info:         pgn.MessageInfo{SourceId: 10, Timestamp: t0.Add(2 * time.Second)},
+		FunctionCode: pgn.TransmitPGNList,
+		Repeating1: []pgn.PgnListTransmitAndReceiveRepeating1{
+			{Pgn: func() *uint32 { v := uint32(129025); return &v }()},
+			{Pgn: func() *uint32 { v := uint32(129026); return &v }()},
+		},
+	})
+	assert.Len(t, changes, 4)
+
+	// other messages update last seen, without a change notification
+	subs.HandleStruct(pgn.CogSogRapidUpdate{Info: pgn.MessageInfo{SourceId: 10, Timestamp: t0.Add(3 * time.Second)}})
+	assert.Len(t, changes, 4)
+
+	d, ok := r.ForAddress(10)
+	assert.True(t, ok)
+	assert.Equal(t, gps, d.Name)
+	assert.Equal(t, pgn.ManufacturerCodeConst(135), d.Manufacturer)
+	assert.Equal(t, "GPS 9000", d.Model())
+	assert.Equal(t, "2.1", d.SoftwareVersion())
+	assert.Equal(t, []uint32{129025, 129026}, d.TransmitPgns)
+	assert.Equal(t, t0, d.FirstSeen)
+	assert.Equal(t, t0.Add(3*time.Second), d.LastSeen)
+
+	name, ok := r.NameFor(pgn.MessageInfo{SourceId: 20})
+	assert.True(t, ok)
+	assert.Equal(t, uint64(plotter), name)
+	_, ok = r.NameFor(pgn.MessageInfo{SourceId: 30})
+	assert.False(t, ok)
+
+	// the plotter wins address 10, so the gps moves to 11
+	subs.HandleStruct(claimFrom(t, plotter, 10, t0.Add(4*time.Second)))
+	d, _ = r.ForName(gps)
+	assert.Equal(t, uint8(addressclaim.NullAddress), d.Address)
+	subs.HandleStruct(claimFrom(t, gps, 11, t0.Add(4*time.Second)))
+	d, _ = r.ForName(gps)
+	assert.Equal(t, uint8(11), d.Address)
+	assert.Equal(t, "GPS 9000", d.Model())
+	d, _ = r.ForAddress(10)
+	assert.Equal(t, plotter, d.Name)
+	_, ok = r.ForAddress(20)
+	assert.False(t, ok)
+
+	devices := r.Devices()
+	assert.Len(t, devices, 2)
+	assert.Equal(t, uint8(10), devices[0].Address)
+	assert.Equal(t, uint8(11), devices[1].Address)
+
+	// messages from an address before its claim create a device that's completed by the claim
+	subs.HandleStruct(pgn.Heartbeat{Info: pgn.MessageInfo{SourceId: 40, Timestamp: t0}})
+	d, ok = r.ForAddress(40)
+	assert.True(t, ok)
+	assert.Equal(t, addressclaim.Name(0), d.Name)
+	assert.NotNil(t, d.Heartbeat)
+	other := addressclaim.NameFields{UniqueNumber: 3}.Name()
+	subs.HandleStruct(claimFrom(t, other, 40, t0.Add(time.Second)))
+	d, ok = r.ForName(other)
+	assert.True(t, ok)
+	assert.NotNil(t, d.Heartbeat)
+	assert.Len(t, r.Devices(), 3)
+}
+
+func TestRegistryClaims(t *testing.T) {
+	r := NewRegistry()
+	t0 := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	// claims that can't be decoded are ignored, rather than sharing a device
+	bad := uint32(1 << 22)
+	r.HandleStruct(pgn.IsoAddressClaim{Info: pgn.MessageInfo{SourceId: 10, Timestamp: t0}, UniqueNumber: &bad})
+	r.HandleStruct(pgn.IsoAddressClaim{Info: pgn.MessageInfo{SourceId: 11, Timestamp: t0}, UniqueNumber: &bad})
+	assert.Empty(t, r.Devices())
+
+	// a known device's messages from its new address, seen before its claim there, are merged into it
+	gps := addressclaim.NameFields{UniqueNumber: 1, ManufacturerCode: pgn.ManufacturerCodeConst(135)}.Name()
+	r.HandleStruct(claimFrom(t, gps, 10, t0))
+	r.HandleStruct(pgn.ProductInformation{Info: pgn.MessageInfo{SourceId: 10, Timestamp: t0}, ModelId: "GPS 9000"})
+	r.HandleStruct(pgn.Heartbeat{Info: pgn.MessageInfo{SourceId: 12, Timestamp: t0.Add(time.Second)}})
+	assert.Len(t, r.Devices(), 2)
+	r.HandleStruct(claimFrom(t, gps, 12, t0.Add(2*time.Second)))
+
+	devices := r.Devices()
+	assert.Len(t, devices, 1)
+	d := devices[0]
+	assert.Equal(t, gps, d.Name)
+	assert.Equal(t, uint8(12), d.Address)
+	assert.Equal(t, "GPS 9000", d.Model())
+	assert.NotNil(t, d.Heartbeat)
+	assert.Equal(t, t0, d.FirstSeen)
+	_, ok := r.ForAddress(10)
+	assert.False(t, ok)
+}
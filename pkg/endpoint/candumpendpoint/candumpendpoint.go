@@ -0,0 +1,216 @@
+// Package candumpendpoint contains the CandumpEndpoint struct described below
+package candumpendpoint
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/brutella/can"
+	"github.com/sirupsen/logrus"
+
+	"github.com/boatkit-io/n2k/pkg/adapter"
+	"github.com/boatkit-io/n2k/pkg/endpoint"
+	"github.com/boatkit-io/n2k/pkg/endpoint/linereader"
+)
+
+// DefaultInterface is the interface name written when none is specified.
+const DefaultInterface = "can0"
+
+// CandumpEndpoint reads and writes the can-utils candump log format, as recorded by "candump -l" and
+// replayed by canplayer. Each line holds an absolute timestamp, the interface name and a frame, like
+// "(1700000000.123456) can0 09F80101#0102030405060708".
+// Read frames are sent to its output as an adapter.TimestampedMessage wrapping a *can.Frame, with the
+// recorded timestamp; connect it to a canadapter instance.
+// Frames written to it are appended to its writer, timestamped with the current time unless they're wrapped in an
+// adapter.TimestampedMessage.
+type CandumpEndpoint struct {
+	log      *logrus.Logger
+	reader   *linereader.LineReader
+	realtime bool
+
+	writeMu sync.Mutex
+	writer  io.Writer
+	iface   string
+
+	ctx     context.Context
+	first   time.Time // timestamp of the first frame read
+	started time.Time // when the first frame was read
+
+	handler endpoint.MessageHandler
+}
+
+// NewCandumpFileEndpoint builds a new CandumpEndpoint reading the log file at path.
+func NewCandumpFileEndpoint(log *logrus.Logger, path string) *CandumpEndpoint {
+	return &CandumpEndpoint{log: log, reader: linereader.NewFileLineReader(path)}
+}
+
+// NewCandumpStreamEndpoint builds a new CandumpEndpoint reading log lines from stream, for example os.Stdin
+// piped from "candump -L".
+func NewCandumpStreamEndpoint(log *logrus.Logger, stream io.Reader) *CandumpEndpoint {
+	return &CandumpEndpoint{log: log, reader: linereader.NewStreamLineReader(stream)}
+}
+
+// NewCandumpWriterEndpoint builds a new CandumpEndpoint that writes log lines to w, for interface iface
+// (DefaultInterface if empty). Its Run method waits for the context to be done.
+func NewCandumpWriterEndpoint(log *logrus.Logger, w io.Writer, iface string) *CandumpEndpoint {
+	if iface == "" {
+		iface = DefaultInterface
+	}
+	return &CandumpEndpoint{log: log, writer: w, iface: iface}
+}
+
+// SetRealtime sets whether frames are read at the pace they were recorded, rather than as fast as possible.
+// Call it before Run.
+func (c *CandumpEndpoint) SetRealtime(realtime bool) {
+	c.realtime = realtime
+}
+
+// SetOutput subscribes a callback handler for whenever a message is ready
+func (c *CandumpEndpoint) SetOutput(mh endpoint.MessageHandler) {
+	c.handler = mh
+}
+
+// Run reads frames until the context is done or the end of the input.
+// An endpoint without an input waits for the context to be done.
+func (c *CandumpEndpoint) Run(ctx context.Context) error {
+	if c.reader == nil {
+		<-ctx.Done()
+		return nil
+	}
+	c.ctx = ctx
+	return c.reader.Run(ctx, c.handleLine)
+}
+
+// Close will stop the endpoint from processing further frames
+func (c *CandumpEndpoint) Close() error {
+	if c.reader == nil {
+		return nil
+	}
+	return c.reader.Close()
+}
+
+// WriteMessage appends a *can.Frame (or can.Frame), optionally wrapped in an adapter.TimestampedMessage, to the log.
+func (c *CandumpEndpoint) WriteMessage(ctx context.Context, msg adapter.Message) error {
+	msg, timestamp := adapter.Unwrap(msg)
+	if timestamp.IsZero() {
+		timestamp = time.Now()
+	}
+	var f can.Frame
+	switch m := msg.(type) {
+	case *can.Frame:
+		f = *m
+	case can.Frame:
+		f = m
+	default:
+		return fmt.Errorf("CandumpEndpoint expected *can.Frame, received: %T", msg)
+	}
+	if c.writer == nil {
+		return fmt.Errorf("CandumpEndpoint has no writer")
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	_, err := io.WriteString(c.writer, FormatLine(timestamp, c.iface, &f)+"\n")
+	return err
+}
+
+// handleLine parses a log line, passing on its frame.
+func (c *CandumpEndpoint) handleLine(line string) {
+	timestamp, _, frame, err := ParseLine(line)
+	if err != nil {
+		c.log.Debugf("Discarding candump line %q: %v", line, err)
+		return
+	}
+	if c.realtime {
+		c.wait(timestamp)
+	}
+	if c.handler != nil && c.ctx.Err() == nil {
+		c.handler.HandleMessage(adapter.TimestampedMessage{Timestamp: timestamp, Message: frame})
+	}
+}
+
+// wait sleeps until it's time to pass on a frame recorded at timestamp, or the context is done.
+func (c *CandumpEndpoint) wait(timestamp time.Time) {
+	if c.first.IsZero() {
+		c.first = timestamp
+		c.started = time.Now()
+		return
+	}
+	delay := time.Until(c.started.Add(timestamp.Sub(c.first)))
+	if delay <= 0 {
+		return
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-c.ctx.Done():
+	}
+}
+
+// ParseLine parses a candump log line into its timestamp, interface name and frame.
+func ParseLine(line string) (time.Time, string, *can.Frame, error) {
+	fields := strings.Fields(line)
+	if len(fields) < 3 {
+		return time.Time{}, "", nil, fmt.Errorf("expected 3 fields, found %d", len(fields))
+	}
+
+	stamp := fields[0]
+	if len(stamp) < 3 || stamp[0] != '(' || stamp[len(stamp)-1] != ')' {
+		return time.Time{}, "", nil, fmt.Errorf("invalid timestamp: %q", stamp)
+	}
+	secs, micros, found := strings.Cut(stamp[1:len(stamp)-1], ".")
+	s, err := strconv.ParseInt(secs, 10, 64)
+	if err != nil {
+		return time.Time{}, "", nil, fmt.Errorf("invalid timestamp: %q", stamp)
+	}
+	var ns int64
+	if found {
+		// pad or truncate the fraction to nanoseconds
+		fraction := (micros + "000000000")[:9]
+		if ns, err = strconv.ParseInt(fraction, 10, 64); err != nil {
+			return time.Time{}, "", nil, fmt.Errorf("invalid timestamp: %q", stamp)
+		}
+	}
+
+	id, data, found := strings.Cut(fields[2], "#")
+	if !found {
+		return time.Time{}, "", nil, fmt.Errorf("missing '#': %q", fields[2])
+	}
+	if len(id) != 8 {
+		return time.Time{}, "", nil, fmt.Errorf("expected an extended (8 digit) frame ID: %q", id)
+	}
+	frameId, err := strconv.ParseUint(id, 16, 32)
+	if err != nil || frameId > can.MaskIDEff {
+		return time.Time{}, "", nil, fmt.Errorf("invalid frame ID: %q", id)
+	}
+	if strings.HasPrefix(data, "#") || strings.HasPrefix(data, "R") {
+		return time.Time{}, "", nil, fmt.Errorf("CAN FD and remote frames aren't supported: %q", fields[2])
+	}
+	bytes, err := hex.DecodeString(data)
+	if err != nil || len(bytes) > 8 {
+		return time.Time{}, "", nil, fmt.Errorf("invalid data: %q", data)
+	}
+
+	frame := can.Frame{ID: uint32(frameId), Length: uint8(len(bytes))}
+	copy(frame.Data[:], bytes)
+	return time.Unix(s, ns), fields[1], &frame, nil
+}
+
+// FormatLine formats a frame as a candump log line, without a line ending.
+func FormatLine(timestamp time.Time, iface string, frame *can.Frame) string {
+	length := int(frame.Length)
+	if length > 8 {
+		length = 8
+	}
+	return fmt.Sprintf("(%d.%06d) %s %08X#%X", timestamp.Unix(), timestamp.Nanosecond()/1000, iface, frame.ID&can.MaskIDEff, frame.Data[:length])
+}
@@ -0,0 +1,100 @@
+package candumpendpoint
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brutella/can"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/boatkit-io/n2k/internal/testutil"
+	"github.com/boatkit-io/n2k/pkg/adapter"
+)
+
+func TestParseLine(t *testing.T) {
+	ts, iface, f, err := ParseLine("(1700000000.123456) can0 09F80101#0102030405060708")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Unix(1700000000, 123456000), ts)
+	assert.Equal(t, "can0", iface)
+	assert.Equal(t, uint32(0x09F80101), f.ID)
+	assert.Equal(t, uint8(8), f.Length)
+	assert.Equal(t, [8]uint8{1, 2, 3, 4, 5, 6, 7, 8}, f.Data)
+	assert.Equal(t, "(1700000000.123456) can0 09F80101#0102030405060708", FormatLine(ts, iface, f))
+
+	// interface names of any length, and short frames
+	ts, iface, f, err = ParseLine("(1700000001.5) vcan10 18EAFF03#14F001")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Unix(1700000001, 500000000), ts)
+	assert.Equal(t, "vcan10", iface)
+	assert.Equal(t, uint8(3), f.Length)
+	assert.Equal(t, "(1700000001.500000) vcan10 18EAFF03#14F001", FormatLine(ts, iface, f))
+
+	for _, bad := range []string{
+		"(1700000000.123456) can0",
+		"1700000000.123456 can0 09F80101#01",
+		"(17000x0000.123456) can0 09F80101#01",
+		"(1700000000.123456) can0 09F80101",
+		"(1700000000.123456) can0 123#01",
+		"(1700000000.123456) can0 3FFFFFFF#01",
+		"(1700000000.123456) can0 09F80101#010",
+		"(1700000000.123456) can0 09F80101#010203040506070809",
+		"(1700000000.123456) can0 09F80101##10102",
+		"(1700000000.123456) can0 09F80101#R",
+	} {
+		_, _, _, err = ParseLine(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestRead(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "candump.log")
+	assert.NoError(t, os.WriteFile(path, []uint8("(1700000000.000000) can0 09F80101#0102030405060708\n"+
+		"garbage\n"+
+		"(1700000000.050000) can0 18EAFF03#14F001\n"), 0o600))
+
+	ep := NewCandumpFileEndpoint(logrus.StandardLogger(), path)
+	ep.SetRealtime(true)
+	c := &testutil.MessageCollector{}
+	ep.SetOutput(c)
+	start := time.Now()
+	assert.NoError(t, ep.Run(context.Background()))
+	assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+	assert.Len(t, c.Messages(), 2)
+	m := c.Messages()[1].(adapter.TimestampedMessage)
+	assert.Equal(t, time.Unix(1700000000, 50000000), m.Timestamp)
+	assert.Equal(t, uint32(0x18EAFF03), m.Message.(*can.Frame).ID)
+}
+
+func TestWrite(t *testing.T) {
+	var buf bytes.Buffer
+	ep := NewCandumpWriterEndpoint(logrus.StandardLogger(), &buf, "")
+	assert.NoError(t, ep.WriteMessage(context.Background(), &can.Frame{ID: 0x09F80101, Length: 2, Data: [8]uint8{0xAB, 0xCD}}))
+	assert.NoError(t, ep.WriteMessage(context.Background(), can.Frame{ID: 0x18EAFF03, Length: 3, Data: [8]uint8{0x14, 0xF0, 0x01}}))
+	assert.NoError(t, ep.WriteMessage(context.Background(), adapter.TimestampedMessage{
+		Timestamp: time.Unix(1700000000, 123456000),
+		Message:   &can.Frame{ID: 0x09F80101, Length: 1, Data: [8]uint8{0x01}},
+	}))
+	assert.Error(t, ep.WriteMessage(context.Background(), "frame"))
+
+	// what's written can be read back
+	r := NewCandumpStreamEndpoint(logrus.StandardLogger(), strings.NewReader(buf.String()))
+	c := &testutil.MessageCollector{}
+	r.SetOutput(c)
+	assert.NoError(t, r.Run(context.Background()))
+	assert.Len(t, c.Messages(), 3)
+	f := c.Messages()[0].(adapter.TimestampedMessage).Message.(*can.Frame)
+	assert.Equal(t, uint32(0x09F80101), f.ID)
+	assert.Equal(t, uint8(2), f.Length)
+	assert.Contains(t, buf.String(), " can0 18EAFF03#14F001\n")
+	assert.Contains(t, buf.String(), "(1700000000.123456) can0 09F80101#01\n")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.NoError(t, ep.Run(ctx))
+}
@@ -0,0 +1,89 @@
+// Package captureendpoint contains the CaptureEndpoint struct described below
+package captureendpoint
+
+import (
+	"context"
+	"sync"
+
+	"github.com/boatkit-io/n2k/pkg/adapter"
+	"github.com/boatkit-io/n2k/pkg/endpoint"
+)
+
+// CaptureEndpoint is an endpoint that isn't connected to anything. It's intended for tests.
+// Messages written to it are captured instead of being transmitted, and Inject passes messages to the
+// handler as if they'd been received.
+type CaptureEndpoint struct {
+	mu       sync.Mutex
+	captured []adapter.Message
+	done     chan struct{}
+	closed   bool
+
+	handler endpoint.MessageHandler
+}
+
+// NewCaptureEndpoint builds a new CaptureEndpoint
+func NewCaptureEndpoint() *CaptureEndpoint {
+	return &CaptureEndpoint{
+		done: make(chan struct{}),
+	}
+}
+
+// Run blocks until the context is done or the endpoint is closed
+func (c *CaptureEndpoint) Run(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+	case <-c.done:
+	}
+	return nil
+}
+
+// SetOutput subscribes a callback handler for whenever a message is ready
+func (c *CaptureEndpoint) SetOutput(mh endpoint.MessageHandler) {
+	c.handler = mh
+}
+
+// Close stops Run
+func (c *CaptureEndpoint) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.closed {
+		c.closed = true
+		close(c.done)
+	}
+	return nil
+}
+
+// WriteMessage captures the message
+func (c *CaptureEndpoint) WriteMessage(ctx context.Context, msg adapter.Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.captured = append(c.captured, msg)
+	return nil
+}
+
+// Messages returns a copy of the messages written so far
+func (c *CaptureEndpoint) Messages() []adapter.Message {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]adapter.Message(nil), c.captured...)
+}
+
+// Reset discards the messages written so far
+func (c *CaptureEndpoint) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.captured = nil
+}
+
+// Inject passes a message to the handler, as if it had been received
+func (c *CaptureEndpoint) Inject(msg adapter.Message) {
+	if c.handler != nil {
+		c.handler.HandleMessage(msg)
+	}
+}
+
+// assert CaptureEndpoint satisfies the interface
+var _ endpoint.ReadWriteEndpoint = (*CaptureEndpoint)(nil)
@@ -0,0 +1,41 @@
+package captureendpoint
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brutella/can"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/boatkit-io/n2k/internal/testutil"
+	"github.com/boatkit-io/n2k/pkg/adapter"
+)
+
+func TestCaptureEndpoint(t *testing.T) {
+	c := NewCaptureEndpoint()
+	collector := &testutil.MessageCollector{}
+	c.SetOutput(collector)
+
+	ctx := context.Background()
+	f := &can.Frame{ID: 0x18EAFF16, Length: 3, Data: [8]uint8{0x14, 0xF0, 0x01}}
+	assert.NoError(t, c.WriteMessage(ctx, f))
+	assert.Equal(t, []adapter.Message{f}, c.Messages())
+	assert.Empty(t, collector.Messages())
+
+	c.Inject(f)
+	assert.Equal(t, []adapter.Message{f}, collector.Messages())
+
+	c.Reset()
+	assert.Empty(t, c.Messages())
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.Error(t, c.WriteMessage(cancelled, f))
+	assert.Empty(t, c.Messages())
+
+	done := make(chan error)
+	go func() { done <- c.Run(ctx) }()
+	assert.NoError(t, c.Close())
+	assert.NoError(t, <-done)
+	assert.NoError(t, c.Close())
+}
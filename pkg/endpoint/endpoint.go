@@ -3,6 +3,7 @@ package endpoint
 
 import (
 	"context"
+	"errors"
 
 	"github.com/boatkit-io/n2k/pkg/adapter"
 )
@@ -18,3 +19,18 @@ type Endpoint interface {
 type MessageHandler interface {
 	HandleMessage(adapter.Message)
 }
+
+// ErrNotRunning is returned by MessageWriters asked to write before they've been run, or after they've stopped.
+var ErrNotRunning = errors.New("endpoint not running")
+
+// MessageWriter declares the interface for endpoints that can transmit messages.
+// The Message type accepted is determined by the gateway, as with the messages an Endpoint outputs.
+type MessageWriter interface {
+	WriteMessage(ctx context.Context, msg adapter.Message) error
+}
+
+// ReadWriteEndpoint declares the interface for endpoints that can both receive and transmit messages.
+type ReadWriteEndpoint interface {
+	Endpoint
+	MessageWriter
+}
@@ -0,0 +1,188 @@
+// Package linereader reads lines of text from a TCP connection, UDP datagrams, a file or any io.Reader.
+// It's shared by the endpoints for gateways and log formats that send one message per line.
+package linereader
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// ErrNotConnected is returned by Write when there's no connection to write to.
+var ErrNotConnected = errors.New("not connected")
+
+// LineReader reads lines from its source, passing each (without its line ending) to a handler.
+// TCP and UDP sources can also be written to, for gateways that accept commands.
+type LineReader struct {
+	network string // "tcp", "udp", "file", or "" for stream
+	address string
+	stream  io.Reader
+
+	mu         sync.Mutex
+	closer     io.Closer
+	conn       net.Conn       // TCP
+	packetConn net.PacketConn // UDP
+	remote     net.Addr       // UDP sender, from the last datagram received
+}
+
+// NewTCPLineReader returns a LineReader that connects to address.
+func NewTCPLineReader(address string) *LineReader {
+	return &LineReader{network: "tcp", address: address}
+}
+
+// NewUDPLineReader returns a LineReader that listens on the local listenAddress. Each datagram can contain several lines.
+func NewUDPLineReader(listenAddress string) *LineReader {
+	return &LineReader{network: "udp", address: listenAddress}
+}
+
+// NewFileLineReader returns a LineReader that reads the file at path.
+func NewFileLineReader(path string) *LineReader {
+	return &LineReader{network: "file", address: path}
+}
+
+// NewStreamLineReader returns a LineReader that reads stream, for example os.Stdin.
+func NewStreamLineReader(stream io.Reader) *LineReader {
+	return &LineReader{stream: stream}
+}
+
+// Run opens the source and calls handle for each non-empty line, until the context is done or the source ends.
+// Reaching the end of a file or stream isn't an error.
+func (l *LineReader) Run(ctx context.Context, handle func(line string)) error {
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			// unblock the reads
+			_ = l.Close()
+		case <-done:
+		}
+	}()
+
+	var err error
+	if l.network == "udp" {
+		err = l.runUDP(ctx, handle)
+	} else {
+		err = l.runStream(ctx, handle)
+	}
+	if ctx.Err() != nil {
+		return nil
+	}
+	return err
+}
+
+// runStream reads lines from a TCP connection, file or stream.
+func (l *LineReader) runStream(ctx context.Context, handle func(line string)) error {
+	r := l.stream
+	switch l.network {
+	case "tcp":
+		var d net.Dialer
+		conn, err := d.DialContext(ctx, "tcp", l.address)
+		if err != nil {
+			return errors.Wrapf(err, "connecting to %s", l.address)
+		}
+		l.setCloser(conn)
+		l.mu.Lock()
+		l.conn = conn
+		l.mu.Unlock()
+		r = conn
+	case "file":
+		file, err := os.Open(l.address)
+		if err != nil {
+			return err
+		}
+		l.setCloser(file)
+		r = file
+	}
+	defer l.Close()
+
+	return scanLines(r, handle)
+}
+
+// runUDP reads lines from datagrams.
+func (l *LineReader) runUDP(ctx context.Context, handle func(line string)) error {
+	var lc net.ListenConfig
+	pc, err := lc.ListenPacket(ctx, "udp", l.address)
+	if err != nil {
+		return errors.Wrapf(err, "listening on %s", l.address)
+	}
+	l.setCloser(pc)
+	l.mu.Lock()
+	l.packetConn = pc
+	l.mu.Unlock()
+	defer l.Close()
+
+	buf := make([]uint8, 65536)
+	for {
+		count, remote, err := pc.ReadFrom(buf)
+		if err != nil {
+			return err
+		}
+		l.mu.Lock()
+		l.remote = remote
+		l.mu.Unlock()
+		if err := scanLines(bytes.NewReader(buf[:count]), handle); err != nil {
+			return err
+		}
+	}
+}
+
+// Write sends data to the source while Run is running: to the TCP connection, or to the sender of the last
+// UDP datagram received. It returns ErrNotConnected if there's nowhere to send it.
+func (l *LineReader) Write(data []uint8) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var err error
+	switch {
+	case l.conn != nil:
+		_, err = l.conn.Write(data)
+	case l.packetConn != nil && l.remote != nil:
+		_, err = l.packetConn.WriteTo(data, l.remote)
+	default:
+		err = ErrNotConnected
+	}
+	return err
+}
+
+// Close stops Run.
+func (l *LineReader) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.conn = nil
+	l.packetConn = nil
+	l.remote = nil
+	if l.closer != nil {
+		err := l.closer.Close()
+		l.closer = nil
+		return err
+	}
+	return nil
+}
+
+// setCloser records what Close needs to close.
+func (l *LineReader) setCloser(c io.Closer) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.closer = c
+}
+
+// scanLines calls handle for each non-empty line in r.
+func scanLines(r io.Reader, handle func(line string)) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) > 0 {
+			handle(line)
+		}
+	}
+	return scanner.Err()
+}
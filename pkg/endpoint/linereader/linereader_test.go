@@ -0,0 +1,87 @@
+package linereader
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// lineCollector saves the lines it receives
+type lineCollector struct {
+	mu    sync.Mutex
+	lines []string
+}
+
+// handle method saves the line
+func (c *lineCollector) handle(line string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.lines = append(c.lines, line)
+}
+
+// get method returns a copy of the lines received
+func (c *lineCollector) get() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]string(nil), c.lines...)
+}
+
+func TestTCP(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer listener.Close()
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		_, _ = conn.Write([]uint8("one\r\n\r\n  two  \nthree\n"))
+		// echo a line back, then close
+		buf := make([]uint8, 100)
+		count, _ := conn.Read(buf)
+		_, _ = conn.Write(buf[:count])
+		conn.Close()
+	}()
+
+	c := &lineCollector{}
+	r := NewTCPLineReader(listener.Addr().String())
+	assert.ErrorIs(t, r.Write([]uint8("early\n")), ErrNotConnected)
+	assert.NoError(t, r.Run(context.Background(), func(line string) {
+		c.handle(line)
+		if line == "three" {
+			assert.NoError(t, r.Write([]uint8("four\n")))
+		}
+	}))
+	assert.Equal(t, []string{"one", "two", "three", "four"}, c.get())
+	assert.ErrorIs(t, r.Write([]uint8("late\n")), ErrNotConnected)
+}
+
+func TestUDP(t *testing.T) {
+	// find a free port
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	address := pc.LocalAddr().String()
+	pc.Close()
+
+	c := &lineCollector{}
+	r := NewUDPLineReader(address)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- r.Run(ctx, c.handle) }()
+
+	conn, err := net.Dial("udp", address)
+	assert.NoError(t, err)
+	defer conn.Close()
+	assert.Eventually(t, func() bool {
+		_, _ = conn.Write([]uint8("one\r\ntwo\r\n"))
+		return len(c.get()) >= 2
+	}, time.Second, 20*time.Millisecond)
+	assert.Equal(t, []string{"one", "two"}, c.get()[:2])
+
+	cancel()
+	assert.NoError(t, <-done)
+}
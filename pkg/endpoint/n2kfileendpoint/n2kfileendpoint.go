@@ -6,9 +6,9 @@ import (
 	"bufio"
 	"context"
 	"fmt"
-	"math"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/boatkit-io/n2k/pkg/adapter"
@@ -18,11 +18,27 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
+// AsFastAsPossible is the replay speed that sends frames without waiting.
+const AsFastAsPossible = 0
+
 // N2kFileEndpoint reads an n2k log file and sends canbus frames to its output channel.
+// By default it replays in real time, from the start of the log to the end. The speed can be changed, and
+// playback paused and resumed, while it runs.
 type N2kFileEndpoint struct {
 	log        *logrus.Logger
 	inFilePath string
 
+	mu       sync.Mutex
+	speed    float64
+	start    time.Duration
+	stop     time.Duration
+	paused   bool
+	position time.Duration // log time of the last frame sent
+	clock    time.Duration // log time at clockSet, when playing
+	clockSet time.Time
+	changed  chan struct{} // closed when the speed or pause state changes
+	cancel   context.CancelFunc
+
 	handler endpoint.MessageHandler
 }
 
@@ -31,6 +47,8 @@ func NewN2kFileEndpoint(file string, log *logrus.Logger) *N2kFileEndpoint {
 	return &N2kFileEndpoint{
 		log:        log,
 		inFilePath: file,
+		speed:      1,
+		changed:    make(chan struct{}),
 	}
 }
 
@@ -39,7 +57,86 @@ func (n *N2kFileEndpoint) SetOutput(mh endpoint.MessageHandler) {
 	n.handler = mh
 }
 
-// Run method opens the specified log file and kicks off a goroutine that sends frames to the handler
+// SetSpeed sets the replay speed as a multiple of real time, for example 10 for ten times faster.
+// A speed of AsFastAsPossible (or less) sends frames without waiting.
+func (n *N2kFileEndpoint) SetSpeed(speed float64) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.rebase()
+	n.speed = speed
+	n.notify()
+}
+
+// Speed returns the replay speed.
+func (n *N2kFileEndpoint) Speed() float64 {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.speed
+}
+
+// SetStart skips the frames logged before offset from the start of the log. Call it before Run.
+func (n *N2kFileEndpoint) SetStart(offset time.Duration) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.start = offset
+}
+
+// SetStop ends playback at the first frame logged after offset from the start of the log, or at the end of the
+// log if offset is 0. Call it before Run.
+func (n *N2kFileEndpoint) SetStop(offset time.Duration) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.stop = offset
+}
+
+// Pause stops sending frames until Resume is called.
+func (n *N2kFileEndpoint) Pause() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if !n.paused {
+		n.rebase()
+		n.paused = true
+		n.notify()
+	}
+}
+
+// Resume continues playback after Pause.
+func (n *N2kFileEndpoint) Resume() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.paused {
+		n.paused = false
+		n.clockSet = time.Now()
+		n.notify()
+	}
+}
+
+// Paused returns true if playback is paused.
+func (n *N2kFileEndpoint) Paused() bool {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.paused
+}
+
+// Position returns the log time, the offset from the start of the log, of the last frame sent.
+func (n *N2kFileEndpoint) Position() time.Duration {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.position
+}
+
+// Close stops playback.
+func (n *N2kFileEndpoint) Close() error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.cancel != nil {
+		n.cancel()
+	}
+	return nil
+}
+
+// Run method opens the specified log file and sends its frames to the handler, pacing them by their logged times,
+// until the stop time, the end of the log, or the context is done.
 func (n *N2kFileEndpoint) Run(ctx context.Context) error {
 	file, err := os.Open(n.inFilePath)
 	if err != nil {
@@ -48,19 +145,22 @@ func (n *N2kFileEndpoint) Run(ctx context.Context) error {
 
 	defer file.Close()
 
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	n.mu.Lock()
+	n.cancel = cancel
+	start, stop := n.start, n.stop
+	n.clock = start
+	n.clockSet = time.Now()
+	n.mu.Unlock()
+
 	startTime := time.Now()
 
 	n.log.Info("starting n2k file playback")
 
-	canceled := false
-	go func() {
-		<-ctx.Done()
-		canceled = true
-	}()
-
 	scanner := bufio.NewScanner(file)
 	for scanner.Scan() {
-		if canceled {
+		if ctx.Err() != nil {
 			break
 		}
 
@@ -70,40 +170,25 @@ func (n *N2kFileEndpoint) Run(ctx context.Context) error {
 		if len(line) == 0 {
 			continue
 		}
-		var frame can.Frame
-		var canDead string
-		var timeDelta float32
-		_, err := fmt.Sscanf(line, " (%f)  %s  %8X   [%d]", &timeDelta, &canDead, &frame.ID, &frame.Length)
+		frame, offset, err := parseLine(line)
 		if err != nil {
 			return err
 		}
-		bts := strings.Split(line[37:], " ")
-		for i := range frame.Length {
-			_, err := fmt.Sscanf(bts[i], "%X", &frame.Data[i])
-			if err != nil {
-				return err
-			}
+		if offset < start {
+			continue
 		}
-		// Pause until the timeDelta has expired, so this all replays in "real-time" (relative to start, obvs)
-		for {
-			if canceled {
-				break
-			}
-
-			curDelta := time.Since(startTime).Seconds()
-			nextTime := timeDelta - float32(curDelta)
-			// Make sure we wait at most 0.5 seconds to gracefully quit as needed
-			time.Sleep(time.Duration(math.Min(500, float64(nextTime)*1000.0)) * time.Millisecond)
-
-			if time.Since(startTime) > time.Duration(timeDelta) {
-				break
-			}
+		if stop > 0 && offset > stop {
+			break
 		}
-		if canceled {
+		if !n.waitFor(ctx, offset) {
 			break
 		}
 
-		n.frameReady(&frame)
+		// the log's times are relative, so the frames are timestamped as if the log started with playback
+		n.frameReady(adapter.TimestampedMessage{
+			Timestamp: startTime.Add(offset),
+			Message:   frame,
+		})
 	}
 
 	if err := scanner.Err(); err != nil {
@@ -115,6 +200,95 @@ func (n *N2kFileEndpoint) Run(ctx context.Context) error {
 	return nil
 }
 
+// waitFor waits until the replay clock reaches offset, returning false if the context is done first.
+func (n *N2kFileEndpoint) waitFor(ctx context.Context, offset time.Duration) bool {
+	for {
+		n.mu.Lock()
+		paused := n.paused
+		var wait time.Duration
+		if n.speed > AsFastAsPossible {
+			wait = time.Duration(float64(offset-n.now()) / n.speed)
+		}
+		changed := n.changed
+		if !paused && wait <= 0 {
+			n.position = offset
+			if n.speed <= AsFastAsPossible {
+				// keep the clock with the frames, so slowing down carries on from here
+				n.clock = offset
+				n.clockSet = time.Now()
+			}
+			n.mu.Unlock()
+			return true
+		}
+		n.mu.Unlock()
+
+		var timer *time.Timer
+		var timeout <-chan time.Time
+		if !paused {
+			timer = time.NewTimer(wait)
+			timeout = timer.C
+		}
+		select {
+		case <-ctx.Done():
+		case <-changed:
+		case <-timeout:
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+		if ctx.Err() != nil {
+			return false
+		}
+	}
+}
+
+// now returns the current log time. Call with the lock held.
+func (n *N2kFileEndpoint) now() time.Duration {
+	if n.paused || n.speed <= AsFastAsPossible {
+		return n.clock
+	}
+	return n.clock + time.Duration(float64(time.Since(n.clockSet))*n.speed)
+}
+
+// rebase moves the clock's reference point to now, before the speed or pause state changes.
+// Call with the lock held.
+func (n *N2kFileEndpoint) rebase() {
+	n.clock = n.now()
+	n.clockSet = time.Now()
+}
+
+// notify wakes waitFor after the speed or pause state changes. Call with the lock held.
+func (n *N2kFileEndpoint) notify() {
+	close(n.changed)
+	n.changed = make(chan struct{})
+}
+
+// parseLine parses a log line into its frame and log time.
+func parseLine(line string) (*can.Frame, time.Duration, error) {
+	var frame can.Frame
+	var canDead string
+	var timeDelta float64
+	_, err := fmt.Sscanf(line, " (%f)  %s  %8X   [%d]", &timeDelta, &canDead, &frame.ID, &frame.Length)
+	if err != nil {
+		return nil, 0, err
+	}
+	if frame.Length > 8 {
+		return nil, 0, fmt.Errorf("invalid frame length %d in line: %q", frame.Length, line)
+	}
+	// the data follows the length, wherever the columns fall
+	bts := strings.Fields(line[strings.Index(line, "]")+1:])
+	if len(bts) < int(frame.Length) {
+		return nil, 0, fmt.Errorf("expected %d data bytes in line: %q", frame.Length, line)
+	}
+	for i := range frame.Length {
+		_, err := fmt.Sscanf(bts[i], "%X", &frame.Data[i])
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+	return &frame, time.Duration(timeDelta * float64(time.Second)), nil
+}
+
 // frameReady is a helper to handle passing completed frames to the handler
 func (n *N2kFileEndpoint) frameReady(frame adapter.Message) {
 	if n.handler != nil {
@@ -0,0 +1,111 @@
+package n2kfileendpoint
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/brutella/can"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/boatkit-io/n2k/internal/testutil"
+	"github.com/boatkit-io/n2k/pkg/adapter"
+)
+
+// writeLog writes a log with frames a second apart, returning its path.
+func writeLog(t *testing.T) string {
+	path := filepath.Join(t.TempDir(), "replay.n2k")
+	assert.NoError(t, os.WriteFile(path, []uint8(
+		" (000.000000)  can1  08FF0401   [8]  AC 98 21 FC 5E FD 64 FF\n"+
+			" (001.000000)  can1  08FF0401   [8]  AC 98 21 FC 5E FD 64 FF\n"+
+			"\n"+
+			" (002.000000)  can1  09F80101   [3]  01 02 03\n"+
+			" (003.000000)  vcan10  09F80101   [2]  04 05\n"), 0o600))
+	return path
+}
+
+func TestParseLine(t *testing.T) {
+	frame, offset, err := parseLine(" (010.139585)  can1  08FF0401   [8]  AC 98 21 FC 5E FD 64 FF")
+	assert.NoError(t, err)
+	assert.Equal(t, 10139585*time.Microsecond, offset)
+	assert.Equal(t, &can.Frame{ID: 0x08FF0401, Length: 8, Data: [8]uint8{0xAC, 0x98, 0x21, 0xFC, 0x5E, 0xFD, 0x64, 0xFF}}, frame)
+
+	frame, _, err = parseLine(" (001.000000)  vcan10  09F80101   [2]  04 05")
+	assert.NoError(t, err)
+	assert.Equal(t, [8]uint8{0x04, 0x05}, frame.Data)
+
+	_, _, err = parseLine(" (001.000000)  can1  09F80101   [3]  04 05")
+	assert.Error(t, err)
+	_, _, err = parseLine("garbage")
+	assert.Error(t, err)
+}
+
+func TestAsFastAsPossible(t *testing.T) {
+	ep := NewN2kFileEndpoint(writeLog(t), logrus.StandardLogger())
+	ep.SetSpeed(AsFastAsPossible)
+	c := &testutil.MessageCollector{}
+	ep.SetOutput(c)
+
+	start := time.Now()
+	assert.NoError(t, ep.Run(context.Background()))
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+	assert.Equal(t, 4, c.Count())
+	_, first := adapter.Unwrap(c.Messages()[0])
+	_, last := adapter.Unwrap(c.Messages()[3])
+	assert.Equal(t, 3*time.Second, last.Sub(first))
+	assert.Equal(t, 3*time.Second, ep.Position())
+}
+
+func TestSpeedStartStop(t *testing.T) {
+	ep := NewN2kFileEndpoint(writeLog(t), logrus.StandardLogger())
+	ep.SetSpeed(20)
+	ep.SetStart(time.Second)
+	ep.SetStop(2500 * time.Millisecond)
+	c := &testutil.MessageCollector{}
+	ep.SetOutput(c)
+
+	start := time.Now()
+	assert.NoError(t, ep.Run(context.Background()))
+	// from the 1 second frame to the 2 second frame at 20x
+	elapsed := time.Since(start)
+	assert.GreaterOrEqual(t, elapsed, 50*time.Millisecond)
+	assert.Less(t, elapsed, 500*time.Millisecond)
+	assert.Equal(t, 2, c.Count())
+	assert.Equal(t, uint8(8), c.Messages()[0].(adapter.TimestampedMessage).Message.(*can.Frame).Length)
+	assert.Equal(t, uint8(3), c.Messages()[1].(adapter.TimestampedMessage).Message.(*can.Frame).Length)
+}
+
+func TestPauseResume(t *testing.T) {
+	ep := NewN2kFileEndpoint(writeLog(t), logrus.StandardLogger())
+	ep.SetSpeed(AsFastAsPossible)
+	ep.Pause()
+	assert.True(t, ep.Paused())
+	c := &testutil.MessageCollector{}
+	ep.SetOutput(c)
+
+	done := make(chan error)
+	go func() { done <- ep.Run(context.Background()) }()
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, 0, c.Count())
+
+	ep.Resume()
+	assert.NoError(t, <-done)
+	assert.Equal(t, 4, c.Count())
+}
+
+func TestClose(t *testing.T) {
+	ep := NewN2kFileEndpoint(writeLog(t), logrus.StandardLogger())
+	c := &testutil.MessageCollector{}
+	ep.SetOutput(c)
+
+	done := make(chan error)
+	go func() { done <- ep.Run(context.Background()) }()
+	// the first frame is sent straight away, the next a second later
+	assert.Eventually(t, func() bool { return c.Count() == 1 }, time.Second, 10*time.Millisecond)
+	assert.NoError(t, ep.Close())
+	assert.NoError(t, <-done)
+	assert.Equal(t, 1, c.Count())
+}
@@ -0,0 +1,204 @@
+// Package ngt1endpoint contains the NGT1Endpoint struct described below
+package ngt1endpoint
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"go.bug.st/serial"
+
+	"github.com/boatkit-io/n2k/pkg/adapter"
+	"github.com/boatkit-io/n2k/pkg/adapter/pgnadapter"
+	"github.com/boatkit-io/n2k/pkg/endpoint"
+)
+
+// SerialBaudRate is the NGT-1's serial port speed.
+const SerialBaudRate = 115200
+
+// NGT1Endpoint is an endpoint backed by an Actisense NGT-1, speaking its binary serial protocol.
+// The NGT-1 reassembles fast packets, so it outputs complete *pgnadapter.Message PGNs, wrapped in
+// adapter.TimestampedMessages with the NGT-1's timestamps: connect it to a pgnadapter.PGNAdapter.
+// WriteMessage accepts the same Messages.
+type NGT1Endpoint struct {
+	log *logrus.Logger
+
+	serialPortName string
+	stream         io.Reader
+
+	writeMutex sync.Mutex
+	writer     io.Writer
+	closer     io.Closer
+
+	clock deviceClock
+
+	handler endpoint.MessageHandler
+}
+
+// NewNGT1Endpoint builds a new NGT1Endpoint for the NGT-1 on the given serial port
+func NewNGT1Endpoint(log *logrus.Logger, serialPortName string) endpoint.ReadWriteEndpoint {
+	return &NGT1Endpoint{
+		log:            log,
+		serialPortName: serialPortName,
+	}
+}
+
+// NewNGT1StreamEndpoint builds a new NGT1Endpoint reading the protocol from stream, for example a captured log.
+// If stream is also an io.Writer messages are written to it.
+func NewNGT1StreamEndpoint(log *logrus.Logger, stream io.Reader) *NGT1Endpoint {
+	n := &NGT1Endpoint{
+		log:    log,
+		stream: stream,
+	}
+	if w, ok := stream.(io.Writer); ok {
+		n.writer = w
+	}
+	if c, ok := stream.(io.Closer); ok {
+		n.closer = c
+	}
+	return n
+}
+
+// SetOutput subscribes a callback handler for whenever a message is ready
+func (n *NGT1Endpoint) SetOutput(mh endpoint.MessageHandler) {
+	n.handler = mh
+}
+
+// Run opens the serial port (unless built from a stream) and processes messages until the context is done,
+// or the stream ends.
+func (n *NGT1Endpoint) Run(ctx context.Context) error {
+	if n.stream == nil {
+		port, err := serial.Open(n.serialPortName, &serial.Mode{BaudRate: SerialBaudRate})
+		if err != nil {
+			return errors.Wrap(err, "opening NGT-1 serial port")
+		}
+		n.writeMutex.Lock()
+		n.stream = port
+		n.writer = port
+		n.closer = port
+		n.writeMutex.Unlock()
+
+		if err := n.write(ngtMsgSend, startupMsg); err != nil {
+			return errors.Wrap(err, "sending NGT-1 startup message")
+		}
+		n.log.WithField("portName", n.serialPortName).Info("Opened NGT-1 and listening")
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			// unblock the Read
+			_ = n.Close()
+		case <-done:
+		}
+	}()
+
+	var fr frameReader
+	buf := make([]uint8, 256)
+	for {
+		count, err := n.stream.Read(buf)
+		for _, b := range buf[:count] {
+			if msg, complete := fr.add(b); complete {
+				n.handleFrame(msg)
+			}
+		}
+		if err != nil {
+			if err == io.EOF || ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		if ctx.Err() != nil {
+			return nil
+		}
+	}
+}
+
+// Close will stop the endpoint from processing further messages
+func (n *NGT1Endpoint) Close() error {
+	n.writeMutex.Lock()
+	defer n.writeMutex.Unlock()
+
+	if n.closer != nil {
+		err := n.closer.Close()
+		n.closer = nil
+		if err != nil {
+			return errors.Wrap(err, "closing NGT-1 stream")
+		}
+	}
+	return nil
+}
+
+// WriteMessage sends a *pgnadapter.Message (or pgnadapter.Message) to the network. The NGT-1 fills in the source address.
+func (n *NGT1Endpoint) WriteMessage(ctx context.Context, msg adapter.Message) error {
+	var m *pgnadapter.Message
+	switch v := msg.(type) {
+	case *pgnadapter.Message:
+		m = v
+	case pgnadapter.Message:
+		m = &v
+	default:
+		return fmt.Errorf("NGT1Endpoint expected *pgnadapter.Message, received: %T", msg)
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	payload, err := encodeN2kMessage(m)
+	if err != nil {
+		return err
+	}
+	return n.write(n2kMsgSend, payload)
+}
+
+// write frames and sends a command.
+func (n *NGT1Endpoint) write(command uint8, payload []uint8) error {
+	out, err := frameMessage(command, payload)
+	if err != nil {
+		return err
+	}
+
+	n.writeMutex.Lock()
+	defer n.writeMutex.Unlock()
+	if n.writer == nil {
+		return fmt.Errorf("NGT1Endpoint isn't writable")
+	}
+	_, err = n.writer.Write(out)
+	return err
+}
+
+// handleFrame parses a message from the stream, passing on received PGNs.
+func (n *NGT1Endpoint) handleFrame(msg []uint8) {
+	command, payload, err := parseMessage(msg)
+	if err != nil {
+		n.log.Debugf("Discarding NGT-1 message: %v", err)
+		return
+	}
+
+	switch command {
+	case n2kMsgReceived:
+		m, ms, err := decodeN2kMessage(payload)
+		if err != nil {
+			n.log.Debugf("Discarding NGT-1 message: %v", err)
+			return
+		}
+		n.messageReady(adapter.TimestampedMessage{Timestamp: n.clock.timestamp(ms, time.Now()), Message: m})
+	case ngtMsgReceived:
+		n.log.Debugf("NGT-1 response: % X", payload)
+	default:
+		n.log.Debugf("Ignoring NGT-1 command 0x%02X", command)
+	}
+}
+
+// messageReady is a helper to handle passing completed messages to the handler
+func (n *NGT1Endpoint) messageReady(m adapter.TimestampedMessage) {
+	if n.handler != nil {
+		n.handler.HandleMessage(m)
+	}
+}
@@ -0,0 +1,111 @@
+package ngt1endpoint
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/boatkit-io/n2k/internal/testutil"
+	"github.com/boatkit-io/n2k/pkg/adapter/pgnadapter"
+	"github.com/boatkit-io/n2k/pkg/pgn"
+	"github.com/boatkit-io/n2k/pkg/pkt"
+)
+
+// vesselHeading is a captured VesselHeading (127250) from source 1, with DLEs in the timestamp and data
+var vesselHeading = []uint8{
+	0x10, 0x02, 0x93, 0x13, 0x02, 0x12, 0xF1, 0x01, 0xFF, 0x01, 0x10, 0x10, 0x27, 0x00, 0x00, 0x08,
+	0xFF, 0x10, 0x10, 0x27, 0xFF, 0x7F, 0xFF, 0x7F, 0xFD, 0xE6, 0x10, 0x03,
+}
+
+func TestNGT1Stream(t *testing.T) {
+	var stream []uint8
+	stream = append(stream, 0x00, 0x55, 0x10, 0x03) // noise before the first frame
+	stream = append(stream, vesselHeading...)
+	badChecksum := append([]uint8(nil), vesselHeading...)
+	badChecksum[25] = 0xE7
+	stream = append(stream, badChecksum...)
+	ngtResponse, err := frameMessage(ngtMsgReceived, []uint8{0x11, 0x01})
+	assert.NoError(t, err)
+	stream = append(stream, ngtResponse...)
+	stream = append(stream, vesselHeading[:10]...) // a truncated frame is discarded by the next start
+	stream = append(stream, vesselHeading...)
+
+	log := logrus.StandardLogger()
+	ep := NewNGT1StreamEndpoint(log, bytes.NewReader(stream))
+	pa := pgnadapter.NewPGNAdapter(log)
+	ps := pkt.NewPacketStruct()
+	collector := &testutil.StructCollector{}
+	ep.SetOutput(pa)
+	pa.SetOutput(ps)
+	ps.SetOutput(collector)
+
+	assert.NoError(t, ep.Run(context.Background()))
+	assert.Len(t, collector.Structs, 2)
+	// both have the same NGT-1 timestamp
+	first := collector.Structs[0].(pgn.VesselHeading).Info.Timestamp
+	assert.False(t, first.IsZero())
+	for _, s := range collector.Structs {
+		vh, ok := s.(pgn.VesselHeading)
+		assert.True(t, ok)
+		assert.Equal(t, uint32(127250), vh.Info.PGN)
+		assert.Equal(t, uint8(1), vh.Info.SourceId)
+		assert.Equal(t, uint8(2), vh.Info.Priority)
+		assert.InDelta(t, 1.0, *vh.Heading, 0.0001)
+		assert.Nil(t, vh.Deviation)
+		assert.Equal(t, pgn.DirectionReferenceConst(1), vh.Reference)
+		assert.Equal(t, first, vh.Info.Timestamp)
+	}
+}
+
+func TestDeviceClock(t *testing.T) {
+	var c deviceClock
+	t0 := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	// the first timestamp is when it's received, later ones follow the device's clock rather than reception
+	assert.Equal(t, t0, c.timestamp(10000, t0))
+	assert.Equal(t, t0.Add(250*time.Millisecond), c.timestamp(10250, t0.Add(time.Second)))
+	assert.Equal(t, t0.Add(250*time.Millisecond), c.timestamp(10250, t0.Add(time.Second)))
+
+	// the counter wraps around
+	c.timestamp(0xFFFFFF00, t0)
+	assert.Equal(t, t0.Add(356*time.Millisecond), c.timestamp(100, t0))
+
+	// the NGT-1 restarted
+	t1 := t0.Add(time.Minute)
+	assert.Equal(t, t1, c.timestamp(50, t1))
+}
+
+func TestNGT1Write(t *testing.T) {
+	var buf bytes.Buffer
+	ep := NewNGT1StreamEndpoint(logrus.StandardLogger(), &buf)
+
+	requested := uint32(126996)
+	info, data, err := pgn.Encode(pgn.IsoRequest{Info: pgn.MessageInfo{Priority: 6, TargetId: 0x10}, Pgn: &requested})
+	assert.NoError(t, err)
+	assert.NoError(t, ep.WriteMessage(context.Background(), &pgnadapter.Message{Info: info, Data: data}))
+	assert.Error(t, ep.WriteMessage(context.Background(), data))
+
+	// the target (0x10) is escaped
+	assert.Equal(t, []uint8{0x10, 0x02, 0x94, 0x09, 0x06, 0x00, 0xEA, 0x00, 0x10, 0x10, 0x03, 0x14, 0xF0, 0x01, 0x5B, 0x10, 0x03}, buf.Bytes())
+
+	var fr frameReader
+	var msgs [][]uint8
+	for _, b := range buf.Bytes() {
+		if msg, ok := fr.add(b); ok {
+			msgs = append(msgs, msg)
+		}
+	}
+	assert.Len(t, msgs, 1)
+	command, payload, err := parseMessage(msgs[0])
+	assert.NoError(t, err)
+	assert.Equal(t, uint8(n2kMsgSend), command)
+	assert.Equal(t, []uint8{0x06, 0x00, 0xEA, 0x00, 0x10, 0x03, 0x14, 0xF0, 0x01}, payload)
+
+	// not writable
+	ro := NewNGT1StreamEndpoint(logrus.StandardLogger(), bytes.NewReader(nil))
+	assert.Error(t, ro.WriteMessage(context.Background(), pgnadapter.Message{Info: info, Data: data}))
+}
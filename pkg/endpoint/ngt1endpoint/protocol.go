@@ -0,0 +1,197 @@
+package ngt1endpoint
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"github.com/boatkit-io/n2k/pkg/adapter/pgnadapter"
+	"github.com/boatkit-io/n2k/pkg/pgn"
+)
+
+// The NGT-1 serial protocol is described (by observation) in canboat's actisense-serial:
+// https://github.com/canboat/canboat/blob/master/actisense-serial/actisense-serial.c
+// Messages are framed as DLE STX <command> <length> <payload> <checksum> DLE ETX, with any DLE inside
+// the frame doubled. The checksum makes the sum of the command, length, payload and checksum bytes 0.
+
+const (
+	dle = 0x10
+	stx = 0x02
+	etx = 0x03
+
+	// n2kMsgReceived is the command for a PGN received from the network.
+	n2kMsgReceived = 0x93
+	// n2kMsgSend is the command to transmit a PGN.
+	n2kMsgSend = 0x94
+	// ngtMsgReceived is the command for a response from the NGT-1 itself.
+	ngtMsgReceived = 0xA0
+	// ngtMsgSend is the command for a request to the NGT-1 itself.
+	ngtMsgSend = 0xA1
+
+	// maxPayload is the largest payload that fits the length byte.
+	maxPayload = 255
+
+	// maxClockStep is the longest gap between device timestamps that's trusted. Longer gaps, or timestamps that
+	// go backwards because the NGT-1 restarted, move the clock to the time the message is received.
+	maxClockStep = time.Hour
+)
+
+// startupMsg is the ngtMsgSend payload that sets the NGT-1 to pass on all PGNs it receives.
+var startupMsg = []uint8{0x11, 0x02, 0x00}
+
+// frameReader extracts messages from the framed byte stream, removing the framing and escapes.
+type frameReader struct {
+	buf       []uint8
+	inMessage bool
+	escaped   bool
+}
+
+// add method processes the next byte of the stream. It returns the message and true when a frame is complete.
+func (f *frameReader) add(b uint8) ([]uint8, bool) {
+	if f.escaped {
+		f.escaped = false
+		switch b {
+		case stx:
+			// start of a frame, even if we were already in one
+			f.inMessage = true
+			f.buf = f.buf[:0]
+		case etx:
+			if f.inMessage {
+				f.inMessage = false
+				return append([]uint8(nil), f.buf...), true
+			}
+		case dle:
+			if f.inMessage {
+				f.buf = append(f.buf, dle)
+			}
+		default:
+			// invalid escape, so discard the frame
+			f.inMessage = false
+		}
+		return nil, false
+	}
+
+	if b == dle {
+		f.escaped = true
+	} else if f.inMessage {
+		f.buf = append(f.buf, b)
+	}
+	return nil, false
+}
+
+// parseMessage checks the length and checksum of an unframed message, returning its command and payload.
+func parseMessage(msg []uint8) (uint8, []uint8, error) {
+	if len(msg) < 3 {
+		return 0, nil, fmt.Errorf("message too short: %d bytes", len(msg))
+	}
+	length := int(msg[1])
+	if len(msg) != length+3 {
+		return 0, nil, fmt.Errorf("message length %d doesn't match length byte %d", len(msg)-3, length)
+	}
+	var sum uint8
+	for _, b := range msg {
+		sum += b
+	}
+	if sum != 0 {
+		return 0, nil, fmt.Errorf("bad checksum for command 0x%02X", msg[0])
+	}
+	return msg[0], msg[2 : 2+length], nil
+}
+
+// frameMessage returns the framed and escaped bytes to send command with payload.
+func frameMessage(command uint8, payload []uint8) ([]uint8, error) {
+	if len(payload) > maxPayload {
+		return nil, fmt.Errorf("payload too long: %d bytes", len(payload))
+	}
+
+	msg := make([]uint8, 0, len(payload)+3)
+	msg = append(msg, command, uint8(len(payload)))
+	msg = append(msg, payload...)
+	var sum uint8
+	for _, b := range msg {
+		sum += b
+	}
+	msg = append(msg, -sum)
+
+	out := make([]uint8, 0, len(msg)+8)
+	out = append(out, dle, stx)
+	for _, b := range msg {
+		if b == dle {
+			out = append(out, dle)
+		}
+		out = append(out, b)
+	}
+	return append(out, dle, etx), nil
+}
+
+// deviceClock converts the NGT-1's timestamps, milliseconds since it started, into times.
+// The first timestamp is taken to be the time it's received, and later ones are offset from it.
+type deviceClock struct {
+	set  bool
+	last uint32    // the last device timestamp
+	time time.Time // the time of the last device timestamp
+}
+
+// timestamp method returns the time of a device timestamp received at now.
+func (c *deviceClock) timestamp(ms uint32, now time.Time) time.Time {
+	// unsigned subtraction handles the counter wrapping around
+	elapsed := time.Duration(ms-c.last) * time.Millisecond
+	if !c.set || elapsed > maxClockStep {
+		c.time = now
+	} else {
+		c.time = c.time.Add(elapsed)
+	}
+	c.set = true
+	c.last = ms
+	return c.time
+}
+
+// decodeN2kMessage converts an n2kMsgReceived payload into a Message, and returns the NGT-1's timestamp.
+// Payload: priority, PGN (3 bytes), destination, source, timestamp (4 bytes), length, data.
+// The Message's Timestamp is left for the caller to set from the NGT-1's.
+func decodeN2kMessage(payload []uint8) (*pgnadapter.Message, uint32, error) {
+	if len(payload) < 11 {
+		return nil, 0, fmt.Errorf("N2K message too short: %d bytes", len(payload))
+	}
+	length := int(payload[10])
+	if len(payload) < 11+length {
+		return nil, 0, fmt.Errorf("N2K message data length %d longer than payload", length)
+	}
+
+	info := pgn.MessageInfo{
+		Priority: payload[0] & 0x7,
+		PGN:      uint32(payload[1]) | uint32(payload[2])<<8 | uint32(payload[3])<<16,
+		SourceId: payload[5],
+	}
+	if (info.PGN&0xFF00)>>8 < 240 {
+		// targeted PGN, matching canadapter.NewPacketInfo
+		info.TargetId = payload[4]
+		info.PGN &= 0x3FF00
+	}
+	return &pgnadapter.Message{
+		Info: info,
+		Data: append([]uint8(nil), payload[11:11+length]...),
+	}, binary.LittleEndian.Uint32(payload[6:10]), nil
+}
+
+// encodeN2kMessage returns the n2kMsgSend payload for a Message.
+// Payload: priority, PGN (3 bytes), destination, length, data. The NGT-1 supplies the source address.
+func encodeN2kMessage(m *pgnadapter.Message) ([]uint8, error) {
+	if len(m.Data) > maxPayload-6 {
+		return nil, fmt.Errorf("N2K message data too long: %d bytes", len(m.Data))
+	}
+
+	destination := uint8(255)
+	if (m.Info.PGN&0xFF00)>>8 < 240 {
+		destination = m.Info.TargetId
+	}
+	payload := []uint8{
+		m.Info.Priority & 0x7,
+		uint8(m.Info.PGN),
+		uint8(m.Info.PGN >> 8),
+		uint8(m.Info.PGN >> 16),
+		destination,
+		uint8(len(m.Data)),
+	}
+	return append(payload, m.Data...), nil
+}
@@ -0,0 +1,170 @@
+// Package rawendpoint contains the RawEndpoint struct described below
+package rawendpoint
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/boatkit-io/n2k/pkg/adapter"
+	"github.com/boatkit-io/n2k/pkg/adapter/canadapter"
+	"github.com/boatkit-io/n2k/pkg/endpoint"
+	"github.com/boatkit-io/n2k/pkg/endpoint/linereader"
+	"github.com/boatkit-io/n2k/pkg/pgn"
+)
+
+// timestampLayouts are the timestamp formats found in raw lines. Fractional seconds are accepted by all of them.
+var timestampLayouts = []string{
+	"2006-01-02-15:04:05",
+	"2006-01-02T15:04:05Z07:00",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+}
+
+// RawEndpoint reads the canboat "raw" (Actisense ASCII) format, as output by canboat's actisense-serial and n2kd
+// and read by its analyzer, and sends canbus frames to its output, wrapped in adapter.TimestampedMessages
+// with the line's timestamp. Connect it to a canadapter instance.
+// The plain and fast formats are described at https://github.com/canboat/canboat/wiki/analyzer; a plain line looks like
+// "2011-11-24-22:42:04.388,2,127251,36,255,8,7d,0b,7d,02,00,ff,ff,ff".
+// Lines with up to 8 bytes of data are single frames, including the frames of fast packets logged a line per frame.
+// Lines with more than 8 bytes of data carry a whole fast packet PGN, which is split into frames.
+type RawEndpoint struct {
+	log        *logrus.Logger
+	reader     *linereader.LineReader
+	fragmenter *canadapter.Fragmenter
+
+	handler endpoint.MessageHandler
+}
+
+// NewRawStreamEndpoint builds a new RawEndpoint reading stream, for example os.Stdin piped from actisense-serial.
+func NewRawStreamEndpoint(log *logrus.Logger, stream io.Reader) *RawEndpoint {
+	return newRawEndpoint(log, linereader.NewStreamLineReader(stream))
+}
+
+// NewRawFileEndpoint builds a new RawEndpoint reading a log file.
+func NewRawFileEndpoint(log *logrus.Logger, path string) *RawEndpoint {
+	return newRawEndpoint(log, linereader.NewFileLineReader(path))
+}
+
+// NewRawTCPEndpoint builds a new RawEndpoint connecting to a TCP server sending raw lines, like n2kd.
+func NewRawTCPEndpoint(log *logrus.Logger, address string) *RawEndpoint {
+	return newRawEndpoint(log, linereader.NewTCPLineReader(address))
+}
+
+// newRawEndpoint builds a new RawEndpoint reading from reader.
+func newRawEndpoint(log *logrus.Logger, reader *linereader.LineReader) *RawEndpoint {
+	return &RawEndpoint{
+		log:        log,
+		reader:     reader,
+		fragmenter: canadapter.NewFragmenter(),
+	}
+}
+
+// SetOutput subscribes a callback handler for whenever a message is ready
+func (r *RawEndpoint) SetOutput(mh endpoint.MessageHandler) {
+	r.handler = mh
+}
+
+// Run reads lines until the context is done, the connection closes or the end of the input.
+func (r *RawEndpoint) Run(ctx context.Context) error {
+	return r.reader.Run(ctx, r.handleLine)
+}
+
+// Close will stop the endpoint from processing further lines
+func (r *RawEndpoint) Close() error {
+	return r.reader.Close()
+}
+
+// handleLine parses a line, passing on its frames.
+func (r *RawEndpoint) handleLine(line string) {
+	if strings.HasPrefix(line, "#") {
+		return
+	}
+	info, data, err := ParseLine(line)
+	if err != nil {
+		r.log.Debugf("Discarding raw line %q: %v", line, err)
+		return
+	}
+	// as in canboat's analyzer, only data too long for a frame is a whole fast packet
+	frames, err := r.fragmenter.Fragment(info, data, len(data) > 8)
+	if err != nil {
+		r.log.Debugf("Discarding raw line %q: %v", line, err)
+		return
+	}
+	if r.handler != nil {
+		for _, f := range frames {
+			r.handler.HandleMessage(adapter.TimestampedMessage{Timestamp: info.Timestamp, Message: f})
+		}
+	}
+}
+
+// ParseLine parses a raw line into the message's info and data.
+// The fields are the timestamp, priority, PGN, source, destination, length and data. The data is either
+// a field per byte (the plain format) or a single field with all the bytes (the fast format), in hex.
+func ParseLine(line string) (pgn.MessageInfo, []uint8, error) {
+	fields := strings.Split(strings.TrimSpace(line), ",")
+	if len(fields) < 7 {
+		return pgn.MessageInfo{}, nil, fmt.Errorf("expected at least 7 fields, found %d", len(fields))
+	}
+
+	timestamp, err := parseTimestamp(fields[0])
+	if err != nil {
+		return pgn.MessageInfo{}, nil, err
+	}
+	var values [5]uint64
+	for i, bits := range []int{3, 18, 8, 8, 16} {
+		if values[i], err = strconv.ParseUint(strings.TrimSpace(fields[i+1]), 10, bits); err != nil {
+			return pgn.MessageInfo{}, nil, fmt.Errorf("invalid field %d: %q", i+1, fields[i+1])
+		}
+	}
+	info := pgn.MessageInfo{
+		Timestamp: timestamp,
+		Priority:  uint8(values[0]),
+		PGN:       uint32(values[1]),
+		SourceId:  uint8(values[2]),
+		TargetId:  uint8(values[3]),
+	}
+	length := int(values[4])
+
+	var data []uint8
+	if len(fields) == 7 && length > 1 {
+		data, err = hex.DecodeString(strings.TrimSpace(fields[6]))
+		if err != nil {
+			return pgn.MessageInfo{}, nil, fmt.Errorf("invalid data: %q", fields[6])
+		}
+	} else {
+		data = make([]uint8, 0, len(fields)-6)
+		for _, field := range fields[6:] {
+			b, err := strconv.ParseUint(strings.TrimSpace(field), 16, 8)
+			if err != nil {
+				return pgn.MessageInfo{}, nil, fmt.Errorf("invalid data byte: %q", field)
+			}
+			data = append(data, uint8(b))
+		}
+	}
+	if len(data) != length {
+		return pgn.MessageInfo{}, nil, fmt.Errorf("expected %d data bytes, found %d", length, len(data))
+	}
+	if (info.PGN&0xFF00)>>8 >= 240 {
+		// broadcast PGNs can't be targeted, so don't pass on the destination
+		info.TargetId = 0
+	}
+	return info, data, nil
+}
+
+// parseTimestamp parses the timestamp field of a raw line.
+func parseTimestamp(field string) (time.Time, error) {
+	field = strings.TrimSpace(field)
+	for _, layout := range timestampLayouts {
+		if t, err := time.ParseInLocation(layout, field, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid timestamp: %q", field)
+}
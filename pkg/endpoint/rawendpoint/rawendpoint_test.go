@@ -0,0 +1,112 @@
+package rawendpoint
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/boatkit-io/n2k/internal/testutil"
+	"github.com/boatkit-io/n2k/pkg/adapter/canadapter"
+)
+
+func TestParseLine(t *testing.T) {
+	info, data, err := ParseLine("2011-11-24-22:42:04.388,2,127251,36,255,8,7d,0b,7d,02,00,ff,ff,ff")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2011, 11, 24, 22, 42, 4, 388000000, time.Local), info.Timestamp)
+	assert.Equal(t, uint8(2), info.Priority)
+	assert.Equal(t, uint32(127251), info.PGN)
+	assert.Equal(t, uint8(36), info.SourceId)
+	assert.Equal(t, uint8(0), info.TargetId)
+	assert.Equal(t, []uint8{0x7d, 0x0b, 0x7d, 0x02, 0x00, 0xff, 0xff, 0xff}, data)
+
+	// fast format, and a targeted PGN
+	info, data, err = ParseLine("2022-03-15T10:12:44.123Z,6,59904,3,35,3,14f001")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2022, 3, 15, 10, 12, 44, 123000000, time.UTC), info.Timestamp.UTC())
+	assert.Equal(t, uint32(59904), info.PGN)
+	assert.Equal(t, uint8(35), info.TargetId)
+	assert.Equal(t, []uint8{0x14, 0xf0, 0x01}, data)
+
+	for _, bad := range []string{
+		"2011-11-24-22:42:04.388,2,127251,36,255,8,7d,0b,7d,02,00,ff,ff",
+		"2011-11-24-22:42:04.388,2,127251,36,255,8",
+		"yesterday,2,127251,36,255,1,7d",
+		"2011-11-24-22:42:04.388,9,127251,36,255,1,7d",
+		"2011-11-24-22:42:04.388,2,127251,36,255,1,7g",
+		"2011-11-24-22:42:04.388,2,127251,36,255,2,7d0",
+	} {
+		_, _, err = ParseLine(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestEndpoint(t *testing.T) {
+	input := "# a comment\n" +
+		"2011-11-24-22:42:04.388,2,127251,36,255,8,7d,0b,7d,02,00,ff,ff,ff\n" +
+		"not a raw line\n" +
+		"2011-11-24-22:42:04.390,6,126996,3,255,13,10,27,e5,04,30,00,00,00,00,00,00,00,00\n" +
+		"2011-11-24-22:42:04.392,6,126464,3,255,8,20,07,00,00,ee,01,00,ee\n" +
+		"2011-11-24-22:42:04.393,6,126464,3,255,8,21,00,ff,ff,ff,ff,ff,ff\n"
+
+	a := canadapter.NewCANAdapter(logrus.StandardLogger())
+	c := &testutil.PacketCollector{}
+	a.SetOutput(c)
+	ep := NewRawStreamEndpoint(logrus.StandardLogger(), strings.NewReader(input))
+	ep.SetOutput(a)
+	assert.NoError(t, ep.Run(context.Background()))
+
+	assert.Len(t, c.Packets, 3)
+	assert.Equal(t, uint32(127251), c.Packets[0].Info.PGN)
+	assert.Equal(t, time.Date(2011, 11, 24, 22, 42, 4, 388000000, time.Local), c.Packets[0].Info.Timestamp)
+	assert.Equal(t, uint32(126996), c.Packets[1].Info.PGN)
+	assert.True(t, c.Packets[1].Complete)
+	assert.Equal(t, []uint8{0x10, 0x27, 0xe5, 0x04, 0x30, 0, 0, 0, 0, 0, 0, 0, 0}, c.Packets[1].Data[:13])
+
+	// a fast packet logged a line per frame
+	assert.Equal(t, uint32(126464), c.Packets[2].Info.PGN)
+	assert.True(t, c.Packets[2].Complete)
+	assert.Equal(t, []uint8{0x00, 0x00, 0xee, 0x01, 0x00, 0xee, 0x00}, c.Packets[2].Data[:7])
+}
+
+func TestEndpointFrames(t *testing.T) {
+	// a 134 byte Product Information, logged as its 20 frames
+	data := make([]uint8, 134)
+	for i := range data {
+		data[i] = uint8(i)
+	}
+	var input strings.Builder
+	for frame, offset := 0, 0; offset < len(data); frame++ {
+		bytes := []uint8{0x40 | uint8(frame)}
+		if frame == 0 {
+			bytes = append(bytes, uint8(len(data)))
+		}
+		n := min(8-len(bytes), len(data)-offset)
+		bytes = append(bytes, data[offset:offset+n]...)
+		offset += n
+		for len(bytes) < 8 {
+			bytes = append(bytes, 0xff)
+		}
+		input.WriteString("2011-11-24-22:42:05.120,6,126996,3,255,8")
+		for _, b := range bytes {
+			fmt.Fprintf(&input, ",%02x", b)
+		}
+		input.WriteString("\n")
+	}
+	assert.Equal(t, 20, strings.Count(input.String(), "\n"))
+
+	a := canadapter.NewCANAdapter(logrus.StandardLogger())
+	c := &testutil.PacketCollector{}
+	a.SetOutput(c)
+	ep := NewRawStreamEndpoint(logrus.StandardLogger(), strings.NewReader(input.String()))
+	ep.SetOutput(a)
+	assert.NoError(t, ep.Run(context.Background()))
+
+	assert.Len(t, c.Packets, 1)
+	assert.True(t, c.Packets[0].Complete)
+	assert.Equal(t, data, c.Packets[0].Data)
+}
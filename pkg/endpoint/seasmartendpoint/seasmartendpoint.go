@@ -0,0 +1,70 @@
+// Package seasmartendpoint contains the SeaSmartEndpoint struct described below
+package seasmartendpoint
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/boatkit-io/n2k/pkg/adapter/seasmartadapter"
+	"github.com/boatkit-io/n2k/pkg/endpoint"
+	"github.com/boatkit-io/n2k/pkg/endpoint/linereader"
+)
+
+// SeaSmartEndpoint reads $PCDIN (SeaSmart.Net) and $MXPGN (MiniPlex) sentences from a gateway or a log file.
+// It outputs a seasmartadapter.Sentence for each; connect it to a seasmartadapter.SeaSmartAdapter.
+// Other lines, like the NMEA 0183 sentences these gateways also send, are skipped.
+type SeaSmartEndpoint struct {
+	log    *logrus.Logger
+	reader *linereader.LineReader
+
+	handler endpoint.MessageHandler
+}
+
+// NewSeaSmartTCPEndpoint builds a new SeaSmartEndpoint connecting to a gateway's TCP server, for example "192.168.1.10:10110".
+func NewSeaSmartTCPEndpoint(log *logrus.Logger, address string) *SeaSmartEndpoint {
+	return &SeaSmartEndpoint{log: log, reader: linereader.NewTCPLineReader(address)}
+}
+
+// NewSeaSmartUDPEndpoint builds a new SeaSmartEndpoint listening on a local UDP address, for example ":10110".
+func NewSeaSmartUDPEndpoint(log *logrus.Logger, listenAddress string) *SeaSmartEndpoint {
+	return &SeaSmartEndpoint{log: log, reader: linereader.NewUDPLineReader(listenAddress)}
+}
+
+// NewSeaSmartFileEndpoint builds a new SeaSmartEndpoint reading a log file of sentences.
+func NewSeaSmartFileEndpoint(log *logrus.Logger, path string) *SeaSmartEndpoint {
+	return &SeaSmartEndpoint{log: log, reader: linereader.NewFileLineReader(path)}
+}
+
+// NewSeaSmartStreamEndpoint builds a new SeaSmartEndpoint reading sentences from stream.
+func NewSeaSmartStreamEndpoint(log *logrus.Logger, stream io.Reader) *SeaSmartEndpoint {
+	return &SeaSmartEndpoint{log: log, reader: linereader.NewStreamLineReader(stream)}
+}
+
+// SetOutput subscribes a callback handler for whenever a message is ready
+func (s *SeaSmartEndpoint) SetOutput(mh endpoint.MessageHandler) {
+	s.handler = mh
+}
+
+// Run reads sentences until the context is done, the connection closes or the end of the file.
+func (s *SeaSmartEndpoint) Run(ctx context.Context) error {
+	return s.reader.Run(ctx, s.handleLine)
+}
+
+// Close will stop the endpoint from processing further sentences
+func (s *SeaSmartEndpoint) Close() error {
+	return s.reader.Close()
+}
+
+// handleLine passes on $PCDIN and $MXPGN sentences.
+func (s *SeaSmartEndpoint) handleLine(line string) {
+	if !strings.HasPrefix(line, "$PCDIN,") && !strings.HasPrefix(line, "$MXPGN,") {
+		s.log.Debugf("Skipping line %q", line)
+		return
+	}
+	if s.handler != nil {
+		s.handler.HandleMessage(seasmartadapter.Sentence(line))
+	}
+}
@@ -0,0 +1,46 @@
+package seasmartendpoint
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/boatkit-io/n2k/internal/testutil"
+	"github.com/boatkit-io/n2k/pkg/adapter"
+	"github.com/boatkit-io/n2k/pkg/adapter/seasmartadapter"
+)
+
+const testLog = "$PCDIN,01F119,00000000,0F,2AAF00D1067414FF*59\r\n" +
+	"$GPGLL,4916.45,N,12311.12,W,225444,A*1D\r\n" +
+	"\r\n" +
+	"$MXPGN,01F801,2801,C1308AC40C5DE343*19\r\n"
+
+func TestStream(t *testing.T) {
+	ep := NewSeaSmartStreamEndpoint(logrus.StandardLogger(), strings.NewReader(testLog))
+	c := &testutil.MessageCollector{}
+	ep.SetOutput(c)
+	assert.NoError(t, ep.Run(context.Background()))
+	assert.Equal(t, []adapter.Message{
+		seasmartadapter.Sentence("$PCDIN,01F119,00000000,0F,2AAF00D1067414FF*59"),
+		seasmartadapter.Sentence("$MXPGN,01F801,2801,C1308AC40C5DE343*19"),
+	}, c.Messages())
+}
+
+func TestFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "seasmart.log")
+	assert.NoError(t, os.WriteFile(path, []uint8(testLog), 0o600))
+
+	ep := NewSeaSmartFileEndpoint(logrus.StandardLogger(), path)
+	c := &testutil.MessageCollector{}
+	ep.SetOutput(c)
+	assert.NoError(t, ep.Run(context.Background()))
+	assert.Len(t, c.Messages(), 2)
+
+	ep = NewSeaSmartFileEndpoint(logrus.StandardLogger(), filepath.Join(t.TempDir(), "missing.log"))
+	assert.Error(t, ep.Run(context.Background()))
+}
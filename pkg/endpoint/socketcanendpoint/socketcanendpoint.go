@@ -3,6 +3,9 @@ package socketcanendpoint
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"sync"
 
 	"github.com/boatkit-io/n2k/pkg/adapter"
 	"github.com/boatkit-io/n2k/pkg/endpoint"
@@ -13,19 +16,30 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-// SocketCANEndpoint is an endpoint backed by a live SocketCAN interface, pulling down CAN frames
+// canEffFlag marks a SocketCAN frame ID as using the extended (29 bit) frame format, as NMEA 2000 requires.
+const canEffFlag = 0x80000000
+
+// SocketCANEndpoint is an endpoint backed by a live SocketCAN interface, pulling down CAN frames.
+// On Linux frames are read from a socket with kernel timestamps (from the interface's hardware when it supports them),
+// and output as adapter.TimestampedMessages wrapping a *can.Frame. Otherwise they're output as *can.Frame.
 type SocketCANEndpoint struct {
-	log *logrus.Logger
+	log           *logrus.Logger
+	interfaceName string
 
 	channel canbus.Interface
 
+	mu      sync.Mutex
+	running bool             // Run has been called, so frames can be written once the channel is open
+	socket  *timestampSocket // reads and writes frames when timestamps are available
+
 	handler endpoint.MessageHandler
 }
 
 // NewSocketCANEndpoint builds a new SocketCANEndpoint for the given CAN interface name
-func NewSocketCANEndpoint(log *logrus.Logger, canInterfaceName string) endpoint.Endpoint {
+func NewSocketCANEndpoint(log *logrus.Logger, canInterfaceName string) endpoint.ReadWriteEndpoint {
 	c := SocketCANEndpoint{
-		log: log,
+		log:           log,
+		interfaceName: canInterfaceName,
 	}
 
 	channelOpts := canbus.SocketCANChannelOptions{
@@ -41,8 +55,62 @@ func NewSocketCANEndpoint(log *logrus.Logger, canInterfaceName string) endpoint.
 
 // Run should, in theory, run the endpoint and block until completion/error, but the canbus implementation doesn't work like that
 // right now unfortunately, so it just spawns in the background and keeps running until Shutdown kills it...
+// The channel still configures the interface when the timestamping socket is used, but its frames are ignored
+// unless reading the socket fails.
 func (c *SocketCANEndpoint) Run(ctx context.Context) error {
-	return c.channel.Run(ctx)
+	c.setRunning(true)
+	defer c.setRunning(false)
+
+	socket, err := openTimestampSocket(c.interfaceName)
+	if err != nil {
+		c.log.WithError(err).Info("SocketCAN timestamps unavailable, frames will be timestamped when handled")
+		return c.channel.Run(ctx)
+	}
+	c.mu.Lock()
+	c.socket = socket
+	c.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		c.readTimestamped(socket)
+	}()
+
+	err = c.channel.Run(ctx)
+	c.mu.Lock()
+	c.socket = nil
+	c.mu.Unlock()
+	_ = socket.close()
+	<-done
+	return err
+}
+
+// setRunning records whether Run is running, and so whether frames can be written.
+func (c *SocketCANEndpoint) setRunning(running bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.running = running
+}
+
+// readTimestamped passes on frames from the timestamping socket until it's closed.
+func (c *SocketCANEndpoint) readTimestamped(socket *timestampSocket) {
+	for {
+		frame, timestamp, err := socket.read()
+		if err != nil {
+			if !errors.Is(err, os.ErrClosed) {
+				c.log.WithError(err).Warn("Reading SocketCAN timestamps failed, falling back to frames without them")
+				c.mu.Lock()
+				if c.socket == socket {
+					c.socket = nil
+				}
+				c.mu.Unlock()
+			}
+			return
+		}
+		if c.handler != nil {
+			c.handler.HandleMessage(adapter.TimestampedMessage{Timestamp: timestamp, Message: frame})
+		}
+	}
 }
 
 // SetOutput subscribes a callback handler for whenever a message is ready
@@ -52,6 +120,12 @@ func (c *SocketCANEndpoint) SetOutput(mh endpoint.MessageHandler) {
 
 // Close will stop the endpoint from processing further frames
 func (c *SocketCANEndpoint) Close() error {
+	c.mu.Lock()
+	if c.socket != nil {
+		_ = c.socket.close()
+	}
+	c.mu.Unlock()
+
 	if c.channel != nil {
 		var errs []error
 
@@ -71,9 +145,54 @@ func (c *SocketCANEndpoint) Close() error {
 	return nil
 }
 
+// WriteMessage sends a *can.Frame (or can.Frame) to the canbus.
+// The endpoint must be running: it returns endpoint.ErrNotRunning otherwise.
+func (c *SocketCANEndpoint) WriteMessage(ctx context.Context, msg adapter.Message) error {
+	var f can.Frame
+	switch m := msg.(type) {
+	case *can.Frame:
+		f = *m
+	case can.Frame:
+		f = m
+	default:
+		return fmt.Errorf("SocketCANEndpoint expected *can.Frame, received: %T", msg)
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	running, socket := c.running, c.socket
+	c.mu.Unlock()
+	if !running {
+		return endpoint.ErrNotRunning
+	}
+	if socket != nil {
+		// written from the reading socket so it isn't looped back to it
+		return socket.write(&f)
+	}
+
+	f.ID |= canEffFlag
+	return c.writeFrame(f)
+}
+
+// writeFrame writes a frame to the channel. The channel doesn't say when Run has opened its bus, and writing before
+// then panics, so that's returned as endpoint.ErrNotRunning.
+func (c *SocketCANEndpoint) writeFrame(f can.Frame) (err error) {
+	defer func() {
+		if recover() != nil {
+			err = endpoint.ErrNotRunning
+		}
+	}()
+	return c.channel.WriteFrame(f)
+}
+
 // frameReady is a helper to handle passing completed frames to the handler
 func (c *SocketCANEndpoint) frameReady(frame can.Frame) {
-	if c.handler != nil {
+	c.mu.Lock()
+	timestamped := c.socket != nil
+	c.mu.Unlock()
+	if c.handler != nil && !timestamped {
 		c.handler.HandleMessage(adapter.Message(&frame))
 	}
 }
@@ -0,0 +1,24 @@
+package socketcanendpoint
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brutella/can"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/boatkit-io/n2k/pkg/endpoint"
+)
+
+func TestWriteBeforeRun(t *testing.T) {
+	ep := NewSocketCANEndpoint(logrus.StandardLogger(), "can0")
+	err := ep.WriteMessage(context.Background(), &can.Frame{ID: 0x09F80103, Length: 8})
+	assert.ErrorIs(t, err, endpoint.ErrNotRunning)
+
+	// running, but the bus isn't open yet
+	c := ep.(*SocketCANEndpoint)
+	c.setRunning(true)
+	err = c.WriteMessage(context.Background(), &can.Frame{ID: 0x09F80103, Length: 8})
+	assert.ErrorIs(t, err, endpoint.ErrNotRunning)
+}
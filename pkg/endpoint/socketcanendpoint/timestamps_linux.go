@@ -0,0 +1,148 @@
+//go:build linux
+
+package socketcanendpoint
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"time"
+	"unsafe"
+
+	"github.com/brutella/can"
+	"golang.org/x/sys/unix"
+)
+
+// canFrameSize is the size of the kernel's struct can_frame.
+const canFrameSize = 16
+
+// timestampSocket is a raw SocketCAN socket that receives frames with kernel timestamps.
+// It asks for hardware timestamps, and falls back to the kernel's software receive timestamps when the
+// interface doesn't provide them.
+type timestampSocket struct {
+	file *os.File
+}
+
+// openTimestampSocket opens a timestampSocket bound to the named interface.
+func openTimestampSocket(interfaceName string) (*timestampSocket, error) {
+	iface, err := net.InterfaceByName(interfaceName)
+	if err != nil {
+		return nil, err
+	}
+	fd, err := unix.Socket(unix.AF_CAN, unix.SOCK_RAW|unix.SOCK_NONBLOCK|unix.SOCK_CLOEXEC, unix.CAN_RAW)
+	if err != nil {
+		return nil, fmt.Errorf("opening CAN socket: %w", err)
+	}
+	flags := unix.SOF_TIMESTAMPING_RX_HARDWARE | unix.SOF_TIMESTAMPING_RAW_HARDWARE |
+		unix.SOF_TIMESTAMPING_RX_SOFTWARE | unix.SOF_TIMESTAMPING_SOFTWARE
+	if err := unix.SetsockoptInt(fd, unix.SOL_SOCKET, unix.SO_TIMESTAMPING, flags); err != nil {
+		unix.Close(fd)
+		return nil, fmt.Errorf("enabling timestamps: %w", err)
+	}
+	if err := unix.Bind(fd, &unix.SockaddrCAN{Ifindex: iface.Index}); err != nil {
+		unix.Close(fd)
+		return nil, fmt.Errorf("binding CAN socket to %s: %w", interfaceName, err)
+	}
+	// wrapping the non-blocking socket in a File uses the runtime poller, so Close unblocks reads
+	return &timestampSocket{file: os.NewFile(uintptr(fd), interfaceName)}, nil
+}
+
+// read waits for the next data frame, returning it and its timestamp.
+func (s *timestampSocket) read() (*can.Frame, time.Time, error) {
+	rc, err := s.file.SyscallConn()
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	buf := make([]uint8, canFrameSize)
+	oob := make([]uint8, unix.CmsgSpace(3*int(unsafe.Sizeof(unix.Timespec{}))))
+	for {
+		var n, oobn int
+		var readErr error
+		err = rc.Read(func(fd uintptr) bool {
+			n, oobn, _, _, readErr = unix.Recvmsg(int(fd), buf, oob, 0)
+			return readErr != unix.EAGAIN
+		})
+		if err == nil {
+			err = readErr
+		}
+		if errors.Is(err, unix.ENETDOWN) {
+			// reported once when the interface goes down, for example while its bitrate is changed
+			continue
+		}
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+
+		frame, ok := decodeFrame(buf[:n])
+		if !ok {
+			continue
+		}
+		timestamp := decodeTimestamp(oob[:oobn])
+		if timestamp.IsZero() {
+			timestamp = time.Now()
+		}
+		return frame, timestamp, nil
+	}
+}
+
+// write sends a frame with an extended (29 bit) ID.
+func (s *timestampSocket) write(frame *can.Frame) error {
+	_, err := s.file.Write(encodeFrame(frame))
+	return err
+}
+
+// close closes the socket, unblocking any read.
+func (s *timestampSocket) close() error {
+	return s.file.Close()
+}
+
+// decodeFrame decodes a struct can_frame, returning false for anything but extended data frames.
+func decodeFrame(buf []uint8) (*can.Frame, bool) {
+	if len(buf) < canFrameSize {
+		return nil, false
+	}
+	id := binary.LittleEndian.Uint32(buf)
+	if id&can.MaskEff == 0 || id&(can.MaskErr|can.MaskRtr) != 0 {
+		return nil, false
+	}
+	frame := can.Frame{ID: id & can.MaskIDEff, Length: buf[4]}
+	if frame.Length > 8 {
+		return nil, false
+	}
+	copy(frame.Data[:], buf[8:8+frame.Length])
+	return &frame, true
+}
+
+// encodeFrame encodes a frame as a struct can_frame.
+func encodeFrame(frame *can.Frame) []uint8 {
+	buf := make([]uint8, canFrameSize)
+	binary.LittleEndian.PutUint32(buf, frame.ID&can.MaskIDEff|canEffFlag)
+	buf[4] = frame.Length
+	copy(buf[8:], frame.Data[:])
+	return buf
+}
+
+// decodeTimestamp returns the timestamp from a SCM_TIMESTAMPING control message, preferring the hardware
+// timestamp. It returns a zero time if there isn't one.
+func decodeTimestamp(oob []uint8) time.Time {
+	messages, err := unix.ParseSocketControlMessage(oob)
+	if err != nil {
+		return time.Time{}
+	}
+	for _, m := range messages {
+		if m.Header.Level != unix.SOL_SOCKET || m.Header.Type != unix.SCM_TIMESTAMPING ||
+			len(m.Data) < 3*int(unsafe.Sizeof(unix.Timespec{})) {
+			continue
+		}
+		// software, deprecated, and raw hardware timestamps
+		stamps := (*[3]unix.Timespec)(unsafe.Pointer(&m.Data[0]))
+		for _, i := range []int{2, 0} {
+			if stamps[i].Sec != 0 || stamps[i].Nsec != 0 {
+				return time.Unix(stamps[i].Unix())
+			}
+		}
+	}
+	return time.Time{}
+}
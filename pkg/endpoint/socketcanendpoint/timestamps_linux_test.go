@@ -0,0 +1,90 @@
+//go:build linux
+
+package socketcanendpoint
+
+import (
+	"os"
+	"testing"
+	"time"
+	"unsafe"
+
+	"github.com/brutella/can"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/sys/unix"
+
+	"github.com/boatkit-io/n2k/pkg/adapter"
+)
+
+// timestampingMessage builds a SCM_TIMESTAMPING control message holding stamps.
+func timestampingMessage(stamps [3]unix.Timespec) []uint8 {
+	size := int(unsafe.Sizeof(stamps))
+	buf := make([]uint8, unix.CmsgSpace(size))
+	h := (*unix.Cmsghdr)(unsafe.Pointer(&buf[0]))
+	h.Level = unix.SOL_SOCKET
+	h.Type = unix.SCM_TIMESTAMPING
+	h.SetLen(unix.CmsgLen(size))
+	copy(buf[unix.CmsgLen(0):], unsafe.Slice((*uint8)(unsafe.Pointer(&stamps)), size))
+	return buf
+}
+
+func TestFrameEncoding(t *testing.T) {
+	frame := &can.Frame{ID: 0x09F80101, Length: 3, Data: [8]uint8{1, 2, 3}}
+	buf := encodeFrame(frame)
+	assert.Equal(t, []uint8{0x01, 0x01, 0xF8, 0x89, 3, 0, 0, 0, 1, 2, 3, 0, 0, 0, 0, 0}, buf)
+
+	decoded, ok := decodeFrame(buf)
+	assert.True(t, ok)
+	assert.Equal(t, frame, decoded)
+
+	// standard and remote frames aren't NMEA 2000
+	_, ok = decodeFrame([]uint8{0x23, 0x01, 0, 0, 1, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0})
+	assert.False(t, ok)
+	_, ok = decodeFrame([]uint8{0x01, 0x01, 0xF8, 0xC9, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0})
+	assert.False(t, ok)
+	_, ok = decodeFrame(buf[:8])
+	assert.False(t, ok)
+}
+
+func TestDecodeTimestamp(t *testing.T) {
+	software := unix.NsecToTimespec(time.Unix(1700000000, 1000).UnixNano())
+	hardware := unix.NsecToTimespec(time.Unix(1700000000, 2000).UnixNano())
+
+	assert.Equal(t, time.Unix(1700000000, 2000), decodeTimestamp(timestampingMessage([3]unix.Timespec{software, {}, hardware})))
+	assert.Equal(t, time.Unix(1700000000, 1000), decodeTimestamp(timestampingMessage([3]unix.Timespec{software, {}, {}})))
+	assert.True(t, decodeTimestamp(timestampingMessage([3]unix.Timespec{})).IsZero())
+	assert.True(t, decodeTimestamp(nil).IsZero())
+}
+
+// messageCounter is a MessageHandler that counts the messages it receives
+type messageCounter struct {
+	count int
+}
+
+// HandleMessage method counts the message
+func (c *messageCounter) HandleMessage(adapter.Message) {
+	c.count++
+}
+
+func TestTimestampReadFailure(t *testing.T) {
+	c := NewSocketCANEndpoint(logrus.StandardLogger(), "can0").(*SocketCANEndpoint)
+	counter := &messageCounter{}
+	c.SetOutput(counter)
+
+	// reading a pipe rather than a CAN socket fails
+	r, w, err := os.Pipe()
+	assert.NoError(t, err)
+	defer w.Close()
+	socket := &timestampSocket{file: r}
+	c.socket = socket
+
+	// frames from the bus are ignored while the socket is read
+	c.frameReady(can.Frame{ID: 0x09F80101, Length: 8})
+	assert.Equal(t, 0, counter.count)
+
+	c.readTimestamped(socket)
+	_ = socket.close()
+	assert.Nil(t, c.socket)
+	c.frameReady(can.Frame{ID: 0x09F80101, Length: 8})
+	assert.Equal(t, 1, counter.count)
+}
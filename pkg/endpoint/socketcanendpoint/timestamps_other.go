@@ -0,0 +1,33 @@
+//go:build !linux
+
+package socketcanendpoint
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/brutella/can"
+)
+
+// timestampSocket isn't available without Linux.
+type timestampSocket struct{}
+
+// openTimestampSocket returns an error, since kernel timestamps need Linux.
+func openTimestampSocket(interfaceName string) (*timestampSocket, error) {
+	return nil, fmt.Errorf("SocketCAN timestamps need Linux")
+}
+
+// read isn't available without Linux.
+func (s *timestampSocket) read() (*can.Frame, time.Time, error) {
+	return nil, time.Time{}, fmt.Errorf("SocketCAN timestamps need Linux")
+}
+
+// write isn't available without Linux.
+func (s *timestampSocket) write(frame *can.Frame) error {
+	return fmt.Errorf("SocketCAN timestamps need Linux")
+}
+
+// close does nothing without Linux.
+func (s *timestampSocket) close() error {
+	return nil
+}
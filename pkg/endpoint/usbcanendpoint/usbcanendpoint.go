@@ -3,6 +3,8 @@ package usbcanendpoint
 
 import (
 	"context"
+	"fmt"
+	"sync"
 
 	"github.com/boatkit-io/n2k/pkg/adapter"
 	"github.com/boatkit-io/n2k/pkg/endpoint"
@@ -19,11 +21,14 @@ type USBCANEndpoint struct {
 
 	channel canbus.Interface
 
+	mu      sync.Mutex
+	running bool // Run has been called, so frames can be written once the channel is open
+
 	handler endpoint.MessageHandler
 }
 
 // NewUSBCANEndpoint builds a new SocketCANEndpoint for the given CAN interface name
-func NewUSBCANEndpoint(log *logrus.Logger, serialPortName string) endpoint.Endpoint {
+func NewUSBCANEndpoint(log *logrus.Logger, serialPortName string) endpoint.ReadWriteEndpoint {
 	c := USBCANEndpoint{
 		log: log,
 	}
@@ -43,9 +48,18 @@ func NewUSBCANEndpoint(log *logrus.Logger, serialPortName string) endpoint.Endpo
 // Run should, in theory, run the endpoint and block until completion/error, but the canbus implementation doesn't work like that
 // right now unfortunately, so it just spawns in the background and keeps running until Shutdown kills it...
 func (c *USBCANEndpoint) Run(ctx context.Context) error {
+	c.setRunning(true)
+	defer c.setRunning(false)
 	return c.channel.Run(ctx)
 }
 
+// setRunning records whether Run is running, and so whether frames can be written.
+func (c *USBCANEndpoint) setRunning(running bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.running = running
+}
+
 // SetOutput subscribes a callback handler for whenever a message is ready
 func (c *USBCANEndpoint) SetOutput(mh endpoint.MessageHandler) {
 	c.handler = mh
@@ -72,6 +86,43 @@ func (c *USBCANEndpoint) Close() error {
 	return nil
 }
 
+// WriteMessage sends a *can.Frame (or can.Frame) to the canbus.
+// The endpoint must be running: it returns endpoint.ErrNotRunning otherwise.
+func (c *USBCANEndpoint) WriteMessage(ctx context.Context, msg adapter.Message) error {
+	var f can.Frame
+	switch m := msg.(type) {
+	case *can.Frame:
+		f = *m
+	case can.Frame:
+		f = m
+	default:
+		return fmt.Errorf("USBCANEndpoint expected *can.Frame, received: %T", msg)
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	f.ID &= can.MaskIDEff
+
+	c.mu.Lock()
+	running := c.running
+	c.mu.Unlock()
+	if !running {
+		return endpoint.ErrNotRunning
+	}
+	return c.writeFrame(f)
+}
+
+// writeFrame writes a frame to the channel, returning endpoint.ErrNotRunning if Run hasn't opened the serial port yet.
+// The channel doesn't expose its port, and panics when written to without one.
+func (c *USBCANEndpoint) writeFrame(f can.Frame) (err error) {
+	defer func() {
+		if recover() != nil {
+			err = endpoint.ErrNotRunning
+		}
+	}()
+	return c.channel.WriteFrame(f)
+}
+
 // frameReady is a helper to handle passing completed frames to the handler
 func (c *USBCANEndpoint) frameReady(frame can.Frame) {
 	if c.handler != nil {
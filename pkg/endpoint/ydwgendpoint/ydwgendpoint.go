@@ -0,0 +1,169 @@
+// Package ydwgendpoint contains the YDWGEndpoint struct described below
+package ydwgendpoint
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/brutella/can"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/boatkit-io/n2k/pkg/adapter"
+	"github.com/boatkit-io/n2k/pkg/endpoint"
+	"github.com/boatkit-io/n2k/pkg/endpoint/linereader"
+)
+
+// YDWGEndpoint is an endpoint backed by a Yacht Devices YDWG-02 or YDEN-02 gateway, using its RAW protocol.
+// The protocol is described in Appendix E of https://www.yachtd.com/downloads/ydwg02.pdf.
+// The gateway sends a line per canbus frame, like "17:33:21.107 R 19F51323 01 2F 30 70 00 2F 30 70".
+// "R" frames were received from the network, "T" frames were transmitted by the gateway; both are output,
+// as adapter.TimestampedMessages wrapping a *can.Frame, timestamped with the gateway's time of day.
+// Frames written to the endpoint are sent to the gateway as "19F51323 01 2F 30 70 00 2F 30 70" lines,
+// which it transmits.
+type YDWGEndpoint struct {
+	log    *logrus.Logger
+	reader *linereader.LineReader
+
+	handler endpoint.MessageHandler
+}
+
+// NewYDWGTCPEndpoint builds a new YDWGEndpoint connecting to the gateway's TCP RAW server, for example "192.168.4.1:1457".
+func NewYDWGTCPEndpoint(log *logrus.Logger, address string) endpoint.ReadWriteEndpoint {
+	return &YDWGEndpoint{log: log, reader: linereader.NewTCPLineReader(address)}
+}
+
+// NewYDWGUDPEndpoint builds a new YDWGEndpoint listening on a local UDP address, for example ":1456", for the
+// gateway's UDP RAW broadcasts. Writes are sent to the gateway the last datagram came from.
+func NewYDWGUDPEndpoint(log *logrus.Logger, listenAddress string) endpoint.ReadWriteEndpoint {
+	return &YDWGEndpoint{log: log, reader: linereader.NewUDPLineReader(listenAddress)}
+}
+
+// SetOutput subscribes a callback handler for whenever a message is ready
+func (y *YDWGEndpoint) SetOutput(mh endpoint.MessageHandler) {
+	y.handler = mh
+}
+
+// Run connects to (or listens for) the gateway and processes frames until the context is done or the
+// connection is closed.
+func (y *YDWGEndpoint) Run(ctx context.Context) error {
+	return y.reader.Run(ctx, y.handleLine)
+}
+
+// Close will stop the endpoint from processing further frames
+func (y *YDWGEndpoint) Close() error {
+	if err := y.reader.Close(); err != nil {
+		return errors.Wrap(err, "closing YDWG connection")
+	}
+	return nil
+}
+
+// WriteMessage sends a *can.Frame (or can.Frame) to the gateway to transmit.
+// The endpoint must be running: it returns endpoint.ErrNotRunning otherwise, or for UDP until the gateway's been heard from.
+func (y *YDWGEndpoint) WriteMessage(ctx context.Context, msg adapter.Message) error {
+	var f can.Frame
+	switch m := msg.(type) {
+	case *can.Frame:
+		f = *m
+	case can.Frame:
+		f = m
+	default:
+		return fmt.Errorf("YDWGEndpoint expected *can.Frame, received: %T", msg)
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	err := y.reader.Write([]uint8(FormatFrame(&f) + "\r\n"))
+	if errors.Is(err, linereader.ErrNotConnected) {
+		return endpoint.ErrNotRunning
+	}
+	return err
+}
+
+// handleLine parses a line from the gateway, passing on its frame.
+func (y *YDWGEndpoint) handleLine(line string) {
+	timeOfDay, frame, _, err := ParseLine(line)
+	if err != nil {
+		y.log.Debugf("Discarding YDWG line %q: %v", line, err)
+		return
+	}
+	if y.handler != nil {
+		y.handler.HandleMessage(adapter.TimestampedMessage{Timestamp: Timestamp(timeOfDay, time.Now()), Message: frame})
+	}
+}
+
+// Timestamp returns the time with the gateway's time of day that's nearest to now.
+// The gateway's clock is UTC, set from the network's time messages, so a line received just after midnight can
+// have a time of day from the previous day.
+func Timestamp(timeOfDay time.Duration, now time.Time) time.Time {
+	day := now.UTC().Truncate(24 * time.Hour)
+	ret := day.Add(timeOfDay)
+	if ret.Sub(now) > 12*time.Hour {
+		ret = ret.AddDate(0, 0, -1)
+	} else if now.Sub(ret) > 12*time.Hour {
+		ret = ret.AddDate(0, 0, 1)
+	}
+	return ret
+}
+
+// ParseLine parses a RAW protocol line from the gateway, like "17:33:21.107 R 19F51323 01 2F 30 70 00 2F 30 70".
+// It returns the gateway's time of day, the frame, and true if it was transmitted by the gateway ("T") rather
+// than received ("R").
+func ParseLine(line string) (time.Duration, *can.Frame, bool, error) {
+	elems := strings.Fields(line)
+	if len(elems) < 4 || len(elems) > 11 {
+		return 0, nil, false, fmt.Errorf("wrong number of fields: %d", len(elems))
+	}
+	timeOfDay, err := parseTimeOfDay(elems[0])
+	if err != nil {
+		return 0, nil, false, err
+	}
+	var transmitted bool
+	switch elems[1] {
+	case "R":
+	case "T":
+		transmitted = true
+	default:
+		return 0, nil, false, fmt.Errorf("unknown direction: %s", elems[1])
+	}
+
+	id, err := strconv.ParseUint(elems[2], 16, 32)
+	if err != nil {
+		return 0, nil, false, errors.Wrap(err, "parsing frame ID")
+	}
+	frame := can.Frame{
+		ID:     uint32(id) & can.MaskIDEff,
+		Length: uint8(len(elems) - 3),
+	}
+	for i, elem := range elems[3:] {
+		b, err := strconv.ParseUint(elem, 16, 8)
+		if err != nil {
+			return 0, nil, false, errors.Wrap(err, "parsing frame data")
+		}
+		frame.Data[i] = uint8(b)
+	}
+	return timeOfDay, &frame, transmitted, nil
+}
+
+// parseTimeOfDay parses the gateway's "hh:mm:ss.sss" time into the time since midnight.
+func parseTimeOfDay(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04:05.999", s)
+	if err != nil {
+		return 0, errors.Wrap(err, "parsing time")
+	}
+	return t.Sub(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)), nil
+}
+
+// FormatFrame returns the RAW protocol line (without its line ending) that asks the gateway to transmit frame.
+func FormatFrame(frame *can.Frame) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%08X", frame.ID&can.MaskIDEff)
+	for _, b := range frame.Data[:frame.Length] {
+		fmt.Fprintf(&sb, " %02X", b)
+	}
+	return sb.String()
+}
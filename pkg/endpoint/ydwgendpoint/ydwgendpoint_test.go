@@ -0,0 +1,134 @@
+package ydwgendpoint
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/brutella/can"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/boatkit-io/n2k/internal/testutil"
+	"github.com/boatkit-io/n2k/pkg/adapter"
+	"github.com/boatkit-io/n2k/pkg/endpoint"
+)
+
+func TestParseLine(t *testing.T) {
+	timeOfDay, f, transmitted, err := ParseLine("17:33:21.107 R 19F51323 01 2F 30 70 00 2F 30 70")
+	assert.NoError(t, err)
+	assert.Equal(t, 17*time.Hour+33*time.Minute+21107*time.Millisecond, timeOfDay)
+	assert.False(t, transmitted)
+	assert.Equal(t, uint32(0x19F51323), f.ID)
+	assert.Equal(t, uint8(8), f.Length)
+	assert.Equal(t, [8]uint8{0x01, 0x2F, 0x30, 0x70, 0x00, 0x2F, 0x30, 0x70}, f.Data)
+
+	_, f, transmitted, err = ParseLine("17:33:21.108 T 18EAFF03 14 F0 01")
+	assert.NoError(t, err)
+	assert.True(t, transmitted)
+	assert.Equal(t, uint8(3), f.Length)
+	assert.Equal(t, "18EAFF03 14 F0 01", FormatFrame(f))
+
+	for _, bad := range []string{
+		"17:33:21.107 X 19F51323 01",
+		"17:33:21.107 R 19F5132G 01",
+		"17:33:21.107 R 19F51323 01 2F 30 70 00 2F 30 70 01",
+		"17:33:21.107 R 19F51323 1FF",
+		"17:33:21.107 R",
+		"17:33 R 19F51323 01",
+		"25:33:21.107 R 19F51323 01",
+	} {
+		_, _, _, err = ParseLine(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestTimestamp(t *testing.T) {
+	now := time.Date(2024, 6, 1, 17, 33, 22, 0, time.UTC)
+	timeOfDay := 17*time.Hour + 33*time.Minute + 21107*time.Millisecond
+	assert.Equal(t, time.Date(2024, 6, 1, 17, 33, 21, 107000000, time.UTC), Timestamp(timeOfDay, now))
+
+	// either side of midnight
+	assert.Equal(t, time.Date(2024, 5, 31, 23, 59, 59, 0, time.UTC),
+		Timestamp(23*time.Hour+59*time.Minute+59*time.Second, time.Date(2024, 6, 1, 0, 0, 1, 0, time.UTC)))
+	assert.Equal(t, time.Date(2024, 6, 2, 0, 0, 1, 0, time.UTC),
+		Timestamp(time.Second, time.Date(2024, 6, 1, 23, 59, 59, 0, time.UTC)))
+}
+
+func TestTCP(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer listener.Close()
+
+	// the stand-in gateway sends some frames, then reports what it receives
+	received := make(chan string, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		_, _ = conn.Write([]uint8("17:33:21.107 R 19F51323 01 2F 30 70 00 2F 30 70\r\n" +
+			"garbage\r\n" +
+			"17:33:21.108 T 18EAFF03 14 F0 01\r\n"))
+		line, err := bufio.NewReader(conn).ReadString('\n')
+		if err == nil {
+			received <- line
+		}
+	}()
+
+	ep := NewYDWGTCPEndpoint(logrus.StandardLogger(), listener.Addr().String())
+	collector := &testutil.MessageCollector{}
+	ep.SetOutput(collector)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- ep.Run(ctx) }()
+
+	assert.Eventually(t, func() bool { return collector.Count() == 2 }, time.Second, time.Millisecond)
+	msg, timestamp := adapter.Unwrap(collector.Messages()[1])
+	assert.Equal(t, uint32(0x18EAFF03), msg.(*can.Frame).ID)
+	assert.Equal(t, 21108*time.Millisecond, timestamp.Sub(timestamp.Truncate(time.Minute)))
+
+	assert.NoError(t, ep.WriteMessage(ctx, &can.Frame{ID: 0x09F80101, Length: 8, Data: [8]uint8{1, 2, 3, 4, 5, 6, 7, 8}}))
+	assert.Equal(t, "09F80101 01 02 03 04 05 06 07 08\r\n", <-received)
+
+	cancel()
+	assert.NoError(t, <-done)
+	assert.ErrorIs(t, ep.WriteMessage(context.Background(), &can.Frame{}), endpoint.ErrNotRunning)
+}
+
+func TestUDP(t *testing.T) {
+	// find a free port
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	local := pc.LocalAddr()
+	pc.Close()
+
+	ep := NewYDWGUDPEndpoint(logrus.StandardLogger(), local.String())
+	collector := &testutil.MessageCollector{}
+	ep.SetOutput(collector)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() { _ = ep.Run(ctx) }()
+
+	// not connected until a datagram arrives
+	assert.ErrorIs(t, ep.WriteMessage(ctx, &can.Frame{}), endpoint.ErrNotRunning)
+
+	gateway, err := net.ListenPacket("udp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer gateway.Close()
+	assert.Eventually(t, func() bool {
+		_, err = gateway.WriteTo([]uint8("17:33:21.107 R 19F51323 01 2F\r\n17:33:21.108 R 19F51323 02 30\r\n"), local)
+		assert.NoError(t, err)
+		return collector.Count() >= 2
+	}, time.Second, 20*time.Millisecond)
+
+	assert.NoError(t, ep.WriteMessage(ctx, can.Frame{ID: 0x09F80101, Length: 1, Data: [8]uint8{0xAB}}))
+	buf := make([]uint8, 100)
+	_ = gateway.SetReadDeadline(time.Now().Add(time.Second))
+	count, _, err := gateway.ReadFrom(buf)
+	assert.NoError(t, err)
+	assert.Equal(t, "09F80101 AB\r\n", string(buf[:count]))
+}
@@ -0,0 +1,246 @@
+package export
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/boatkit-io/n2k/pkg/pgn"
+	"github.com/boatkit-io/n2k/pkg/pkt"
+)
+
+// canboatTimeFormat is the timestamp format of canboat's analyzer.
+const canboatTimeFormat = "2006-01-02T15:04:05.000Z"
+
+// CanboatRecord describes a decoded PGN struct in the JSON schema of canboat's "analyzer -json", also used by tools like
+// Signal K's canboatjs. Values are in SI units, as with analyzer's -si option.
+type CanboatRecord struct {
+	Timestamp   string `json:"timestamp"`
+	Priority    uint8  `json:"prio"`
+	Source      uint8  `json:"src"`
+	Destination uint8  `json:"dst"`
+	PGN         uint32 `json:"pgn"`
+	Description string `json:"description"`
+	Fields      Fields `json:"fields"`
+}
+
+// NewCanboatRecord returns the CanboatRecord for a PGN struct, as output by PacketStruct.
+// Fields are named as in canboat, and missing values are left out. Repeating field sets are in "list" (and "list2"),
+// binary data is hex bytes separated by spaces, and strings don't have trailing padding.
+func NewCanboatRecord(p any) (CanboatRecord, error) {
+	return newCanboatRecord(p, nil)
+}
+
+// NewCanboatPacketRecord returns the CanboatRecord for a complete packet, as output by CANAdapter, decoded as
+// PacketStruct would. Numbers with a resolution are worked out from the packet's data rather than the decoded struct,
+// whose float32s can't hold every value of the longer fields, so they match analyzer's to the last digit.
+func NewCanboatPacketRecord(p pkt.Packet) (CanboatRecord, error) {
+	var decoded structHolder
+	ps := pkt.NewPacketStruct()
+	ps.SetOutput(&decoded)
+	ps.HandlePacket(p)
+	return newCanboatRecord(decoded.p, p.Data)
+}
+
+// structHolder is a StructHandler that keeps the last struct it receives.
+type structHolder struct {
+	p any
+}
+
+// HandleStruct method keeps the struct
+func (h *structHolder) HandleStruct(p any) {
+	h.p = p
+}
+
+// newCanboatRecord returns the CanboatRecord for a PGN struct, with numbers from data if it's not nil.
+func newCanboatRecord(p any, data []uint8) (CanboatRecord, error) {
+	r, err := NewRecord(p)
+	if err != nil {
+		return CanboatRecord{}, err
+	}
+	v := reflect.ValueOf(p)
+	if v.Kind() == reflect.Pointer {
+		v = v.Elem()
+	}
+
+	cr := CanboatRecord{
+		Timestamp:   r.Timestamp.UTC().Format(canboatTimeFormat),
+		Priority:    r.Priority,
+		Source:      r.Source,
+		Destination: r.Destination,
+		PGN:         r.PGN,
+		Description: r.Description,
+	}
+	if r.Timestamp.IsZero() {
+		cr.Timestamp = time.Unix(0, 0).UTC().Format(canboatTimeFormat)
+	}
+	if u, ok := p.(pgn.UnknownPGN); ok {
+		cr.Fields = Fields{{Name: "Data", Value: canboatBinary(u.Data)}}
+		if cr.Description == "" {
+			cr.Description = "Unknown PGN"
+		}
+		return cr, nil
+	}
+	if u, ok := p.(*pgn.UnknownPGN); ok {
+		return newCanboatRecord(*u, data)
+	}
+	cr.Fields = canboatFields(v, pgn.IdLookup[r.Type], data)
+	return cr, nil
+}
+
+// MarshalCanboat returns a PGN struct as a line of canboat analyzer JSON, without a line ending.
+func MarshalCanboat(p any) ([]byte, error) {
+	r, err := NewCanboatRecord(p)
+	if err != nil {
+		return nil, err
+	}
+	return marshal(r)
+}
+
+// canboatFields returns the fields of a PGN struct (or repeating field set) with canboat's names, apart from Info.
+// Numbers are read from data, the PGN's data, if it's not nil; it's nil for repeating field sets, which move.
+func canboatFields(v reflect.Value, pi *pgn.PgnInfo, data []uint8) Fields {
+	t := v.Type()
+	fields := make(Fields, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		id := t.Field(i).Name
+		f := v.Field(i)
+		switch {
+		case id == "Info":
+			continue
+		case strings.HasPrefix(id, "Repeating"):
+			if f.Len() == 0 {
+				continue
+			}
+			items := make([]Fields, f.Len())
+			for j := range items {
+				items[j] = canboatFields(f.Index(j), pi, nil)
+			}
+			// and fields after them
+			data = nil
+			name := "list"
+			if id != "Repeating1" {
+				name += strings.TrimPrefix(id, "Repeating")
+			}
+			fields = append(fields, Field{Name: name, Value: items})
+			continue
+		}
+
+		fd := descriptor(pi, id)
+		value := canboatValue(f, fd)
+		if value == nil {
+			continue
+		}
+		if _, ok := value.(json.Number); ok && data != nil && fd != nil {
+			if n, ok := rawNumber(data, pi, fd); ok {
+				value = n
+			}
+		}
+		name := id
+		if fd != nil {
+			name = fd.Name
+		}
+		fields = append(fields, Field{Name: name, Value: value})
+	}
+	return fields
+}
+
+// canboatValue converts a field's value to canboat's representation, or nil if it's missing.
+func canboatValue(v reflect.Value, fd *pgn.FieldDescriptor) any {
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	switch {
+	case v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8:
+		return canboatBinary(v.Bytes())
+	case v.Kind() == reflect.String:
+		// without the padding of fixed length strings
+		return strings.TrimRight(v.String(), " ")
+	}
+
+	if s, ok := v.Interface().(fmt.Stringer); ok && (v.CanInt() || v.CanUint()) {
+		// lookups, printed as a number when the value has no name
+		name := s.String()
+		if name == fmt.Sprintf("%s(%d)", v.Type().Name(), v.Interface()) {
+			if v.CanInt() {
+				return json.Number(strconv.FormatInt(v.Int(), 10))
+			}
+			return json.Number(strconv.FormatUint(v.Uint(), 10))
+		}
+		return name
+	}
+
+	// the same as Record, but with units left implicit
+	value := fieldValue(v, fd, nil)
+	if q, ok := value.(Quantity); ok {
+		if n, ok := q.Value.(json.Number); ok && fd != nil {
+			// round tugboat units like other numbers
+			if f, err := n.Float64(); err == nil {
+				return formatFloat(f, 64, fd)
+			}
+		}
+		return q.Value
+	}
+	return value
+}
+
+// rawNumber returns a number field's value worked out from the PGN's data and rounded to its resolution, or false if
+// it doesn't have a resolution, or its position in the data isn't fixed.
+func rawNumber(data []uint8, pi *pgn.PgnInfo, fd *pgn.FieldDescriptor) (json.Number, bool) {
+	if fd.CanboatType != "NUMBER" || fd.Resolution <= 0 || fd.Resolution == 1 || fd.BitLength == 0 || fd.BitLength > 64 {
+		return "", false
+	}
+	at := fieldOrder(pi, fd)
+	for order, other := range pi.Fields {
+		// fields after a variable length one have no fixed offset
+		if order < at && other.BitLengthVariable {
+			return "", false
+		}
+	}
+	offset, length := int(fd.BitOffset), int(fd.BitLength)
+	if offset+length > len(data)*8 {
+		return "", false
+	}
+
+	var raw uint64
+	for i := 0; i < length; i++ {
+		bit := offset + i
+		raw |= uint64(data[bit/8]>>(bit%8)&1) << i
+	}
+	value := float64(raw)
+	if fd.Signed {
+		value = float64(int64(raw<<(64-length)) >> (64 - length))
+	}
+	// the resolution's decimal value, rather than its float32 approximation
+	resolution, err := strconv.ParseFloat(strconv.FormatFloat(float64(fd.Resolution), 'g', -1, 32), 64)
+	if err != nil {
+		return "", false
+	}
+	return formatFloat(value*resolution, 64, fd), true
+}
+
+// fieldOrder returns the position of a field in its PGN.
+func fieldOrder(pi *pgn.PgnInfo, fd *pgn.FieldDescriptor) int {
+	for order, other := range pi.Fields {
+		if other == fd {
+			return order
+		}
+	}
+	return -1
+}
+
+// canboatBinary formats binary data as canboat does.
+func canboatBinary(data []uint8) string {
+	parts := make([]string, len(data))
+	for i, b := range data {
+		parts[i] = hex.EncodeToString([]uint8{b})
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,84 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/boatkit-io/n2k/pkg/adapter/canadapter"
+	"github.com/boatkit-io/n2k/pkg/endpoint/rawendpoint"
+	"github.com/boatkit-io/n2k/pkg/pgn"
+	"github.com/boatkit-io/n2k/pkg/pkt"
+)
+
+// packetWriter is a PacketHandler that writes the packets it receives
+type packetWriter struct {
+	t *testing.T
+	w *Writer
+}
+
+// HandlePacket method writes the packet
+func (pw packetWriter) HandlePacket(p pkt.Packet) {
+	assert.NoError(pw.t, pw.w.WritePacket(p))
+}
+
+func TestCanboatRecord(t *testing.T) {
+	b, err := MarshalCanboat(heading())
+	assert.NoError(t, err)
+	assert.Equal(t, `{"timestamp":"2024-06-01T12:30:00.500Z","prio":2,"src":36,"dst":255,"pgn":127250,`+
+		`"description":"Vessel Heading","fields":{"Heading":1.2345,"Reference":"Magnetic"}}`, string(b))
+
+	// lookups without a name are numbers
+	h := heading()
+	h.Reference = pgn.DirectionReferenceConst(3)
+	r, err := NewCanboatRecord(&h)
+	assert.NoError(t, err)
+	assert.Equal(t, Field{Name: "Reference", Value: json.Number("3")}, r.Fields[1])
+
+	_, err = NewCanboatRecord("heading")
+	assert.Error(t, err)
+}
+
+// TestCanboatGolden decodes canboat raw lines and compares the output to what analyzer -json -si outputs for them.
+// analyzer and canboat's sample logs aren't available where this was written, so canboat.json was worked out by hand
+// from canboat.raw and canboat's PGN definitions, not generated by analyzer: edit it rather than regenerating it from
+// this package's output, and replace it with analyzer's output when that can be run.
+func TestCanboatGolden(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, FormatCanboat)
+	assert.NoError(t, err)
+	ca := canadapter.NewCANAdapter(logrus.StandardLogger())
+	ca.SetOutput(packetWriter{t: t, w: w})
+	ep := rawendpoint.NewRawFileEndpoint(logrus.StandardLogger(), filepath.Join("testdata", "canboat.raw"))
+	ep.SetOutput(ca)
+	assert.NoError(t, ep.Run(context.Background()))
+	assert.NoError(t, w.Close())
+	assert.Error(t, w.WriteRecord(Record{}))
+
+	expected, err := os.ReadFile(filepath.Join("testdata", "canboat.json"))
+	assert.NoError(t, err)
+	assert.Equal(t, string(expected), buf.String())
+}
+
+func TestWritePacket(t *testing.T) {
+	// Heave, in metres, is 67305.985, which a float32 can't hold
+	p := pkt.NewPacket(pgn.MessageInfo{PGN: 65280, SourceId: 5, Priority: 6, TargetId: 255},
+		[]uint8{0x3f, 0x9f, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06})
+	p.AddDecoders()
+
+	r, err := NewCanboatPacketRecord(*p)
+	assert.NoError(t, err)
+	assert.Equal(t, Field{Name: "Heave", Value: json.Number("67305.985")}, r.Fields[2])
+
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, FormatJSONL)
+	assert.NoError(t, err)
+	assert.NoError(t, w.WritePacket(*p))
+	assert.Contains(t, buf.String(), `"type":"FurunoHeave"`)
+}
@@ -0,0 +1,116 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/boatkit-io/tugboat/pkg/units"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/boatkit-io/n2k/pkg/pgn"
+)
+
+// testTime is the timestamp of the test structs.
+var testTime = time.Date(2024, 6, 1, 12, 30, 0, 500000000, time.UTC)
+
+// heading returns a VesselHeading for tests.
+func heading() pgn.VesselHeading {
+	// as decoded, which isn't exactly 1.2345
+	h := float32(12345) * float32(0.0001)
+	return pgn.VesselHeading{
+		Info:      pgn.MessageInfo{Timestamp: testTime, Priority: 2, PGN: 127250, SourceId: 36},
+		Heading:   &h,
+		Reference: pgn.Magnetic,
+	}
+}
+
+func TestRecord(t *testing.T) {
+	r, err := NewRecord(heading())
+	assert.NoError(t, err)
+	b, err := json.Marshal(r)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"timestamp":"2024-06-01T12:30:00.5Z","pgn":127250,"priority":2,"source":36,"destination":255,
+		"type":"VesselHeading","description":"Vessel Heading",
+		"fields":{"Sid":null,"Heading":1.2345,"Deviation":null,"Variation":null,"Reference":"Magnetic"}}`, string(b))
+	// fields keep their order
+	assert.Contains(t, string(b), `{"Sid":null,"Heading":1.2345,`)
+
+	depth := units.NewDistance(units.Meter, 4.5)
+	r, err = NewRecord(&pgn.WaterDepth{Info: pgn.MessageInfo{PGN: 128267}, Depth: &depth})
+	assert.NoError(t, err)
+	assert.Equal(t, Quantity{Value: json.Number("4.5"), Unit: "Meter"}, r.Fields[1].Value)
+
+	pgnNum := uint32(127250)
+	r, err = NewRecord(pgn.PgnListTransmitAndReceive{
+		Info:         pgn.MessageInfo{PGN: 126464, TargetId: 255},
+		FunctionCode: pgn.TransmitPGNList,
+		Repeating1:   []pgn.PgnListTransmitAndReceiveRepeating1{{Pgn: &pgnNum}},
+	})
+	assert.NoError(t, err)
+	b, err = json.Marshal(r.Fields)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"FunctionCode":"Transmit PGN list","Repeating1":[{"Pgn":127250}]}`, string(b))
+
+	_, err = NewRecord(42)
+	assert.Error(t, err)
+}
+
+func TestUnknownPGN(t *testing.T) {
+	r, err := NewRecord(pgn.UnknownPGN{
+		Info:   pgn.MessageInfo{Timestamp: testTime, PGN: 127250, SourceId: 7},
+		Data:   []uint8{0x01, 0xAB},
+		Reason: errors.New("too short"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "UnknownPGN", r.Type)
+	assert.Equal(t, "Vessel Heading", r.Description)
+	b, err := json.Marshal(r.Fields)
+	assert.NoError(t, err)
+	assert.Contains(t, string(b), `"Data":"01ab"`)
+	assert.Contains(t, string(b), `"Reason":"too short"`)
+}
+
+func TestWriter(t *testing.T) {
+	var buf bytes.Buffer
+	_, err := NewWriter(&buf, "xml")
+	assert.Error(t, err)
+
+	w, err := NewWriter(&buf, FormatJSONL)
+	assert.NoError(t, err)
+	assert.NoError(t, w.Write(heading()))
+	assert.NoError(t, w.Write(heading()))
+	assert.NoError(t, w.Close())
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Len(t, lines, 2)
+	assert.True(t, json.Valid([]uint8(lines[1])))
+
+	buf.Reset()
+	w, err = NewWriter(&buf, FormatJSON)
+	assert.NoError(t, err)
+	assert.NoError(t, w.Close())
+	assert.Equal(t, "[]\n", buf.String())
+
+	buf.Reset()
+	w, err = NewWriter(&buf, FormatJSON)
+	assert.NoError(t, err)
+	assert.NoError(t, w.Write(heading()))
+	assert.Error(t, w.Write("not a struct"))
+	assert.NoError(t, w.Write(heading()))
+	assert.NoError(t, w.Close())
+	var records []map[string]any
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &records))
+	assert.Len(t, records, 2)
+
+	buf.Reset()
+	w, err = NewWriter(&buf, FormatCSV)
+	assert.NoError(t, err)
+	assert.NoError(t, w.Write(heading()))
+	assert.NoError(t, w.Close())
+	assert.Equal(t, "timestamp,pgn,priority,source,destination,type,description,fields\n"+
+		`2024-06-01T12:30:00.5Z,127250,2,36,255,VesselHeading,Vessel Heading,"{""Sid"":null,""Heading"":1.2345,""Deviation"":null,""Variation"":null,""Reference"":""Magnetic""}"`+"\n",
+		buf.String())
+}
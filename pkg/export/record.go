@@ -0,0 +1,206 @@
+// Package export converts decoded PGN structs to JSON and CSV, for tools like jq and data analysis notebooks.
+package export
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/boatkit-io/n2k/pkg/pgn"
+)
+
+// Record describes a decoded PGN struct.
+type Record struct {
+	Timestamp   time.Time `json:"timestamp"`
+	PGN         uint32    `json:"pgn"`
+	Priority    uint8     `json:"priority"`
+	Source      uint8     `json:"source"`
+	Destination uint8     `json:"destination"` // 255 for broadcast messages
+	Type        string    `json:"type"`        // the name of the struct
+	Description string    `json:"description"`
+	Fields      Fields    `json:"fields"`
+}
+
+// Fields holds a struct's fields, in order.
+// Missing values are nil, lookups are their names, and numbers with a unit are a Quantity.
+// Binary data is a hex string, and repeating field sets are a slice of Fields.
+type Fields []Field
+
+// Field is a named value.
+type Field struct {
+	Name  string
+	Value any
+}
+
+// Quantity is a number and its unit.
+type Quantity struct {
+	Value any    `json:"value"`
+	Unit  string `json:"unit"`
+}
+
+// MarshalJSON method writes the fields as a JSON object, keeping their order.
+func (f Fields) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, field := range f {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		name, err := marshal(field.Name)
+		if err != nil {
+			return nil, err
+		}
+		value, err := marshal(field.Value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(name)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// marshal returns v as JSON, like json.Marshal but without escaping characters like "&" for HTML.
+func marshal(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// NewRecord returns the Record for a PGN struct, as output by PacketStruct.
+func NewRecord(p any) (Record, error) {
+	v := reflect.ValueOf(p)
+	if v.Kind() == reflect.Pointer {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return Record{}, fmt.Errorf("expected a PGN struct, received: %T", p)
+	}
+	info, ok := pgn.InfoOf(p)
+	if !ok {
+		return Record{}, fmt.Errorf("expected a PGN struct, received: %T", p)
+	}
+
+	r := Record{
+		Timestamp:   info.Timestamp,
+		PGN:         info.PGN,
+		Priority:    info.Priority,
+		Source:      info.SourceId,
+		Destination: info.TargetId,
+		Type:        v.Type().Name(),
+	}
+	if (info.PGN&0xFF00)>>8 >= 240 {
+		r.Destination = 255
+	}
+	pi := pgn.IdLookup[r.Type]
+	if pi != nil {
+		r.Description = pi.Description
+	} else if known := pgn.PgnInfoLookup[info.PGN]; len(known) > 0 {
+		// an UnknownPGN of a known PGN that failed to decode
+		r.Description = known[0].Description
+	}
+	r.Fields = structFields(v, pi)
+	return r, nil
+}
+
+// structFields returns the fields of a PGN struct (or repeating field set), apart from Info.
+// pi describes the PGN, and is nil for UnknownPGN.
+func structFields(v reflect.Value, pi *pgn.PgnInfo) Fields {
+	t := v.Type()
+	fields := make(Fields, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		name := t.Field(i).Name
+		if name == "Info" {
+			continue
+		}
+		fields = append(fields, Field{Name: name, Value: fieldValue(v.Field(i), descriptor(pi, name), pi)})
+	}
+	return fields
+}
+
+// fieldValue converts a field's value to its JSON representation.
+func fieldValue(v reflect.Value, fd *pgn.FieldDescriptor, pi *pgn.PgnInfo) any {
+	if v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		if err, ok := v.Interface().(error); ok {
+			return err.Error()
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		// tugboat units
+		value, unit := v.FieldByName("Value"), v.FieldByName("Unit")
+		if value.IsValid() && unit.IsValid() {
+			return Quantity{Value: fieldValue(value, nil, pi), Unit: fmt.Sprint(unit.Interface())}
+		}
+		return structFields(v, pi)
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return hex.EncodeToString(v.Bytes())
+		}
+		items := make([]any, v.Len())
+		for i := range items {
+			items[i] = fieldValue(v.Index(i), nil, pi)
+		}
+		return items
+	}
+
+	if s, ok := v.Interface().(fmt.Stringer); ok {
+		// lookups
+		return s.String()
+	}
+	var value any
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		value = formatFloat(v.Float(), v.Type().Bits(), fd)
+	default:
+		value = v.Interface()
+	}
+	if fd != nil && fd.Unit != "" {
+		return Quantity{Value: value, Unit: fd.Unit}
+	}
+	return value
+}
+
+// descriptor returns the FieldDescriptor for the struct field named id, or nil.
+func descriptor(pi *pgn.PgnInfo, id string) *pgn.FieldDescriptor {
+	if pi == nil {
+		return nil
+	}
+	for _, fd := range pi.Fields {
+		if fd.Id == id {
+			return fd
+		}
+	}
+	return nil
+}
+
+// formatFloat formats a number, rounded to the field's resolution if it's known.
+func formatFloat(f float64, bits int, fd *pgn.FieldDescriptor) json.Number {
+	if fd == nil || fd.Resolution <= 0 || fd.Resolution >= 1 {
+		return json.Number(strconv.FormatFloat(f, 'g', -1, bits))
+	}
+	decimals := int(math.Ceil(-math.Log10(float64(fd.Resolution)) - 1e-9))
+	str := strconv.FormatFloat(f, 'f', decimals, 64)
+	str = strings.TrimRight(strings.TrimRight(str, "0"), ".")
+	if str == "-0" {
+		str = "0"
+	}
+	return json.Number(str)
+}
@@ -0,0 +1,164 @@
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/boatkit-io/n2k/pkg/pkt"
+)
+
+// Format is an output format for a Writer.
+type Format string
+
+const (
+	// FormatJSON writes a JSON array of Records.
+	FormatJSON Format = "json"
+	// FormatJSONL writes a Record per line, as JSON Lines.
+	FormatJSONL Format = "jsonl"
+	// FormatCSV writes a CSV row per Record, with the fields as a JSON object in the last column.
+	FormatCSV Format = "csv"
+	// FormatCanboat writes a line of canboat analyzer JSON per struct; see CanboatRecord.
+	FormatCanboat Format = "canboat"
+)
+
+// csvHeader is the header row for FormatCSV.
+var csvHeader = []string{"timestamp", "pgn", "priority", "source", "destination", "type", "description", "fields"}
+
+// Writer writes PGN structs to an io.Writer in one of the Formats.
+type Writer struct {
+	w      io.Writer
+	format Format
+	csv    *csv.Writer
+	count  int
+}
+
+// NewWriter returns a Writer writing to w in format.
+func NewWriter(w io.Writer, format Format) (*Writer, error) {
+	ew := &Writer{w: w, format: format}
+	switch format {
+	case FormatJSON, FormatJSONL, FormatCanboat:
+	case FormatCSV:
+		ew.csv = csv.NewWriter(w)
+	default:
+		return nil, fmt.Errorf("unknown export format: %q", format)
+	}
+	return ew, nil
+}
+
+// Write method writes a PGN struct.
+func (w *Writer) Write(p any) error {
+	if w.format == FormatCanboat {
+		b, err := MarshalCanboat(p)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(w.w, "%s\n", b); err != nil {
+			return err
+		}
+		w.count++
+		return nil
+	}
+	r, err := NewRecord(p)
+	if err != nil {
+		return err
+	}
+	return w.WriteRecord(r)
+}
+
+// WritePacket method writes a complete packet, decoded as PacketStruct would. FormatCanboat works out numbers from its
+// data, as NewCanboatPacketRecord does.
+func (w *Writer) WritePacket(p pkt.Packet) error {
+	if w.format == FormatCanboat {
+		r, err := NewCanboatPacketRecord(p)
+		if err != nil {
+			return err
+		}
+		b, err := marshal(r)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(w.w, "%s\n", b); err != nil {
+			return err
+		}
+		w.count++
+		return nil
+	}
+	var decoded structHolder
+	ps := pkt.NewPacketStruct()
+	ps.SetOutput(&decoded)
+	ps.HandlePacket(p)
+	return w.Write(decoded.p)
+}
+
+// WriteRecord method writes a Record. FormatCanboat needs the struct, so use Write for it.
+func (w *Writer) WriteRecord(r Record) (err error) {
+	if w.format == FormatCanboat {
+		return fmt.Errorf("can't write a Record in canboat format")
+	}
+	defer func() {
+		if err == nil {
+			w.count++
+		}
+	}()
+
+	switch w.format {
+	case FormatCSV:
+		if w.count == 0 {
+			if err := w.csv.Write(csvHeader); err != nil {
+				return err
+			}
+		}
+		fields, err := json.Marshal(r.Fields)
+		if err != nil {
+			return err
+		}
+		if err := w.csv.Write([]string{
+			r.Timestamp.Format(time.RFC3339Nano),
+			strconv.FormatUint(uint64(r.PGN), 10),
+			strconv.FormatUint(uint64(r.Priority), 10),
+			strconv.FormatUint(uint64(r.Source), 10),
+			strconv.FormatUint(uint64(r.Destination), 10),
+			r.Type,
+			r.Description,
+			string(fields),
+		}); err != nil {
+			return err
+		}
+		w.csv.Flush()
+		return w.csv.Error()
+	default:
+		b, err := json.Marshal(r)
+		if err != nil {
+			return err
+		}
+		prefix := ""
+		if w.format == FormatJSON {
+			prefix = ",\n"
+			if w.count == 0 {
+				prefix = "[\n"
+			}
+		}
+		_, err = fmt.Fprintf(w.w, "%s%s", prefix, b)
+		if err == nil && w.format == FormatJSONL {
+			_, err = io.WriteString(w.w, "\n")
+		}
+		return err
+	}
+}
+
+// Close method finishes the output. It doesn't close the underlying io.Writer.
+func (w *Writer) Close() error {
+	if w.format != FormatJSON {
+		return nil
+	}
+	end := "\n]\n"
+	if w.count == 0 {
+		end = "[]\n"
+	}
+	_, err := io.WriteString(w.w, end)
+	return err
+}
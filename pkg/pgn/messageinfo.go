@@ -1,6 +1,7 @@
 package pgn
 
 import (
+	"reflect"
 	"time"
 )
 
@@ -21,3 +22,24 @@ type MessageInfo struct {
 	// target address, when relevant (PGNs with PF < 240)
 	TargetId uint8
 }
+
+// InfoOf returns the Info field of a PGN struct (or a pointer to one), like those output by PacketStruct, and false
+// for anything else.
+func InfoOf(p any) (MessageInfo, bool) {
+	v := reflect.ValueOf(p)
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return MessageInfo{}, false
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return MessageInfo{}, false
+	}
+	f := v.FieldByName("Info")
+	if !f.IsValid() {
+		return MessageInfo{}, false
+	}
+	info, ok := f.Interface().(MessageInfo)
+	return info, ok
+}
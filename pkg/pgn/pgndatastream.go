@@ -298,14 +298,17 @@ func (s *PGNDataStream) readBinaryData(bitLength uint16) ([]uint8, error) {
 
 // readStringStartStopByte method reads a string encoded as described in reference:
 // https://github.com/canboat/canboatjs/blob/b857a503323291b92dd0fe8c41ad6fa0d6bda088/lib/fromPgn.js#L752
-/* func (s *PGNDataStream) readStringStartStopByte() (string, error) {
+// This format "STRING_VAR" not used by existing PGN definitions.
+func (s *PGNDataStream) readStringStartStopByte() (string, error) {
 	// guaranteed to be aligned on byte boundary
 	startByte, err := s.getNumberRaw(8)
 	if err != nil {
 		return "", err
 	}
-	// TO FIX: 0x0 or 0x1 indicates an empty string
-	// This format "STRING_VAR" not used by existing PGN definitions.
+	// 0x0 or 0x1 indicates an empty string
+	if startByte == 0 || startByte == 1 {
+		return "", nil
+	}
 	if startByte != 2 {
 		return "", fmt.Errorf("[Wrong start byte:%08X]", startByte)
 	}
@@ -322,7 +325,6 @@ func (s *PGNDataStream) readBinaryData(bitLength uint16) ([]uint8, error) {
 		arr = append(arr, uint8(b))
 	}
 }
-*/
 
 // readStringWithLengthAndControl method reads a string with length and control byte
 // String has a terminating zero.
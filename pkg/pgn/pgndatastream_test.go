@@ -130,3 +130,233 @@ func TestNumerics(t *testing.T) {
 }
 
 // TODO: Tests for strings once we get more confidence
+
+func TestWriteNumerics(t *testing.T) {
+	// write then read back a variety of uint64s, on and off byte boundaries
+	uintTests := []struct {
+		v      uint64
+		offset uint16
+		length uint16
+	}{
+		{0x12, 0, 8},
+		{0x1234, 0, 16},
+		{0x1234, 8, 16},
+		{0xffffeed4, 0, 32},
+		{0x1E, 0, 5},
+		{2, 1, 3},
+		{0x3E, 2, 6},
+		{0x21, 12, 8},
+		{0xC080, 2, 16},
+		{0x123456789ABC, 3, 48},
+	}
+
+	for _, tst := range uintTests {
+		w := NewPgnWriter()
+		w.writeReserved(tst.offset)
+		assert.NoError(t, w.writeUInt64(tst.length, &tst.v))
+		r := NewPgnDataStream(w.GetData())
+		if tst.offset > 0 {
+			_ = r.skipBits(tst.offset)
+		}
+		v, err := r.readUInt64(tst.length)
+		assert.NoError(t, err)
+		assert.Equal(t, tst.v, *v)
+	}
+
+	// exact bytes match the read tests
+	w := NewPgnWriter()
+	vuint := uint32(0xFFFFEED4)
+	assert.NoError(t, w.writeUInt32(32, &vuint))
+	assert.Equal(t, []uint8{0xd4, 0xee, 0xff, 0xff}, w.GetData())
+	w = NewPgnWriter()
+	vint := int32(-4396)
+	assert.NoError(t, w.writeInt32(32, &vint))
+	assert.Equal(t, []uint8{0xd4, 0xee, 0xff, 0xff}, w.GetData())
+	w = NewPgnWriter()
+	vint2 := int16(-4396)
+	assert.NoError(t, w.writeInt16(16, &vint2))
+	assert.Equal(t, []uint8{0xd4, 0xee}, w.GetData())
+	w = NewPgnWriter()
+	vint3 := int8(-44)
+	assert.NoError(t, w.writeInt8(8, &vint3))
+	assert.Equal(t, []uint8{0xd4}, w.GetData())
+	w = NewPgnWriter()
+	vint4 := int64(-4396)
+	assert.NoError(t, w.writeInt64(32, &vint4))
+	assert.Equal(t, []uint8{0xd4, 0xee, 0xff, 0xff}, w.GetData())
+
+	// nil values write the "not available" value
+	w = NewPgnWriter()
+	assert.NoError(t, w.writeUInt16(16, nil))
+	assert.NoError(t, w.writeInt32(32, nil))
+	assert.NoError(t, w.writeInt8(4, nil))
+	assert.NoError(t, w.writeUInt8(4, nil))
+	assert.Equal(t, []uint8{0xff, 0xff, 0xff, 0xff, 0xff, 0x7f, 0xf7}, w.GetData())
+	r := NewPgnDataStream(w.GetData())
+	vu16, err := r.readUInt16(16)
+	assert.NoError(t, err)
+	assert.Nil(t, vu16)
+	vi32, err := r.readInt32(32)
+	assert.NoError(t, err)
+	assert.Nil(t, vi32)
+	vi8, err := r.readInt8(4)
+	assert.NoError(t, err)
+	assert.Nil(t, vi8)
+	vu8, err := r.readUInt8(4)
+	assert.NoError(t, err)
+	assert.Nil(t, vu8)
+
+	// signed values at the extremes of a sub-byte field
+	for _, v := range []int8{-8, -1, 0, 6} {
+		w = NewPgnWriter()
+		assert.NoError(t, w.writeInt8(4, &v))
+		vr, err := NewPgnDataStream(w.GetData()).readInt8(4)
+		assert.NoError(t, err)
+		assert.Equal(t, v, *vr)
+	}
+
+	// values that don't fit, or collide with "not available"
+	tooBig := uint8(0xF)
+	assert.Error(t, NewPgnWriter().writeUInt8(4, &tooBig))
+	tooBig2 := uint16(0xFFFF)
+	assert.Error(t, NewPgnWriter().writeUInt16(16, &tooBig2))
+	tooBig3 := int8(7)
+	assert.Error(t, NewPgnWriter().writeInt8(4, &tooBig3))
+	tooSmall := int8(-9)
+	assert.Error(t, NewPgnWriter().writeInt8(4, &tooSmall))
+	assert.Error(t, NewPgnWriter().writeUInt8(9, &tooBig))
+	assert.Error(t, NewPgnWriter().writeLookupField(3, 8))
+
+	// lookups
+	w = NewPgnWriter()
+	assert.NoError(t, w.writeLookupField(3, 5))
+	assert.NoError(t, w.writeLookupField(5, 0x1F))
+	lv, err := NewPgnDataStream(w.GetData()).readLookupField(3)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(5), lv)
+	assert.Equal(t, []uint8{0xFD}, w.GetData())
+
+	// reserved fields are all 1s, and writes over them mask correctly
+	w = NewPgnWriter()
+	w.writeReserved(2)
+	assert.NoError(t, w.writeLookupField(2, 0))
+	w.writeReserved(68)
+	assert.Equal(t, []uint8{0xF3, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, w.GetData())
+
+	// float
+	w = NewPgnWriter()
+	f := float32(3.25)
+	assert.NoError(t, w.writeFloat32(&f))
+	assert.NoError(t, w.writeFloat32(nil))
+	r = NewPgnDataStream(w.GetData())
+	vf, err := r.readFloat32()
+	assert.NoError(t, err)
+	assert.Equal(t, f, *vf)
+	vf, err = r.readFloat32()
+	assert.NoError(t, err)
+	assert.Nil(t, vf)
+}
+
+func TestWriteResolution(t *testing.T) {
+	tests := []struct {
+		v        float32
+		length   uint16
+		multiply float32
+		signed   bool
+	}{
+		{-0.5, 16, 0.01, true},
+		{3.14, 16, 0.0001, true},
+		{-0.0031, 32, 3.125e-08, true},
+		{327.66, 16, 0.01, false},
+		{12.5, 10, 0.1, false},
+		{-12.7, 8, 0.1, true},
+	}
+
+	for _, tst := range tests {
+		w := NewPgnWriter()
+		var err error
+		var v *float32
+		if tst.signed {
+			assert.NoError(t, w.writeSignedResolution(tst.length, tst.multiply, &tst.v))
+			v, err = NewPgnDataStream(w.GetData()).readSignedResolution(tst.length, tst.multiply)
+		} else {
+			assert.NoError(t, w.writeUnsignedResolution(tst.length, tst.multiply, &tst.v))
+			v, err = NewPgnDataStream(w.GetData()).readUnsignedResolution(tst.length, tst.multiply)
+		}
+		assert.NoError(t, err)
+		assert.InDelta(t, tst.v, *v, float64(tst.multiply)/2)
+	}
+
+	// 64 bit override, as used for lat/lon
+	w := NewPgnWriter()
+	lat := 47.6062095
+	assert.NoError(t, w.writeSignedResolution64Override(64, 1e-16, &lat))
+	vl, err := NewPgnDataStream(w.GetData()).readSignedResolution64Override(64, 1e-16)
+	assert.NoError(t, err)
+	assert.InDelta(t, lat, *vl, 1e-15)
+
+	// nils
+	w = NewPgnWriter()
+	assert.NoError(t, w.writeSignedResolution(16, 0.01, nil))
+	assert.NoError(t, w.writeUnsignedResolution(16, 0.01, nil))
+	assert.NoError(t, w.writeSignedResolution64Override(32, 1e-7, nil))
+	assert.Equal(t, []uint8{0xff, 0x7f, 0xff, 0xff, 0xff, 0xff, 0xff, 0x7f}, w.GetData())
+
+	// out of range
+	neg := float32(-1)
+	assert.Error(t, NewPgnWriter().writeUnsignedResolution(16, 0.01, &neg))
+	big := float32(400)
+	assert.Error(t, NewPgnWriter().writeSignedResolution(16, 0.01, &big))
+}
+
+func TestWriteBinaryAndStrings(t *testing.T) {
+	// binary data, off byte boundary and padded
+	w := NewPgnWriter()
+	w.writeReserved(4)
+	assert.NoError(t, w.writeBinaryData(24, []uint8{1, 2}))
+	r := NewPgnDataStream(w.GetData())
+	_ = r.skipBits(4)
+	bd, err := r.readBinaryData(24)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint8{1, 2, 0xff}, bd)
+	assert.Error(t, NewPgnWriter().writeBinaryData(8, []uint8{1, 2}))
+
+	// STRING_FIX
+	w = NewPgnWriter()
+	assert.NoError(t, w.writeFixedString(64, "ABC"))
+	assert.Equal(t, []uint8{'A', 'B', 'C', 0xff, 0xff, 0xff, 0xff, 0xff}, w.GetData())
+	str, err := NewPgnDataStream(w.GetData()).readFixedString(64)
+	assert.NoError(t, err)
+	assert.Equal(t, "ABC", str)
+	assert.Error(t, NewPgnWriter().writeFixedString(16, "ABC"))
+
+	// STRING_LAU
+	w = NewPgnWriter()
+	assert.NoError(t, w.writeStringWithLengthAndControl("Boat"))
+	assert.Equal(t, []uint8{6, 1, 'B', 'o', 'a', 't'}, w.GetData())
+	str, err = NewPgnDataStream(w.GetData()).readStringWithLengthAndControl()
+	assert.NoError(t, err)
+	assert.Equal(t, "Boat", str)
+
+	// STRING_LZ
+	w = NewPgnWriter()
+	assert.NoError(t, w.writeStringWithLength("Kit"))
+	assert.Equal(t, []uint8{3, 'K', 'i', 't'}, w.GetData())
+	str, err = NewPgnDataStream(w.GetData()).readStringWithLength()
+	assert.NoError(t, err)
+	assert.Equal(t, "Kit", str)
+
+	// STRING_VAR
+	w = NewPgnWriter()
+	assert.NoError(t, w.writeStringStartStopByte("Hi"))
+	assert.NoError(t, w.writeStringStartStopByte(""))
+	assert.Equal(t, []uint8{2, 'H', 'i', 1, 1}, w.GetData())
+	r = NewPgnDataStream(w.GetData())
+	str, err = r.readStringStartStopByte()
+	assert.NoError(t, err)
+	assert.Equal(t, "Hi", str)
+	str, err = r.readStringStartStopByte()
+	assert.NoError(t, err)
+	assert.Equal(t, "", str)
+	assert.Error(t, NewPgnWriter().writeStringStartStopByte("a\x01b"))
+}
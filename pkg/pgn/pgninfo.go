@@ -3,6 +3,7 @@ package pgn
 
 import (
 	"fmt"
+	"reflect"
 )
 
 // PgnInfo instances (generated by the pgngen command) describe known NMEA 2000 messages.
@@ -20,12 +21,15 @@ type PgnInfo struct {
 	ManId ManufacturerCodeConst
 	// Decoder is a function that generates golang data from the messsage data.
 	Decoder func(MessageInfo, *PGNDataStream) (any, error)
+	// Encoder is a function that generates the message data from golang data.
+	Encoder func(any, *PGNWriter) error
 	// Fields is a map of field descriptions needed at runtime to deal with variable pgn fields
 	Fields map[int]*FieldDescriptor
 }
 
 // FieldDescriptor instances describe a PGN field.
 type FieldDescriptor struct {
+	Id                string // the name of the field in the generated struct
 	Name              string
 	BitLength         uint16
 	BitOffset         uint16
@@ -45,10 +49,15 @@ var PgnInfoLookup map[uint32][]*PgnInfo
 // UnseenLookup is a map of PGNs not yet seen in log files to PgnInfo pointers.
 var UnseenLookup map[uint32][]*PgnInfo
 
+// IdLookup is a map of PgnInfo Ids (the names of the generated structs) to PgnInfo pointers.
+// Only PGNs in pgnList are included: unseenList PGNs have no generated structs, so there's nothing to encode.
+var IdLookup map[string]*PgnInfo
+
 // init initializes PgnInfoLookup from pgnList (defined in pgninfo_generated.go)
 func init() {
 	PgnInfoLookup = make(map[uint32][]*PgnInfo)
 	UnseenLookup = make(map[uint32][]*PgnInfo)
+	IdLookup = make(map[string]*PgnInfo)
 
 	for i, pi := range pgnList {
 		pgnList[i].Self = &pgnList[i]
@@ -56,6 +65,7 @@ func init() {
 			PgnInfoLookup[pi.PGN] = make([]*PgnInfo, 0)
 		}
 		PgnInfoLookup[pi.PGN] = append(PgnInfoLookup[pi.PGN], &pgnList[i])
+		IdLookup[pi.Id] = &pgnList[i]
 	}
 
 	for i, pi := range unseenList {
@@ -144,3 +154,27 @@ func GetFieldDescriptor(pgn uint32, manID ManufacturerCodeConst, fieldIndex uint
 func SearchUnseenList(pgn uint32) bool {
 	return UnseenLookup[pgn] != nil
 }
+
+// Encode serializes a generated PGN struct into message data.
+// It returns the struct's MessageInfo, with the PGN set to match the struct's type.
+// PGNs that canboat hasn't seen in log files (see SearchUnseenList) have no structs, so they can't be encoded;
+// send their data with canadapter.StructWriter.WriteData instead.
+func Encode(p any) (MessageInfo, []uint8, error) {
+	tp := reflect.TypeOf(p)
+	if tp == nil || tp.Kind() != reflect.Struct {
+		return MessageInfo{}, nil, fmt.Errorf("can't encode non-struct type: %T", p)
+	}
+	pi, known := IdLookup[tp.Name()]
+	if !known {
+		return MessageInfo{}, nil, fmt.Errorf("no encoder for type: %s", tp.Name())
+	}
+
+	info, _ := InfoOf(p)
+	info.PGN = pi.PGN
+
+	stream := NewPgnWriter()
+	if err := pi.Encoder(p, stream); err != nil {
+		return info, nil, err
+	}
+	return info, stream.GetData(), nil
+}
@@ -16,6 +16,14 @@ func nullableUnit[T any, U any, V float32|uint16|int16|uint32](u U, v *V, newer
 	return &ov
 }
 
+func nullableUnitValue[T ~struct{ Value float32; Unit U }, U ~int](t *T, u U, convert func(t T, u U) T) *float32 {
+	if t == nil {
+		return nil
+	}
+	ov := units.Unit[U](convert(*t, u)).Value
+	return &ov
+}
+
 // Spit out global consts
 
 type LightingCommandConst uint8
@@ -8843,8 +8851,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeIsoAcknowledgement,
+		Encoder: EncodeIsoAcknowledgement,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Control",
 			Name: "Control",
 			BitLength: 8,
 			BitOffset: 0,
@@ -8853,8 +8863,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IsoControlConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "GroupFunction",
 			Name: "Group Function",
 			BitLength: 8,
 			BitOffset: 8,
@@ -8863,8 +8875,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "XxReserved3",
 			Name: "Reserved",
 			BitLength: 24,
 			BitOffset: 16,
@@ -8873,8 +8887,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Pgn",
 			Name: "PGN",
 			BitLength: 24,
 			BitOffset: 40,
@@ -8883,6 +8899,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -8893,8 +8910,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeIsoRequest,
+		Encoder: EncodeIsoRequest,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Pgn",
 			Name: "PGN",
 			BitLength: 24,
 			BitOffset: 0,
@@ -8903,6 +8922,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -8913,8 +8933,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeIsoTransportProtocolDataTransfer,
+		Encoder: EncodeIsoTransportProtocolDataTransfer,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 0,
@@ -8923,8 +8945,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "Data",
 			Name: "Data",
 			BitLength: 56,
 			BitOffset: 8,
@@ -8933,6 +8957,7 @@ var pgnList = []PgnInfo{
 			GolangType:"[]uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -8943,8 +8968,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeIsoTransportProtocolConnectionManagementRequestToSend,
+		Encoder: EncodeIsoTransportProtocolConnectionManagementRequestToSend,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "GroupFunctionCode",
 			Name: "Group Function Code",
 			BitLength: 8,
 			BitOffset: 0,
@@ -8953,8 +8980,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IsoCommandConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "MessageSize",
 			Name: "Message size",
 			BitLength: 16,
 			BitOffset: 8,
@@ -8963,8 +8992,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "Packets",
 			Name: "Packets",
 			BitLength: 8,
 			BitOffset: 24,
@@ -8973,8 +9004,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "PacketsReply",
 			Name: "Packets reply",
 			BitLength: 8,
 			BitOffset: 32,
@@ -8983,8 +9016,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "Pgn",
 			Name: "PGN",
 			BitLength: 24,
 			BitOffset: 40,
@@ -8993,6 +9028,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -9003,8 +9039,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeIsoTransportProtocolConnectionManagementClearToSend,
+		Encoder: EncodeIsoTransportProtocolConnectionManagementClearToSend,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "GroupFunctionCode",
 			Name: "Group Function Code",
 			BitLength: 8,
 			BitOffset: 0,
@@ -9013,8 +9051,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IsoCommandConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "MaxPackets",
 			Name: "Max packets",
 			BitLength: 8,
 			BitOffset: 8,
@@ -9023,8 +9063,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "NextSid",
 			Name: "Next SID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -9033,8 +9075,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 16,
 			BitOffset: 24,
@@ -9043,8 +9087,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "Pgn",
 			Name: "PGN",
 			BitLength: 24,
 			BitOffset: 40,
@@ -9053,6 +9099,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -9063,8 +9110,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeIsoTransportProtocolConnectionManagementEndOfMessage,
+		Encoder: EncodeIsoTransportProtocolConnectionManagementEndOfMessage,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "GroupFunctionCode",
 			Name: "Group Function Code",
 			BitLength: 8,
 			BitOffset: 0,
@@ -9073,8 +9122,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IsoCommandConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "TotalMessageSize",
 			Name: "Total message size",
 			BitLength: 16,
 			BitOffset: 8,
@@ -9083,8 +9134,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "TotalNumberOfFramesReceived",
 			Name: "Total number of frames received",
 			BitLength: 8,
 			BitOffset: 24,
@@ -9093,8 +9146,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 8,
 			BitOffset: 32,
@@ -9103,8 +9158,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "Pgn",
 			Name: "PGN",
 			BitLength: 24,
 			BitOffset: 40,
@@ -9113,6 +9170,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -9123,8 +9181,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeIsoTransportProtocolConnectionManagementBroadcastAnnounce,
+		Encoder: EncodeIsoTransportProtocolConnectionManagementBroadcastAnnounce,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "GroupFunctionCode",
 			Name: "Group Function Code",
 			BitLength: 8,
 			BitOffset: 0,
@@ -9133,8 +9193,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IsoCommandConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "MessageSize",
 			Name: "Message size",
 			BitLength: 16,
 			BitOffset: 8,
@@ -9143,8 +9205,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "Packets",
 			Name: "Packets",
 			BitLength: 8,
 			BitOffset: 24,
@@ -9153,8 +9217,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 8,
 			BitOffset: 32,
@@ -9163,8 +9229,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "Pgn",
 			Name: "PGN",
 			BitLength: 24,
 			BitOffset: 40,
@@ -9173,6 +9241,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -9183,8 +9252,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeIsoTransportProtocolConnectionManagementAbort,
+		Encoder: EncodeIsoTransportProtocolConnectionManagementAbort,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "GroupFunctionCode",
 			Name: "Group Function Code",
 			BitLength: 8,
 			BitOffset: 0,
@@ -9193,8 +9264,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IsoCommandConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "Reason",
 			Name: "Reason",
 			BitLength: 8,
 			BitOffset: 8,
@@ -9203,8 +9276,10 @@ var pgnList = []PgnInfo{
 			GolangType:"[]uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "XxReserved3",
 			Name: "Reserved",
 			BitLength: 24,
 			BitOffset: 16,
@@ -9213,8 +9288,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Pgn",
 			Name: "PGN",
 			BitLength: 24,
 			BitOffset: 40,
@@ -9223,6 +9300,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -9233,8 +9311,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeIsoAddressClaim,
+		Encoder: EncodeIsoAddressClaim,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "UniqueNumber",
 			Name: "Unique Number",
 			BitLength: 21,
 			BitOffset: 0,
@@ -9243,8 +9323,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 21,
@@ -9253,8 +9335,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "DeviceInstanceLower",
 			Name: "Device Instance Lower",
 			BitLength: 3,
 			BitOffset: 32,
@@ -9263,8 +9347,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "DeviceInstanceUpper",
 			Name: "Device Instance Upper",
 			BitLength: 5,
 			BitOffset: 35,
@@ -9273,8 +9359,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "DeviceFunction",
 			Name: "Device Function",
 			BitLength: 8,
 			BitOffset: 40,
@@ -9283,8 +9371,10 @@ var pgnList = []PgnInfo{
 			GolangType:"DeviceFunctionConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "XxReserved6",
 			Name: "Spare",
 			BitLength: 1,
 			BitOffset: 48,
@@ -9293,8 +9383,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "DeviceClass",
 			Name: "Device Class",
 			BitLength: 7,
 			BitOffset: 49,
@@ -9303,8 +9395,10 @@ var pgnList = []PgnInfo{
 			GolangType:"DeviceClassConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "SystemInstance",
 			Name: "System Instance",
 			BitLength: 4,
 			BitOffset: 56,
@@ -9313,8 +9407,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "IndustryGroup",
 			Name: "Industry Group",
 			BitLength: 3,
 			BitOffset: 60,
@@ -9323,8 +9419,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "ArbitraryAddressCapable",
 			Name: "Arbitrary address capable",
 			BitLength: 1,
 			BitOffset: 63,
@@ -9333,6 +9431,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -9343,8 +9442,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 1851,
 		Decoder: DecodeSeatalkWirelessKeypadLightControl,
+		Encoder: EncodeSeatalkWirelessKeypadLightControl,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -9353,8 +9454,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -9363,8 +9466,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -9373,8 +9478,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "ProprietaryId",
 			Name: "Proprietary ID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -9383,8 +9490,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "Variant",
 			Name: "Variant",
 			BitLength: 8,
 			BitOffset: 24,
@@ -9393,8 +9502,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "WirelessSetting",
 			Name: "Wireless Setting",
 			BitLength: 8,
 			BitOffset: 32,
@@ -9403,8 +9514,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "WiredSetting",
 			Name: "Wired Setting",
 			BitLength: 8,
 			BitOffset: 40,
@@ -9413,8 +9526,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "XxReserved8",
 			Name: "Reserved",
 			BitLength: 16,
 			BitOffset: 48,
@@ -9423,6 +9538,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -9433,8 +9549,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 1851,
 		Decoder: DecodeSeatalkWirelessKeypadControl,
+		Encoder: EncodeSeatalkWirelessKeypadControl,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -9443,8 +9561,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -9453,8 +9573,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -9463,8 +9585,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Pid",
 			Name: "PID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -9473,8 +9597,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "Variant",
 			Name: "Variant",
 			BitLength: 8,
 			BitOffset: 24,
@@ -9483,8 +9609,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "BeepControl",
 			Name: "Beep Control",
 			BitLength: 8,
 			BitOffset: 32,
@@ -9493,8 +9621,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "XxReserved7",
 			Name: "Reserved",
 			BitLength: 24,
 			BitOffset: 40,
@@ -9503,6 +9633,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -9513,8 +9644,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 358,
 		Decoder: DecodeVictronBatteryRegister,
+		Encoder: EncodeVictronBatteryRegister,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -9523,8 +9656,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -9533,8 +9668,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -9543,8 +9680,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "RegisterId",
 			Name: "Register Id",
 			BitLength: 16,
 			BitOffset: 16,
@@ -9553,8 +9692,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "Payload",
 			Name: "Payload",
 			BitLength: 32,
 			BitOffset: 32,
@@ -9563,6 +9704,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -9573,8 +9715,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeBus1PhaseCBasicAcQuantities,
+		Encoder: EncodeBus1PhaseCBasicAcQuantities,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "LineLineAcRmsVoltage",
 			Name: "Line-Line AC RMS Voltage",
 			BitLength: 16,
 			BitOffset: 0,
@@ -9583,8 +9727,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "LineNeutralAcRmsVoltage",
 			Name: "Line-Neutral AC RMS Voltage",
 			BitLength: 16,
 			BitOffset: 16,
@@ -9593,8 +9739,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "AcFrequency",
 			Name: "AC Frequency",
 			BitLength: 16,
 			BitOffset: 32,
@@ -9603,8 +9751,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0078125,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 16,
 			BitOffset: 48,
@@ -9613,6 +9763,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -9623,8 +9774,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeBus1PhaseBBasicAcQuantities,
+		Encoder: EncodeBus1PhaseBBasicAcQuantities,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "LineLineAcRmsVoltage",
 			Name: "Line-Line AC RMS Voltage",
 			BitLength: 16,
 			BitOffset: 0,
@@ -9633,8 +9786,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "LineNeutralAcRmsVoltage",
 			Name: "Line-Neutral AC RMS Voltage",
 			BitLength: 16,
 			BitOffset: 16,
@@ -9643,8 +9798,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "AcFrequency",
 			Name: "AC Frequency",
 			BitLength: 16,
 			BitOffset: 32,
@@ -9653,8 +9810,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0078125,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 16,
 			BitOffset: 48,
@@ -9663,6 +9822,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -9673,8 +9833,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeBus1PhaseABasicAcQuantities,
+		Encoder: EncodeBus1PhaseABasicAcQuantities,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "LineLineAcRmsVoltage",
 			Name: "Line-Line AC RMS Voltage",
 			BitLength: 16,
 			BitOffset: 0,
@@ -9683,8 +9845,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "LineNeutralAcRmsVoltage",
 			Name: "Line-Neutral AC RMS Voltage",
 			BitLength: 16,
 			BitOffset: 16,
@@ -9693,8 +9857,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "AcFrequency",
 			Name: "AC Frequency",
 			BitLength: 16,
 			BitOffset: 32,
@@ -9703,8 +9869,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0078125,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 16,
 			BitOffset: 48,
@@ -9713,6 +9881,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -9723,8 +9892,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeBus1AverageBasicAcQuantities,
+		Encoder: EncodeBus1AverageBasicAcQuantities,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "LineLineAcRmsVoltage",
 			Name: "Line-Line AC RMS Voltage",
 			BitLength: 16,
 			BitOffset: 0,
@@ -9733,8 +9904,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "LineNeutralAcRmsVoltage",
 			Name: "Line-Neutral AC RMS Voltage",
 			BitLength: 16,
 			BitOffset: 16,
@@ -9743,8 +9916,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "AcFrequency",
 			Name: "AC Frequency",
 			BitLength: 16,
 			BitOffset: 32,
@@ -9753,8 +9928,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0078125,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 16,
 			BitOffset: 48,
@@ -9763,6 +9940,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -9773,8 +9951,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeUtilityTotalAcEnergy,
+		Encoder: EncodeUtilityTotalAcEnergy,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "TotalEnergyExport",
 			Name: "Total Energy Export",
 			BitLength: 32,
 			BitOffset: 0,
@@ -9783,8 +9963,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "TotalEnergyImport",
 			Name: "Total Energy Import",
 			BitLength: 32,
 			BitOffset: 32,
@@ -9793,6 +9975,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -9803,8 +9986,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeUtilityPhaseCAcReactivePower,
+		Encoder: EncodeUtilityPhaseCAcReactivePower,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ReactivePower",
 			Name: "Reactive Power",
 			BitLength: 16,
 			BitOffset: 0,
@@ -9813,8 +9998,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "PowerFactor",
 			Name: "Power factor",
 			BitLength: 16,
 			BitOffset: 16,
@@ -9823,8 +10010,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:6.10352e-05,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "PowerFactorLagging",
 			Name: "Power Factor Lagging",
 			BitLength: 2,
 			BitOffset: 32,
@@ -9833,8 +10022,10 @@ var pgnList = []PgnInfo{
 			GolangType:"PowerFactorConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 30,
 			BitOffset: 34,
@@ -9843,6 +10034,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -9853,8 +10045,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeUtilityPhaseCAcPower,
+		Encoder: EncodeUtilityPhaseCAcPower,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "RealPower",
 			Name: "Real Power",
 			BitLength: 32,
 			BitOffset: 0,
@@ -9863,8 +10057,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*int32",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		2: { 
+			Id: "ApparentPower",
 			Name: "Apparent Power",
 			BitLength: 32,
 			BitOffset: 32,
@@ -9873,6 +10069,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*int32",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		},
 	},
@@ -9883,8 +10080,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeUtilityPhaseCBasicAcQuantities,
+		Encoder: EncodeUtilityPhaseCBasicAcQuantities,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "LineLineAcRmsVoltage",
 			Name: "Line-Line AC RMS Voltage",
 			BitLength: 16,
 			BitOffset: 0,
@@ -9893,8 +10092,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "LineNeutralAcRmsVoltage",
 			Name: "Line-Neutral AC RMS Voltage",
 			BitLength: 16,
 			BitOffset: 16,
@@ -9903,8 +10104,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "AcFrequency",
 			Name: "AC Frequency",
 			BitLength: 16,
 			BitOffset: 32,
@@ -9913,8 +10116,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0078125,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "AcRmsCurrent",
 			Name: "AC RMS Current",
 			BitLength: 16,
 			BitOffset: 48,
@@ -9923,6 +10128,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -9933,8 +10139,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeUtilityPhaseBAcReactivePower,
+		Encoder: EncodeUtilityPhaseBAcReactivePower,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ReactivePower",
 			Name: "Reactive Power",
 			BitLength: 16,
 			BitOffset: 0,
@@ -9943,8 +10151,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "PowerFactor",
 			Name: "Power factor",
 			BitLength: 16,
 			BitOffset: 16,
@@ -9953,8 +10163,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:6.10352e-05,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "PowerFactorLagging",
 			Name: "Power Factor Lagging",
 			BitLength: 2,
 			BitOffset: 32,
@@ -9963,8 +10175,10 @@ var pgnList = []PgnInfo{
 			GolangType:"PowerFactorConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 30,
 			BitOffset: 34,
@@ -9973,6 +10187,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -9983,8 +10198,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeUtilityPhaseBAcPower,
+		Encoder: EncodeUtilityPhaseBAcPower,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "RealPower",
 			Name: "Real Power",
 			BitLength: 32,
 			BitOffset: 0,
@@ -9993,8 +10210,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*int32",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		2: { 
+			Id: "ApparentPower",
 			Name: "Apparent Power",
 			BitLength: 32,
 			BitOffset: 32,
@@ -10003,6 +10222,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*int32",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		},
 	},
@@ -10013,8 +10233,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeUtilityPhaseBBasicAcQuantities,
+		Encoder: EncodeUtilityPhaseBBasicAcQuantities,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "LineLineAcRmsVoltage",
 			Name: "Line-Line AC RMS Voltage",
 			BitLength: 16,
 			BitOffset: 0,
@@ -10023,8 +10245,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "LineNeutralAcRmsVoltage",
 			Name: "Line-Neutral AC RMS Voltage",
 			BitLength: 16,
 			BitOffset: 16,
@@ -10033,8 +10257,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "AcFrequency",
 			Name: "AC Frequency",
 			BitLength: 16,
 			BitOffset: 32,
@@ -10043,8 +10269,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0078125,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "AcRmsCurrent",
 			Name: "AC RMS Current",
 			BitLength: 16,
 			BitOffset: 48,
@@ -10053,6 +10281,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -10063,8 +10292,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeUtilityPhaseAAcReactivePower,
+		Encoder: EncodeUtilityPhaseAAcReactivePower,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ReactivePower",
 			Name: "Reactive Power",
 			BitLength: 32,
 			BitOffset: 0,
@@ -10073,8 +10304,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*int32",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		2: { 
+			Id: "PowerFactor",
 			Name: "Power factor",
 			BitLength: 16,
 			BitOffset: 32,
@@ -10083,8 +10316,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:6.10352e-05,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "PowerFactorLagging",
 			Name: "Power Factor Lagging",
 			BitLength: 2,
 			BitOffset: 48,
@@ -10093,8 +10328,10 @@ var pgnList = []PgnInfo{
 			GolangType:"PowerFactorConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 14,
 			BitOffset: 50,
@@ -10103,6 +10340,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -10113,8 +10351,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeUtilityPhaseAAcPower,
+		Encoder: EncodeUtilityPhaseAAcPower,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "RealPower",
 			Name: "Real Power",
 			BitLength: 32,
 			BitOffset: 0,
@@ -10123,8 +10363,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*int32",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		2: { 
+			Id: "ApparentPower",
 			Name: "Apparent Power",
 			BitLength: 32,
 			BitOffset: 32,
@@ -10133,6 +10375,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*int32",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		},
 	},
@@ -10143,8 +10386,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeUtilityPhaseABasicAcQuantities,
+		Encoder: EncodeUtilityPhaseABasicAcQuantities,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "LineLineAcRmsVoltage",
 			Name: "Line-Line AC RMS Voltage",
 			BitLength: 16,
 			BitOffset: 0,
@@ -10153,8 +10398,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "LineNeutralAcRmsVoltage",
 			Name: "Line-Neutral AC RMS Voltage",
 			BitLength: 16,
 			BitOffset: 16,
@@ -10163,8 +10410,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "AcFrequency",
 			Name: "AC Frequency",
 			BitLength: 16,
 			BitOffset: 32,
@@ -10173,8 +10422,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0078125,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "AcRmsCurrent",
 			Name: "AC RMS Current",
 			BitLength: 16,
 			BitOffset: 48,
@@ -10183,6 +10434,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -10193,8 +10445,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeUtilityTotalAcReactivePower,
+		Encoder: EncodeUtilityTotalAcReactivePower,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ReactivePower",
 			Name: "Reactive Power",
 			BitLength: 32,
 			BitOffset: 0,
@@ -10203,8 +10457,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*int32",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		2: { 
+			Id: "PowerFactor",
 			Name: "Power factor",
 			BitLength: 16,
 			BitOffset: 32,
@@ -10213,8 +10469,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:6.10352e-05,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "PowerFactorLagging",
 			Name: "Power Factor Lagging",
 			BitLength: 2,
 			BitOffset: 48,
@@ -10223,8 +10481,10 @@ var pgnList = []PgnInfo{
 			GolangType:"PowerFactorConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 14,
 			BitOffset: 50,
@@ -10233,6 +10493,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -10243,8 +10504,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeUtilityTotalAcPower,
+		Encoder: EncodeUtilityTotalAcPower,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "RealPower",
 			Name: "Real Power",
 			BitLength: 32,
 			BitOffset: 0,
@@ -10253,8 +10516,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*int32",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		2: { 
+			Id: "ApparentPower",
 			Name: "Apparent Power",
 			BitLength: 32,
 			BitOffset: 32,
@@ -10263,6 +10528,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*int32",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		},
 	},
@@ -10273,8 +10539,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeUtilityAverageBasicAcQuantities,
+		Encoder: EncodeUtilityAverageBasicAcQuantities,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "LineLineAcRmsVoltage",
 			Name: "Line-Line AC RMS Voltage",
 			BitLength: 16,
 			BitOffset: 0,
@@ -10283,8 +10551,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "LineNeutralAcRmsVoltage",
 			Name: "Line-Neutral AC RMS Voltage",
 			BitLength: 16,
 			BitOffset: 16,
@@ -10293,8 +10563,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "AcFrequency",
 			Name: "AC Frequency",
 			BitLength: 16,
 			BitOffset: 32,
@@ -10303,8 +10575,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0078125,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "AcRmsCurrent",
 			Name: "AC RMS Current",
 			BitLength: 16,
 			BitOffset: 48,
@@ -10313,6 +10587,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -10323,8 +10598,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeGeneratorTotalAcEnergy,
+		Encoder: EncodeGeneratorTotalAcEnergy,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "TotalEnergyExport",
 			Name: "Total Energy Export",
 			BitLength: 32,
 			BitOffset: 0,
@@ -10333,8 +10610,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "TotalEnergyImport",
 			Name: "Total Energy Import",
 			BitLength: 32,
 			BitOffset: 32,
@@ -10343,6 +10622,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -10353,8 +10633,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeGeneratorPhaseCAcReactivePower,
+		Encoder: EncodeGeneratorPhaseCAcReactivePower,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ReactivePower",
 			Name: "Reactive Power",
 			BitLength: 32,
 			BitOffset: 0,
@@ -10363,8 +10645,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*int32",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		2: { 
+			Id: "PowerFactor",
 			Name: "Power factor",
 			BitLength: 16,
 			BitOffset: 32,
@@ -10373,8 +10657,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:6.10352e-05,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "PowerFactorLagging",
 			Name: "Power Factor Lagging",
 			BitLength: 2,
 			BitOffset: 48,
@@ -10383,8 +10669,10 @@ var pgnList = []PgnInfo{
 			GolangType:"PowerFactorConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 14,
 			BitOffset: 50,
@@ -10393,6 +10681,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -10403,8 +10692,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeGeneratorPhaseCAcPower,
+		Encoder: EncodeGeneratorPhaseCAcPower,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "RealPower",
 			Name: "Real Power",
 			BitLength: 32,
 			BitOffset: 0,
@@ -10413,8 +10704,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*int32",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		2: { 
+			Id: "ApparentPower",
 			Name: "Apparent Power",
 			BitLength: 32,
 			BitOffset: 32,
@@ -10423,6 +10716,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*int32",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		},
 	},
@@ -10433,8 +10727,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeGeneratorPhaseCBasicAcQuantities,
+		Encoder: EncodeGeneratorPhaseCBasicAcQuantities,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "LineLineAcRmsVoltage",
 			Name: "Line-Line AC RMS Voltage",
 			BitLength: 16,
 			BitOffset: 0,
@@ -10443,8 +10739,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "LineNeutralAcRmsVoltage",
 			Name: "Line-Neutral AC RMS Voltage",
 			BitLength: 16,
 			BitOffset: 16,
@@ -10453,8 +10751,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "AcFrequency",
 			Name: "AC Frequency",
 			BitLength: 16,
 			BitOffset: 32,
@@ -10463,8 +10763,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0078125,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "AcRmsCurrent",
 			Name: "AC RMS Current",
 			BitLength: 16,
 			BitOffset: 48,
@@ -10473,6 +10775,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -10483,8 +10786,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeGeneratorPhaseBAcReactivePower,
+		Encoder: EncodeGeneratorPhaseBAcReactivePower,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ReactivePower",
 			Name: "Reactive Power",
 			BitLength: 32,
 			BitOffset: 0,
@@ -10493,8 +10798,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*int32",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		2: { 
+			Id: "PowerFactor",
 			Name: "Power factor",
 			BitLength: 16,
 			BitOffset: 32,
@@ -10503,8 +10810,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:6.10352e-05,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "PowerFactorLagging",
 			Name: "Power Factor Lagging",
 			BitLength: 2,
 			BitOffset: 48,
@@ -10513,8 +10822,10 @@ var pgnList = []PgnInfo{
 			GolangType:"PowerFactorConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 14,
 			BitOffset: 50,
@@ -10523,6 +10834,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -10533,8 +10845,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeGeneratorPhaseBAcPower,
+		Encoder: EncodeGeneratorPhaseBAcPower,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "RealPower",
 			Name: "Real Power",
 			BitLength: 32,
 			BitOffset: 0,
@@ -10543,8 +10857,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*int32",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		2: { 
+			Id: "ApparentPower",
 			Name: "Apparent Power",
 			BitLength: 32,
 			BitOffset: 32,
@@ -10553,6 +10869,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*int32",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		},
 	},
@@ -10563,8 +10880,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeGeneratorPhaseBBasicAcQuantities,
+		Encoder: EncodeGeneratorPhaseBBasicAcQuantities,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "LineLineAcRmsVoltage",
 			Name: "Line-Line AC RMS Voltage",
 			BitLength: 16,
 			BitOffset: 0,
@@ -10573,8 +10892,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "LineNeutralAcRmsVoltage",
 			Name: "Line-Neutral AC RMS Voltage",
 			BitLength: 16,
 			BitOffset: 16,
@@ -10583,8 +10904,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "AcFrequency",
 			Name: "AC Frequency",
 			BitLength: 16,
 			BitOffset: 32,
@@ -10593,8 +10916,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0078125,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "AcRmsCurrent",
 			Name: "AC RMS Current",
 			BitLength: 16,
 			BitOffset: 48,
@@ -10603,6 +10928,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -10613,8 +10939,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeGeneratorPhaseAAcReactivePower,
+		Encoder: EncodeGeneratorPhaseAAcReactivePower,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ReactivePower",
 			Name: "Reactive Power",
 			BitLength: 32,
 			BitOffset: 0,
@@ -10623,8 +10951,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*int32",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		2: { 
+			Id: "PowerFactor",
 			Name: "Power factor",
 			BitLength: 16,
 			BitOffset: 32,
@@ -10633,8 +10963,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:6.10352e-05,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "PowerFactorLagging",
 			Name: "Power Factor Lagging",
 			BitLength: 2,
 			BitOffset: 48,
@@ -10643,8 +10975,10 @@ var pgnList = []PgnInfo{
 			GolangType:"PowerFactorConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 14,
 			BitOffset: 50,
@@ -10653,6 +10987,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -10663,8 +10998,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeGeneratorPhaseAAcPower,
+		Encoder: EncodeGeneratorPhaseAAcPower,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "RealPower",
 			Name: "Real Power",
 			BitLength: 32,
 			BitOffset: 0,
@@ -10673,8 +11010,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*int32",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		2: { 
+			Id: "ApparentPower",
 			Name: "Apparent Power",
 			BitLength: 32,
 			BitOffset: 32,
@@ -10683,6 +11022,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*int32",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		},
 	},
@@ -10693,8 +11033,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeGeneratorPhaseABasicAcQuantities,
+		Encoder: EncodeGeneratorPhaseABasicAcQuantities,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "LineLineAcRmsVoltage",
 			Name: "Line-Line AC RMS Voltage",
 			BitLength: 16,
 			BitOffset: 0,
@@ -10703,8 +11045,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "LineNeutralAcRmsVoltage",
 			Name: "Line-Neutral AC RMS Voltage",
 			BitLength: 16,
 			BitOffset: 16,
@@ -10713,8 +11057,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "AcFrequency",
 			Name: "AC Frequency",
 			BitLength: 16,
 			BitOffset: 32,
@@ -10723,8 +11069,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0078125,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "AcRmsCurrent",
 			Name: "AC RMS Current",
 			BitLength: 16,
 			BitOffset: 48,
@@ -10733,6 +11081,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -10743,8 +11092,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeGeneratorTotalAcReactivePower,
+		Encoder: EncodeGeneratorTotalAcReactivePower,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ReactivePower",
 			Name: "Reactive Power",
 			BitLength: 32,
 			BitOffset: 0,
@@ -10753,8 +11104,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*int32",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		2: { 
+			Id: "PowerFactor",
 			Name: "Power factor",
 			BitLength: 16,
 			BitOffset: 32,
@@ -10763,8 +11116,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:6.10352e-05,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "PowerFactorLagging",
 			Name: "Power Factor Lagging",
 			BitLength: 2,
 			BitOffset: 48,
@@ -10773,8 +11128,10 @@ var pgnList = []PgnInfo{
 			GolangType:"PowerFactorConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 14,
 			BitOffset: 50,
@@ -10783,6 +11140,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -10793,8 +11151,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeGeneratorTotalAcPower,
+		Encoder: EncodeGeneratorTotalAcPower,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "RealPower",
 			Name: "Real Power",
 			BitLength: 32,
 			BitOffset: 0,
@@ -10803,8 +11163,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*int32",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		2: { 
+			Id: "ApparentPower",
 			Name: "Apparent Power",
 			BitLength: 32,
 			BitOffset: 32,
@@ -10813,6 +11175,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*int32",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		},
 	},
@@ -10823,8 +11186,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeGeneratorAverageBasicAcQuantities,
+		Encoder: EncodeGeneratorAverageBasicAcQuantities,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "LineLineAcRmsVoltage",
 			Name: "Line-Line AC RMS Voltage",
 			BitLength: 16,
 			BitOffset: 0,
@@ -10833,8 +11198,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "LineNeutralAcRmsVoltage",
 			Name: "Line-Neutral AC RMS Voltage",
 			BitLength: 16,
 			BitOffset: 16,
@@ -10843,8 +11210,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "AcFrequency",
 			Name: "AC Frequency",
 			BitLength: 16,
 			BitOffset: 32,
@@ -10853,8 +11222,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0078125,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "AcRmsCurrent",
 			Name: "AC RMS Current",
 			BitLength: 16,
 			BitOffset: 48,
@@ -10863,6 +11234,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -10873,8 +11245,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeIsoCommandedAddress,
+		Encoder: EncodeIsoCommandedAddress,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "UniqueNumber",
 			Name: "Unique Number",
 			BitLength: 21,
 			BitOffset: 0,
@@ -10883,8 +11257,10 @@ var pgnList = []PgnInfo{
 			GolangType:"[]uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 21,
@@ -10893,8 +11269,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "DeviceInstanceLower",
 			Name: "Device Instance Lower",
 			BitLength: 3,
 			BitOffset: 32,
@@ -10903,8 +11281,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "DeviceInstanceUpper",
 			Name: "Device Instance Upper",
 			BitLength: 5,
 			BitOffset: 35,
@@ -10913,8 +11293,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "DeviceFunction",
 			Name: "Device Function",
 			BitLength: 8,
 			BitOffset: 40,
@@ -10923,8 +11305,10 @@ var pgnList = []PgnInfo{
 			GolangType:"DeviceFunctionConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "XxReserved6",
 			Name: "Reserved",
 			BitLength: 1,
 			BitOffset: 48,
@@ -10933,8 +11317,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "DeviceClass",
 			Name: "Device Class",
 			BitLength: 7,
 			BitOffset: 49,
@@ -10943,8 +11329,10 @@ var pgnList = []PgnInfo{
 			GolangType:"DeviceClassConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "SystemInstance",
 			Name: "System Instance",
 			BitLength: 4,
 			BitOffset: 56,
@@ -10953,8 +11341,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 60,
@@ -10963,8 +11353,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "XxReserved10",
 			Name: "Reserved",
 			BitLength: 1,
 			BitOffset: 63,
@@ -10973,8 +11365,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "NewSourceAddress",
 			Name: "New Source Address",
 			BitLength: 8,
 			BitOffset: 64,
@@ -10983,6 +11377,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -10993,8 +11388,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 1855,
 		Decoder: DecodeFurunoHeave,
+		Encoder: EncodeFurunoHeave,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -11003,8 +11400,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -11013,8 +11412,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -11023,8 +11424,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Heave",
 			Name: "Heave",
 			BitLength: 32,
 			BitOffset: 16,
@@ -11033,8 +11436,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Distance",
 			Resolution:0.001,
 			Signed: true,
+			Unit: "m",
 			},
 		5: { 
+			Id: "XxReserved5",
 			Name: "Reserved",
 			BitLength: 16,
 			BitOffset: 48,
@@ -11043,6 +11448,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -11053,8 +11459,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 137,
 		Decoder: DecodeMaretronProprietaryDcBreakerCurrent,
+		Encoder: EncodeMaretronProprietaryDcBreakerCurrent,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -11063,8 +11471,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -11073,8 +11483,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -11083,8 +11495,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "BankInstance",
 			Name: "Bank Instance",
 			BitLength: 8,
 			BitOffset: 16,
@@ -11093,8 +11507,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "IndicatorNumber",
 			Name: "Indicator Number",
 			BitLength: 8,
 			BitOffset: 24,
@@ -11103,8 +11519,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "BreakerCurrent",
 			Name: "Breaker Current",
 			BitLength: 16,
 			BitOffset: 32,
@@ -11113,8 +11531,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "XxReserved7",
 			Name: "Reserved",
 			BitLength: 16,
 			BitOffset: 48,
@@ -11123,6 +11543,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -11133,8 +11554,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 135,
 		Decoder: DecodeAirmarBootStateAcknowledgment,
+		Encoder: EncodeAirmarBootStateAcknowledgment,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -11143,8 +11566,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -11153,8 +11578,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -11163,8 +11590,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "BootState",
 			Name: "Boot State",
 			BitLength: 3,
 			BitOffset: 16,
@@ -11173,8 +11602,10 @@ var pgnList = []PgnInfo{
 			GolangType:"BootStateConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "XxReserved5",
 			Name: "Reserved",
 			BitLength: 45,
 			BitOffset: 19,
@@ -11183,6 +11614,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -11193,8 +11625,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 140,
 		Decoder: DecodeLowranceTemperature,
+		Encoder: EncodeLowranceTemperature,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -11203,8 +11637,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -11213,8 +11649,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -11223,8 +11661,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "TemperatureSource",
 			Name: "Temperature Source",
 			BitLength: 8,
 			BitOffset: 16,
@@ -11233,8 +11673,10 @@ var pgnList = []PgnInfo{
 			GolangType:"TemperatureSourceConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "ActualTemperature",
 			Name: "Actual Temperature",
 			BitLength: 16,
 			BitOffset: 24,
@@ -11243,8 +11685,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Temperature",
 			Resolution:0.01,
 			Signed: false,
+			Unit: "K",
 			},
 		6: { 
+			Id: "XxReserved6",
 			Name: "Reserved",
 			BitLength: 24,
 			BitOffset: 40,
@@ -11253,6 +11697,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -11263,8 +11708,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 409,
 		Decoder: DecodeChetcoDimmer,
+		Encoder: EncodeChetcoDimmer,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -11273,8 +11720,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -11283,8 +11732,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -11293,8 +11744,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Instance",
 			Name: "Instance",
 			BitLength: 8,
 			BitOffset: 16,
@@ -11303,8 +11756,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "Dimmer1",
 			Name: "Dimmer1",
 			BitLength: 8,
 			BitOffset: 24,
@@ -11313,8 +11768,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Dimmer2",
 			Name: "Dimmer2",
 			BitLength: 8,
 			BitOffset: 32,
@@ -11323,8 +11780,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Dimmer3",
 			Name: "Dimmer3",
 			BitLength: 8,
 			BitOffset: 40,
@@ -11333,8 +11792,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "Dimmer4",
 			Name: "Dimmer4",
 			BitLength: 8,
 			BitOffset: 48,
@@ -11343,8 +11804,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "Control",
 			Name: "Control",
 			BitLength: 8,
 			BitOffset: 56,
@@ -11353,6 +11816,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -11363,8 +11827,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 135,
 		Decoder: DecodeAirmarBootStateRequest,
+		Encoder: EncodeAirmarBootStateRequest,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -11373,8 +11839,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -11383,8 +11851,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -11393,8 +11863,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 48,
 			BitOffset: 16,
@@ -11403,6 +11875,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -11413,8 +11886,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 135,
 		Decoder: DecodeAirmarAccessLevel,
+		Encoder: EncodeAirmarAccessLevel,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -11423,8 +11898,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -11433,8 +11910,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -11443,8 +11922,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "FormatCode",
 			Name: "Format Code",
 			BitLength: 8,
 			BitOffset: 16,
@@ -11453,8 +11934,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "AccessLevel",
 			Name: "Access Level",
 			BitLength: 3,
 			BitOffset: 24,
@@ -11463,8 +11946,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AccessLevelConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "XxReserved6",
 			Name: "Reserved",
 			BitLength: 5,
 			BitOffset: 27,
@@ -11473,8 +11958,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "AccessSeedKey",
 			Name: "Access Seed/Key",
 			BitLength: 32,
 			BitOffset: 32,
@@ -11483,6 +11970,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -11493,8 +11981,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 1857,
 		Decoder: DecodeSimnetConfigureTemperatureSensor,
+		Encoder: EncodeSimnetConfigureTemperatureSensor,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -11503,8 +11993,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -11513,8 +12005,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -11523,8 +12017,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 48,
 			BitOffset: 16,
@@ -11533,6 +12029,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -11543,8 +12040,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 1851,
 		Decoder: DecodeSeatalkAlarm,
+		Encoder: EncodeSeatalkAlarm,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -11553,8 +12052,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -11563,8 +12064,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -11573,8 +12076,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -11583,8 +12088,10 @@ var pgnList = []PgnInfo{
 			GolangType:"[]uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "AlarmStatus",
 			Name: "Alarm Status",
 			BitLength: 8,
 			BitOffset: 24,
@@ -11593,8 +12100,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SeatalkAlarmStatusConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "AlarmId",
 			Name: "Alarm ID",
 			BitLength: 8,
 			BitOffset: 32,
@@ -11603,8 +12112,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SeatalkAlarmIdConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "AlarmGroup",
 			Name: "Alarm Group",
 			BitLength: 8,
 			BitOffset: 40,
@@ -11613,8 +12124,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SeatalkAlarmGroupConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "AlarmPriority",
 			Name: "Alarm Priority",
 			BitLength: 16,
 			BitOffset: 48,
@@ -11623,6 +12136,7 @@ var pgnList = []PgnInfo{
 			GolangType:"[]uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -11633,8 +12147,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 1857,
 		Decoder: DecodeSimnetTrimTabSensorCalibration,
+		Encoder: EncodeSimnetTrimTabSensorCalibration,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -11643,8 +12159,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -11653,8 +12171,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -11663,8 +12183,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 48,
 			BitOffset: 16,
@@ -11673,6 +12195,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -11683,8 +12206,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 1857,
 		Decoder: DecodeSimnetPaddleWheelSpeedConfiguration,
+		Encoder: EncodeSimnetPaddleWheelSpeedConfiguration,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -11693,8 +12218,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -11703,8 +12230,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -11713,8 +12242,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 48,
 			BitOffset: 16,
@@ -11723,6 +12254,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -11733,8 +12265,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 1857,
 		Decoder: DecodeSimnetClearFluidLevelWarnings,
+		Encoder: EncodeSimnetClearFluidLevelWarnings,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -11743,8 +12277,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -11753,8 +12289,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -11763,8 +12301,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 48,
 			BitOffset: 16,
@@ -11773,6 +12313,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -11783,8 +12324,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 1857,
 		Decoder: DecodeSimnetLgc2000Configuration,
+		Encoder: EncodeSimnetLgc2000Configuration,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -11793,8 +12336,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -11803,8 +12348,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -11813,8 +12360,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 48,
 			BitOffset: 16,
@@ -11823,6 +12372,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -11833,8 +12383,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 641,
 		Decoder: DecodeDiverseYachtServicesLoadCell,
+		Encoder: EncodeDiverseYachtServicesLoadCell,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -11843,8 +12395,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -11853,8 +12407,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -11863,8 +12419,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Instance",
 			Name: "Instance",
 			BitLength: 8,
 			BitOffset: 16,
@@ -11873,8 +12431,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "XxReserved5",
 			Name: "Reserved",
 			BitLength: 8,
 			BitOffset: 24,
@@ -11883,8 +12443,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "LoadCell",
 			Name: "Load Cell",
 			BitLength: 32,
 			BitOffset: 32,
@@ -11893,6 +12455,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -11903,8 +12466,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 1857,
 		Decoder: DecodeSimnetApUnknown1,
+		Encoder: EncodeSimnetApUnknown1,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -11913,8 +12478,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -11923,8 +12490,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -11933,8 +12502,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "A",
 			Name: "A",
 			BitLength: 8,
 			BitOffset: 16,
@@ -11943,8 +12514,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "B",
 			Name: "B",
 			BitLength: 8,
 			BitOffset: 24,
@@ -11953,8 +12526,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "C",
 			Name: "C",
 			BitLength: 16,
 			BitOffset: 32,
@@ -11963,8 +12538,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "D",
 			Name: "D",
 			BitLength: 8,
 			BitOffset: 48,
@@ -11973,8 +12550,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "XxReserved8",
 			Name: "Reserved",
 			BitLength: 8,
 			BitOffset: 56,
@@ -11983,6 +12562,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -11993,8 +12573,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 1857,
 		Decoder: DecodeSimnetDeviceStatus,
+		Encoder: EncodeSimnetDeviceStatus,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -12003,8 +12585,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -12013,8 +12597,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -12023,8 +12609,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Model",
 			Name: "Model",
 			BitLength: 8,
 			BitOffset: 16,
@@ -12033,8 +12621,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SimnetDeviceModelConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "Report",
 			Name: "Report",
 			BitLength: 8,
 			BitOffset: 24,
@@ -12043,8 +12633,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SimnetDeviceReportConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Status",
 			Name: "Status",
 			BitLength: 8,
 			BitOffset: 32,
@@ -12053,8 +12645,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SimnetApStatusConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "XxReserved7",
 			Name: "Spare",
 			BitLength: 24,
 			BitOffset: 40,
@@ -12063,6 +12657,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -12073,8 +12668,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 1857,
 		Decoder: DecodeSimnetDeviceStatusRequest,
+		Encoder: EncodeSimnetDeviceStatusRequest,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -12083,8 +12680,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -12093,8 +12692,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -12103,8 +12704,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Model",
 			Name: "Model",
 			BitLength: 8,
 			BitOffset: 16,
@@ -12113,8 +12716,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SimnetDeviceModelConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "Report",
 			Name: "Report",
 			BitLength: 8,
 			BitOffset: 24,
@@ -12123,8 +12728,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SimnetDeviceReportConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "XxReserved6",
 			Name: "Spare",
 			BitLength: 32,
 			BitOffset: 32,
@@ -12133,6 +12740,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -12143,8 +12751,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 1857,
 		Decoder: DecodeSimnetPilotMode,
+		Encoder: EncodeSimnetPilotMode,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -12153,8 +12763,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -12163,8 +12775,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -12173,8 +12787,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Model",
 			Name: "Model",
 			BitLength: 8,
 			BitOffset: 16,
@@ -12183,8 +12799,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SimnetDeviceModelConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "Report",
 			Name: "Report",
 			BitLength: 8,
 			BitOffset: 24,
@@ -12193,8 +12811,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SimnetDeviceReportConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Mode",
 			Name: "Mode",
 			BitLength: 16,
 			BitOffset: 32,
@@ -12203,8 +12823,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SimnetApModeBitfieldConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "XxReserved7",
 			Name: "Spare",
 			BitLength: 16,
 			BitOffset: 48,
@@ -12213,6 +12835,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -12223,8 +12846,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 1857,
 		Decoder: DecodeSimnetDeviceModeRequest,
+		Encoder: EncodeSimnetDeviceModeRequest,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -12233,8 +12858,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -12243,8 +12870,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -12253,8 +12882,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Model",
 			Name: "Model",
 			BitLength: 8,
 			BitOffset: 16,
@@ -12263,8 +12894,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SimnetDeviceModelConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "Report",
 			Name: "Report",
 			BitLength: 8,
 			BitOffset: 24,
@@ -12273,8 +12906,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SimnetDeviceReportConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "XxReserved6",
 			Name: "Spare",
 			BitLength: 32,
 			BitOffset: 32,
@@ -12283,6 +12918,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -12293,8 +12929,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 1857,
 		Decoder: DecodeSimnetSailingProcessorStatus,
+		Encoder: EncodeSimnetSailingProcessorStatus,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -12303,8 +12941,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -12313,8 +12953,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -12323,8 +12965,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Model",
 			Name: "Model",
 			BitLength: 8,
 			BitOffset: 16,
@@ -12333,8 +12977,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SimnetDeviceModelConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "Report",
 			Name: "Report",
 			BitLength: 8,
 			BitOffset: 24,
@@ -12343,8 +12989,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SimnetDeviceReportConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Data",
 			Name: "Data",
 			BitLength: 32,
 			BitOffset: 32,
@@ -12353,6 +13001,7 @@ var pgnList = []PgnInfo{
 			GolangType:"[]uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -12363,8 +13012,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 275,
 		Decoder: DecodeNavicoWirelessBatteryStatus,
+		Encoder: EncodeNavicoWirelessBatteryStatus,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -12373,8 +13024,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -12383,8 +13036,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -12393,8 +13048,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Status",
 			Name: "Status",
 			BitLength: 8,
 			BitOffset: 16,
@@ -12403,8 +13060,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "BatteryStatus",
 			Name: "Battery Status",
 			BitLength: 8,
 			BitOffset: 24,
@@ -12413,8 +13072,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "BatteryChargeStatus",
 			Name: "Battery Charge Status",
 			BitLength: 8,
 			BitOffset: 32,
@@ -12423,8 +13084,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "XxReserved7",
 			Name: "Reserved",
 			BitLength: 24,
 			BitOffset: 40,
@@ -12433,6 +13096,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -12443,8 +13107,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 275,
 		Decoder: DecodeNavicoWirelessSignalStatus,
+		Encoder: EncodeNavicoWirelessSignalStatus,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -12453,8 +13119,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -12463,8 +13131,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -12473,8 +13143,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Unknown",
 			Name: "Unknown",
 			BitLength: 8,
 			BitOffset: 16,
@@ -12483,8 +13155,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "SignalStrength",
 			Name: "Signal Strength",
 			BitLength: 8,
 			BitOffset: 24,
@@ -12493,8 +13167,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "XxReserved6",
 			Name: "Reserved",
 			BitLength: 32,
 			BitOffset: 32,
@@ -12503,6 +13179,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -12513,8 +13190,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 1857,
 		Decoder: DecodeSimnetApUnknown2,
+		Encoder: EncodeSimnetApUnknown2,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -12523,8 +13202,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -12533,8 +13214,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -12543,8 +13226,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "A",
 			Name: "A",
 			BitLength: 8,
 			BitOffset: 16,
@@ -12553,8 +13238,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "B",
 			Name: "B",
 			BitLength: 8,
 			BitOffset: 24,
@@ -12563,8 +13250,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "C",
 			Name: "C",
 			BitLength: 8,
 			BitOffset: 32,
@@ -12573,8 +13262,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "D",
 			Name: "D",
 			BitLength: 8,
 			BitOffset: 40,
@@ -12583,8 +13274,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "E",
 			Name: "E",
 			BitLength: 8,
 			BitOffset: 48,
@@ -12593,8 +13286,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "XxReserved9",
 			Name: "Reserved",
 			BitLength: 8,
 			BitOffset: 56,
@@ -12603,6 +13298,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -12613,8 +13309,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 1857,
 		Decoder: DecodeSimnetAutopilotAngle,
+		Encoder: EncodeSimnetAutopilotAngle,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -12623,8 +13321,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -12633,8 +13333,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -12643,8 +13345,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 16,
 			BitOffset: 16,
@@ -12653,8 +13357,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "Mode",
 			Name: "Mode",
 			BitLength: 8,
 			BitOffset: 32,
@@ -12663,8 +13369,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SimnetApModeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "XxReserved6",
 			Name: "Reserved",
 			BitLength: 8,
 			BitOffset: 40,
@@ -12673,8 +13381,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Angle",
 			Name: "Angle",
 			BitLength: 16,
 			BitOffset: 48,
@@ -12683,6 +13393,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -12693,8 +13404,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 1851,
 		Decoder: DecodeSeatalkPilotWindDatum,
+		Encoder: EncodeSeatalkPilotWindDatum,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -12703,8 +13416,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -12713,8 +13428,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -12723,8 +13440,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "WindDatum",
 			Name: "Wind Datum",
 			BitLength: 16,
 			BitOffset: 16,
@@ -12733,8 +13452,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "RollingAverageWindAngle",
 			Name: "Rolling Average Wind Angle",
 			BitLength: 16,
 			BitOffset: 32,
@@ -12743,8 +13464,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "XxReserved6",
 			Name: "Reserved",
 			BitLength: 16,
 			BitOffset: 48,
@@ -12753,6 +13476,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -12763,8 +13487,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeSimnetMagneticField,
+		Encoder: EncodeSimnetMagneticField,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "A",
 			Name: "A",
 			BitLength: 16,
 			BitOffset: 0,
@@ -12773,8 +13499,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: true,
+			Unit: "",
 			},
 		2: { 
+			Id: "B",
 			Name: "B",
 			BitLength: 8,
 			BitOffset: 16,
@@ -12783,8 +13511,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "C",
 			Name: "C",
 			BitLength: 16,
 			BitOffset: 24,
@@ -12793,8 +13523,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: true,
+			Unit: "",
 			},
 		4: { 
+			Id: "D",
 			Name: "D",
 			BitLength: 16,
 			BitOffset: 40,
@@ -12803,8 +13535,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: true,
+			Unit: "",
 			},
 		5: { 
+			Id: "XxReserved5",
 			Name: "Reserved",
 			BitLength: 8,
 			BitOffset: 56,
@@ -12813,6 +13547,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -12823,8 +13558,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 1851,
 		Decoder: DecodeSeatalkPilotHeading,
+		Encoder: EncodeSeatalkPilotHeading,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -12833,8 +13570,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -12843,8 +13582,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -12853,8 +13594,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -12863,8 +13606,10 @@ var pgnList = []PgnInfo{
 			GolangType:"[]uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "HeadingTrue",
 			Name: "Heading True",
 			BitLength: 16,
 			BitOffset: 24,
@@ -12873,8 +13618,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "HeadingMagnetic",
 			Name: "Heading Magnetic",
 			BitLength: 16,
 			BitOffset: 40,
@@ -12883,8 +13630,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "XxReserved7",
 			Name: "Reserved",
 			BitLength: 8,
 			BitOffset: 56,
@@ -12893,6 +13642,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -12903,8 +13653,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 1851,
 		Decoder: DecodeSeatalkPilotLockedHeading,
+		Encoder: EncodeSeatalkPilotLockedHeading,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -12913,8 +13665,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -12923,8 +13677,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -12933,8 +13689,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -12943,8 +13701,10 @@ var pgnList = []PgnInfo{
 			GolangType:"[]uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "TargetHeadingTrue",
 			Name: "Target Heading True",
 			BitLength: 16,
 			BitOffset: 24,
@@ -12953,8 +13713,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "TargetHeadingMagnetic",
 			Name: "Target Heading Magnetic",
 			BitLength: 16,
 			BitOffset: 40,
@@ -12963,8 +13725,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "XxReserved7",
 			Name: "Reserved",
 			BitLength: 8,
 			BitOffset: 56,
@@ -12973,6 +13737,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -12983,8 +13748,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 1851,
 		Decoder: DecodeSeatalkSilenceAlarm,
+		Encoder: EncodeSeatalkSilenceAlarm,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -12993,8 +13760,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -13003,8 +13772,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -13013,8 +13784,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "AlarmId",
 			Name: "Alarm ID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -13023,8 +13796,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SeatalkAlarmIdConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "AlarmGroup",
 			Name: "Alarm Group",
 			BitLength: 8,
 			BitOffset: 24,
@@ -13033,8 +13808,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SeatalkAlarmGroupConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "XxReserved6",
 			Name: "Reserved",
 			BitLength: 32,
 			BitOffset: 32,
@@ -13043,6 +13820,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -13053,8 +13831,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 1851,
 		Decoder: DecodeSeatalkKeypadMessage,
+		Encoder: EncodeSeatalkKeypadMessage,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -13063,8 +13843,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -13073,8 +13855,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -13083,8 +13867,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "ProprietaryId",
 			Name: "Proprietary ID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -13093,8 +13879,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "FirstKey",
 			Name: "First key",
 			BitLength: 8,
 			BitOffset: 24,
@@ -13103,8 +13891,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "SecondKey",
 			Name: "Second key",
 			BitLength: 8,
 			BitOffset: 32,
@@ -13113,8 +13903,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "FirstKeyState",
 			Name: "First key state",
 			BitLength: 2,
 			BitOffset: 40,
@@ -13123,8 +13915,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "SecondKeyState",
 			Name: "Second key state",
 			BitLength: 2,
 			BitOffset: 42,
@@ -13133,8 +13927,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "XxReserved9",
 			Name: "Reserved",
 			BitLength: 4,
 			BitOffset: 44,
@@ -13143,8 +13939,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "EncoderPosition",
 			Name: "Encoder Position",
 			BitLength: 8,
 			BitOffset: 48,
@@ -13153,8 +13951,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "XxReserved11",
 			Name: "Reserved",
 			BitLength: 8,
 			BitOffset: 56,
@@ -13163,6 +13963,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -13173,8 +13974,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 1851,
 		Decoder: DecodeSeatalkKeypadHeartbeat,
+		Encoder: EncodeSeatalkKeypadHeartbeat,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -13183,8 +13986,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -13193,8 +13998,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -13203,8 +14010,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "ProprietaryId",
 			Name: "Proprietary ID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -13213,8 +14022,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "Variant",
 			Name: "Variant",
 			BitLength: 8,
 			BitOffset: 24,
@@ -13223,8 +14034,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Status",
 			Name: "Status",
 			BitLength: 8,
 			BitOffset: 32,
@@ -13233,8 +14046,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "XxReserved7",
 			Name: "Reserved",
 			BitLength: 24,
 			BitOffset: 40,
@@ -13243,6 +14058,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -13253,8 +14069,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 1851,
 		Decoder: DecodeSeatalkPilotMode,
+		Encoder: EncodeSeatalkPilotMode,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -13263,8 +14081,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -13273,8 +14093,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -13283,8 +14105,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "PilotMode",
 			Name: "Pilot Mode",
 			BitLength: 16,
 			BitOffset: 16,
@@ -13293,8 +14117,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SeatalkPilotMode16Const",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "SubMode",
 			Name: "Sub Mode",
 			BitLength: 16,
 			BitOffset: 32,
@@ -13303,8 +14129,10 @@ var pgnList = []PgnInfo{
 			GolangType:"[]uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "PilotModeData",
 			Name: "Pilot Mode Data",
 			BitLength: 8,
 			BitOffset: 48,
@@ -13313,8 +14141,10 @@ var pgnList = []PgnInfo{
 			GolangType:"[]uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "XxReserved7",
 			Name: "Reserved",
 			BitLength: 8,
 			BitOffset: 56,
@@ -13323,6 +14153,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -13333,8 +14164,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 135,
 		Decoder: DecodeAirmarDepthQualityFactor,
+		Encoder: EncodeAirmarDepthQualityFactor,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -13343,8 +14176,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -13353,8 +14188,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -13363,8 +14200,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -13373,8 +14212,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "DepthQualityFactor",
 			Name: "Depth Quality Factor",
 			BitLength: 4,
 			BitOffset: 24,
@@ -13383,8 +14224,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AirmarDepthQualityFactorConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "XxReserved6",
 			Name: "Reserved",
 			BitLength: 36,
 			BitOffset: 28,
@@ -13393,6 +14236,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -13403,8 +14247,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 135,
 		Decoder: DecodeAirmarSpeedPulseCount,
+		Encoder: EncodeAirmarSpeedPulseCount,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -13413,8 +14259,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -13423,8 +14271,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -13433,8 +14283,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -13443,8 +14295,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "DurationOfInterval",
 			Name: "Duration of interval",
 			BitLength: 16,
 			BitOffset: 24,
@@ -13453,8 +14307,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.001,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "NumberOfPulsesReceived",
 			Name: "Number of pulses received",
 			BitLength: 16,
 			BitOffset: 40,
@@ -13463,8 +14319,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "XxReserved7",
 			Name: "Reserved",
 			BitLength: 8,
 			BitOffset: 56,
@@ -13473,6 +14331,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -13483,8 +14342,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 135,
 		Decoder: DecodeAirmarDeviceInformation,
+		Encoder: EncodeAirmarDeviceInformation,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -13493,8 +14354,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -13503,8 +14366,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -13513,8 +14378,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -13523,8 +14390,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "InternalDeviceTemperature",
 			Name: "Internal Device Temperature",
 			BitLength: 16,
 			BitOffset: 24,
@@ -13533,8 +14402,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Temperature",
 			Resolution:0.01,
 			Signed: false,
+			Unit: "K",
 			},
 		6: { 
+			Id: "SupplyVoltage",
 			Name: "Supply Voltage",
 			BitLength: 16,
 			BitOffset: 40,
@@ -13543,8 +14414,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.01,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "XxReserved7",
 			Name: "Reserved",
 			BitLength: 8,
 			BitOffset: 56,
@@ -13553,6 +14426,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -13563,8 +14437,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 1857,
 		Decoder: DecodeSimnetApUnknown3,
+		Encoder: EncodeSimnetApUnknown3,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -13573,8 +14449,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -13583,8 +14461,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -13593,8 +14473,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "A",
 			Name: "A",
 			BitLength: 8,
 			BitOffset: 16,
@@ -13603,8 +14485,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "B",
 			Name: "B",
 			BitLength: 8,
 			BitOffset: 24,
@@ -13613,8 +14497,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "C",
 			Name: "C",
 			BitLength: 8,
 			BitOffset: 32,
@@ -13623,8 +14509,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "D",
 			Name: "D",
 			BitLength: 8,
 			BitOffset: 40,
@@ -13633,8 +14521,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "E",
 			Name: "E",
 			BitLength: 8,
 			BitOffset: 48,
@@ -13643,8 +14533,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "XxReserved9",
 			Name: "Reserved",
 			BitLength: 8,
 			BitOffset: 56,
@@ -13653,6 +14545,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -13663,8 +14556,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 1857,
 		Decoder: DecodeSimnetAutopilotMode,
+		Encoder: EncodeSimnetAutopilotMode,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -13673,8 +14568,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -13683,8 +14580,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -13693,8 +14592,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 48,
 			BitOffset: 16,
@@ -13703,6 +14604,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -13713,8 +14615,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeNmeaRequestGroupFunction,
+		Encoder: EncodeNmeaRequestGroupFunction,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "FunctionCode",
 			Name: "Function Code",
 			BitLength: 8,
 			BitOffset: 0,
@@ -13723,8 +14627,10 @@ var pgnList = []PgnInfo{
 			GolangType:"GroupFunctionConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "Pgn",
 			Name: "PGN",
 			BitLength: 24,
 			BitOffset: 8,
@@ -13733,8 +14639,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "TransmissionInterval",
 			Name: "Transmission interval",
 			BitLength: 32,
 			BitOffset: 32,
@@ -13743,8 +14651,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.001,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "TransmissionIntervalOffset",
 			Name: "Transmission interval offset",
 			BitLength: 16,
 			BitOffset: 64,
@@ -13753,8 +14663,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.01,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "NumberOfParameters",
 			Name: "Number of Parameters",
 			BitLength: 8,
 			BitOffset: 80,
@@ -13763,8 +14675,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Parameter",
 			Name: "Parameter",
 			BitLength: 8,
 			BitOffset: 88,
@@ -13773,8 +14687,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Value",
 			Name: "Value",
 			BitLength: 0,
 			BitOffset: 0,
@@ -13783,6 +14699,7 @@ var pgnList = []PgnInfo{
 			GolangType:"[]uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -13793,8 +14710,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeNmeaCommandGroupFunction,
+		Encoder: EncodeNmeaCommandGroupFunction,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "FunctionCode",
 			Name: "Function Code",
 			BitLength: 8,
 			BitOffset: 0,
@@ -13803,8 +14722,10 @@ var pgnList = []PgnInfo{
 			GolangType:"GroupFunctionConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "Pgn",
 			Name: "PGN",
 			BitLength: 24,
 			BitOffset: 8,
@@ -13813,8 +14734,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "Priority",
 			Name: "Priority",
 			BitLength: 4,
 			BitOffset: 32,
@@ -13823,8 +14746,10 @@ var pgnList = []PgnInfo{
 			GolangType:"PriorityConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 4,
 			BitOffset: 36,
@@ -13833,8 +14758,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "NumberOfParameters",
 			Name: "Number of Parameters",
 			BitLength: 8,
 			BitOffset: 40,
@@ -13843,8 +14770,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Parameter",
 			Name: "Parameter",
 			BitLength: 8,
 			BitOffset: 48,
@@ -13853,8 +14782,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Value",
 			Name: "Value",
 			BitLength: 0,
 			BitOffset: 0,
@@ -13863,6 +14794,7 @@ var pgnList = []PgnInfo{
 			GolangType:"[]uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -13873,8 +14805,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeNmeaAcknowledgeGroupFunction,
+		Encoder: EncodeNmeaAcknowledgeGroupFunction,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "FunctionCode",
 			Name: "Function Code",
 			BitLength: 8,
 			BitOffset: 0,
@@ -13883,8 +14817,10 @@ var pgnList = []PgnInfo{
 			GolangType:"GroupFunctionConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "Pgn",
 			Name: "PGN",
 			BitLength: 24,
 			BitOffset: 8,
@@ -13893,8 +14829,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "PgnErrorCode",
 			Name: "PGN error code",
 			BitLength: 4,
 			BitOffset: 32,
@@ -13903,8 +14841,10 @@ var pgnList = []PgnInfo{
 			GolangType:"PgnErrorCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "TransmissionIntervalPriorityErrorCode",
 			Name: "Transmission interval/Priority error code",
 			BitLength: 4,
 			BitOffset: 36,
@@ -13913,8 +14853,10 @@ var pgnList = []PgnInfo{
 			GolangType:"TransmissionIntervalConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "NumberOfParameters",
 			Name: "Number of Parameters",
 			BitLength: 8,
 			BitOffset: 40,
@@ -13923,8 +14865,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Parameter",
 			Name: "Parameter",
 			BitLength: 4,
 			BitOffset: 48,
@@ -13933,6 +14877,7 @@ var pgnList = []PgnInfo{
 			GolangType:"ParameterFieldConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -13943,8 +14888,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeNmeaReadFieldsGroupFunction,
+		Encoder: EncodeNmeaReadFieldsGroupFunction,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "FunctionCode",
 			Name: "Function Code",
 			BitLength: 8,
 			BitOffset: 0,
@@ -13953,8 +14900,10 @@ var pgnList = []PgnInfo{
 			GolangType:"GroupFunctionConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "Pgn",
 			Name: "PGN",
 			BitLength: 24,
 			BitOffset: 8,
@@ -13963,8 +14912,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 32,
@@ -13973,8 +14924,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 0,
@@ -13983,8 +14936,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 0,
@@ -13993,8 +14948,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "UniqueId",
 			Name: "Unique ID",
 			BitLength: 8,
 			BitOffset: 0,
@@ -14003,8 +14960,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "NumberOfSelectionPairs",
 			Name: "Number of Selection Pairs",
 			BitLength: 8,
 			BitOffset: 0,
@@ -14013,8 +14972,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "NumberOfParameters",
 			Name: "Number of Parameters",
 			BitLength: 8,
 			BitOffset: 0,
@@ -14023,8 +14984,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "SelectionParameter",
 			Name: "Selection Parameter",
 			BitLength: 8,
 			BitOffset: 0,
@@ -14033,8 +14996,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "SelectionValue",
 			Name: "Selection Value",
 			BitLength: 0,
 			BitOffset: 0,
@@ -14043,8 +15008,10 @@ var pgnList = []PgnInfo{
 			GolangType:"[]uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "Parameter",
 			Name: "Parameter",
 			BitLength: 8,
 			BitOffset: 0,
@@ -14053,6 +15020,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -14063,8 +15031,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeNmeaReadFieldsReplyGroupFunction,
+		Encoder: EncodeNmeaReadFieldsReplyGroupFunction,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "FunctionCode",
 			Name: "Function Code",
 			BitLength: 8,
 			BitOffset: 0,
@@ -14073,8 +15043,10 @@ var pgnList = []PgnInfo{
 			GolangType:"GroupFunctionConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "Pgn",
 			Name: "PGN",
 			BitLength: 24,
 			BitOffset: 8,
@@ -14083,8 +15055,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 32,
@@ -14093,8 +15067,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 0,
@@ -14103,8 +15079,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 0,
@@ -14113,8 +15091,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "UniqueId",
 			Name: "Unique ID",
 			BitLength: 8,
 			BitOffset: 0,
@@ -14123,8 +15103,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "NumberOfSelectionPairs",
 			Name: "Number of Selection Pairs",
 			BitLength: 8,
 			BitOffset: 0,
@@ -14133,8 +15115,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "NumberOfParameters",
 			Name: "Number of Parameters",
 			BitLength: 8,
 			BitOffset: 0,
@@ -14143,8 +15127,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "SelectionParameter",
 			Name: "Selection Parameter",
 			BitLength: 8,
 			BitOffset: 0,
@@ -14153,8 +15139,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "SelectionValue",
 			Name: "Selection Value",
 			BitLength: 0,
 			BitOffset: 0,
@@ -14163,8 +15151,10 @@ var pgnList = []PgnInfo{
 			GolangType:"[]uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "Parameter",
 			Name: "Parameter",
 			BitLength: 8,
 			BitOffset: 0,
@@ -14173,8 +15163,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		12: { 
+			Id: "Value",
 			Name: "Value",
 			BitLength: 0,
 			BitOffset: 0,
@@ -14183,6 +15175,7 @@ var pgnList = []PgnInfo{
 			GolangType:"[]uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -14193,8 +15186,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeNmeaWriteFieldsGroupFunction,
+		Encoder: EncodeNmeaWriteFieldsGroupFunction,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "FunctionCode",
 			Name: "Function Code",
 			BitLength: 8,
 			BitOffset: 0,
@@ -14203,8 +15198,10 @@ var pgnList = []PgnInfo{
 			GolangType:"GroupFunctionConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "Pgn",
 			Name: "PGN",
 			BitLength: 24,
 			BitOffset: 8,
@@ -14213,8 +15210,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 32,
@@ -14223,8 +15222,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 0,
@@ -14233,8 +15234,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 0,
@@ -14243,8 +15246,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "UniqueId",
 			Name: "Unique ID",
 			BitLength: 8,
 			BitOffset: 0,
@@ -14253,8 +15258,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "NumberOfSelectionPairs",
 			Name: "Number of Selection Pairs",
 			BitLength: 8,
 			BitOffset: 0,
@@ -14263,8 +15270,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "NumberOfParameters",
 			Name: "Number of Parameters",
 			BitLength: 8,
 			BitOffset: 0,
@@ -14273,8 +15282,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "SelectionParameter",
 			Name: "Selection Parameter",
 			BitLength: 8,
 			BitOffset: 0,
@@ -14283,8 +15294,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "SelectionValue",
 			Name: "Selection Value",
 			BitLength: 0,
 			BitOffset: 0,
@@ -14293,8 +15306,10 @@ var pgnList = []PgnInfo{
 			GolangType:"[]uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "Parameter",
 			Name: "Parameter",
 			BitLength: 8,
 			BitOffset: 0,
@@ -14303,8 +15318,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		12: { 
+			Id: "Value",
 			Name: "Value",
 			BitLength: 0,
 			BitOffset: 0,
@@ -14313,6 +15330,7 @@ var pgnList = []PgnInfo{
 			GolangType:"[]uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -14323,8 +15341,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeNmeaWriteFieldsReplyGroupFunction,
+		Encoder: EncodeNmeaWriteFieldsReplyGroupFunction,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "FunctionCode",
 			Name: "Function Code",
 			BitLength: 8,
 			BitOffset: 0,
@@ -14333,8 +15353,10 @@ var pgnList = []PgnInfo{
 			GolangType:"GroupFunctionConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "Pgn",
 			Name: "PGN",
 			BitLength: 24,
 			BitOffset: 8,
@@ -14343,8 +15365,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 32,
@@ -14353,8 +15377,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 0,
@@ -14363,8 +15389,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 0,
@@ -14373,8 +15401,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "UniqueId",
 			Name: "Unique ID",
 			BitLength: 8,
 			BitOffset: 0,
@@ -14383,8 +15413,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "NumberOfSelectionPairs",
 			Name: "Number of Selection Pairs",
 			BitLength: 8,
 			BitOffset: 0,
@@ -14393,8 +15425,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "NumberOfParameters",
 			Name: "Number of Parameters",
 			BitLength: 8,
 			BitOffset: 0,
@@ -14403,8 +15437,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "SelectionParameter",
 			Name: "Selection Parameter",
 			BitLength: 8,
 			BitOffset: 0,
@@ -14413,8 +15449,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "SelectionValue",
 			Name: "Selection Value",
 			BitLength: 0,
 			BitOffset: 0,
@@ -14423,8 +15461,10 @@ var pgnList = []PgnInfo{
 			GolangType:"[]uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "Parameter",
 			Name: "Parameter",
 			BitLength: 8,
 			BitOffset: 0,
@@ -14433,8 +15473,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		12: { 
+			Id: "Value",
 			Name: "Value",
 			BitLength: 0,
 			BitOffset: 0,
@@ -14443,6 +15485,7 @@ var pgnList = []PgnInfo{
 			GolangType:"[]uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -14453,8 +15496,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodePgnListTransmitAndReceive,
+		Encoder: EncodePgnListTransmitAndReceive,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "FunctionCode",
 			Name: "Function Code",
 			BitLength: 8,
 			BitOffset: 0,
@@ -14463,8 +15508,10 @@ var pgnList = []PgnInfo{
 			GolangType:"PgnListFunctionConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "Pgn",
 			Name: "PGN",
 			BitLength: 24,
 			BitOffset: 8,
@@ -14473,6 +15520,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -14483,8 +15531,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 1851,
 		Decoder: DecodeSeatalk1PilotMode,
+		Encoder: EncodeSeatalk1PilotMode,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -14493,8 +15543,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -14503,8 +15555,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -14513,8 +15567,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "ProprietaryId",
 			Name: "Proprietary ID",
 			BitLength: 16,
 			BitOffset: 16,
@@ -14523,8 +15579,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "Command",
 			Name: "command",
 			BitLength: 8,
 			BitOffset: 32,
@@ -14533,8 +15591,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Unknown1",
 			Name: "Unknown 1",
 			BitLength: 24,
 			BitOffset: 40,
@@ -14543,8 +15603,10 @@ var pgnList = []PgnInfo{
 			GolangType:"[]uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "PilotMode",
 			Name: "Pilot Mode",
 			BitLength: 8,
 			BitOffset: 64,
@@ -14553,8 +15615,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SeatalkPilotModeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "SubMode",
 			Name: "Sub Mode",
 			BitLength: 8,
 			BitOffset: 72,
@@ -14563,8 +15627,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "PilotModeData",
 			Name: "Pilot Mode Data",
 			BitLength: 8,
 			BitOffset: 80,
@@ -14573,8 +15639,10 @@ var pgnList = []PgnInfo{
 			GolangType:"[]uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "Unknown2",
 			Name: "Unknown 2",
 			BitLength: 80,
 			BitOffset: 88,
@@ -14583,6 +15651,7 @@ var pgnList = []PgnInfo{
 			GolangType:"[]uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -14593,8 +15662,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 419,
 		Decoder: DecodeFusionMediaControl,
+		Encoder: EncodeFusionMediaControl,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -14603,8 +15674,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -14613,8 +15686,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -14623,8 +15698,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "ProprietaryId",
 			Name: "Proprietary ID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -14633,8 +15710,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "Unknown",
 			Name: "Unknown",
 			BitLength: 8,
 			BitOffset: 24,
@@ -14643,8 +15722,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "SourceId",
 			Name: "Source ID",
 			BitLength: 8,
 			BitOffset: 32,
@@ -14653,8 +15734,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Command",
 			Name: "Command",
 			BitLength: 8,
 			BitOffset: 40,
@@ -14663,6 +15746,7 @@ var pgnList = []PgnInfo{
 			GolangType:"FusionCommandConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -14673,8 +15757,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 419,
 		Decoder: DecodeFusionSiriusControl,
+		Encoder: EncodeFusionSiriusControl,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -14683,8 +15769,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -14693,8 +15781,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -14703,8 +15793,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "ProprietaryId",
 			Name: "Proprietary ID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -14713,8 +15805,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "Unknown",
 			Name: "Unknown",
 			BitLength: 8,
 			BitOffset: 24,
@@ -14723,8 +15817,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "SourceId",
 			Name: "Source ID",
 			BitLength: 8,
 			BitOffset: 32,
@@ -14733,8 +15829,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Command",
 			Name: "Command",
 			BitLength: 8,
 			BitOffset: 40,
@@ -14743,6 +15841,7 @@ var pgnList = []PgnInfo{
 			GolangType:"FusionSiriusCommandConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -14753,8 +15852,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 419,
 		Decoder: DecodeFusionRequestStatus,
+		Encoder: EncodeFusionRequestStatus,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -14763,8 +15864,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -14773,8 +15876,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -14783,8 +15888,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "ProprietaryId",
 			Name: "Proprietary ID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -14793,8 +15900,10 @@ var pgnList = []PgnInfo{
 			GolangType:"FusionMessageIdConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "Unknown",
 			Name: "Unknown",
 			BitLength: 8,
 			BitOffset: 24,
@@ -14803,6 +15912,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -14813,8 +15923,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 419,
 		Decoder: DecodeFusionSetSource,
+		Encoder: EncodeFusionSetSource,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -14823,8 +15935,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -14833,8 +15947,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -14843,8 +15959,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "ProprietaryId",
 			Name: "Proprietary ID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -14853,8 +15971,10 @@ var pgnList = []PgnInfo{
 			GolangType:"FusionMessageIdConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "Unknown",
 			Name: "Unknown",
 			BitLength: 8,
 			BitOffset: 24,
@@ -14863,8 +15983,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "SourceId",
 			Name: "Source ID",
 			BitLength: 8,
 			BitOffset: 32,
@@ -14873,6 +15995,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -14883,8 +16006,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 419,
 		Decoder: DecodeFusionSetMute,
+		Encoder: EncodeFusionSetMute,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -14893,8 +16018,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -14903,8 +16030,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -14913,8 +16042,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "ProprietaryId",
 			Name: "Proprietary ID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -14923,8 +16054,10 @@ var pgnList = []PgnInfo{
 			GolangType:"FusionMessageIdConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "Command",
 			Name: "Command",
 			BitLength: 8,
 			BitOffset: 24,
@@ -14933,6 +16066,7 @@ var pgnList = []PgnInfo{
 			GolangType:"FusionMuteCommandConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -14943,8 +16077,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 419,
 		Decoder: DecodeFusionSetZoneVolume,
+		Encoder: EncodeFusionSetZoneVolume,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -14953,8 +16089,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -14963,8 +16101,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -14973,8 +16113,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "ProprietaryId",
 			Name: "Proprietary ID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -14983,8 +16125,10 @@ var pgnList = []PgnInfo{
 			GolangType:"FusionMessageIdConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "Unknown",
 			Name: "Unknown",
 			BitLength: 8,
 			BitOffset: 24,
@@ -14993,8 +16137,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Zone",
 			Name: "Zone",
 			BitLength: 8,
 			BitOffset: 32,
@@ -15003,8 +16149,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Volume",
 			Name: "Volume",
 			BitLength: 8,
 			BitOffset: 40,
@@ -15013,6 +16161,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -15023,8 +16172,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 419,
 		Decoder: DecodeFusionSetAllVolumes,
+		Encoder: EncodeFusionSetAllVolumes,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -15033,8 +16184,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -15043,8 +16196,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -15053,8 +16208,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "ProprietaryId",
 			Name: "Proprietary ID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -15063,8 +16220,10 @@ var pgnList = []PgnInfo{
 			GolangType:"FusionMessageIdConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "Unknown",
 			Name: "Unknown",
 			BitLength: 8,
 			BitOffset: 24,
@@ -15073,8 +16232,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Zone1",
 			Name: "Zone1",
 			BitLength: 8,
 			BitOffset: 32,
@@ -15083,8 +16244,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Zone2",
 			Name: "Zone2",
 			BitLength: 8,
 			BitOffset: 40,
@@ -15093,8 +16256,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "Zone3",
 			Name: "Zone3",
 			BitLength: 8,
 			BitOffset: 48,
@@ -15103,8 +16268,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "Zone4",
 			Name: "Zone4",
 			BitLength: 8,
 			BitOffset: 56,
@@ -15113,6 +16280,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -15123,8 +16291,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 1851,
 		Decoder: DecodeSeatalk1Keystroke,
+		Encoder: EncodeSeatalk1Keystroke,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -15133,8 +16303,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -15143,8 +16315,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -15153,8 +16327,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "ProprietaryId",
 			Name: "Proprietary ID",
 			BitLength: 16,
 			BitOffset: 16,
@@ -15163,8 +16339,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "Command",
 			Name: "command",
 			BitLength: 8,
 			BitOffset: 32,
@@ -15173,8 +16351,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Device",
 			Name: "device",
 			BitLength: 8,
 			BitOffset: 40,
@@ -15183,8 +16363,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Key",
 			Name: "key",
 			BitLength: 8,
 			BitOffset: 48,
@@ -15193,8 +16375,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SeatalkKeystrokeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "Keyinverted",
 			Name: "keyInverted",
 			BitLength: 8,
 			BitOffset: 56,
@@ -15203,8 +16387,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "UnknownData",
 			Name: "Unknown data",
 			BitLength: 112,
 			BitOffset: 64,
@@ -15213,6 +16399,7 @@ var pgnList = []PgnInfo{
 			GolangType:"[]uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -15223,8 +16410,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 1851,
 		Decoder: DecodeSeatalk1DeviceIdentification,
+		Encoder: EncodeSeatalk1DeviceIdentification,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -15233,8 +16422,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -15243,8 +16434,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -15253,8 +16446,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "ProprietaryId",
 			Name: "Proprietary ID",
 			BitLength: 16,
 			BitOffset: 16,
@@ -15263,8 +16458,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "Command",
 			Name: "command",
 			BitLength: 8,
 			BitOffset: 32,
@@ -15273,8 +16470,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "XxReserved6",
 			Name: "Reserved",
 			BitLength: 8,
 			BitOffset: 40,
@@ -15283,8 +16482,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Device",
 			Name: "device",
 			BitLength: 8,
 			BitOffset: 48,
@@ -15293,6 +16494,7 @@ var pgnList = []PgnInfo{
 			GolangType:"SeatalkDeviceIdConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -15303,8 +16505,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 1851,
 		Decoder: DecodeSeatalk1DisplayBrightness,
+		Encoder: EncodeSeatalk1DisplayBrightness,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -15313,8 +16517,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -15323,8 +16529,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -15333,8 +16541,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "ProprietaryId",
 			Name: "Proprietary ID",
 			BitLength: 16,
 			BitOffset: 16,
@@ -15343,8 +16553,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "Group",
 			Name: "Group",
 			BitLength: 8,
 			BitOffset: 32,
@@ -15353,8 +16565,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SeatalkNetworkGroupConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Unknown1",
 			Name: "Unknown 1",
 			BitLength: 8,
 			BitOffset: 40,
@@ -15363,8 +16577,10 @@ var pgnList = []PgnInfo{
 			GolangType:"[]uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Command",
 			Name: "Command",
 			BitLength: 8,
 			BitOffset: 48,
@@ -15373,8 +16589,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "Brightness",
 			Name: "Brightness",
 			BitLength: 8,
 			BitOffset: 56,
@@ -15383,8 +16601,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "Unknown2",
 			Name: "Unknown 2",
 			BitLength: 8,
 			BitOffset: 64,
@@ -15393,6 +16613,7 @@ var pgnList = []PgnInfo{
 			GolangType:"[]uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -15403,8 +16624,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 1851,
 		Decoder: DecodeSeatalk1DisplayColor,
+		Encoder: EncodeSeatalk1DisplayColor,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -15413,8 +16636,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -15423,8 +16648,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -15433,8 +16660,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "ProprietaryId",
 			Name: "Proprietary ID",
 			BitLength: 16,
 			BitOffset: 16,
@@ -15443,8 +16672,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "Group",
 			Name: "Group",
 			BitLength: 8,
 			BitOffset: 32,
@@ -15453,8 +16684,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SeatalkNetworkGroupConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Unknown1",
 			Name: "Unknown 1",
 			BitLength: 8,
 			BitOffset: 40,
@@ -15463,8 +16696,10 @@ var pgnList = []PgnInfo{
 			GolangType:"[]uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Command",
 			Name: "Command",
 			BitLength: 8,
 			BitOffset: 48,
@@ -15473,8 +16708,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "Color",
 			Name: "Color",
 			BitLength: 8,
 			BitOffset: 56,
@@ -15483,8 +16720,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SeatalkDisplayColorConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "Unknown2",
 			Name: "Unknown 2",
 			BitLength: 8,
 			BitOffset: 64,
@@ -15493,6 +16732,7 @@ var pgnList = []PgnInfo{
 			GolangType:"[]uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -15503,8 +16743,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 135,
 		Decoder: DecodeAirmarAttitudeOffset,
+		Encoder: EncodeAirmarAttitudeOffset,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -15513,8 +16755,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -15523,8 +16767,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -15533,8 +16779,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "ProprietaryId",
 			Name: "Proprietary ID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -15543,8 +16791,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AirmarCommandConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "AzimuthOffset",
 			Name: "Azimuth offset",
 			BitLength: 16,
 			BitOffset: 24,
@@ -15553,8 +16803,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: true,
+			Unit: "",
 			},
 		6: { 
+			Id: "PitchOffset",
 			Name: "Pitch offset",
 			BitLength: 16,
 			BitOffset: 40,
@@ -15563,8 +16815,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: true,
+			Unit: "",
 			},
 		7: { 
+			Id: "RollOffset",
 			Name: "Roll offset",
 			BitLength: 16,
 			BitOffset: 56,
@@ -15573,6 +16827,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: true,
+			Unit: "",
 			},
 		},
 	},
@@ -15583,8 +16838,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 135,
 		Decoder: DecodeAirmarCalibrateCompass,
+		Encoder: EncodeAirmarCalibrateCompass,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -15593,8 +16850,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -15603,8 +16862,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -15613,8 +16874,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "ProprietaryId",
 			Name: "Proprietary ID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -15623,8 +16886,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AirmarCommandConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "CalibrateFunction",
 			Name: "Calibrate Function",
 			BitLength: 8,
 			BitOffset: 24,
@@ -15633,8 +16898,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AirmarCalibrateFunctionConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "CalibrationStatus",
 			Name: "Calibration Status",
 			BitLength: 8,
 			BitOffset: 32,
@@ -15643,8 +16910,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AirmarCalibrateStatusConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "VerifyScore",
 			Name: "Verify Score",
 			BitLength: 8,
 			BitOffset: 40,
@@ -15653,8 +16922,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "XAxisGainValue",
 			Name: "X-axis gain value",
 			BitLength: 16,
 			BitOffset: 48,
@@ -15663,8 +16934,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.01,
 			Signed: true,
+			Unit: "",
 			},
 		9: { 
+			Id: "YAxisGainValue",
 			Name: "Y-axis gain value",
 			BitLength: 16,
 			BitOffset: 64,
@@ -15673,8 +16946,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.01,
 			Signed: true,
+			Unit: "",
 			},
 		10: { 
+			Id: "ZAxisGainValue",
 			Name: "Z-axis gain value",
 			BitLength: 16,
 			BitOffset: 80,
@@ -15683,8 +16958,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.01,
 			Signed: true,
+			Unit: "",
 			},
 		11: { 
+			Id: "XAxisLinearOffset",
 			Name: "X-axis linear offset",
 			BitLength: 16,
 			BitOffset: 96,
@@ -15693,8 +16970,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.01,
 			Signed: true,
+			Unit: "",
 			},
 		12: { 
+			Id: "YAxisLinearOffset",
 			Name: "Y-axis linear offset",
 			BitLength: 16,
 			BitOffset: 112,
@@ -15703,8 +16982,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.01,
 			Signed: true,
+			Unit: "",
 			},
 		13: { 
+			Id: "ZAxisLinearOffset",
 			Name: "Z-axis linear offset",
 			BitLength: 16,
 			BitOffset: 128,
@@ -15713,8 +16994,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.01,
 			Signed: true,
+			Unit: "",
 			},
 		14: { 
+			Id: "XAxisAngularOffset",
 			Name: "X-axis angular offset",
 			BitLength: 16,
 			BitOffset: 144,
@@ -15723,8 +17006,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.1,
 			Signed: true,
+			Unit: "",
 			},
 		15: { 
+			Id: "PitchAndRollDamping",
 			Name: "Pitch and Roll damping",
 			BitLength: 16,
 			BitOffset: 160,
@@ -15733,8 +17018,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.05,
 			Signed: true,
+			Unit: "",
 			},
 		16: { 
+			Id: "CompassRateGyroDamping",
 			Name: "Compass/Rate gyro damping",
 			BitLength: 16,
 			BitOffset: 176,
@@ -15743,6 +17030,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.05,
 			Signed: true,
+			Unit: "",
 			},
 		},
 	},
@@ -15753,8 +17041,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 135,
 		Decoder: DecodeAirmarTrueWindOptions,
+		Encoder: EncodeAirmarTrueWindOptions,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -15763,8 +17053,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -15773,8 +17065,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -15783,8 +17077,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "ProprietaryId",
 			Name: "Proprietary ID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -15793,8 +17089,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AirmarCommandConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "CogSubstitutionForHdg",
 			Name: "COG substitution for HDG",
 			BitLength: 2,
 			BitOffset: 24,
@@ -15803,8 +17101,10 @@ var pgnList = []PgnInfo{
 			GolangType:"YesNoConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "XxReserved6",
 			Name: "Reserved",
 			BitLength: 22,
 			BitOffset: 26,
@@ -15813,6 +17113,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -15823,8 +17124,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 135,
 		Decoder: DecodeAirmarSimulateMode,
+		Encoder: EncodeAirmarSimulateMode,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -15833,8 +17136,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -15843,8 +17148,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -15853,8 +17160,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "ProprietaryId",
 			Name: "Proprietary ID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -15863,8 +17172,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AirmarCommandConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "SimulateMode",
 			Name: "Simulate Mode",
 			BitLength: 2,
 			BitOffset: 24,
@@ -15873,8 +17184,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "XxReserved6",
 			Name: "Reserved",
 			BitLength: 22,
 			BitOffset: 26,
@@ -15883,6 +17196,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -15893,8 +17207,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 135,
 		Decoder: DecodeAirmarCalibrateDepth,
+		Encoder: EncodeAirmarCalibrateDepth,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -15903,8 +17219,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -15913,8 +17231,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -15923,8 +17243,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "ProprietaryId",
 			Name: "Proprietary ID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -15933,8 +17255,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AirmarCommandConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "SpeedOfSoundMode",
 			Name: "Speed of Sound Mode",
 			BitLength: 16,
 			BitOffset: 24,
@@ -15943,8 +17267,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Velocity",
 			Resolution:0.1,
 			Signed: false,
+			Unit: "m/s",
 			},
 		6: { 
+			Id: "XxReserved6",
 			Name: "Reserved",
 			BitLength: 8,
 			BitOffset: 40,
@@ -15953,6 +17279,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -15963,8 +17290,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 135,
 		Decoder: DecodeAirmarCalibrateSpeed,
+		Encoder: EncodeAirmarCalibrateSpeed,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -15973,8 +17302,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -15983,8 +17314,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -15993,8 +17326,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "ProprietaryId",
 			Name: "Proprietary ID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -16003,8 +17338,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AirmarCommandConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "NumberOfPairsOfDataPoints",
 			Name: "Number of pairs of data points",
 			BitLength: 8,
 			BitOffset: 24,
@@ -16013,8 +17350,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "InputFrequency",
 			Name: "Input frequency",
 			BitLength: 16,
 			BitOffset: 32,
@@ -16023,8 +17362,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "OutputSpeed",
 			Name: "Output speed",
 			BitLength: 16,
 			BitOffset: 48,
@@ -16033,6 +17374,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Velocity",
 			Resolution:0.01,
 			Signed: false,
+			Unit: "m/s",
 			},
 		},
 	},
@@ -16043,8 +17385,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 135,
 		Decoder: DecodeAirmarCalibrateTemperature,
+		Encoder: EncodeAirmarCalibrateTemperature,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -16053,8 +17397,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -16063,8 +17409,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -16073,8 +17421,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "ProprietaryId",
 			Name: "Proprietary ID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -16083,8 +17433,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AirmarCommandConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "TemperatureInstance",
 			Name: "Temperature instance",
 			BitLength: 2,
 			BitOffset: 24,
@@ -16093,8 +17445,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AirmarTemperatureInstanceConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "XxReserved6",
 			Name: "Reserved",
 			BitLength: 6,
 			BitOffset: 26,
@@ -16103,8 +17457,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "TemperatureOffset",
 			Name: "Temperature offset",
 			BitLength: 16,
 			BitOffset: 32,
@@ -16113,6 +17469,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Temperature",
 			Resolution:0.001,
 			Signed: true,
+			Unit: "K",
 			},
 		},
 	},
@@ -16123,8 +17480,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 135,
 		Decoder: DecodeAirmarSpeedFilterNone,
+		Encoder: EncodeAirmarSpeedFilterNone,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -16133,8 +17492,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -16143,8 +17504,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -16153,8 +17516,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "ProprietaryId",
 			Name: "Proprietary ID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -16163,8 +17528,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AirmarCommandConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "FilterType",
 			Name: "Filter type",
 			BitLength: 4,
 			BitOffset: 24,
@@ -16173,8 +17540,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "XxReserved6",
 			Name: "Reserved",
 			BitLength: 4,
 			BitOffset: 28,
@@ -16183,8 +17552,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "SampleInterval",
 			Name: "Sample interval",
 			BitLength: 16,
 			BitOffset: 32,
@@ -16193,6 +17564,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.01,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -16203,8 +17575,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 135,
 		Decoder: DecodeAirmarSpeedFilterIir,
+		Encoder: EncodeAirmarSpeedFilterIir,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -16213,8 +17587,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -16223,8 +17599,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -16233,8 +17611,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "ProprietaryId",
 			Name: "Proprietary ID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -16243,8 +17623,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AirmarCommandConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "FilterType",
 			Name: "Filter type",
 			BitLength: 4,
 			BitOffset: 24,
@@ -16253,8 +17635,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "XxReserved6",
 			Name: "Reserved",
 			BitLength: 4,
 			BitOffset: 28,
@@ -16263,8 +17647,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "SampleInterval",
 			Name: "Sample interval",
 			BitLength: 16,
 			BitOffset: 32,
@@ -16273,8 +17659,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.01,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "FilterDuration",
 			Name: "Filter duration",
 			BitLength: 16,
 			BitOffset: 48,
@@ -16283,6 +17671,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.01,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -16293,8 +17682,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 135,
 		Decoder: DecodeAirmarTemperatureFilterNone,
+		Encoder: EncodeAirmarTemperatureFilterNone,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -16303,8 +17694,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -16313,8 +17706,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -16323,8 +17718,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "ProprietaryId",
 			Name: "Proprietary ID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -16333,8 +17730,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AirmarCommandConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "FilterType",
 			Name: "Filter type",
 			BitLength: 4,
 			BitOffset: 24,
@@ -16343,8 +17742,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "XxReserved6",
 			Name: "Reserved",
 			BitLength: 4,
 			BitOffset: 28,
@@ -16353,8 +17754,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "SampleInterval",
 			Name: "Sample interval",
 			BitLength: 16,
 			BitOffset: 32,
@@ -16363,6 +17766,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.01,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -16373,8 +17777,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 135,
 		Decoder: DecodeAirmarTemperatureFilterIir,
+		Encoder: EncodeAirmarTemperatureFilterIir,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -16383,8 +17789,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -16393,8 +17801,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -16403,8 +17813,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "ProprietaryId",
 			Name: "Proprietary ID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -16413,8 +17825,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AirmarCommandConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "FilterType",
 			Name: "Filter type",
 			BitLength: 4,
 			BitOffset: 24,
@@ -16423,8 +17837,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "XxReserved6",
 			Name: "Reserved",
 			BitLength: 4,
 			BitOffset: 28,
@@ -16433,8 +17849,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "SampleInterval",
 			Name: "Sample interval",
 			BitLength: 16,
 			BitOffset: 32,
@@ -16443,8 +17861,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.01,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "FilterDuration",
 			Name: "Filter duration",
 			BitLength: 16,
 			BitOffset: 48,
@@ -16453,6 +17873,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.01,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -16463,8 +17884,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 135,
 		Decoder: DecodeAirmarNmea2000Options,
+		Encoder: EncodeAirmarNmea2000Options,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -16473,8 +17896,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -16483,8 +17908,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -16493,8 +17920,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "ProprietaryId",
 			Name: "Proprietary ID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -16503,8 +17932,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AirmarCommandConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "TransmissionInterval",
 			Name: "Transmission Interval",
 			BitLength: 2,
 			BitOffset: 24,
@@ -16513,8 +17944,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AirmarTransmissionIntervalConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "XxReserved6",
 			Name: "Reserved",
 			BitLength: 22,
 			BitOffset: 26,
@@ -16523,6 +17956,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -16533,8 +17967,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 135,
 		Decoder: DecodeAirmarAddressableMultiFrame,
+		Encoder: EncodeAirmarAddressableMultiFrame,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -16543,8 +17979,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -16553,8 +17991,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -16563,8 +18003,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "ProprietaryId",
 			Name: "Proprietary ID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -16573,6 +18015,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -16583,8 +18026,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 137,
 		Decoder: DecodeMaretronSlaveResponse,
+		Encoder: EncodeMaretronSlaveResponse,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -16593,8 +18038,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -16603,8 +18050,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -16613,8 +18062,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "ProductCode",
 			Name: "Product code",
 			BitLength: 16,
 			BitOffset: 16,
@@ -16623,8 +18074,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "SoftwareCode",
 			Name: "Software code",
 			BitLength: 16,
 			BitOffset: 32,
@@ -16633,8 +18086,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Command",
 			Name: "Command",
 			BitLength: 8,
 			BitOffset: 48,
@@ -16643,8 +18098,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Status",
 			Name: "Status",
 			BitLength: 8,
 			BitOffset: 56,
@@ -16653,6 +18110,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -16663,8 +18121,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 229,
 		Decoder: DecodeGarminDayMode,
+		Encoder: EncodeGarminDayMode,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -16673,8 +18133,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -16683,8 +18145,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -16693,8 +18157,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "UnknownId1",
 			Name: "Unknown ID 1",
 			BitLength: 8,
 			BitOffset: 16,
@@ -16703,8 +18169,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "UnknownId2",
 			Name: "Unknown ID 2",
 			BitLength: 8,
 			BitOffset: 24,
@@ -16713,8 +18181,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "UnknownId3",
 			Name: "Unknown ID 3",
 			BitLength: 8,
 			BitOffset: 32,
@@ -16723,8 +18193,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "UnknownId4",
 			Name: "Unknown ID 4",
 			BitLength: 8,
 			BitOffset: 40,
@@ -16733,8 +18205,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "XxReserved8",
 			Name: "Spare",
 			BitLength: 16,
 			BitOffset: 48,
@@ -16743,8 +18217,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "Mode",
 			Name: "Mode",
 			BitLength: 8,
 			BitOffset: 64,
@@ -16753,8 +18229,10 @@ var pgnList = []PgnInfo{
 			GolangType:"GarminColorModeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "XxReserved10",
 			Name: "Spare",
 			BitLength: 8,
 			BitOffset: 72,
@@ -16763,8 +18241,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "Backlight",
 			Name: "Backlight",
 			BitLength: 8,
 			BitOffset: 80,
@@ -16773,6 +18253,7 @@ var pgnList = []PgnInfo{
 			GolangType:"GarminBacklightLevelConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -16783,8 +18264,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 229,
 		Decoder: DecodeGarminNightMode,
+		Encoder: EncodeGarminNightMode,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -16793,8 +18276,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -16803,8 +18288,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -16813,8 +18300,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "UnknownId1",
 			Name: "Unknown ID 1",
 			BitLength: 8,
 			BitOffset: 16,
@@ -16823,8 +18312,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "UnknownId2",
 			Name: "Unknown ID 2",
 			BitLength: 8,
 			BitOffset: 24,
@@ -16833,8 +18324,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "UnknownId3",
 			Name: "Unknown ID 3",
 			BitLength: 8,
 			BitOffset: 32,
@@ -16843,8 +18336,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "UnknownId4",
 			Name: "Unknown ID 4",
 			BitLength: 8,
 			BitOffset: 40,
@@ -16853,8 +18348,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "XxReserved8",
 			Name: "Spare",
 			BitLength: 16,
 			BitOffset: 48,
@@ -16863,8 +18360,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "Mode",
 			Name: "Mode",
 			BitLength: 8,
 			BitOffset: 64,
@@ -16873,8 +18372,10 @@ var pgnList = []PgnInfo{
 			GolangType:"GarminColorModeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "XxReserved10",
 			Name: "Spare",
 			BitLength: 8,
 			BitOffset: 72,
@@ -16883,8 +18384,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "Backlight",
 			Name: "Backlight",
 			BitLength: 8,
 			BitOffset: 80,
@@ -16893,6 +18396,7 @@ var pgnList = []PgnInfo{
 			GolangType:"GarminBacklightLevelConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -16903,8 +18407,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 229,
 		Decoder: DecodeGarminColorMode,
+		Encoder: EncodeGarminColorMode,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -16913,8 +18419,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -16923,8 +18431,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -16933,8 +18443,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "UnknownId1",
 			Name: "Unknown ID 1",
 			BitLength: 8,
 			BitOffset: 16,
@@ -16943,8 +18455,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "UnknownId2",
 			Name: "Unknown ID 2",
 			BitLength: 8,
 			BitOffset: 24,
@@ -16953,8 +18467,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "UnknownId3",
 			Name: "Unknown ID 3",
 			BitLength: 8,
 			BitOffset: 32,
@@ -16963,8 +18479,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "UnknownId4",
 			Name: "Unknown ID 4",
 			BitLength: 8,
 			BitOffset: 40,
@@ -16973,8 +18491,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "XxReserved8",
 			Name: "Spare",
 			BitLength: 16,
 			BitOffset: 48,
@@ -16983,8 +18503,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "Mode",
 			Name: "Mode",
 			BitLength: 8,
 			BitOffset: 64,
@@ -16993,8 +18515,10 @@ var pgnList = []PgnInfo{
 			GolangType:"GarminColorModeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "XxReserved10",
 			Name: "Spare",
 			BitLength: 8,
 			BitOffset: 72,
@@ -17003,8 +18527,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "Color",
 			Name: "Color",
 			BitLength: 8,
 			BitOffset: 80,
@@ -17013,6 +18539,7 @@ var pgnList = []PgnInfo{
 			GolangType:"GarminColorConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -17023,8 +18550,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeAlert,
+		Encoder: EncodeAlert,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "AlertType",
 			Name: "Alert Type",
 			BitLength: 4,
 			BitOffset: 0,
@@ -17033,8 +18562,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AlertTypeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "AlertCategory",
 			Name: "Alert Category",
 			BitLength: 4,
 			BitOffset: 4,
@@ -17043,8 +18574,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AlertCategoryConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "AlertSystem",
 			Name: "Alert System",
 			BitLength: 8,
 			BitOffset: 8,
@@ -17053,8 +18586,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "AlertSubSystem",
 			Name: "Alert Sub-System",
 			BitLength: 8,
 			BitOffset: 16,
@@ -17063,8 +18598,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "AlertId",
 			Name: "Alert ID",
 			BitLength: 16,
 			BitOffset: 24,
@@ -17073,8 +18610,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "DataSourceNetworkIdName",
 			Name: "Data Source Network ID NAME",
 			BitLength: 64,
 			BitOffset: 40,
@@ -17083,8 +18622,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint64",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "DataSourceInstance",
 			Name: "Data Source Instance",
 			BitLength: 8,
 			BitOffset: 104,
@@ -17093,8 +18634,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "DataSourceIndexSource",
 			Name: "Data Source Index-Source",
 			BitLength: 8,
 			BitOffset: 112,
@@ -17103,8 +18646,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "AlertOccurrenceNumber",
 			Name: "Alert Occurrence Number",
 			BitLength: 8,
 			BitOffset: 120,
@@ -17113,8 +18658,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "TemporarySilenceStatus",
 			Name: "Temporary Silence Status",
 			BitLength: 1,
 			BitOffset: 128,
@@ -17123,8 +18670,10 @@ var pgnList = []PgnInfo{
 			GolangType:"YesNoConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "AcknowledgeStatus",
 			Name: "Acknowledge Status",
 			BitLength: 1,
 			BitOffset: 129,
@@ -17133,8 +18682,10 @@ var pgnList = []PgnInfo{
 			GolangType:"YesNoConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		12: { 
+			Id: "EscalationStatus",
 			Name: "Escalation Status",
 			BitLength: 1,
 			BitOffset: 130,
@@ -17143,8 +18694,10 @@ var pgnList = []PgnInfo{
 			GolangType:"YesNoConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		13: { 
+			Id: "TemporarySilenceSupport",
 			Name: "Temporary Silence Support",
 			BitLength: 1,
 			BitOffset: 131,
@@ -17153,8 +18706,10 @@ var pgnList = []PgnInfo{
 			GolangType:"YesNoConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		14: { 
+			Id: "AcknowledgeSupport",
 			Name: "Acknowledge Support",
 			BitLength: 1,
 			BitOffset: 132,
@@ -17163,8 +18718,10 @@ var pgnList = []PgnInfo{
 			GolangType:"YesNoConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		15: { 
+			Id: "EscalationSupport",
 			Name: "Escalation Support",
 			BitLength: 1,
 			BitOffset: 133,
@@ -17173,8 +18730,10 @@ var pgnList = []PgnInfo{
 			GolangType:"YesNoConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		16: { 
+			Id: "XxReserved16",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 134,
@@ -17183,8 +18742,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		17: { 
+			Id: "AcknowledgeSourceNetworkIdName",
 			Name: "Acknowledge Source Network ID NAME",
 			BitLength: 64,
 			BitOffset: 136,
@@ -17193,8 +18754,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint64",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		18: { 
+			Id: "TriggerCondition",
 			Name: "Trigger Condition",
 			BitLength: 4,
 			BitOffset: 200,
@@ -17203,8 +18766,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AlertTriggerConditionConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		19: { 
+			Id: "ThresholdStatus",
 			Name: "Threshold Status",
 			BitLength: 4,
 			BitOffset: 204,
@@ -17213,8 +18778,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AlertThresholdStatusConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		20: { 
+			Id: "AlertPriority",
 			Name: "Alert Priority",
 			BitLength: 8,
 			BitOffset: 208,
@@ -17223,8 +18790,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		21: { 
+			Id: "AlertState",
 			Name: "Alert State",
 			BitLength: 8,
 			BitOffset: 216,
@@ -17233,6 +18802,7 @@ var pgnList = []PgnInfo{
 			GolangType:"AlertStateConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -17243,8 +18813,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeAlertResponse,
+		Encoder: EncodeAlertResponse,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "AlertType",
 			Name: "Alert Type",
 			BitLength: 4,
 			BitOffset: 0,
@@ -17253,8 +18825,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AlertTypeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "AlertCategory",
 			Name: "Alert Category",
 			BitLength: 4,
 			BitOffset: 4,
@@ -17263,8 +18837,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AlertCategoryConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "AlertSystem",
 			Name: "Alert System",
 			BitLength: 8,
 			BitOffset: 8,
@@ -17273,8 +18849,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "AlertSubSystem",
 			Name: "Alert Sub-System",
 			BitLength: 8,
 			BitOffset: 16,
@@ -17283,8 +18861,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "AlertId",
 			Name: "Alert ID",
 			BitLength: 16,
 			BitOffset: 24,
@@ -17293,8 +18873,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "DataSourceNetworkIdName",
 			Name: "Data Source Network ID NAME",
 			BitLength: 64,
 			BitOffset: 40,
@@ -17303,8 +18885,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint64",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "DataSourceInstance",
 			Name: "Data Source Instance",
 			BitLength: 8,
 			BitOffset: 104,
@@ -17313,8 +18897,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "DataSourceIndexSource",
 			Name: "Data Source Index-Source",
 			BitLength: 8,
 			BitOffset: 112,
@@ -17323,8 +18909,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "AlertOccurrenceNumber",
 			Name: "Alert Occurrence Number",
 			BitLength: 8,
 			BitOffset: 120,
@@ -17333,8 +18921,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "AcknowledgeSourceNetworkIdName",
 			Name: "Acknowledge Source Network ID NAME",
 			BitLength: 64,
 			BitOffset: 128,
@@ -17343,8 +18933,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint64",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "ResponseCommand",
 			Name: "Response Command",
 			BitLength: 2,
 			BitOffset: 192,
@@ -17353,8 +18945,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AlertResponseCommandConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		12: { 
+			Id: "XxReserved12",
 			Name: "Reserved",
 			BitLength: 6,
 			BitOffset: 194,
@@ -17363,6 +18957,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -17373,8 +18968,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeAlertText,
+		Encoder: EncodeAlertText,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "AlertType",
 			Name: "Alert Type",
 			BitLength: 4,
 			BitOffset: 0,
@@ -17383,8 +18980,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AlertTypeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "AlertCategory",
 			Name: "Alert Category",
 			BitLength: 4,
 			BitOffset: 4,
@@ -17393,8 +18992,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AlertCategoryConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "AlertSystem",
 			Name: "Alert System",
 			BitLength: 8,
 			BitOffset: 8,
@@ -17403,8 +19004,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "AlertSubSystem",
 			Name: "Alert Sub-System",
 			BitLength: 8,
 			BitOffset: 16,
@@ -17413,8 +19016,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "AlertId",
 			Name: "Alert ID",
 			BitLength: 16,
 			BitOffset: 24,
@@ -17423,8 +19028,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "DataSourceNetworkIdName",
 			Name: "Data Source Network ID NAME",
 			BitLength: 64,
 			BitOffset: 40,
@@ -17433,8 +19040,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint64",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "DataSourceInstance",
 			Name: "Data Source Instance",
 			BitLength: 8,
 			BitOffset: 104,
@@ -17443,8 +19052,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "DataSourceIndexSource",
 			Name: "Data Source Index-Source",
 			BitLength: 8,
 			BitOffset: 112,
@@ -17453,8 +19064,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "AlertOccurrenceNumber",
 			Name: "Alert Occurrence Number",
 			BitLength: 8,
 			BitOffset: 120,
@@ -17463,8 +19076,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "LanguageId",
 			Name: "Language ID",
 			BitLength: 8,
 			BitOffset: 128,
@@ -17473,8 +19088,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AlertLanguageIdConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "AlertTextDescription",
 			Name: "Alert Text Description",
 			BitLength: 0,
 			BitOffset: 0,
@@ -17483,8 +19100,10 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		12: { 
+			Id: "AlertLocationTextDescription",
 			Name: "Alert Location Text Description",
 			BitLength: 0,
 			BitOffset: 0,
@@ -17493,6 +19112,7 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -17503,8 +19123,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeAlertConfiguration,
+		Encoder: EncodeAlertConfiguration,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "AlertType",
 			Name: "Alert Type",
 			BitLength: 4,
 			BitOffset: 0,
@@ -17513,8 +19135,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AlertTypeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "AlertCategory",
 			Name: "Alert Category",
 			BitLength: 4,
 			BitOffset: 4,
@@ -17523,8 +19147,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AlertCategoryConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "AlertSystem",
 			Name: "Alert System",
 			BitLength: 8,
 			BitOffset: 8,
@@ -17533,8 +19159,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "AlertSubSystem",
 			Name: "Alert Sub-System",
 			BitLength: 8,
 			BitOffset: 16,
@@ -17543,8 +19171,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "AlertId",
 			Name: "Alert ID",
 			BitLength: 16,
 			BitOffset: 24,
@@ -17553,8 +19183,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "DataSourceNetworkIdName",
 			Name: "Data Source Network ID NAME",
 			BitLength: 64,
 			BitOffset: 40,
@@ -17563,8 +19195,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint64",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "DataSourceInstance",
 			Name: "Data Source Instance",
 			BitLength: 8,
 			BitOffset: 104,
@@ -17573,8 +19207,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "DataSourceIndexSource",
 			Name: "Data Source Index-Source",
 			BitLength: 8,
 			BitOffset: 112,
@@ -17583,8 +19219,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "AlertOccurrenceNumber",
 			Name: "Alert Occurrence Number",
 			BitLength: 8,
 			BitOffset: 120,
@@ -17593,8 +19231,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "AlertControl",
 			Name: "Alert Control",
 			BitLength: 2,
 			BitOffset: 128,
@@ -17603,8 +19243,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "UserDefinedAlertAssignment",
 			Name: "User Defined Alert Assignment",
 			BitLength: 2,
 			BitOffset: 130,
@@ -17613,8 +19255,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		12: { 
+			Id: "XxReserved12",
 			Name: "Reserved",
 			BitLength: 4,
 			BitOffset: 132,
@@ -17623,8 +19267,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		13: { 
+			Id: "ReactivationPeriod",
 			Name: "Reactivation Period",
 			BitLength: 8,
 			BitOffset: 136,
@@ -17633,8 +19279,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		14: { 
+			Id: "TemporarySilencePeriod",
 			Name: "Temporary Silence Period",
 			BitLength: 8,
 			BitOffset: 144,
@@ -17643,8 +19291,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		15: { 
+			Id: "EscalationPeriod",
 			Name: "Escalation Period",
 			BitLength: 8,
 			BitOffset: 152,
@@ -17653,6 +19303,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -17663,8 +19314,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeAlertThreshold,
+		Encoder: EncodeAlertThreshold,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "AlertType",
 			Name: "Alert Type",
 			BitLength: 4,
 			BitOffset: 0,
@@ -17673,8 +19326,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AlertTypeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "AlertCategory",
 			Name: "Alert Category",
 			BitLength: 4,
 			BitOffset: 4,
@@ -17683,8 +19338,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AlertCategoryConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "AlertSystem",
 			Name: "Alert System",
 			BitLength: 8,
 			BitOffset: 8,
@@ -17693,8 +19350,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "AlertSubSystem",
 			Name: "Alert Sub-System",
 			BitLength: 8,
 			BitOffset: 16,
@@ -17703,8 +19362,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "AlertId",
 			Name: "Alert ID",
 			BitLength: 16,
 			BitOffset: 24,
@@ -17713,8 +19374,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "DataSourceNetworkIdName",
 			Name: "Data Source Network ID NAME",
 			BitLength: 64,
 			BitOffset: 40,
@@ -17723,8 +19386,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint64",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "DataSourceInstance",
 			Name: "Data Source Instance",
 			BitLength: 8,
 			BitOffset: 104,
@@ -17733,8 +19398,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "DataSourceIndexSource",
 			Name: "Data Source Index-Source",
 			BitLength: 8,
 			BitOffset: 112,
@@ -17743,8 +19410,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "AlertOccurrenceNumber",
 			Name: "Alert Occurrence Number",
 			BitLength: 8,
 			BitOffset: 120,
@@ -17753,8 +19422,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "NumberOfParameters",
 			Name: "Number of Parameters",
 			BitLength: 8,
 			BitOffset: 128,
@@ -17763,8 +19434,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "ParameterNumber",
 			Name: "Parameter Number",
 			BitLength: 8,
 			BitOffset: 136,
@@ -17773,8 +19446,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		12: { 
+			Id: "TriggerMethod",
 			Name: "Trigger Method",
 			BitLength: 8,
 			BitOffset: 144,
@@ -17783,8 +19458,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		13: { 
+			Id: "ThresholdDataFormat",
 			Name: "Threshold Data Format",
 			BitLength: 8,
 			BitOffset: 152,
@@ -17793,8 +19470,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		14: { 
+			Id: "ThresholdLevel",
 			Name: "Threshold Level",
 			BitLength: 64,
 			BitOffset: 160,
@@ -17803,6 +19482,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint64",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -17813,8 +19493,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeAlertValue,
+		Encoder: EncodeAlertValue,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "AlertType",
 			Name: "Alert Type",
 			BitLength: 4,
 			BitOffset: 0,
@@ -17823,8 +19505,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AlertTypeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "AlertCategory",
 			Name: "Alert Category",
 			BitLength: 4,
 			BitOffset: 4,
@@ -17833,8 +19517,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AlertCategoryConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "AlertSystem",
 			Name: "Alert System",
 			BitLength: 8,
 			BitOffset: 8,
@@ -17843,8 +19529,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "AlertSubSystem",
 			Name: "Alert Sub-System",
 			BitLength: 8,
 			BitOffset: 16,
@@ -17853,8 +19541,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "AlertId",
 			Name: "Alert ID",
 			BitLength: 16,
 			BitOffset: 24,
@@ -17863,8 +19553,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "DataSourceNetworkIdName",
 			Name: "Data Source Network ID NAME",
 			BitLength: 64,
 			BitOffset: 40,
@@ -17873,8 +19565,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint64",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "DataSourceInstance",
 			Name: "Data Source Instance",
 			BitLength: 8,
 			BitOffset: 104,
@@ -17883,8 +19577,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "DataSourceIndexSource",
 			Name: "Data Source Index-Source",
 			BitLength: 8,
 			BitOffset: 112,
@@ -17893,8 +19589,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "AlertOccurrenceNumber",
 			Name: "Alert Occurrence Number",
 			BitLength: 8,
 			BitOffset: 120,
@@ -17903,8 +19601,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "NumberOfParameters",
 			Name: "Number of Parameters",
 			BitLength: 8,
 			BitOffset: 128,
@@ -17913,8 +19613,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "ValueParameterNumber",
 			Name: "Value Parameter Number",
 			BitLength: 8,
 			BitOffset: 136,
@@ -17923,8 +19625,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		12: { 
+			Id: "ValueDataFormat",
 			Name: "Value Data Format",
 			BitLength: 8,
 			BitOffset: 144,
@@ -17933,8 +19637,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		13: { 
+			Id: "ValueData",
 			Name: "Value Data",
 			BitLength: 64,
 			BitOffset: 152,
@@ -17943,6 +19649,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint64",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -17953,8 +19660,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeSystemTime,
+		Encoder: EncodeSystemTime,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 0,
@@ -17963,8 +19672,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "Source",
 			Name: "Source",
 			BitLength: 4,
 			BitOffset: 8,
@@ -17973,8 +19684,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SystemTimeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "XxReserved3",
 			Name: "Reserved",
 			BitLength: 4,
 			BitOffset: 12,
@@ -17983,8 +19696,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Date",
 			Name: "Date",
 			BitLength: 16,
 			BitOffset: 16,
@@ -17993,8 +19708,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "Time",
 			Name: "Time",
 			BitLength: 32,
 			BitOffset: 32,
@@ -18003,6 +19720,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -18013,8 +19731,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeHeartbeat,
+		Encoder: EncodeHeartbeat,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "DataTransmitOffset",
 			Name: "Data transmit offset",
 			BitLength: 16,
 			BitOffset: 0,
@@ -18023,8 +19743,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.001,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "SequenceCounter",
 			Name: "Sequence Counter",
 			BitLength: 8,
 			BitOffset: 16,
@@ -18033,8 +19755,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "Controller1State",
 			Name: "Controller 1 State",
 			BitLength: 2,
 			BitOffset: 24,
@@ -18043,8 +19767,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ControllerStateConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Controller2State",
 			Name: "Controller 2 State",
 			BitLength: 2,
 			BitOffset: 26,
@@ -18053,8 +19779,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ControllerStateConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "EquipmentStatus",
 			Name: "Equipment Status",
 			BitLength: 2,
 			BitOffset: 28,
@@ -18063,8 +19791,10 @@ var pgnList = []PgnInfo{
 			GolangType:"EquipmentStatusConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "XxReserved6",
 			Name: "Reserved",
 			BitLength: 34,
 			BitOffset: 30,
@@ -18073,6 +19803,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -18083,8 +19814,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeProductInformation,
+		Encoder: EncodeProductInformation,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Nmea2000Version",
 			Name: "NMEA 2000 Version",
 			BitLength: 16,
 			BitOffset: 0,
@@ -18093,8 +19826,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.001,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "ProductCode",
 			Name: "Product Code",
 			BitLength: 16,
 			BitOffset: 16,
@@ -18103,8 +19838,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "ModelId",
 			Name: "Model ID",
 			BitLength: 256,
 			BitOffset: 32,
@@ -18113,8 +19850,10 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "SoftwareVersionCode",
 			Name: "Software Version Code",
 			BitLength: 256,
 			BitOffset: 288,
@@ -18123,8 +19862,10 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "ModelVersion",
 			Name: "Model Version",
 			BitLength: 256,
 			BitOffset: 544,
@@ -18133,8 +19874,10 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "ModelSerialCode",
 			Name: "Model Serial Code",
 			BitLength: 256,
 			BitOffset: 800,
@@ -18143,8 +19886,10 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "CertificationLevel",
 			Name: "Certification Level",
 			BitLength: 8,
 			BitOffset: 1056,
@@ -18153,8 +19898,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "LoadEquivalency",
 			Name: "Load Equivalency",
 			BitLength: 8,
 			BitOffset: 1064,
@@ -18163,6 +19910,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -18173,8 +19921,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeConfigurationInformation,
+		Encoder: EncodeConfigurationInformation,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "InstallationDescription1",
 			Name: "Installation Description #1",
 			BitLength: 0,
 			BitOffset: 0,
@@ -18183,8 +19933,10 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "InstallationDescription2",
 			Name: "Installation Description #2",
 			BitLength: 0,
 			BitOffset: 0,
@@ -18193,8 +19945,10 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "ManufacturerInformation",
 			Name: "Manufacturer Information",
 			BitLength: 0,
 			BitOffset: 0,
@@ -18203,6 +19957,7 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -18213,8 +19968,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeManOverboardNotification,
+		Encoder: EncodeManOverboardNotification,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 0,
@@ -18223,8 +19980,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "MobEmitterId",
 			Name: "MOB Emitter ID",
 			BitLength: 32,
 			BitOffset: 8,
@@ -18233,8 +19992,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "ManOverboardStatus",
 			Name: "Man Overboard Status",
 			BitLength: 3,
 			BitOffset: 40,
@@ -18243,8 +20004,10 @@ var pgnList = []PgnInfo{
 			GolangType:"MobStatusConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 5,
 			BitOffset: 43,
@@ -18253,8 +20016,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "ActivationTime",
 			Name: "Activation Time",
 			BitLength: 32,
 			BitOffset: 48,
@@ -18263,8 +20028,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "PositionSource",
 			Name: "Position Source",
 			BitLength: 3,
 			BitOffset: 80,
@@ -18273,8 +20040,10 @@ var pgnList = []PgnInfo{
 			GolangType:"MobPositionSourceConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "XxReserved7",
 			Name: "Reserved",
 			BitLength: 5,
 			BitOffset: 83,
@@ -18283,8 +20052,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "PositionDate",
 			Name: "Position Date",
 			BitLength: 16,
 			BitOffset: 88,
@@ -18293,8 +20064,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "PositionTime",
 			Name: "Position Time",
 			BitLength: 32,
 			BitOffset: 104,
@@ -18303,8 +20076,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "Latitude",
 			Name: "Latitude",
 			BitLength: 32,
 			BitOffset: 136,
@@ -18313,8 +20088,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float64",
 			Resolution:1e-07,
 			Signed: true,
+			Unit: "",
 			},
 		11: { 
+			Id: "Longitude",
 			Name: "Longitude",
 			BitLength: 32,
 			BitOffset: 168,
@@ -18323,8 +20100,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float64",
 			Resolution:1e-07,
 			Signed: true,
+			Unit: "",
 			},
 		12: { 
+			Id: "CogReference",
 			Name: "COG Reference",
 			BitLength: 2,
 			BitOffset: 200,
@@ -18333,8 +20112,10 @@ var pgnList = []PgnInfo{
 			GolangType:"DirectionReferenceConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		13: { 
+			Id: "XxReserved13",
 			Name: "Reserved",
 			BitLength: 6,
 			BitOffset: 202,
@@ -18343,8 +20124,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		14: { 
+			Id: "Cog",
 			Name: "COG",
 			BitLength: 16,
 			BitOffset: 208,
@@ -18353,8 +20136,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: false,
+			Unit: "",
 			},
 		15: { 
+			Id: "Sog",
 			Name: "SOG",
 			BitLength: 16,
 			BitOffset: 224,
@@ -18363,8 +20148,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Velocity",
 			Resolution:0.01,
 			Signed: false,
+			Unit: "m/s",
 			},
 		16: { 
+			Id: "MmsiOfVesselOfOrigin",
 			Name: "MMSI of vessel of origin",
 			BitLength: 32,
 			BitOffset: 240,
@@ -18373,8 +20160,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		17: { 
+			Id: "MobEmitterBatteryLowStatus",
 			Name: "MOB Emitter Battery Low Status",
 			BitLength: 3,
 			BitOffset: 272,
@@ -18383,8 +20172,10 @@ var pgnList = []PgnInfo{
 			GolangType:"LowBatteryConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		18: { 
+			Id: "XxReserved18",
 			Name: "Reserved",
 			BitLength: 5,
 			BitOffset: 275,
@@ -18393,6 +20184,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -18403,8 +20195,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeHeadingTrackControl,
+		Encoder: EncodeHeadingTrackControl,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "RudderLimitExceeded",
 			Name: "Rudder Limit Exceeded",
 			BitLength: 2,
 			BitOffset: 0,
@@ -18413,8 +20207,10 @@ var pgnList = []PgnInfo{
 			GolangType:"YesNoConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "OffHeadingLimitExceeded",
 			Name: "Off-Heading Limit Exceeded",
 			BitLength: 2,
 			BitOffset: 2,
@@ -18423,8 +20219,10 @@ var pgnList = []PgnInfo{
 			GolangType:"YesNoConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "OffTrackLimitExceeded",
 			Name: "Off-Track Limit Exceeded",
 			BitLength: 2,
 			BitOffset: 4,
@@ -18433,8 +20231,10 @@ var pgnList = []PgnInfo{
 			GolangType:"YesNoConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Override",
 			Name: "Override",
 			BitLength: 2,
 			BitOffset: 6,
@@ -18443,8 +20243,10 @@ var pgnList = []PgnInfo{
 			GolangType:"YesNoConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "SteeringMode",
 			Name: "Steering Mode",
 			BitLength: 3,
 			BitOffset: 8,
@@ -18453,8 +20255,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SteeringModeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "TurnMode",
 			Name: "Turn Mode",
 			BitLength: 3,
 			BitOffset: 11,
@@ -18463,8 +20267,10 @@ var pgnList = []PgnInfo{
 			GolangType:"TurnModeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "HeadingReference",
 			Name: "Heading Reference",
 			BitLength: 2,
 			BitOffset: 14,
@@ -18473,8 +20279,10 @@ var pgnList = []PgnInfo{
 			GolangType:"DirectionReferenceConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "XxReserved8",
 			Name: "Reserved",
 			BitLength: 5,
 			BitOffset: 16,
@@ -18483,8 +20291,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "CommandedRudderDirection",
 			Name: "Commanded Rudder Direction",
 			BitLength: 3,
 			BitOffset: 21,
@@ -18493,8 +20303,10 @@ var pgnList = []PgnInfo{
 			GolangType:"DirectionRudderConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "CommandedRudderAngle",
 			Name: "Commanded Rudder Angle",
 			BitLength: 16,
 			BitOffset: 24,
@@ -18503,8 +20315,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: true,
+			Unit: "",
 			},
 		11: { 
+			Id: "HeadingToSteerCourse",
 			Name: "Heading-To-Steer (Course)",
 			BitLength: 16,
 			BitOffset: 40,
@@ -18513,8 +20327,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: false,
+			Unit: "",
 			},
 		12: { 
+			Id: "Track",
 			Name: "Track",
 			BitLength: 16,
 			BitOffset: 56,
@@ -18523,8 +20339,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: false,
+			Unit: "",
 			},
 		13: { 
+			Id: "RudderLimit",
 			Name: "Rudder Limit",
 			BitLength: 16,
 			BitOffset: 72,
@@ -18533,8 +20351,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: false,
+			Unit: "",
 			},
 		14: { 
+			Id: "OffHeadingLimit",
 			Name: "Off-Heading Limit",
 			BitLength: 16,
 			BitOffset: 88,
@@ -18543,8 +20363,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: false,
+			Unit: "",
 			},
 		15: { 
+			Id: "RadiusOfTurnOrder",
 			Name: "Radius of Turn Order",
 			BitLength: 16,
 			BitOffset: 104,
@@ -18553,8 +20375,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: true,
+			Unit: "",
 			},
 		16: { 
+			Id: "RateOfTurnOrder",
 			Name: "Rate of Turn Order",
 			BitLength: 16,
 			BitOffset: 120,
@@ -18563,8 +20387,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:3.125e-05,
 			Signed: true,
+			Unit: "",
 			},
 		17: { 
+			Id: "OffTrackLimit",
 			Name: "Off-Track Limit",
 			BitLength: 16,
 			BitOffset: 136,
@@ -18573,8 +20399,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Distance",
 			Resolution:1,
 			Signed: true,
+			Unit: "m",
 			},
 		18: { 
+			Id: "VesselHeading",
 			Name: "Vessel Heading",
 			BitLength: 16,
 			BitOffset: 152,
@@ -18583,6 +20411,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -18593,8 +20422,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeRudder,
+		Encoder: EncodeRudder,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Instance",
 			Name: "Instance",
 			BitLength: 8,
 			BitOffset: 0,
@@ -18603,8 +20434,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "DirectionOrder",
 			Name: "Direction Order",
 			BitLength: 3,
 			BitOffset: 8,
@@ -18613,8 +20446,10 @@ var pgnList = []PgnInfo{
 			GolangType:"DirectionRudderConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "XxReserved3",
 			Name: "Reserved",
 			BitLength: 5,
 			BitOffset: 11,
@@ -18623,8 +20458,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "AngleOrder",
 			Name: "Angle Order",
 			BitLength: 16,
 			BitOffset: 16,
@@ -18633,8 +20470,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: true,
+			Unit: "",
 			},
 		5: { 
+			Id: "Position",
 			Name: "Position",
 			BitLength: 16,
 			BitOffset: 32,
@@ -18643,8 +20482,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: true,
+			Unit: "",
 			},
 		6: { 
+			Id: "XxReserved6",
 			Name: "Reserved",
 			BitLength: 16,
 			BitOffset: 48,
@@ -18653,6 +20494,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -18663,8 +20505,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeVesselHeading,
+		Encoder: EncodeVesselHeading,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 0,
@@ -18673,8 +20517,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "Heading",
 			Name: "Heading",
 			BitLength: 16,
 			BitOffset: 8,
@@ -18683,8 +20529,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "Deviation",
 			Name: "Deviation",
 			BitLength: 16,
 			BitOffset: 24,
@@ -18693,8 +20541,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: true,
+			Unit: "",
 			},
 		4: { 
+			Id: "Variation",
 			Name: "Variation",
 			BitLength: 16,
 			BitOffset: 40,
@@ -18703,8 +20553,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: true,
+			Unit: "",
 			},
 		5: { 
+			Id: "Reference",
 			Name: "Reference",
 			BitLength: 2,
 			BitOffset: 56,
@@ -18713,8 +20565,10 @@ var pgnList = []PgnInfo{
 			GolangType:"DirectionReferenceConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "XxReserved6",
 			Name: "Reserved",
 			BitLength: 6,
 			BitOffset: 58,
@@ -18723,6 +20577,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -18733,8 +20588,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeRateOfTurn,
+		Encoder: EncodeRateOfTurn,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 0,
@@ -18743,8 +20600,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "Rate",
 			Name: "Rate",
 			BitLength: 32,
 			BitOffset: 8,
@@ -18753,8 +20612,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float64",
 			Resolution:3.125e-08,
 			Signed: true,
+			Unit: "",
 			},
 		3: { 
+			Id: "XxReserved3",
 			Name: "Reserved",
 			BitLength: 24,
 			BitOffset: 40,
@@ -18763,6 +20624,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -18773,8 +20635,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeHeave,
+		Encoder: EncodeHeave,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 0,
@@ -18783,8 +20647,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "Heave",
 			Name: "Heave",
 			BitLength: 16,
 			BitOffset: 8,
@@ -18793,8 +20659,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Distance",
 			Resolution:0.01,
 			Signed: true,
+			Unit: "m",
 			},
 		3: { 
+			Id: "XxReserved3",
 			Name: "Reserved",
 			BitLength: 40,
 			BitOffset: 24,
@@ -18803,6 +20671,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -18813,8 +20682,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeAttitude,
+		Encoder: EncodeAttitude,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 0,
@@ -18823,8 +20694,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "Yaw",
 			Name: "Yaw",
 			BitLength: 16,
 			BitOffset: 8,
@@ -18833,8 +20706,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: true,
+			Unit: "",
 			},
 		3: { 
+			Id: "Pitch",
 			Name: "Pitch",
 			BitLength: 16,
 			BitOffset: 24,
@@ -18843,8 +20718,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: true,
+			Unit: "",
 			},
 		4: { 
+			Id: "Roll",
 			Name: "Roll",
 			BitLength: 16,
 			BitOffset: 40,
@@ -18853,8 +20730,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: true,
+			Unit: "",
 			},
 		5: { 
+			Id: "XxReserved5",
 			Name: "Reserved",
 			BitLength: 8,
 			BitOffset: 56,
@@ -18863,6 +20742,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -18873,8 +20753,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeMagneticVariation,
+		Encoder: EncodeMagneticVariation,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 0,
@@ -18883,8 +20765,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "Source",
 			Name: "Source",
 			BitLength: 4,
 			BitOffset: 8,
@@ -18893,8 +20777,10 @@ var pgnList = []PgnInfo{
 			GolangType:"MagneticVariationConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "XxReserved3",
 			Name: "Reserved",
 			BitLength: 4,
 			BitOffset: 12,
@@ -18903,8 +20789,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "AgeOfService",
 			Name: "Age of service",
 			BitLength: 16,
 			BitOffset: 16,
@@ -18913,8 +20801,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "Variation",
 			Name: "Variation",
 			BitLength: 16,
 			BitOffset: 32,
@@ -18923,8 +20813,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: true,
+			Unit: "",
 			},
 		6: { 
+			Id: "XxReserved6",
 			Name: "Reserved",
 			BitLength: 16,
 			BitOffset: 48,
@@ -18933,6 +20825,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -18943,8 +20836,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeEngineParametersRapidUpdate,
+		Encoder: EncodeEngineParametersRapidUpdate,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Instance",
 			Name: "Instance",
 			BitLength: 8,
 			BitOffset: 0,
@@ -18953,8 +20848,10 @@ var pgnList = []PgnInfo{
 			GolangType:"EngineInstanceConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "Speed",
 			Name: "Speed",
 			BitLength: 16,
 			BitOffset: 8,
@@ -18963,8 +20860,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.25,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "BoostPressure",
 			Name: "Boost Pressure",
 			BitLength: 16,
 			BitOffset: 24,
@@ -18973,8 +20872,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Pressure",
 			Resolution:100,
 			Signed: false,
+			Unit: "Pa",
 			},
 		4: { 
+			Id: "TiltTrim",
 			Name: "Tilt/Trim",
 			BitLength: 8,
 			BitOffset: 40,
@@ -18983,8 +20884,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*int8",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		5: { 
+			Id: "XxReserved5",
 			Name: "Reserved",
 			BitLength: 16,
 			BitOffset: 48,
@@ -18993,6 +20896,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -19003,8 +20907,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeEngineParametersDynamic,
+		Encoder: EncodeEngineParametersDynamic,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Instance",
 			Name: "Instance",
 			BitLength: 8,
 			BitOffset: 0,
@@ -19013,8 +20919,10 @@ var pgnList = []PgnInfo{
 			GolangType:"EngineInstanceConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "OilPressure",
 			Name: "Oil pressure",
 			BitLength: 16,
 			BitOffset: 8,
@@ -19023,8 +20931,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Pressure",
 			Resolution:100,
 			Signed: false,
+			Unit: "Pa",
 			},
 		3: { 
+			Id: "OilTemperature",
 			Name: "Oil temperature",
 			BitLength: 16,
 			BitOffset: 24,
@@ -19033,8 +20943,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Temperature",
 			Resolution:0.1,
 			Signed: false,
+			Unit: "K",
 			},
 		4: { 
+			Id: "Temperature",
 			Name: "Temperature",
 			BitLength: 16,
 			BitOffset: 40,
@@ -19043,8 +20955,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Temperature",
 			Resolution:0.01,
 			Signed: false,
+			Unit: "K",
 			},
 		5: { 
+			Id: "AlternatorPotential",
 			Name: "Alternator Potential",
 			BitLength: 16,
 			BitOffset: 56,
@@ -19053,8 +20967,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.01,
 			Signed: true,
+			Unit: "",
 			},
 		6: { 
+			Id: "FuelRate",
 			Name: "Fuel Rate",
 			BitLength: 16,
 			BitOffset: 72,
@@ -19063,8 +20979,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Flow",
 			Resolution:0.1,
 			Signed: true,
+			Unit: "L/h",
 			},
 		7: { 
+			Id: "TotalEngineHours",
 			Name: "Total Engine hours",
 			BitLength: 32,
 			BitOffset: 88,
@@ -19073,8 +20991,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "CoolantPressure",
 			Name: "Coolant Pressure",
 			BitLength: 16,
 			BitOffset: 120,
@@ -19083,8 +21003,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Pressure",
 			Resolution:100,
 			Signed: false,
+			Unit: "Pa",
 			},
 		9: { 
+			Id: "FuelPressure",
 			Name: "Fuel Pressure",
 			BitLength: 16,
 			BitOffset: 136,
@@ -19093,8 +21015,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Pressure",
 			Resolution:1000,
 			Signed: false,
+			Unit: "Pa",
 			},
 		10: { 
+			Id: "XxReserved10",
 			Name: "Reserved",
 			BitLength: 8,
 			BitOffset: 152,
@@ -19103,8 +21027,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "DiscreteStatus1",
 			Name: "Discrete Status 1",
 			BitLength: 16,
 			BitOffset: 160,
@@ -19113,8 +21039,10 @@ var pgnList = []PgnInfo{
 			GolangType:"EngineStatus1Const",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		12: { 
+			Id: "DiscreteStatus2",
 			Name: "Discrete Status 2",
 			BitLength: 16,
 			BitOffset: 176,
@@ -19123,8 +21051,10 @@ var pgnList = []PgnInfo{
 			GolangType:"EngineStatus2Const",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		13: { 
+			Id: "EngineLoad",
 			Name: "Engine Load",
 			BitLength: 8,
 			BitOffset: 192,
@@ -19133,8 +21063,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*int8",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		14: { 
+			Id: "EngineTorque",
 			Name: "Engine Torque",
 			BitLength: 8,
 			BitOffset: 200,
@@ -19143,6 +21075,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*int8",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		},
 	},
@@ -19153,8 +21086,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeTransmissionParametersDynamic,
+		Encoder: EncodeTransmissionParametersDynamic,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Instance",
 			Name: "Instance",
 			BitLength: 8,
 			BitOffset: 0,
@@ -19163,8 +21098,10 @@ var pgnList = []PgnInfo{
 			GolangType:"EngineInstanceConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "TransmissionGear",
 			Name: "Transmission Gear",
 			BitLength: 2,
 			BitOffset: 8,
@@ -19173,8 +21110,10 @@ var pgnList = []PgnInfo{
 			GolangType:"GearStatusConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "XxReserved3",
 			Name: "Reserved",
 			BitLength: 6,
 			BitOffset: 10,
@@ -19183,8 +21122,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "OilPressure",
 			Name: "Oil pressure",
 			BitLength: 16,
 			BitOffset: 16,
@@ -19193,8 +21134,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Pressure",
 			Resolution:100,
 			Signed: false,
+			Unit: "Pa",
 			},
 		5: { 
+			Id: "OilTemperature",
 			Name: "Oil temperature",
 			BitLength: 16,
 			BitOffset: 32,
@@ -19203,8 +21146,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Temperature",
 			Resolution:0.1,
 			Signed: false,
+			Unit: "K",
 			},
 		6: { 
+			Id: "DiscreteStatus1",
 			Name: "Discrete Status 1",
 			BitLength: 8,
 			BitOffset: 48,
@@ -19213,8 +21158,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "XxReserved7",
 			Name: "Reserved",
 			BitLength: 8,
 			BitOffset: 56,
@@ -19223,6 +21170,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -19233,8 +21181,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeTripParametersVessel,
+		Encoder: EncodeTripParametersVessel,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "TimeToEmpty",
 			Name: "Time to Empty",
 			BitLength: 32,
 			BitOffset: 0,
@@ -19243,8 +21193,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.001,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "DistanceToEmpty",
 			Name: "Distance to Empty",
 			BitLength: 32,
 			BitOffset: 32,
@@ -19253,8 +21205,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Distance",
 			Resolution:0.01,
 			Signed: false,
+			Unit: "m",
 			},
 		3: { 
+			Id: "EstimatedFuelRemaining",
 			Name: "Estimated Fuel Remaining",
 			BitLength: 16,
 			BitOffset: 64,
@@ -19263,8 +21217,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Volume",
 			Resolution:1,
 			Signed: false,
+			Unit: "L",
 			},
 		4: { 
+			Id: "TripRunTime",
 			Name: "Trip Run Time",
 			BitLength: 32,
 			BitOffset: 80,
@@ -19273,6 +21229,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.001,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -19283,8 +21240,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeTripParametersEngine,
+		Encoder: EncodeTripParametersEngine,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Instance",
 			Name: "Instance",
 			BitLength: 8,
 			BitOffset: 0,
@@ -19293,8 +21252,10 @@ var pgnList = []PgnInfo{
 			GolangType:"EngineInstanceConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "TripFuelUsed",
 			Name: "Trip Fuel Used",
 			BitLength: 16,
 			BitOffset: 8,
@@ -19303,8 +21264,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Volume",
 			Resolution:1,
 			Signed: false,
+			Unit: "L",
 			},
 		3: { 
+			Id: "FuelRateAverage",
 			Name: "Fuel Rate, Average",
 			BitLength: 16,
 			BitOffset: 24,
@@ -19313,8 +21276,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Flow",
 			Resolution:0.1,
 			Signed: true,
+			Unit: "L/h",
 			},
 		4: { 
+			Id: "FuelRateEconomy",
 			Name: "Fuel Rate, Economy",
 			BitLength: 16,
 			BitOffset: 40,
@@ -19323,8 +21288,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Flow",
 			Resolution:0.1,
 			Signed: true,
+			Unit: "L/h",
 			},
 		5: { 
+			Id: "InstantaneousFuelEconomy",
 			Name: "Instantaneous Fuel Economy",
 			BitLength: 16,
 			BitOffset: 56,
@@ -19333,6 +21300,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Flow",
 			Resolution:0.1,
 			Signed: true,
+			Unit: "L/h",
 			},
 		},
 	},
@@ -19343,8 +21311,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeEngineParametersStatic,
+		Encoder: EncodeEngineParametersStatic,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Instance",
 			Name: "Instance",
 			BitLength: 8,
 			BitOffset: 0,
@@ -19353,8 +21323,10 @@ var pgnList = []PgnInfo{
 			GolangType:"EngineInstanceConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "RatedEngineSpeed",
 			Name: "Rated Engine Speed",
 			BitLength: 16,
 			BitOffset: 8,
@@ -19363,8 +21335,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.25,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "Vin",
 			Name: "VIN",
 			BitLength: 136,
 			BitOffset: 24,
@@ -19373,8 +21347,10 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "SoftwareId",
 			Name: "Software ID",
 			BitLength: 256,
 			BitOffset: 160,
@@ -19383,6 +21359,7 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -19393,8 +21370,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeLoadControllerConnectionStateControl,
+		Encoder: EncodeLoadControllerConnectionStateControl,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "SequenceId",
 			Name: "Sequence ID",
 			BitLength: 8,
 			BitOffset: 0,
@@ -19403,8 +21382,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "ConnectionId",
 			Name: "Connection ID",
 			BitLength: 8,
 			BitOffset: 8,
@@ -19413,8 +21394,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "State",
 			Name: "State",
 			BitLength: 8,
 			BitOffset: 16,
@@ -19423,8 +21406,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Status",
 			Name: "Status",
 			BitLength: 8,
 			BitOffset: 24,
@@ -19433,8 +21418,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "OperationalStatusControl",
 			Name: "Operational Status & Control",
 			BitLength: 8,
 			BitOffset: 32,
@@ -19443,8 +21430,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "PwmDutyCycle",
 			Name: "PWM Duty Cycle",
 			BitLength: 8,
 			BitOffset: 40,
@@ -19453,8 +21442,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Timeon",
 			Name: "TimeON",
 			BitLength: 8,
 			BitOffset: 48,
@@ -19463,8 +21454,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "Timeoff",
 			Name: "TimeOFF",
 			BitLength: 8,
 			BitOffset: 56,
@@ -19473,6 +21466,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -19483,8 +21477,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeBinarySwitchBankStatus,
+		Encoder: EncodeBinarySwitchBankStatus,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Instance",
 			Name: "Instance",
 			BitLength: 8,
 			BitOffset: 0,
@@ -19493,8 +21489,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "Indicator1",
 			Name: "Indicator1",
 			BitLength: 2,
 			BitOffset: 8,
@@ -19503,8 +21501,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "Indicator2",
 			Name: "Indicator2",
 			BitLength: 2,
 			BitOffset: 10,
@@ -19513,8 +21513,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Indicator3",
 			Name: "Indicator3",
 			BitLength: 2,
 			BitOffset: 12,
@@ -19523,8 +21525,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "Indicator4",
 			Name: "Indicator4",
 			BitLength: 2,
 			BitOffset: 14,
@@ -19533,8 +21537,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Indicator5",
 			Name: "Indicator5",
 			BitLength: 2,
 			BitOffset: 16,
@@ -19543,8 +21549,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Indicator6",
 			Name: "Indicator6",
 			BitLength: 2,
 			BitOffset: 18,
@@ -19553,8 +21561,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "Indicator7",
 			Name: "Indicator7",
 			BitLength: 2,
 			BitOffset: 20,
@@ -19563,8 +21573,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "Indicator8",
 			Name: "Indicator8",
 			BitLength: 2,
 			BitOffset: 22,
@@ -19573,8 +21585,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "Indicator9",
 			Name: "Indicator9",
 			BitLength: 2,
 			BitOffset: 24,
@@ -19583,8 +21597,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "Indicator10",
 			Name: "Indicator10",
 			BitLength: 2,
 			BitOffset: 26,
@@ -19593,8 +21609,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		12: { 
+			Id: "Indicator11",
 			Name: "Indicator11",
 			BitLength: 2,
 			BitOffset: 28,
@@ -19603,8 +21621,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		13: { 
+			Id: "Indicator12",
 			Name: "Indicator12",
 			BitLength: 2,
 			BitOffset: 30,
@@ -19613,8 +21633,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		14: { 
+			Id: "Indicator13",
 			Name: "Indicator13",
 			BitLength: 2,
 			BitOffset: 32,
@@ -19623,8 +21645,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		15: { 
+			Id: "Indicator14",
 			Name: "Indicator14",
 			BitLength: 2,
 			BitOffset: 34,
@@ -19633,8 +21657,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		16: { 
+			Id: "Indicator15",
 			Name: "Indicator15",
 			BitLength: 2,
 			BitOffset: 36,
@@ -19643,8 +21669,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		17: { 
+			Id: "Indicator16",
 			Name: "Indicator16",
 			BitLength: 2,
 			BitOffset: 38,
@@ -19653,8 +21681,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		18: { 
+			Id: "Indicator17",
 			Name: "Indicator17",
 			BitLength: 2,
 			BitOffset: 40,
@@ -19663,8 +21693,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		19: { 
+			Id: "Indicator18",
 			Name: "Indicator18",
 			BitLength: 2,
 			BitOffset: 42,
@@ -19673,8 +21705,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		20: { 
+			Id: "Indicator19",
 			Name: "Indicator19",
 			BitLength: 2,
 			BitOffset: 44,
@@ -19683,8 +21717,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		21: { 
+			Id: "Indicator20",
 			Name: "Indicator20",
 			BitLength: 2,
 			BitOffset: 46,
@@ -19693,8 +21729,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		22: { 
+			Id: "Indicator21",
 			Name: "Indicator21",
 			BitLength: 2,
 			BitOffset: 48,
@@ -19703,8 +21741,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		23: { 
+			Id: "Indicator22",
 			Name: "Indicator22",
 			BitLength: 2,
 			BitOffset: 50,
@@ -19713,8 +21753,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		24: { 
+			Id: "Indicator23",
 			Name: "Indicator23",
 			BitLength: 2,
 			BitOffset: 52,
@@ -19723,8 +21765,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		25: { 
+			Id: "Indicator24",
 			Name: "Indicator24",
 			BitLength: 2,
 			BitOffset: 54,
@@ -19733,8 +21777,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		26: { 
+			Id: "Indicator25",
 			Name: "Indicator25",
 			BitLength: 2,
 			BitOffset: 56,
@@ -19743,8 +21789,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		27: { 
+			Id: "Indicator26",
 			Name: "Indicator26",
 			BitLength: 2,
 			BitOffset: 58,
@@ -19753,8 +21801,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		28: { 
+			Id: "Indicator27",
 			Name: "Indicator27",
 			BitLength: 2,
 			BitOffset: 60,
@@ -19763,8 +21813,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		29: { 
+			Id: "Indicator28",
 			Name: "Indicator28",
 			BitLength: 2,
 			BitOffset: 62,
@@ -19773,6 +21825,7 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -19783,8 +21836,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeSwitchBankControl,
+		Encoder: EncodeSwitchBankControl,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Instance",
 			Name: "Instance",
 			BitLength: 8,
 			BitOffset: 0,
@@ -19793,8 +21848,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "Switch1",
 			Name: "Switch1",
 			BitLength: 2,
 			BitOffset: 8,
@@ -19803,8 +21860,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "Switch2",
 			Name: "Switch2",
 			BitLength: 2,
 			BitOffset: 10,
@@ -19813,8 +21872,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Switch3",
 			Name: "Switch3",
 			BitLength: 2,
 			BitOffset: 12,
@@ -19823,8 +21884,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "Switch4",
 			Name: "Switch4",
 			BitLength: 2,
 			BitOffset: 14,
@@ -19833,8 +21896,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Switch5",
 			Name: "Switch5",
 			BitLength: 2,
 			BitOffset: 16,
@@ -19843,8 +21908,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Switch6",
 			Name: "Switch6",
 			BitLength: 2,
 			BitOffset: 18,
@@ -19853,8 +21920,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "Switch7",
 			Name: "Switch7",
 			BitLength: 2,
 			BitOffset: 20,
@@ -19863,8 +21932,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "Switch8",
 			Name: "Switch8",
 			BitLength: 2,
 			BitOffset: 22,
@@ -19873,8 +21944,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "Switch9",
 			Name: "Switch9",
 			BitLength: 2,
 			BitOffset: 24,
@@ -19883,8 +21956,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "Switch10",
 			Name: "Switch10",
 			BitLength: 2,
 			BitOffset: 26,
@@ -19893,8 +21968,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		12: { 
+			Id: "Switch11",
 			Name: "Switch11",
 			BitLength: 2,
 			BitOffset: 28,
@@ -19903,8 +21980,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		13: { 
+			Id: "Switch12",
 			Name: "Switch12",
 			BitLength: 2,
 			BitOffset: 30,
@@ -19913,8 +21992,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		14: { 
+			Id: "Switch13",
 			Name: "Switch13",
 			BitLength: 2,
 			BitOffset: 32,
@@ -19923,8 +22004,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		15: { 
+			Id: "Switch14",
 			Name: "Switch14",
 			BitLength: 2,
 			BitOffset: 34,
@@ -19933,8 +22016,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		16: { 
+			Id: "Switch15",
 			Name: "Switch15",
 			BitLength: 2,
 			BitOffset: 36,
@@ -19943,8 +22028,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		17: { 
+			Id: "Switch16",
 			Name: "Switch16",
 			BitLength: 2,
 			BitOffset: 38,
@@ -19953,8 +22040,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		18: { 
+			Id: "Switch17",
 			Name: "Switch17",
 			BitLength: 2,
 			BitOffset: 40,
@@ -19963,8 +22052,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		19: { 
+			Id: "Switch18",
 			Name: "Switch18",
 			BitLength: 2,
 			BitOffset: 42,
@@ -19973,8 +22064,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		20: { 
+			Id: "Switch19",
 			Name: "Switch19",
 			BitLength: 2,
 			BitOffset: 44,
@@ -19983,8 +22076,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		21: { 
+			Id: "Switch20",
 			Name: "Switch20",
 			BitLength: 2,
 			BitOffset: 46,
@@ -19993,8 +22088,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		22: { 
+			Id: "Switch21",
 			Name: "Switch21",
 			BitLength: 2,
 			BitOffset: 48,
@@ -20003,8 +22100,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		23: { 
+			Id: "Switch22",
 			Name: "Switch22",
 			BitLength: 2,
 			BitOffset: 50,
@@ -20013,8 +22112,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		24: { 
+			Id: "Switch23",
 			Name: "Switch23",
 			BitLength: 2,
 			BitOffset: 52,
@@ -20023,8 +22124,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		25: { 
+			Id: "Switch24",
 			Name: "Switch24",
 			BitLength: 2,
 			BitOffset: 54,
@@ -20033,8 +22136,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		26: { 
+			Id: "Switch25",
 			Name: "Switch25",
 			BitLength: 2,
 			BitOffset: 56,
@@ -20043,8 +22148,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		27: { 
+			Id: "Switch26",
 			Name: "Switch26",
 			BitLength: 2,
 			BitOffset: 58,
@@ -20053,8 +22160,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		28: { 
+			Id: "Switch27",
 			Name: "Switch27",
 			BitLength: 2,
 			BitOffset: 60,
@@ -20063,8 +22172,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		29: { 
+			Id: "Switch28",
 			Name: "Switch28",
 			BitLength: 2,
 			BitOffset: 62,
@@ -20073,6 +22184,7 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -20083,8 +22195,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeAcInputStatus,
+		Encoder: EncodeAcInputStatus,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Instance",
 			Name: "Instance",
 			BitLength: 8,
 			BitOffset: 0,
@@ -20093,8 +22207,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "NumberOfLines",
 			Name: "Number of Lines",
 			BitLength: 8,
 			BitOffset: 8,
@@ -20103,8 +22219,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "Line",
 			Name: "Line",
 			BitLength: 2,
 			BitOffset: 16,
@@ -20113,8 +22231,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Acceptability",
 			Name: "Acceptability",
 			BitLength: 2,
 			BitOffset: 18,
@@ -20123,8 +22243,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AcceptabilityConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "XxReserved5",
 			Name: "Reserved",
 			BitLength: 4,
 			BitOffset: 20,
@@ -20133,8 +22255,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Voltage",
 			Name: "Voltage",
 			BitLength: 16,
 			BitOffset: 24,
@@ -20143,8 +22267,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.01,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Current",
 			Name: "Current",
 			BitLength: 16,
 			BitOffset: 40,
@@ -20153,8 +22279,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "Frequency",
 			Name: "Frequency",
 			BitLength: 16,
 			BitOffset: 56,
@@ -20163,8 +22291,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.01,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "BreakerSize",
 			Name: "Breaker Size",
 			BitLength: 16,
 			BitOffset: 72,
@@ -20173,8 +22303,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "RealPower",
 			Name: "Real Power",
 			BitLength: 32,
 			BitOffset: 88,
@@ -20183,8 +22315,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "ReactivePower",
 			Name: "Reactive Power",
 			BitLength: 32,
 			BitOffset: 120,
@@ -20193,8 +22327,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		12: { 
+			Id: "PowerFactor",
 			Name: "Power factor",
 			BitLength: 8,
 			BitOffset: 152,
@@ -20203,6 +22339,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.01,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -20213,8 +22350,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeAcOutputStatus,
+		Encoder: EncodeAcOutputStatus,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Instance",
 			Name: "Instance",
 			BitLength: 8,
 			BitOffset: 0,
@@ -20223,8 +22362,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "NumberOfLines",
 			Name: "Number of Lines",
 			BitLength: 8,
 			BitOffset: 8,
@@ -20233,8 +22374,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "Line",
 			Name: "Line",
 			BitLength: 2,
 			BitOffset: 16,
@@ -20243,8 +22386,10 @@ var pgnList = []PgnInfo{
 			GolangType:"LineConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Waveform",
 			Name: "Waveform",
 			BitLength: 3,
 			BitOffset: 18,
@@ -20253,8 +22398,10 @@ var pgnList = []PgnInfo{
 			GolangType:"WaveformConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "XxReserved5",
 			Name: "Reserved",
 			BitLength: 3,
 			BitOffset: 21,
@@ -20263,8 +22410,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Voltage",
 			Name: "Voltage",
 			BitLength: 16,
 			BitOffset: 24,
@@ -20273,8 +22422,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.01,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Current",
 			Name: "Current",
 			BitLength: 16,
 			BitOffset: 40,
@@ -20283,8 +22434,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "Frequency",
 			Name: "Frequency",
 			BitLength: 16,
 			BitOffset: 56,
@@ -20293,8 +22446,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.01,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "BreakerSize",
 			Name: "Breaker Size",
 			BitLength: 16,
 			BitOffset: 72,
@@ -20303,8 +22458,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "RealPower",
 			Name: "Real Power",
 			BitLength: 32,
 			BitOffset: 88,
@@ -20313,8 +22470,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "ReactivePower",
 			Name: "Reactive Power",
 			BitLength: 32,
 			BitOffset: 120,
@@ -20323,8 +22482,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		12: { 
+			Id: "PowerFactor",
 			Name: "Power factor",
 			BitLength: 8,
 			BitOffset: 152,
@@ -20333,6 +22494,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.01,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -20343,8 +22505,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeFluidLevel,
+		Encoder: EncodeFluidLevel,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Instance",
 			Name: "Instance",
 			BitLength: 4,
 			BitOffset: 0,
@@ -20353,8 +22517,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "Type",
 			Name: "Type",
 			BitLength: 4,
 			BitOffset: 4,
@@ -20363,8 +22529,10 @@ var pgnList = []PgnInfo{
 			GolangType:"TankTypeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "Level",
 			Name: "Level",
 			BitLength: 16,
 			BitOffset: 8,
@@ -20373,8 +22541,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.004,
 			Signed: true,
+			Unit: "",
 			},
 		4: { 
+			Id: "Capacity",
 			Name: "Capacity",
 			BitLength: 32,
 			BitOffset: 24,
@@ -20383,8 +22553,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Volume",
 			Resolution:0.1,
 			Signed: false,
+			Unit: "L",
 			},
 		5: { 
+			Id: "XxReserved5",
 			Name: "Reserved",
 			BitLength: 8,
 			BitOffset: 56,
@@ -20393,6 +22565,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -20403,8 +22576,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeDcDetailedStatus,
+		Encoder: EncodeDcDetailedStatus,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 0,
@@ -20413,8 +22588,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "Instance",
 			Name: "Instance",
 			BitLength: 8,
 			BitOffset: 8,
@@ -20423,8 +22600,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "DcType",
 			Name: "DC Type",
 			BitLength: 8,
 			BitOffset: 16,
@@ -20433,8 +22612,10 @@ var pgnList = []PgnInfo{
 			GolangType:"DcSourceConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "StateOfCharge",
 			Name: "State of Charge",
 			BitLength: 8,
 			BitOffset: 24,
@@ -20443,8 +22624,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "StateOfHealth",
 			Name: "State of Health",
 			BitLength: 8,
 			BitOffset: 32,
@@ -20453,8 +22636,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "TimeRemaining",
 			Name: "Time Remaining",
 			BitLength: 16,
 			BitOffset: 40,
@@ -20463,8 +22648,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:60,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "RippleVoltage",
 			Name: "Ripple Voltage",
 			BitLength: 16,
 			BitOffset: 56,
@@ -20473,8 +22660,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.01,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "RemainingCapacity",
 			Name: "Remaining capacity",
 			BitLength: 16,
 			BitOffset: 72,
@@ -20483,6 +22672,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -20493,8 +22683,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeChargerStatus,
+		Encoder: EncodeChargerStatus,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Instance",
 			Name: "Instance",
 			BitLength: 8,
 			BitOffset: 0,
@@ -20503,8 +22695,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "BatteryInstance",
 			Name: "Battery Instance",
 			BitLength: 8,
 			BitOffset: 8,
@@ -20513,8 +22707,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "OperatingState",
 			Name: "Operating State",
 			BitLength: 4,
 			BitOffset: 16,
@@ -20523,8 +22719,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ChargerStateConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "ChargeMode",
 			Name: "Charge Mode",
 			BitLength: 4,
 			BitOffset: 20,
@@ -20533,8 +22731,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ChargerModeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "Enabled",
 			Name: "Enabled",
 			BitLength: 2,
 			BitOffset: 24,
@@ -20543,8 +22743,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "EqualizationPending",
 			Name: "Equalization Pending",
 			BitLength: 2,
 			BitOffset: 26,
@@ -20553,8 +22755,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "XxReserved7",
 			Name: "Reserved",
 			BitLength: 4,
 			BitOffset: 28,
@@ -20563,8 +22767,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "EqualizationTimeRemaining",
 			Name: "Equalization Time Remaining",
 			BitLength: 16,
 			BitOffset: 32,
@@ -20573,6 +22779,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:60,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -20583,8 +22790,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeBatteryStatus,
+		Encoder: EncodeBatteryStatus,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Instance",
 			Name: "Instance",
 			BitLength: 8,
 			BitOffset: 0,
@@ -20593,8 +22802,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "Voltage",
 			Name: "Voltage",
 			BitLength: 16,
 			BitOffset: 8,
@@ -20603,8 +22814,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.01,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "Current",
 			Name: "Current",
 			BitLength: 16,
 			BitOffset: 24,
@@ -20613,8 +22826,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.1,
 			Signed: true,
+			Unit: "",
 			},
 		4: { 
+			Id: "Temperature",
 			Name: "Temperature",
 			BitLength: 16,
 			BitOffset: 40,
@@ -20623,8 +22838,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Temperature",
 			Resolution:0.01,
 			Signed: false,
+			Unit: "K",
 			},
 		5: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 56,
@@ -20633,6 +22850,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -20643,8 +22861,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeInverterStatus,
+		Encoder: EncodeInverterStatus,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Instance",
 			Name: "Instance",
 			BitLength: 8,
 			BitOffset: 0,
@@ -20653,8 +22873,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "AcInstance",
 			Name: "AC Instance",
 			BitLength: 8,
 			BitOffset: 8,
@@ -20663,8 +22885,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "DcInstance",
 			Name: "DC Instance",
 			BitLength: 8,
 			BitOffset: 16,
@@ -20673,8 +22897,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "OperatingState",
 			Name: "Operating State",
 			BitLength: 4,
 			BitOffset: 24,
@@ -20683,8 +22909,10 @@ var pgnList = []PgnInfo{
 			GolangType:"InverterStateConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "InverterEnable",
 			Name: "Inverter Enable",
 			BitLength: 2,
 			BitOffset: 28,
@@ -20693,8 +22921,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "XxReserved6",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 30,
@@ -20703,6 +22933,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -20713,8 +22944,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeInverterConfigurationStatus,
+		Encoder: EncodeInverterConfigurationStatus,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Instance",
 			Name: "Instance",
 			BitLength: 8,
 			BitOffset: 0,
@@ -20723,8 +22956,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "AcInstance",
 			Name: "AC Instance",
 			BitLength: 8,
 			BitOffset: 8,
@@ -20733,8 +22968,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "DcInstance",
 			Name: "DC Instance",
 			BitLength: 8,
 			BitOffset: 16,
@@ -20743,8 +22980,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "InverterEnableDisable",
 			Name: "Inverter Enable/Disable",
 			BitLength: 2,
 			BitOffset: 24,
@@ -20753,8 +22992,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "XxReserved5",
 			Name: "Reserved",
 			BitLength: 6,
 			BitOffset: 26,
@@ -20763,8 +23004,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "InverterMode",
 			Name: "Inverter Mode",
 			BitLength: 8,
 			BitOffset: 32,
@@ -20773,8 +23016,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "LoadSenseEnableDisable",
 			Name: "Load Sense Enable/Disable",
 			BitLength: 8,
 			BitOffset: 40,
@@ -20783,8 +23028,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "LoadSensePowerThreshold",
 			Name: "Load Sense Power Threshold",
 			BitLength: 8,
 			BitOffset: 48,
@@ -20793,8 +23040,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "LoadSenseInterval",
 			Name: "Load Sense Interval",
 			BitLength: 8,
 			BitOffset: 56,
@@ -20803,6 +23052,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -20813,8 +23063,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeAgsConfigurationStatus,
+		Encoder: EncodeAgsConfigurationStatus,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Instance",
 			Name: "Instance",
 			BitLength: 8,
 			BitOffset: 0,
@@ -20823,8 +23075,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "GeneratorInstance",
 			Name: "Generator Instance",
 			BitLength: 8,
 			BitOffset: 8,
@@ -20833,8 +23087,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "AgsMode",
 			Name: "AGS Mode",
 			BitLength: 8,
 			BitOffset: 16,
@@ -20843,8 +23099,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 40,
 			BitOffset: 24,
@@ -20853,6 +23111,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -20863,8 +23122,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeBatteryConfigurationStatus,
+		Encoder: EncodeBatteryConfigurationStatus,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Instance",
 			Name: "Instance",
 			BitLength: 8,
 			BitOffset: 0,
@@ -20873,8 +23134,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "BatteryType",
 			Name: "Battery Type",
 			BitLength: 4,
 			BitOffset: 8,
@@ -20883,8 +23146,10 @@ var pgnList = []PgnInfo{
 			GolangType:"BatteryTypeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "SupportsEqualization",
 			Name: "Supports Equalization",
 			BitLength: 2,
 			BitOffset: 12,
@@ -20893,8 +23158,10 @@ var pgnList = []PgnInfo{
 			GolangType:"YesNoConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 14,
@@ -20903,8 +23170,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "NominalVoltage",
 			Name: "Nominal Voltage",
 			BitLength: 4,
 			BitOffset: 16,
@@ -20913,8 +23182,10 @@ var pgnList = []PgnInfo{
 			GolangType:"BatteryVoltageConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Chemistry",
 			Name: "Chemistry",
 			BitLength: 4,
 			BitOffset: 20,
@@ -20923,8 +23194,10 @@ var pgnList = []PgnInfo{
 			GolangType:"BatteryChemistryConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Capacity",
 			Name: "Capacity",
 			BitLength: 16,
 			BitOffset: 24,
@@ -20933,8 +23206,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "TemperatureCoefficient",
 			Name: "Temperature Coefficient",
 			BitLength: 8,
 			BitOffset: 40,
@@ -20943,8 +23218,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*int8",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		9: { 
+			Id: "PeukertExponent",
 			Name: "Peukert Exponent",
 			BitLength: 8,
 			BitOffset: 48,
@@ -20953,8 +23230,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.002,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "ChargeEfficiencyFactor",
 			Name: "Charge Efficiency Factor",
 			BitLength: 8,
 			BitOffset: 56,
@@ -20963,6 +23242,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*int8",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		},
 	},
@@ -20973,8 +23253,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeAgsStatus,
+		Encoder: EncodeAgsStatus,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Instance",
 			Name: "Instance",
 			BitLength: 8,
 			BitOffset: 0,
@@ -20983,8 +23265,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "GeneratorInstance",
 			Name: "Generator Instance",
 			BitLength: 8,
 			BitOffset: 8,
@@ -20993,8 +23277,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "AgsOperatingState",
 			Name: "AGS Operating State",
 			BitLength: 8,
 			BitOffset: 16,
@@ -21003,8 +23289,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "GeneratorState",
 			Name: "Generator State",
 			BitLength: 8,
 			BitOffset: 24,
@@ -21013,8 +23301,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "GeneratorOnReason",
 			Name: "Generator On Reason",
 			BitLength: 8,
 			BitOffset: 32,
@@ -21023,8 +23313,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "GeneratorOffReason",
 			Name: "Generator Off Reason",
 			BitLength: 8,
 			BitOffset: 40,
@@ -21033,8 +23325,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "XxReserved7",
 			Name: "Reserved",
 			BitLength: 16,
 			BitOffset: 48,
@@ -21043,6 +23337,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -21053,8 +23348,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeAcPowerCurrentPhaseA,
+		Encoder: EncodeAcPowerCurrentPhaseA,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 0,
@@ -21063,8 +23360,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "ConnectionNumber",
 			Name: "Connection Number",
 			BitLength: 8,
 			BitOffset: 8,
@@ -21073,8 +23372,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "AcRmsCurrent",
 			Name: "AC RMS Current",
 			BitLength: 16,
 			BitOffset: 16,
@@ -21083,8 +23384,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Power",
 			Name: "Power",
 			BitLength: 32,
 			BitOffset: 32,
@@ -21093,6 +23396,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*int32",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		},
 	},
@@ -21103,8 +23407,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeAcPowerCurrentPhaseB,
+		Encoder: EncodeAcPowerCurrentPhaseB,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 0,
@@ -21113,8 +23419,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "ConnectionNumber",
 			Name: "Connection Number",
 			BitLength: 8,
 			BitOffset: 8,
@@ -21123,8 +23431,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "AcRmsCurrent",
 			Name: "AC RMS Current",
 			BitLength: 16,
 			BitOffset: 16,
@@ -21133,8 +23443,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Power",
 			Name: "Power",
 			BitLength: 32,
 			BitOffset: 32,
@@ -21143,6 +23455,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*int32",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		},
 	},
@@ -21153,8 +23466,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeAcPowerCurrentPhaseC,
+		Encoder: EncodeAcPowerCurrentPhaseC,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 0,
@@ -21163,8 +23478,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "ConnectionNumber",
 			Name: "Connection Number",
 			BitLength: 8,
 			BitOffset: 8,
@@ -21173,8 +23490,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "AcRmsCurrent",
 			Name: "AC RMS Current",
 			BitLength: 16,
 			BitOffset: 16,
@@ -21183,8 +23502,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Power",
 			Name: "Power",
 			BitLength: 32,
 			BitOffset: 32,
@@ -21193,6 +23514,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*int32",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		},
 	},
@@ -21203,8 +23525,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeConverterStatus,
+		Encoder: EncodeConverterStatus,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 0,
@@ -21213,8 +23537,10 @@ var pgnList = []PgnInfo{
 			GolangType:"[]uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "ConnectionNumber",
 			Name: "Connection Number",
 			BitLength: 8,
 			BitOffset: 8,
@@ -21223,8 +23549,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "OperatingState",
 			Name: "Operating State",
 			BitLength: 8,
 			BitOffset: 16,
@@ -21233,8 +23561,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ConverterStateConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "TemperatureState",
 			Name: "Temperature State",
 			BitLength: 2,
 			BitOffset: 24,
@@ -21243,8 +23573,10 @@ var pgnList = []PgnInfo{
 			GolangType:"GoodWarningErrorConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "OverloadState",
 			Name: "Overload State",
 			BitLength: 2,
 			BitOffset: 26,
@@ -21253,8 +23585,10 @@ var pgnList = []PgnInfo{
 			GolangType:"GoodWarningErrorConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "LowDcVoltageState",
 			Name: "Low DC Voltage State",
 			BitLength: 2,
 			BitOffset: 28,
@@ -21263,8 +23597,10 @@ var pgnList = []PgnInfo{
 			GolangType:"GoodWarningErrorConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "RippleState",
 			Name: "Ripple State",
 			BitLength: 2,
 			BitOffset: 30,
@@ -21273,8 +23609,10 @@ var pgnList = []PgnInfo{
 			GolangType:"GoodWarningErrorConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "XxReserved8",
 			Name: "Reserved",
 			BitLength: 32,
 			BitOffset: 32,
@@ -21283,6 +23621,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -21293,8 +23632,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeDcVoltageCurrent,
+		Encoder: EncodeDcVoltageCurrent,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 0,
@@ -21303,8 +23644,10 @@ var pgnList = []PgnInfo{
 			GolangType:"[]uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "ConnectionNumber",
 			Name: "Connection Number",
 			BitLength: 8,
 			BitOffset: 8,
@@ -21313,8 +23656,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "DcVoltage",
 			Name: "DC Voltage",
 			BitLength: 16,
 			BitOffset: 16,
@@ -21323,8 +23668,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "DcCurrent",
 			Name: "DC Current",
 			BitLength: 24,
 			BitOffset: 32,
@@ -21333,8 +23680,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.01,
 			Signed: true,
+			Unit: "",
 			},
 		5: { 
+			Id: "XxReserved5",
 			Name: "Reserved",
 			BitLength: 8,
 			BitOffset: 56,
@@ -21343,6 +23692,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -21353,8 +23703,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeLeewayAngle,
+		Encoder: EncodeLeewayAngle,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 0,
@@ -21363,8 +23715,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "LeewayAngle",
 			Name: "Leeway Angle",
 			BitLength: 16,
 			BitOffset: 8,
@@ -21373,8 +23727,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: true,
+			Unit: "",
 			},
 		3: { 
+			Id: "XxReserved3",
 			Name: "Reserved",
 			BitLength: 40,
 			BitOffset: 24,
@@ -21383,6 +23739,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -21393,8 +23750,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeThrusterControlStatus,
+		Encoder: EncodeThrusterControlStatus,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 0,
@@ -21403,8 +23762,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "Identifier",
 			Name: "Identifier",
 			BitLength: 8,
 			BitOffset: 8,
@@ -21413,8 +23774,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "DirectionControl",
 			Name: "Direction Control",
 			BitLength: 4,
 			BitOffset: 16,
@@ -21423,8 +23786,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ThrusterDirectionControlConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "PowerEnabled",
 			Name: "Power Enabled",
 			BitLength: 2,
 			BitOffset: 20,
@@ -21433,8 +23798,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "RetractControl",
 			Name: "Retract Control",
 			BitLength: 2,
 			BitOffset: 22,
@@ -21443,8 +23810,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ThrusterRetractControlConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "SpeedControl",
 			Name: "Speed Control",
 			BitLength: 8,
 			BitOffset: 24,
@@ -21453,8 +23822,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "ControlEvents",
 			Name: "Control Events",
 			BitLength: 8,
 			BitOffset: 32,
@@ -21463,8 +23834,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ThrusterControlEventsConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "CommandTimeout",
 			Name: "Command Timeout",
 			BitLength: 8,
 			BitOffset: 40,
@@ -21473,8 +23846,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.005,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "AzimuthControl",
 			Name: "Azimuth Control",
 			BitLength: 16,
 			BitOffset: 48,
@@ -21483,6 +23858,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -21493,8 +23869,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeThrusterInformation,
+		Encoder: EncodeThrusterInformation,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Identifier",
 			Name: "Identifier",
 			BitLength: 8,
 			BitOffset: 0,
@@ -21503,8 +23881,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "MotorType",
 			Name: "Motor Type",
 			BitLength: 4,
 			BitOffset: 8,
@@ -21513,8 +23893,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ThrusterMotorTypeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "XxReserved3",
 			Name: "Reserved",
 			BitLength: 4,
 			BitOffset: 12,
@@ -21523,8 +23905,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "PowerRating",
 			Name: "Power Rating",
 			BitLength: 16,
 			BitOffset: 16,
@@ -21533,8 +23917,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "MaximumTemperatureRating",
 			Name: "Maximum Temperature Rating",
 			BitLength: 16,
 			BitOffset: 32,
@@ -21543,8 +23929,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Temperature",
 			Resolution:0.01,
 			Signed: false,
+			Unit: "K",
 			},
 		6: { 
+			Id: "MaximumRotationalSpeed",
 			Name: "Maximum Rotational Speed",
 			BitLength: 16,
 			BitOffset: 48,
@@ -21553,6 +23941,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.25,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -21563,8 +23952,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeThrusterMotorStatus,
+		Encoder: EncodeThrusterMotorStatus,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 0,
@@ -21573,8 +23964,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "Identifier",
 			Name: "Identifier",
 			BitLength: 8,
 			BitOffset: 8,
@@ -21583,8 +23976,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "MotorEvents",
 			Name: "Motor Events",
 			BitLength: 8,
 			BitOffset: 16,
@@ -21593,8 +23988,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ThrusterMotorEventsConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Current",
 			Name: "Current",
 			BitLength: 8,
 			BitOffset: 24,
@@ -21603,8 +24000,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "Temperature",
 			Name: "Temperature",
 			BitLength: 16,
 			BitOffset: 32,
@@ -21613,8 +24012,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Temperature",
 			Resolution:0.01,
 			Signed: false,
+			Unit: "K",
 			},
 		6: { 
+			Id: "OperatingTime",
 			Name: "Operating Time",
 			BitLength: 16,
 			BitOffset: 48,
@@ -21623,6 +24024,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:60,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -21633,8 +24035,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeSpeed,
+		Encoder: EncodeSpeed,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 0,
@@ -21643,8 +24047,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "SpeedWaterReferenced",
 			Name: "Speed Water Referenced",
 			BitLength: 16,
 			BitOffset: 8,
@@ -21653,8 +24059,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Velocity",
 			Resolution:0.01,
 			Signed: false,
+			Unit: "m/s",
 			},
 		3: { 
+			Id: "SpeedGroundReferenced",
 			Name: "Speed Ground Referenced",
 			BitLength: 16,
 			BitOffset: 24,
@@ -21663,8 +24071,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Velocity",
 			Resolution:0.01,
 			Signed: false,
+			Unit: "m/s",
 			},
 		4: { 
+			Id: "SpeedWaterReferencedType",
 			Name: "Speed Water Referenced Type",
 			BitLength: 8,
 			BitOffset: 40,
@@ -21673,8 +24083,10 @@ var pgnList = []PgnInfo{
 			GolangType:"WaterReferenceConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "SpeedDirection",
 			Name: "Speed Direction",
 			BitLength: 4,
 			BitOffset: 48,
@@ -21683,8 +24095,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "XxReserved6",
 			Name: "Reserved",
 			BitLength: 12,
 			BitOffset: 52,
@@ -21693,6 +24107,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -21703,8 +24118,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeWaterDepth,
+		Encoder: EncodeWaterDepth,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 0,
@@ -21713,8 +24130,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "Depth",
 			Name: "Depth",
 			BitLength: 32,
 			BitOffset: 8,
@@ -21723,8 +24142,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Distance",
 			Resolution:0.01,
 			Signed: false,
+			Unit: "m",
 			},
 		3: { 
+			Id: "Offset",
 			Name: "Offset",
 			BitLength: 16,
 			BitOffset: 40,
@@ -21733,8 +24154,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Distance",
 			Resolution:0.001,
 			Signed: true,
+			Unit: "m",
 			},
 		4: { 
+			Id: "Range",
 			Name: "Range",
 			BitLength: 8,
 			BitOffset: 56,
@@ -21743,6 +24166,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Distance",
 			Resolution:10,
 			Signed: false,
+			Unit: "m",
 			},
 		},
 	},
@@ -21753,8 +24177,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeDistanceLog,
+		Encoder: EncodeDistanceLog,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Date",
 			Name: "Date",
 			BitLength: 16,
 			BitOffset: 0,
@@ -21763,8 +24189,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "Time",
 			Name: "Time",
 			BitLength: 32,
 			BitOffset: 16,
@@ -21773,8 +24201,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "Log",
 			Name: "Log",
 			BitLength: 32,
 			BitOffset: 48,
@@ -21783,8 +24213,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Distance",
 			Resolution:1,
 			Signed: false,
+			Unit: "m",
 			},
 		4: { 
+			Id: "TripLog",
 			Name: "Trip Log",
 			BitLength: 32,
 			BitOffset: 80,
@@ -21793,6 +24225,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Distance",
 			Resolution:1,
 			Signed: false,
+			Unit: "m",
 			},
 		},
 	},
@@ -21803,8 +24236,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeTrackedTargetData,
+		Encoder: EncodeTrackedTargetData,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 0,
@@ -21813,8 +24248,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "TargetId",
 			Name: "Target ID #",
 			BitLength: 8,
 			BitOffset: 8,
@@ -21823,8 +24260,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "TrackStatus",
 			Name: "Track Status",
 			BitLength: 2,
 			BitOffset: 16,
@@ -21833,8 +24272,10 @@ var pgnList = []PgnInfo{
 			GolangType:"TrackingConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "ReportedTarget",
 			Name: "Reported Target",
 			BitLength: 1,
 			BitOffset: 18,
@@ -21843,8 +24284,10 @@ var pgnList = []PgnInfo{
 			GolangType:"YesNoConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "TargetAcquisition",
 			Name: "Target Acquisition",
 			BitLength: 1,
 			BitOffset: 19,
@@ -21853,8 +24296,10 @@ var pgnList = []PgnInfo{
 			GolangType:"TargetAcquisitionConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "BearingReference",
 			Name: "Bearing Reference",
 			BitLength: 2,
 			BitOffset: 20,
@@ -21863,8 +24308,10 @@ var pgnList = []PgnInfo{
 			GolangType:"DirectionReferenceConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "XxReserved7",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 22,
@@ -21873,8 +24320,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "Bearing",
 			Name: "Bearing",
 			BitLength: 16,
 			BitOffset: 24,
@@ -21883,8 +24332,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "Distance",
 			Name: "Distance",
 			BitLength: 32,
 			BitOffset: 40,
@@ -21893,8 +24344,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Distance",
 			Resolution:0.001,
 			Signed: false,
+			Unit: "m",
 			},
 		10: { 
+			Id: "Course",
 			Name: "Course",
 			BitLength: 16,
 			BitOffset: 72,
@@ -21903,8 +24356,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "Speed",
 			Name: "Speed",
 			BitLength: 16,
 			BitOffset: 88,
@@ -21913,8 +24368,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Velocity",
 			Resolution:0.01,
 			Signed: false,
+			Unit: "m/s",
 			},
 		12: { 
+			Id: "Cpa",
 			Name: "CPA",
 			BitLength: 32,
 			BitOffset: 104,
@@ -21923,8 +24380,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Distance",
 			Resolution:0.01,
 			Signed: false,
+			Unit: "m",
 			},
 		13: { 
+			Id: "Tcpa",
 			Name: "TCPA",
 			BitLength: 32,
 			BitOffset: 136,
@@ -21933,8 +24392,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.001,
 			Signed: true,
+			Unit: "",
 			},
 		14: { 
+			Id: "UtcOfFix",
 			Name: "UTC of Fix",
 			BitLength: 32,
 			BitOffset: 168,
@@ -21943,8 +24404,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: false,
+			Unit: "",
 			},
 		15: { 
+			Id: "Name",
 			Name: "Name",
 			BitLength: 1784,
 			BitOffset: 200,
@@ -21953,6 +24416,7 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -21963,8 +24427,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeWindlassControlStatus,
+		Encoder: EncodeWindlassControlStatus,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 0,
@@ -21973,8 +24439,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "WindlassId",
 			Name: "Windlass ID",
 			BitLength: 8,
 			BitOffset: 8,
@@ -21983,8 +24451,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "WindlassDirectionControl",
 			Name: "Windlass Direction Control",
 			BitLength: 2,
 			BitOffset: 16,
@@ -21993,8 +24463,10 @@ var pgnList = []PgnInfo{
 			GolangType:"WindlassDirectionConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "AnchorDockingControl",
 			Name: "Anchor Docking Control",
 			BitLength: 2,
 			BitOffset: 18,
@@ -22003,8 +24475,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "SpeedControlType",
 			Name: "Speed Control Type",
 			BitLength: 2,
 			BitOffset: 20,
@@ -22013,8 +24487,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SpeedTypeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "XxReserved6",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 22,
@@ -22023,8 +24499,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "SpeedControl",
 			Name: "Speed Control",
 			BitLength: 8,
 			BitOffset: 24,
@@ -22033,8 +24511,10 @@ var pgnList = []PgnInfo{
 			GolangType:"[]uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "PowerEnable",
 			Name: "Power Enable",
 			BitLength: 2,
 			BitOffset: 32,
@@ -22043,8 +24523,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "MechanicalLock",
 			Name: "Mechanical Lock",
 			BitLength: 2,
 			BitOffset: 34,
@@ -22053,8 +24535,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "DeckAndAnchorWash",
 			Name: "Deck and Anchor Wash",
 			BitLength: 2,
 			BitOffset: 36,
@@ -22063,8 +24547,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "AnchorLight",
 			Name: "Anchor Light",
 			BitLength: 2,
 			BitOffset: 38,
@@ -22073,8 +24559,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		12: { 
+			Id: "CommandTimeout",
 			Name: "Command Timeout",
 			BitLength: 8,
 			BitOffset: 40,
@@ -22083,8 +24571,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.005,
 			Signed: false,
+			Unit: "",
 			},
 		13: { 
+			Id: "WindlassControlEvents",
 			Name: "Windlass Control Events",
 			BitLength: 4,
 			BitOffset: 48,
@@ -22093,8 +24583,10 @@ var pgnList = []PgnInfo{
 			GolangType:"WindlassControlConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		14: { 
+			Id: "XxReserved14",
 			Name: "Reserved",
 			BitLength: 12,
 			BitOffset: 52,
@@ -22103,6 +24595,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -22113,8 +24606,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeAnchorWindlassOperatingStatus,
+		Encoder: EncodeAnchorWindlassOperatingStatus,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 0,
@@ -22123,8 +24618,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "WindlassId",
 			Name: "Windlass ID",
 			BitLength: 8,
 			BitOffset: 8,
@@ -22133,8 +24630,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "WindlassDirectionControl",
 			Name: "Windlass Direction Control",
 			BitLength: 2,
 			BitOffset: 16,
@@ -22143,8 +24642,10 @@ var pgnList = []PgnInfo{
 			GolangType:"WindlassDirectionConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "WindlassMotionStatus",
 			Name: "Windlass Motion Status",
 			BitLength: 2,
 			BitOffset: 18,
@@ -22153,8 +24654,10 @@ var pgnList = []PgnInfo{
 			GolangType:"WindlassMotionConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "RodeTypeStatus",
 			Name: "Rode Type Status",
 			BitLength: 2,
 			BitOffset: 20,
@@ -22163,8 +24666,10 @@ var pgnList = []PgnInfo{
 			GolangType:"RodeTypeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "XxReserved6",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 22,
@@ -22173,8 +24678,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "RodeCounterValue",
 			Name: "Rode Counter Value",
 			BitLength: 16,
 			BitOffset: 24,
@@ -22183,8 +24690,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Distance",
 			Resolution:0.1,
 			Signed: false,
+			Unit: "m",
 			},
 		8: { 
+			Id: "WindlassLineSpeed",
 			Name: "Windlass Line Speed",
 			BitLength: 16,
 			BitOffset: 40,
@@ -22193,8 +24702,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Velocity",
 			Resolution:0.01,
 			Signed: false,
+			Unit: "m/s",
 			},
 		9: { 
+			Id: "AnchorDockingStatus",
 			Name: "Anchor Docking Status",
 			BitLength: 2,
 			BitOffset: 56,
@@ -22203,8 +24714,10 @@ var pgnList = []PgnInfo{
 			GolangType:"DockingStatusConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "WindlassOperatingEvents",
 			Name: "Windlass Operating Events",
 			BitLength: 6,
 			BitOffset: 58,
@@ -22213,6 +24726,7 @@ var pgnList = []PgnInfo{
 			GolangType:"WindlassOperationConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -22223,8 +24737,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeAnchorWindlassMonitoringStatus,
+		Encoder: EncodeAnchorWindlassMonitoringStatus,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 0,
@@ -22233,8 +24749,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "WindlassId",
 			Name: "Windlass ID",
 			BitLength: 8,
 			BitOffset: 8,
@@ -22243,8 +24761,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "WindlassMonitoringEvents",
 			Name: "Windlass Monitoring Events",
 			BitLength: 8,
 			BitOffset: 16,
@@ -22253,8 +24773,10 @@ var pgnList = []PgnInfo{
 			GolangType:"WindlassMonitoringConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "ControllerVoltage",
 			Name: "Controller voltage",
 			BitLength: 8,
 			BitOffset: 24,
@@ -22263,8 +24785,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.2,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "MotorCurrent",
 			Name: "Motor current",
 			BitLength: 8,
 			BitOffset: 32,
@@ -22273,8 +24797,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "TotalMotorTime",
 			Name: "Total Motor Time",
 			BitLength: 16,
 			BitOffset: 40,
@@ -22283,8 +24809,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:60,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "XxReserved7",
 			Name: "Reserved",
 			BitLength: 8,
 			BitOffset: 56,
@@ -22293,6 +24821,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -22303,8 +24832,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodePositionRapidUpdate,
+		Encoder: EncodePositionRapidUpdate,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Latitude",
 			Name: "Latitude",
 			BitLength: 32,
 			BitOffset: 0,
@@ -22313,8 +24844,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float64",
 			Resolution:1e-07,
 			Signed: true,
+			Unit: "",
 			},
 		2: { 
+			Id: "Longitude",
 			Name: "Longitude",
 			BitLength: 32,
 			BitOffset: 32,
@@ -22323,6 +24856,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*float64",
 			Resolution:1e-07,
 			Signed: true,
+			Unit: "",
 			},
 		},
 	},
@@ -22333,8 +24867,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeCogSogRapidUpdate,
+		Encoder: EncodeCogSogRapidUpdate,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 0,
@@ -22343,8 +24879,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "CogReference",
 			Name: "COG Reference",
 			BitLength: 2,
 			BitOffset: 8,
@@ -22353,8 +24891,10 @@ var pgnList = []PgnInfo{
 			GolangType:"DirectionReferenceConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "XxReserved3",
 			Name: "Reserved",
 			BitLength: 6,
 			BitOffset: 10,
@@ -22363,8 +24903,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Cog",
 			Name: "COG",
 			BitLength: 16,
 			BitOffset: 16,
@@ -22373,8 +24915,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "Sog",
 			Name: "SOG",
 			BitLength: 16,
 			BitOffset: 32,
@@ -22383,8 +24927,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Velocity",
 			Resolution:0.01,
 			Signed: false,
+			Unit: "m/s",
 			},
 		6: { 
+			Id: "XxReserved6",
 			Name: "Reserved",
 			BitLength: 16,
 			BitOffset: 48,
@@ -22393,6 +24939,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -22403,8 +24950,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodePositionDeltaRapidUpdate,
+		Encoder: EncodePositionDeltaRapidUpdate,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 0,
@@ -22413,8 +24962,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "TimeDelta",
 			Name: "Time Delta",
 			BitLength: 16,
 			BitOffset: 8,
@@ -22423,8 +24974,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "LatitudeDelta",
 			Name: "Latitude Delta",
 			BitLength: 16,
 			BitOffset: 24,
@@ -22433,8 +24986,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*int16",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		4: { 
+			Id: "LongitudeDelta",
 			Name: "Longitude Delta",
 			BitLength: 16,
 			BitOffset: 40,
@@ -22443,8 +24998,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*int16",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		5: { 
+			Id: "XxReserved5",
 			Name: "Reserved",
 			BitLength: 8,
 			BitOffset: 56,
@@ -22453,6 +25010,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -22463,8 +25021,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeAltitudeDeltaRapidUpdate,
+		Encoder: EncodeAltitudeDeltaRapidUpdate,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 0,
@@ -22473,8 +25033,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "TimeDelta",
 			Name: "Time Delta",
 			BitLength: 16,
 			BitOffset: 8,
@@ -22483,8 +25045,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*int16",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		3: { 
+			Id: "GnssQuality",
 			Name: "GNSS Quality",
 			BitLength: 2,
 			BitOffset: 24,
@@ -22493,8 +25057,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Direction",
 			Name: "Direction",
 			BitLength: 2,
 			BitOffset: 26,
@@ -22503,8 +25069,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "XxReserved5",
 			Name: "Reserved",
 			BitLength: 4,
 			BitOffset: 28,
@@ -22513,8 +25081,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Cog",
 			Name: "COG",
 			BitLength: 16,
 			BitOffset: 32,
@@ -22523,8 +25093,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "AltitudeDelta",
 			Name: "Altitude Delta",
 			BitLength: 16,
 			BitOffset: 48,
@@ -22533,6 +25105,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*int16",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		},
 	},
@@ -22543,8 +25116,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeGnssPositionData,
+		Encoder: EncodeGnssPositionData,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 0,
@@ -22553,8 +25128,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "Date",
 			Name: "Date",
 			BitLength: 16,
 			BitOffset: 8,
@@ -22563,8 +25140,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "Time",
 			Name: "Time",
 			BitLength: 32,
 			BitOffset: 24,
@@ -22573,8 +25152,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Latitude",
 			Name: "Latitude",
 			BitLength: 64,
 			BitOffset: 56,
@@ -22583,8 +25164,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float64",
 			Resolution:1e-16,
 			Signed: true,
+			Unit: "",
 			},
 		5: { 
+			Id: "Longitude",
 			Name: "Longitude",
 			BitLength: 64,
 			BitOffset: 120,
@@ -22593,8 +25176,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float64",
 			Resolution:1e-16,
 			Signed: true,
+			Unit: "",
 			},
 		6: { 
+			Id: "Altitude",
 			Name: "Altitude",
 			BitLength: 64,
 			BitOffset: 184,
@@ -22603,8 +25188,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Distance",
 			Resolution:1e-06,
 			Signed: true,
+			Unit: "m",
 			},
 		7: { 
+			Id: "GnssType",
 			Name: "GNSS type",
 			BitLength: 4,
 			BitOffset: 248,
@@ -22613,8 +25200,10 @@ var pgnList = []PgnInfo{
 			GolangType:"GnsConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "Method",
 			Name: "Method",
 			BitLength: 4,
 			BitOffset: 252,
@@ -22623,8 +25212,10 @@ var pgnList = []PgnInfo{
 			GolangType:"GnsMethodConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "Integrity",
 			Name: "Integrity",
 			BitLength: 2,
 			BitOffset: 256,
@@ -22633,8 +25224,10 @@ var pgnList = []PgnInfo{
 			GolangType:"GnsIntegrityConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "XxReserved10",
 			Name: "Reserved",
 			BitLength: 6,
 			BitOffset: 258,
@@ -22643,8 +25236,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "NumberOfSvs",
 			Name: "Number of SVs",
 			BitLength: 8,
 			BitOffset: 264,
@@ -22653,8 +25248,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		12: { 
+			Id: "Hdop",
 			Name: "HDOP",
 			BitLength: 16,
 			BitOffset: 272,
@@ -22663,8 +25260,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.01,
 			Signed: true,
+			Unit: "",
 			},
 		13: { 
+			Id: "Pdop",
 			Name: "PDOP",
 			BitLength: 16,
 			BitOffset: 288,
@@ -22673,8 +25272,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.01,
 			Signed: true,
+			Unit: "",
 			},
 		14: { 
+			Id: "GeoidalSeparation",
 			Name: "Geoidal Separation",
 			BitLength: 32,
 			BitOffset: 304,
@@ -22683,8 +25284,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Distance",
 			Resolution:0.01,
 			Signed: true,
+			Unit: "m",
 			},
 		15: { 
+			Id: "ReferenceStations",
 			Name: "Reference Stations",
 			BitLength: 8,
 			BitOffset: 336,
@@ -22693,8 +25296,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		16: { 
+			Id: "ReferenceStationType",
 			Name: "Reference Station Type",
 			BitLength: 4,
 			BitOffset: 344,
@@ -22703,8 +25308,10 @@ var pgnList = []PgnInfo{
 			GolangType:"GnsConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		17: { 
+			Id: "ReferenceStationId",
 			Name: "Reference Station ID",
 			BitLength: 12,
 			BitOffset: 348,
@@ -22713,8 +25320,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		18: { 
+			Id: "AgeOfDgnssCorrections",
 			Name: "Age of DGNSS Corrections",
 			BitLength: 16,
 			BitOffset: 360,
@@ -22723,6 +25332,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.01,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -22733,8 +25343,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeTimeDate,
+		Encoder: EncodeTimeDate,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Date",
 			Name: "Date",
 			BitLength: 16,
 			BitOffset: 0,
@@ -22743,8 +25355,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "Time",
 			Name: "Time",
 			BitLength: 32,
 			BitOffset: 16,
@@ -22753,8 +25367,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "LocalOffset",
 			Name: "Local Offset",
 			BitLength: 16,
 			BitOffset: 48,
@@ -22763,6 +25379,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:60,
 			Signed: true,
+			Unit: "",
 			},
 		},
 	},
@@ -22773,8 +25390,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeAisClassAPositionReport,
+		Encoder: EncodeAisClassAPositionReport,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "MessageId",
 			Name: "Message ID",
 			BitLength: 6,
 			BitOffset: 0,
@@ -22783,8 +25402,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AisMessageIdConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "RepeatIndicator",
 			Name: "Repeat Indicator",
 			BitLength: 2,
 			BitOffset: 6,
@@ -22793,8 +25414,10 @@ var pgnList = []PgnInfo{
 			GolangType:"RepeatIndicatorConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "UserId",
 			Name: "User ID",
 			BitLength: 32,
 			BitOffset: 8,
@@ -22803,8 +25426,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Longitude",
 			Name: "Longitude",
 			BitLength: 32,
 			BitOffset: 40,
@@ -22813,8 +25438,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float64",
 			Resolution:1e-07,
 			Signed: true,
+			Unit: "",
 			},
 		5: { 
+			Id: "Latitude",
 			Name: "Latitude",
 			BitLength: 32,
 			BitOffset: 72,
@@ -22823,8 +25450,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float64",
 			Resolution:1e-07,
 			Signed: true,
+			Unit: "",
 			},
 		6: { 
+			Id: "PositionAccuracy",
 			Name: "Position Accuracy",
 			BitLength: 1,
 			BitOffset: 104,
@@ -22833,8 +25462,10 @@ var pgnList = []PgnInfo{
 			GolangType:"PositionAccuracyConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Raim",
 			Name: "RAIM",
 			BitLength: 1,
 			BitOffset: 105,
@@ -22843,8 +25474,10 @@ var pgnList = []PgnInfo{
 			GolangType:"RaimFlagConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "TimeStamp",
 			Name: "Time Stamp",
 			BitLength: 6,
 			BitOffset: 106,
@@ -22853,8 +25486,10 @@ var pgnList = []PgnInfo{
 			GolangType:"TimeStampConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "Cog",
 			Name: "COG",
 			BitLength: 16,
 			BitOffset: 112,
@@ -22863,8 +25498,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "Sog",
 			Name: "SOG",
 			BitLength: 16,
 			BitOffset: 128,
@@ -22873,8 +25510,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Velocity",
 			Resolution:0.01,
 			Signed: false,
+			Unit: "m/s",
 			},
 		11: { 
+			Id: "CommunicationState",
 			Name: "Communication State",
 			BitLength: 19,
 			BitOffset: 144,
@@ -22883,8 +25522,10 @@ var pgnList = []PgnInfo{
 			GolangType:"[]uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		12: { 
+			Id: "AisTransceiverInformation",
 			Name: "AIS Transceiver information",
 			BitLength: 5,
 			BitOffset: 163,
@@ -22893,8 +25534,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AisTransceiverConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		13: { 
+			Id: "Heading",
 			Name: "Heading",
 			BitLength: 16,
 			BitOffset: 168,
@@ -22903,8 +25546,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: false,
+			Unit: "",
 			},
 		14: { 
+			Id: "RateOfTurn",
 			Name: "Rate of Turn",
 			BitLength: 16,
 			BitOffset: 184,
@@ -22913,8 +25558,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:3.125e-05,
 			Signed: true,
+			Unit: "",
 			},
 		15: { 
+			Id: "NavStatus",
 			Name: "Nav Status",
 			BitLength: 4,
 			BitOffset: 200,
@@ -22923,8 +25570,10 @@ var pgnList = []PgnInfo{
 			GolangType:"NavStatusConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		16: { 
+			Id: "SpecialManeuverIndicator",
 			Name: "Special Maneuver Indicator",
 			BitLength: 2,
 			BitOffset: 204,
@@ -22933,8 +25582,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AisSpecialManeuverConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		17: { 
+			Id: "XxReserved17",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 206,
@@ -22943,8 +25594,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		18: { 
+			Id: "XxReserved18",
 			Name: "Spare",
 			BitLength: 3,
 			BitOffset: 208,
@@ -22953,8 +25606,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		19: { 
+			Id: "XxReserved19",
 			Name: "Reserved",
 			BitLength: 5,
 			BitOffset: 211,
@@ -22963,8 +25618,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		20: { 
+			Id: "SequenceId",
 			Name: "Sequence ID",
 			BitLength: 8,
 			BitOffset: 216,
@@ -22973,6 +25630,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -22983,8 +25641,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeAisClassBPositionReport,
+		Encoder: EncodeAisClassBPositionReport,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "MessageId",
 			Name: "Message ID",
 			BitLength: 6,
 			BitOffset: 0,
@@ -22993,8 +25653,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AisMessageIdConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "RepeatIndicator",
 			Name: "Repeat Indicator",
 			BitLength: 2,
 			BitOffset: 6,
@@ -23003,8 +25665,10 @@ var pgnList = []PgnInfo{
 			GolangType:"RepeatIndicatorConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "UserId",
 			Name: "User ID",
 			BitLength: 32,
 			BitOffset: 8,
@@ -23013,8 +25677,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Longitude",
 			Name: "Longitude",
 			BitLength: 32,
 			BitOffset: 40,
@@ -23023,8 +25689,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float64",
 			Resolution:1e-07,
 			Signed: true,
+			Unit: "",
 			},
 		5: { 
+			Id: "Latitude",
 			Name: "Latitude",
 			BitLength: 32,
 			BitOffset: 72,
@@ -23033,8 +25701,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float64",
 			Resolution:1e-07,
 			Signed: true,
+			Unit: "",
 			},
 		6: { 
+			Id: "PositionAccuracy",
 			Name: "Position Accuracy",
 			BitLength: 1,
 			BitOffset: 104,
@@ -23043,8 +25713,10 @@ var pgnList = []PgnInfo{
 			GolangType:"PositionAccuracyConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Raim",
 			Name: "RAIM",
 			BitLength: 1,
 			BitOffset: 105,
@@ -23053,8 +25725,10 @@ var pgnList = []PgnInfo{
 			GolangType:"RaimFlagConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "TimeStamp",
 			Name: "Time Stamp",
 			BitLength: 6,
 			BitOffset: 106,
@@ -23063,8 +25737,10 @@ var pgnList = []PgnInfo{
 			GolangType:"TimeStampConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "Cog",
 			Name: "COG",
 			BitLength: 16,
 			BitOffset: 112,
@@ -23073,8 +25749,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "Sog",
 			Name: "SOG",
 			BitLength: 16,
 			BitOffset: 128,
@@ -23083,8 +25761,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Velocity",
 			Resolution:0.01,
 			Signed: false,
+			Unit: "m/s",
 			},
 		11: { 
+			Id: "CommunicationState",
 			Name: "Communication State",
 			BitLength: 19,
 			BitOffset: 144,
@@ -23093,8 +25773,10 @@ var pgnList = []PgnInfo{
 			GolangType:"[]uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		12: { 
+			Id: "AisTransceiverInformation",
 			Name: "AIS Transceiver information",
 			BitLength: 5,
 			BitOffset: 163,
@@ -23103,8 +25785,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AisTransceiverConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		13: { 
+			Id: "Heading",
 			Name: "Heading",
 			BitLength: 16,
 			BitOffset: 168,
@@ -23113,8 +25797,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: false,
+			Unit: "",
 			},
 		14: { 
+			Id: "XxReserved14",
 			Name: "Regional Application",
 			BitLength: 8,
 			BitOffset: 184,
@@ -23123,8 +25809,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		15: { 
+			Id: "XxReserved15",
 			Name: "Regional Application B",
 			BitLength: 2,
 			BitOffset: 192,
@@ -23133,8 +25821,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		16: { 
+			Id: "UnitType",
 			Name: "Unit type",
 			BitLength: 1,
 			BitOffset: 194,
@@ -23143,8 +25833,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AisTypeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		17: { 
+			Id: "IntegratedDisplay",
 			Name: "Integrated Display",
 			BitLength: 1,
 			BitOffset: 195,
@@ -23153,8 +25845,10 @@ var pgnList = []PgnInfo{
 			GolangType:"YesNoConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		18: { 
+			Id: "Dsc",
 			Name: "DSC",
 			BitLength: 1,
 			BitOffset: 196,
@@ -23163,8 +25857,10 @@ var pgnList = []PgnInfo{
 			GolangType:"YesNoConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		19: { 
+			Id: "Band",
 			Name: "Band",
 			BitLength: 1,
 			BitOffset: 197,
@@ -23173,8 +25869,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AisBandConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		20: { 
+			Id: "CanHandleMsg22",
 			Name: "Can handle Msg 22",
 			BitLength: 1,
 			BitOffset: 198,
@@ -23183,8 +25881,10 @@ var pgnList = []PgnInfo{
 			GolangType:"YesNoConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		21: { 
+			Id: "AisMode",
 			Name: "AIS mode",
 			BitLength: 1,
 			BitOffset: 199,
@@ -23193,8 +25893,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AisModeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		22: { 
+			Id: "AisCommunicationState",
 			Name: "AIS communication state",
 			BitLength: 1,
 			BitOffset: 200,
@@ -23203,8 +25905,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AisCommunicationStateConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		23: { 
+			Id: "XxReserved23",
 			Name: "Reserved",
 			BitLength: 15,
 			BitOffset: 201,
@@ -23213,6 +25917,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -23223,8 +25928,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeAisClassBExtendedPositionReport,
+		Encoder: EncodeAisClassBExtendedPositionReport,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "MessageId",
 			Name: "Message ID",
 			BitLength: 6,
 			BitOffset: 0,
@@ -23233,8 +25940,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AisMessageIdConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "RepeatIndicator",
 			Name: "Repeat Indicator",
 			BitLength: 2,
 			BitOffset: 6,
@@ -23243,8 +25952,10 @@ var pgnList = []PgnInfo{
 			GolangType:"RepeatIndicatorConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "UserId",
 			Name: "User ID",
 			BitLength: 32,
 			BitOffset: 8,
@@ -23253,8 +25964,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Longitude",
 			Name: "Longitude",
 			BitLength: 32,
 			BitOffset: 40,
@@ -23263,8 +25976,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float64",
 			Resolution:1e-07,
 			Signed: true,
+			Unit: "",
 			},
 		5: { 
+			Id: "Latitude",
 			Name: "Latitude",
 			BitLength: 32,
 			BitOffset: 72,
@@ -23273,8 +25988,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float64",
 			Resolution:1e-07,
 			Signed: true,
+			Unit: "",
 			},
 		6: { 
+			Id: "PositionAccuracy",
 			Name: "Position Accuracy",
 			BitLength: 1,
 			BitOffset: 104,
@@ -23283,8 +26000,10 @@ var pgnList = []PgnInfo{
 			GolangType:"PositionAccuracyConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Raim",
 			Name: "RAIM",
 			BitLength: 1,
 			BitOffset: 105,
@@ -23293,8 +26012,10 @@ var pgnList = []PgnInfo{
 			GolangType:"RaimFlagConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "TimeStamp",
 			Name: "Time Stamp",
 			BitLength: 6,
 			BitOffset: 106,
@@ -23303,8 +26024,10 @@ var pgnList = []PgnInfo{
 			GolangType:"TimeStampConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "Cog",
 			Name: "COG",
 			BitLength: 16,
 			BitOffset: 112,
@@ -23313,8 +26036,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "Sog",
 			Name: "SOG",
 			BitLength: 16,
 			BitOffset: 128,
@@ -23323,8 +26048,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Velocity",
 			Resolution:0.01,
 			Signed: false,
+			Unit: "m/s",
 			},
 		11: { 
+			Id: "XxReserved11",
 			Name: "Regional Application",
 			BitLength: 8,
 			BitOffset: 144,
@@ -23333,8 +26060,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		12: { 
+			Id: "XxReserved12",
 			Name: "Regional Application B",
 			BitLength: 4,
 			BitOffset: 152,
@@ -23343,8 +26072,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		13: { 
+			Id: "XxReserved13",
 			Name: "Reserved",
 			BitLength: 4,
 			BitOffset: 156,
@@ -23353,8 +26084,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		14: { 
+			Id: "TypeOfShip",
 			Name: "Type of ship",
 			BitLength: 8,
 			BitOffset: 160,
@@ -23363,8 +26096,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ShipTypeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		15: { 
+			Id: "TrueHeading",
 			Name: "True Heading",
 			BitLength: 16,
 			BitOffset: 168,
@@ -23373,8 +26108,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: false,
+			Unit: "",
 			},
 		16: { 
+			Id: "XxReserved16",
 			Name: "Reserved",
 			BitLength: 4,
 			BitOffset: 184,
@@ -23383,8 +26120,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		17: { 
+			Id: "GnssType",
 			Name: "GNSS type",
 			BitLength: 4,
 			BitOffset: 188,
@@ -23393,8 +26132,10 @@ var pgnList = []PgnInfo{
 			GolangType:"PositionFixDeviceConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		18: { 
+			Id: "Length",
 			Name: "Length",
 			BitLength: 16,
 			BitOffset: 192,
@@ -23403,8 +26144,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Distance",
 			Resolution:0.1,
 			Signed: false,
+			Unit: "m",
 			},
 		19: { 
+			Id: "Beam",
 			Name: "Beam",
 			BitLength: 16,
 			BitOffset: 208,
@@ -23413,8 +26156,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Distance",
 			Resolution:0.1,
 			Signed: false,
+			Unit: "m",
 			},
 		20: { 
+			Id: "PositionReferenceFromStarboard",
 			Name: "Position reference from Starboard",
 			BitLength: 16,
 			BitOffset: 224,
@@ -23423,8 +26168,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Distance",
 			Resolution:0.1,
 			Signed: false,
+			Unit: "m",
 			},
 		21: { 
+			Id: "PositionReferenceFromBow",
 			Name: "Position reference from Bow",
 			BitLength: 16,
 			BitOffset: 240,
@@ -23433,8 +26180,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Distance",
 			Resolution:0.1,
 			Signed: false,
+			Unit: "m",
 			},
 		22: { 
+			Id: "Name",
 			Name: "Name",
 			BitLength: 160,
 			BitOffset: 256,
@@ -23443,8 +26192,10 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		23: { 
+			Id: "Dte",
 			Name: "DTE",
 			BitLength: 1,
 			BitOffset: 416,
@@ -23453,8 +26204,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AvailableConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		24: { 
+			Id: "AisMode",
 			Name: "AIS mode",
 			BitLength: 1,
 			BitOffset: 417,
@@ -23463,8 +26216,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AisModeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		25: { 
+			Id: "XxReserved25",
 			Name: "Spare",
 			BitLength: 4,
 			BitOffset: 418,
@@ -23473,8 +26228,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		26: { 
+			Id: "AisTransceiverInformation",
 			Name: "AIS Transceiver information",
 			BitLength: 5,
 			BitOffset: 422,
@@ -23483,8 +26240,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AisTransceiverConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		27: { 
+			Id: "XxReserved27",
 			Name: "Reserved",
 			BitLength: 5,
 			BitOffset: 427,
@@ -23493,6 +26252,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -23503,8 +26263,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeAisAidsToNavigationAtonReport,
+		Encoder: EncodeAisAidsToNavigationAtonReport,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "MessageId",
 			Name: "Message ID",
 			BitLength: 6,
 			BitOffset: 0,
@@ -23513,8 +26275,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AisMessageIdConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "RepeatIndicator",
 			Name: "Repeat Indicator",
 			BitLength: 2,
 			BitOffset: 6,
@@ -23523,8 +26287,10 @@ var pgnList = []PgnInfo{
 			GolangType:"RepeatIndicatorConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "UserId",
 			Name: "User ID",
 			BitLength: 32,
 			BitOffset: 8,
@@ -23533,8 +26299,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Longitude",
 			Name: "Longitude",
 			BitLength: 32,
 			BitOffset: 40,
@@ -23543,8 +26311,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float64",
 			Resolution:1e-07,
 			Signed: true,
+			Unit: "",
 			},
 		5: { 
+			Id: "Latitude",
 			Name: "Latitude",
 			BitLength: 32,
 			BitOffset: 72,
@@ -23553,8 +26323,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float64",
 			Resolution:1e-07,
 			Signed: true,
+			Unit: "",
 			},
 		6: { 
+			Id: "PositionAccuracy",
 			Name: "Position Accuracy",
 			BitLength: 1,
 			BitOffset: 104,
@@ -23563,8 +26335,10 @@ var pgnList = []PgnInfo{
 			GolangType:"PositionAccuracyConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Raim",
 			Name: "RAIM",
 			BitLength: 1,
 			BitOffset: 105,
@@ -23573,8 +26347,10 @@ var pgnList = []PgnInfo{
 			GolangType:"RaimFlagConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "TimeStamp",
 			Name: "Time Stamp",
 			BitLength: 6,
 			BitOffset: 106,
@@ -23583,8 +26359,10 @@ var pgnList = []PgnInfo{
 			GolangType:"TimeStampConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "LengthDiameter",
 			Name: "Length/Diameter",
 			BitLength: 16,
 			BitOffset: 112,
@@ -23593,8 +26371,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Distance",
 			Resolution:0.1,
 			Signed: false,
+			Unit: "m",
 			},
 		10: { 
+			Id: "BeamDiameter",
 			Name: "Beam/Diameter",
 			BitLength: 16,
 			BitOffset: 128,
@@ -23603,8 +26383,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Distance",
 			Resolution:0.1,
 			Signed: false,
+			Unit: "m",
 			},
 		11: { 
+			Id: "PositionReferenceFromStarboardEdge",
 			Name: "Position Reference from Starboard Edge",
 			BitLength: 16,
 			BitOffset: 144,
@@ -23613,8 +26395,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Distance",
 			Resolution:0.1,
 			Signed: false,
+			Unit: "m",
 			},
 		12: { 
+			Id: "PositionReferenceFromTrueNorthFacingEdge",
 			Name: "Position Reference from True North Facing Edge",
 			BitLength: 16,
 			BitOffset: 160,
@@ -23623,8 +26407,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Distance",
 			Resolution:0.1,
 			Signed: false,
+			Unit: "m",
 			},
 		13: { 
+			Id: "AtonType",
 			Name: "AtoN Type",
 			BitLength: 5,
 			BitOffset: 176,
@@ -23633,8 +26419,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AtonTypeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		14: { 
+			Id: "OffPositionIndicator",
 			Name: "Off Position Indicator",
 			BitLength: 1,
 			BitOffset: 181,
@@ -23643,8 +26431,10 @@ var pgnList = []PgnInfo{
 			GolangType:"YesNoConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		15: { 
+			Id: "VirtualAtonFlag",
 			Name: "Virtual AtoN Flag",
 			BitLength: 1,
 			BitOffset: 182,
@@ -23653,8 +26443,10 @@ var pgnList = []PgnInfo{
 			GolangType:"YesNoConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		16: { 
+			Id: "AssignedModeFlag",
 			Name: "Assigned Mode Flag",
 			BitLength: 1,
 			BitOffset: 183,
@@ -23663,8 +26455,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AisAssignedModeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		17: { 
+			Id: "XxReserved17",
 			Name: "Spare",
 			BitLength: 1,
 			BitOffset: 184,
@@ -23673,8 +26467,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		18: { 
+			Id: "PositionFixingDeviceType",
 			Name: "Position Fixing Device Type",
 			BitLength: 4,
 			BitOffset: 185,
@@ -23683,8 +26479,10 @@ var pgnList = []PgnInfo{
 			GolangType:"PositionFixDeviceConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		19: { 
+			Id: "XxReserved19",
 			Name: "Reserved",
 			BitLength: 3,
 			BitOffset: 189,
@@ -23693,8 +26491,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		20: { 
+			Id: "AtonStatus",
 			Name: "AtoN Status",
 			BitLength: 8,
 			BitOffset: 192,
@@ -23703,8 +26503,10 @@ var pgnList = []PgnInfo{
 			GolangType:"[]uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		21: { 
+			Id: "AisTransceiverInformation",
 			Name: "AIS Transceiver information",
 			BitLength: 5,
 			BitOffset: 200,
@@ -23713,8 +26515,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AisTransceiverConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		22: { 
+			Id: "XxReserved22",
 			Name: "Reserved",
 			BitLength: 3,
 			BitOffset: 205,
@@ -23723,8 +26527,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		23: { 
+			Id: "AtonName",
 			Name: "AtoN Name",
 			BitLength: 0,
 			BitOffset: 0,
@@ -23733,6 +26539,7 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -23743,8 +26550,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeDatum,
+		Encoder: EncodeDatum,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "LocalDatum",
 			Name: "Local Datum",
 			BitLength: 32,
 			BitOffset: 0,
@@ -23753,8 +26562,10 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "DeltaLatitude",
 			Name: "Delta Latitude",
 			BitLength: 32,
 			BitOffset: 32,
@@ -23763,8 +26574,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float64",
 			Resolution:1e-07,
 			Signed: true,
+			Unit: "",
 			},
 		3: { 
+			Id: "DeltaLongitude",
 			Name: "Delta Longitude",
 			BitLength: 32,
 			BitOffset: 64,
@@ -23773,8 +26586,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float64",
 			Resolution:1e-07,
 			Signed: true,
+			Unit: "",
 			},
 		4: { 
+			Id: "DeltaAltitude",
 			Name: "Delta Altitude",
 			BitLength: 32,
 			BitOffset: 96,
@@ -23783,8 +26598,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Distance",
 			Resolution:0.01,
 			Signed: true,
+			Unit: "m",
 			},
 		5: { 
+			Id: "ReferenceDatum",
 			Name: "Reference Datum",
 			BitLength: 32,
 			BitOffset: 128,
@@ -23793,6 +26610,7 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -23803,8 +26621,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeUserDatum,
+		Encoder: EncodeUserDatum,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "DeltaX",
 			Name: "Delta X",
 			BitLength: 32,
 			BitOffset: 0,
@@ -23813,8 +26633,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Distance",
 			Resolution:0.01,
 			Signed: true,
+			Unit: "m",
 			},
 		2: { 
+			Id: "DeltaY",
 			Name: "Delta Y",
 			BitLength: 32,
 			BitOffset: 32,
@@ -23823,8 +26645,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Distance",
 			Resolution:0.01,
 			Signed: true,
+			Unit: "m",
 			},
 		3: { 
+			Id: "DeltaZ",
 			Name: "Delta Z",
 			BitLength: 32,
 			BitOffset: 64,
@@ -23833,8 +26657,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Distance",
 			Resolution:0.01,
 			Signed: true,
+			Unit: "m",
 			},
 		4: { 
+			Id: "RotationInX",
 			Name: "Rotation in X",
 			BitLength: 32,
 			BitOffset: 96,
@@ -23843,8 +26669,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		5: { 
+			Id: "RotationInY",
 			Name: "Rotation in Y",
 			BitLength: 32,
 			BitOffset: 128,
@@ -23853,8 +26681,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		6: { 
+			Id: "RotationInZ",
 			Name: "Rotation in Z",
 			BitLength: 32,
 			BitOffset: 160,
@@ -23863,8 +26693,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		7: { 
+			Id: "Scale",
 			Name: "Scale",
 			BitLength: 32,
 			BitOffset: 192,
@@ -23873,8 +26705,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		8: { 
+			Id: "EllipsoidSemiMajorAxis",
 			Name: "Ellipsoid Semi-major Axis",
 			BitLength: 32,
 			BitOffset: 224,
@@ -23883,8 +26717,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Distance",
 			Resolution:0.01,
 			Signed: true,
+			Unit: "m",
 			},
 		9: { 
+			Id: "EllipsoidFlatteningInverse",
 			Name: "Ellipsoid Flattening Inverse",
 			BitLength: 32,
 			BitOffset: 256,
@@ -23893,8 +26729,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		10: { 
+			Id: "DatumName",
 			Name: "Datum Name",
 			BitLength: 32,
 			BitOffset: 288,
@@ -23903,6 +26741,7 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -23913,8 +26752,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeCrossTrackError,
+		Encoder: EncodeCrossTrackError,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 0,
@@ -23923,8 +26764,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XteMode",
 			Name: "XTE mode",
 			BitLength: 4,
 			BitOffset: 8,
@@ -23933,8 +26776,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ResidualModeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "XxReserved3",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 12,
@@ -23943,8 +26788,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "NavigationTerminated",
 			Name: "Navigation Terminated",
 			BitLength: 2,
 			BitOffset: 14,
@@ -23953,8 +26800,10 @@ var pgnList = []PgnInfo{
 			GolangType:"YesNoConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "Xte",
 			Name: "XTE",
 			BitLength: 32,
 			BitOffset: 16,
@@ -23963,8 +26812,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Distance",
 			Resolution:0.01,
 			Signed: true,
+			Unit: "m",
 			},
 		6: { 
+			Id: "XxReserved6",
 			Name: "Reserved",
 			BitLength: 16,
 			BitOffset: 48,
@@ -23973,6 +26824,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -23983,8 +26835,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeNavigationData,
+		Encoder: EncodeNavigationData,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 0,
@@ -23993,8 +26847,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "DistanceToWaypoint",
 			Name: "Distance to Waypoint",
 			BitLength: 32,
 			BitOffset: 8,
@@ -24003,8 +26859,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Distance",
 			Resolution:0.01,
 			Signed: false,
+			Unit: "m",
 			},
 		3: { 
+			Id: "CourseBearingReference",
 			Name: "Course/Bearing reference",
 			BitLength: 2,
 			BitOffset: 40,
@@ -24013,8 +26871,10 @@ var pgnList = []PgnInfo{
 			GolangType:"DirectionReferenceConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "PerpendicularCrossed",
 			Name: "Perpendicular Crossed",
 			BitLength: 2,
 			BitOffset: 42,
@@ -24023,8 +26883,10 @@ var pgnList = []PgnInfo{
 			GolangType:"YesNoConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "ArrivalCircleEntered",
 			Name: "Arrival Circle Entered",
 			BitLength: 2,
 			BitOffset: 44,
@@ -24033,8 +26895,10 @@ var pgnList = []PgnInfo{
 			GolangType:"YesNoConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "CalculationType",
 			Name: "Calculation Type",
 			BitLength: 2,
 			BitOffset: 46,
@@ -24043,8 +26907,10 @@ var pgnList = []PgnInfo{
 			GolangType:"BearingModeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "EtaTime",
 			Name: "ETA Time",
 			BitLength: 32,
 			BitOffset: 48,
@@ -24053,8 +26919,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "EtaDate",
 			Name: "ETA Date",
 			BitLength: 16,
 			BitOffset: 80,
@@ -24063,8 +26931,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "BearingOriginToDestinationWaypoint",
 			Name: "Bearing, Origin to Destination Waypoint",
 			BitLength: 16,
 			BitOffset: 96,
@@ -24073,8 +26943,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "BearingPositionToDestinationWaypoint",
 			Name: "Bearing, Position to Destination Waypoint",
 			BitLength: 16,
 			BitOffset: 112,
@@ -24083,8 +26955,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "OriginWaypointNumber",
 			Name: "Origin Waypoint Number",
 			BitLength: 32,
 			BitOffset: 128,
@@ -24093,8 +26967,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		12: { 
+			Id: "DestinationWaypointNumber",
 			Name: "Destination Waypoint Number",
 			BitLength: 32,
 			BitOffset: 160,
@@ -24103,8 +26979,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		13: { 
+			Id: "DestinationLatitude",
 			Name: "Destination Latitude",
 			BitLength: 32,
 			BitOffset: 192,
@@ -24113,8 +26991,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float64",
 			Resolution:1e-07,
 			Signed: true,
+			Unit: "",
 			},
 		14: { 
+			Id: "DestinationLongitude",
 			Name: "Destination Longitude",
 			BitLength: 32,
 			BitOffset: 224,
@@ -24123,8 +27003,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float64",
 			Resolution:1e-07,
 			Signed: true,
+			Unit: "",
 			},
 		15: { 
+			Id: "WaypointClosingVelocity",
 			Name: "Waypoint Closing Velocity",
 			BitLength: 16,
 			BitOffset: 256,
@@ -24133,6 +27015,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Velocity",
 			Resolution:0.01,
 			Signed: true,
+			Unit: "m/s",
 			},
 		},
 	},
@@ -24143,8 +27026,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeNavigationRouteWpInformation,
+		Encoder: EncodeNavigationRouteWpInformation,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "StartRps",
 			Name: "Start RPS#",
 			BitLength: 16,
 			BitOffset: 0,
@@ -24153,8 +27038,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "Nitems",
 			Name: "nItems",
 			BitLength: 16,
 			BitOffset: 16,
@@ -24163,8 +27050,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "DatabaseId",
 			Name: "Database ID",
 			BitLength: 16,
 			BitOffset: 32,
@@ -24173,8 +27062,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "RouteId",
 			Name: "Route ID",
 			BitLength: 16,
 			BitOffset: 48,
@@ -24183,8 +27074,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "NavigationDirectionInRoute",
 			Name: "Navigation direction in route",
 			BitLength: 3,
 			BitOffset: 64,
@@ -24193,8 +27086,10 @@ var pgnList = []PgnInfo{
 			GolangType:"DirectionConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "SupplementaryRouteWpDataAvailable",
 			Name: "Supplementary Route/WP data available",
 			BitLength: 2,
 			BitOffset: 67,
@@ -24203,8 +27098,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "XxReserved7",
 			Name: "Reserved",
 			BitLength: 3,
 			BitOffset: 69,
@@ -24213,8 +27110,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "RouteName",
 			Name: "Route Name",
 			BitLength: 0,
 			BitOffset: 0,
@@ -24223,8 +27122,10 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "XxReserved9",
 			Name: "Reserved",
 			BitLength: 8,
 			BitOffset: 0,
@@ -24233,8 +27134,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "WpId",
 			Name: "WP ID",
 			BitLength: 16,
 			BitOffset: 0,
@@ -24243,8 +27146,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "WpName",
 			Name: "WP Name",
 			BitLength: 0,
 			BitOffset: 0,
@@ -24253,8 +27158,10 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		12: { 
+			Id: "WpLatitude",
 			Name: "WP Latitude",
 			BitLength: 32,
 			BitOffset: 0,
@@ -24263,8 +27170,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float64",
 			Resolution:1e-07,
 			Signed: true,
+			Unit: "",
 			},
 		13: { 
+			Id: "WpLongitude",
 			Name: "WP Longitude",
 			BitLength: 32,
 			BitOffset: 0,
@@ -24273,6 +27182,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*float64",
 			Resolution:1e-07,
 			Signed: true,
+			Unit: "",
 			},
 		},
 	},
@@ -24283,8 +27193,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeSetDriftRapidUpdate,
+		Encoder: EncodeSetDriftRapidUpdate,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 0,
@@ -24293,8 +27205,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "SetReference",
 			Name: "Set Reference",
 			BitLength: 2,
 			BitOffset: 8,
@@ -24303,8 +27217,10 @@ var pgnList = []PgnInfo{
 			GolangType:"DirectionReferenceConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "XxReserved3",
 			Name: "Reserved",
 			BitLength: 6,
 			BitOffset: 10,
@@ -24313,8 +27229,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Set",
 			Name: "Set",
 			BitLength: 16,
 			BitOffset: 16,
@@ -24323,8 +27241,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "Drift",
 			Name: "Drift",
 			BitLength: 16,
 			BitOffset: 32,
@@ -24333,8 +27253,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Velocity",
 			Resolution:0.01,
 			Signed: false,
+			Unit: "m/s",
 			},
 		6: { 
+			Id: "XxReserved6",
 			Name: "Reserved",
 			BitLength: 16,
 			BitOffset: 48,
@@ -24343,6 +27265,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -24353,8 +27276,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeGnssDops,
+		Encoder: EncodeGnssDops,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 0,
@@ -24363,8 +27288,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "DesiredMode",
 			Name: "Desired Mode",
 			BitLength: 3,
 			BitOffset: 8,
@@ -24373,8 +27300,10 @@ var pgnList = []PgnInfo{
 			GolangType:"GnssModeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "ActualMode",
 			Name: "Actual Mode",
 			BitLength: 3,
 			BitOffset: 11,
@@ -24383,8 +27312,10 @@ var pgnList = []PgnInfo{
 			GolangType:"GnssModeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 14,
@@ -24393,8 +27324,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "Hdop",
 			Name: "HDOP",
 			BitLength: 16,
 			BitOffset: 16,
@@ -24403,8 +27336,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.01,
 			Signed: true,
+			Unit: "",
 			},
 		6: { 
+			Id: "Vdop",
 			Name: "VDOP",
 			BitLength: 16,
 			BitOffset: 32,
@@ -24413,8 +27348,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.01,
 			Signed: true,
+			Unit: "",
 			},
 		7: { 
+			Id: "Tdop",
 			Name: "TDOP",
 			BitLength: 16,
 			BitOffset: 48,
@@ -24423,6 +27360,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.01,
 			Signed: true,
+			Unit: "",
 			},
 		},
 	},
@@ -24433,8 +27371,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeGnssSatsInView,
+		Encoder: EncodeGnssSatsInView,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 0,
@@ -24443,8 +27383,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "RangeResidualMode",
 			Name: "Range Residual Mode",
 			BitLength: 2,
 			BitOffset: 8,
@@ -24453,8 +27395,10 @@ var pgnList = []PgnInfo{
 			GolangType:"RangeResidualModeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "XxReserved3",
 			Name: "Reserved",
 			BitLength: 6,
 			BitOffset: 10,
@@ -24463,8 +27407,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "SatsInView",
 			Name: "Sats in View",
 			BitLength: 8,
 			BitOffset: 16,
@@ -24473,8 +27419,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "Prn",
 			Name: "PRN",
 			BitLength: 8,
 			BitOffset: 24,
@@ -24483,8 +27431,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Elevation",
 			Name: "Elevation",
 			BitLength: 16,
 			BitOffset: 32,
@@ -24493,8 +27443,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: true,
+			Unit: "",
 			},
 		7: { 
+			Id: "Azimuth",
 			Name: "Azimuth",
 			BitLength: 16,
 			BitOffset: 48,
@@ -24503,8 +27455,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "Snr",
 			Name: "SNR",
 			BitLength: 16,
 			BitOffset: 64,
@@ -24513,8 +27467,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.01,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "RangeResiduals",
 			Name: "Range residuals",
 			BitLength: 32,
 			BitOffset: 80,
@@ -24523,8 +27479,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*int32",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		10: { 
+			Id: "Status",
 			Name: "Status",
 			BitLength: 4,
 			BitOffset: 112,
@@ -24533,8 +27491,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SatelliteStatusConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "XxReserved11",
 			Name: "Reserved",
 			BitLength: 4,
 			BitOffset: 116,
@@ -24543,6 +27503,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -24553,8 +27514,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeGpsAlmanacData,
+		Encoder: EncodeGpsAlmanacData,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Prn",
 			Name: "PRN",
 			BitLength: 8,
 			BitOffset: 0,
@@ -24563,8 +27526,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "GpsWeekNumber",
 			Name: "GPS Week number",
 			BitLength: 16,
 			BitOffset: 8,
@@ -24573,8 +27538,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "SvHealthBits",
 			Name: "SV Health Bits",
 			BitLength: 8,
 			BitOffset: 24,
@@ -24583,8 +27550,10 @@ var pgnList = []PgnInfo{
 			GolangType:"[]uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Eccentricity",
 			Name: "Eccentricity",
 			BitLength: 16,
 			BitOffset: 32,
@@ -24593,8 +27562,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:4.76837e-07,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "AlmanacReferenceTime",
 			Name: "Almanac Reference Time",
 			BitLength: 8,
 			BitOffset: 48,
@@ -24603,8 +27574,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:4096,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "InclinationAngle",
 			Name: "Inclination Angle",
 			BitLength: 16,
 			BitOffset: 56,
@@ -24613,8 +27586,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:1.90735e-06,
 			Signed: true,
+			Unit: "",
 			},
 		7: { 
+			Id: "RateOfRightAscension",
 			Name: "Rate of Right Ascension",
 			BitLength: 16,
 			BitOffset: 72,
@@ -24623,8 +27598,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float64",
 			Resolution:3.63798e-12,
 			Signed: true,
+			Unit: "",
 			},
 		8: { 
+			Id: "RootOfSemiMajorAxis",
 			Name: "Root of Semi-major Axis",
 			BitLength: 24,
 			BitOffset: 88,
@@ -24633,8 +27610,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.000488281,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "ArgumentOfPerigee",
 			Name: "Argument of Perigee",
 			BitLength: 24,
 			BitOffset: 112,
@@ -24643,8 +27622,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:1.19209e-07,
 			Signed: true,
+			Unit: "",
 			},
 		10: { 
+			Id: "LongitudeOfAscensionNode",
 			Name: "Longitude of Ascension Node",
 			BitLength: 24,
 			BitOffset: 136,
@@ -24653,8 +27634,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:1.19209e-07,
 			Signed: true,
+			Unit: "",
 			},
 		11: { 
+			Id: "MeanAnomaly",
 			Name: "Mean Anomaly",
 			BitLength: 24,
 			BitOffset: 160,
@@ -24663,8 +27646,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:1.19209e-07,
 			Signed: true,
+			Unit: "",
 			},
 		12: { 
+			Id: "ClockParameter1",
 			Name: "Clock Parameter 1",
 			BitLength: 11,
 			BitOffset: 184,
@@ -24673,8 +27658,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:9.53674e-07,
 			Signed: true,
+			Unit: "",
 			},
 		13: { 
+			Id: "ClockParameter2",
 			Name: "Clock Parameter 2",
 			BitLength: 11,
 			BitOffset: 195,
@@ -24683,8 +27670,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float64",
 			Resolution:3.63798e-12,
 			Signed: true,
+			Unit: "",
 			},
 		14: { 
+			Id: "XxReserved14",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 206,
@@ -24693,6 +27682,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -24703,8 +27693,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeAisUtcAndDateReport,
+		Encoder: EncodeAisUtcAndDateReport,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "MessageId",
 			Name: "Message ID",
 			BitLength: 6,
 			BitOffset: 0,
@@ -24713,8 +27705,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AisMessageIdConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "RepeatIndicator",
 			Name: "Repeat Indicator",
 			BitLength: 2,
 			BitOffset: 6,
@@ -24723,8 +27717,10 @@ var pgnList = []PgnInfo{
 			GolangType:"RepeatIndicatorConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "UserId",
 			Name: "User ID",
 			BitLength: 32,
 			BitOffset: 8,
@@ -24733,8 +27729,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Longitude",
 			Name: "Longitude",
 			BitLength: 32,
 			BitOffset: 40,
@@ -24743,8 +27741,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float64",
 			Resolution:1e-07,
 			Signed: true,
+			Unit: "",
 			},
 		5: { 
+			Id: "Latitude",
 			Name: "Latitude",
 			BitLength: 32,
 			BitOffset: 72,
@@ -24753,8 +27753,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float64",
 			Resolution:1e-07,
 			Signed: true,
+			Unit: "",
 			},
 		6: { 
+			Id: "PositionAccuracy",
 			Name: "Position Accuracy",
 			BitLength: 1,
 			BitOffset: 104,
@@ -24763,8 +27765,10 @@ var pgnList = []PgnInfo{
 			GolangType:"PositionAccuracyConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Raim",
 			Name: "RAIM",
 			BitLength: 1,
 			BitOffset: 105,
@@ -24773,8 +27777,10 @@ var pgnList = []PgnInfo{
 			GolangType:"RaimFlagConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "XxReserved8",
 			Name: "Reserved",
 			BitLength: 6,
 			BitOffset: 106,
@@ -24783,8 +27789,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "PositionTime",
 			Name: "Position Time",
 			BitLength: 32,
 			BitOffset: 112,
@@ -24793,8 +27801,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "CommunicationState",
 			Name: "Communication State",
 			BitLength: 19,
 			BitOffset: 144,
@@ -24803,8 +27813,10 @@ var pgnList = []PgnInfo{
 			GolangType:"[]uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "AisTransceiverInformation",
 			Name: "AIS Transceiver information",
 			BitLength: 5,
 			BitOffset: 163,
@@ -24813,8 +27825,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AisTransceiverConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		12: { 
+			Id: "PositionDate",
 			Name: "Position Date",
 			BitLength: 16,
 			BitOffset: 168,
@@ -24823,8 +27837,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		13: { 
+			Id: "XxReserved13",
 			Name: "Reserved",
 			BitLength: 4,
 			BitOffset: 184,
@@ -24833,8 +27849,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		14: { 
+			Id: "GnssType",
 			Name: "GNSS type",
 			BitLength: 4,
 			BitOffset: 188,
@@ -24843,8 +27861,10 @@ var pgnList = []PgnInfo{
 			GolangType:"PositionFixDeviceConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		15: { 
+			Id: "XxReserved15",
 			Name: "Spare",
 			BitLength: 8,
 			BitOffset: 192,
@@ -24853,6 +27873,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -24863,8 +27884,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeAisClassAStaticAndVoyageRelatedData,
+		Encoder: EncodeAisClassAStaticAndVoyageRelatedData,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "MessageId",
 			Name: "Message ID",
 			BitLength: 6,
 			BitOffset: 0,
@@ -24873,8 +27896,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AisMessageIdConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "RepeatIndicator",
 			Name: "Repeat Indicator",
 			BitLength: 2,
 			BitOffset: 6,
@@ -24883,8 +27908,10 @@ var pgnList = []PgnInfo{
 			GolangType:"RepeatIndicatorConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "UserId",
 			Name: "User ID",
 			BitLength: 32,
 			BitOffset: 8,
@@ -24893,8 +27920,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "ImoNumber",
 			Name: "IMO number",
 			BitLength: 32,
 			BitOffset: 40,
@@ -24903,8 +27932,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "Callsign",
 			Name: "Callsign",
 			BitLength: 56,
 			BitOffset: 72,
@@ -24913,8 +27944,10 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Name",
 			Name: "Name",
 			BitLength: 160,
 			BitOffset: 128,
@@ -24923,8 +27956,10 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "TypeOfShip",
 			Name: "Type of ship",
 			BitLength: 8,
 			BitOffset: 288,
@@ -24933,8 +27968,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ShipTypeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "Length",
 			Name: "Length",
 			BitLength: 16,
 			BitOffset: 296,
@@ -24943,8 +27980,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Distance",
 			Resolution:0.1,
 			Signed: false,
+			Unit: "m",
 			},
 		9: { 
+			Id: "Beam",
 			Name: "Beam",
 			BitLength: 16,
 			BitOffset: 312,
@@ -24953,8 +27992,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Distance",
 			Resolution:0.1,
 			Signed: false,
+			Unit: "m",
 			},
 		10: { 
+			Id: "PositionReferenceFromStarboard",
 			Name: "Position reference from Starboard",
 			BitLength: 16,
 			BitOffset: 328,
@@ -24963,8 +28004,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Distance",
 			Resolution:0.1,
 			Signed: false,
+			Unit: "m",
 			},
 		11: { 
+			Id: "PositionReferenceFromBow",
 			Name: "Position reference from Bow",
 			BitLength: 16,
 			BitOffset: 344,
@@ -24973,8 +28016,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Distance",
 			Resolution:0.1,
 			Signed: false,
+			Unit: "m",
 			},
 		12: { 
+			Id: "EtaDate",
 			Name: "ETA Date",
 			BitLength: 16,
 			BitOffset: 360,
@@ -24983,8 +28028,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		13: { 
+			Id: "EtaTime",
 			Name: "ETA Time",
 			BitLength: 32,
 			BitOffset: 376,
@@ -24993,8 +28040,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: false,
+			Unit: "",
 			},
 		14: { 
+			Id: "Draft",
 			Name: "Draft",
 			BitLength: 16,
 			BitOffset: 408,
@@ -25003,8 +28052,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Distance",
 			Resolution:0.01,
 			Signed: false,
+			Unit: "m",
 			},
 		15: { 
+			Id: "Destination",
 			Name: "Destination",
 			BitLength: 160,
 			BitOffset: 424,
@@ -25013,8 +28064,10 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		16: { 
+			Id: "AisVersionIndicator",
 			Name: "AIS version indicator",
 			BitLength: 2,
 			BitOffset: 584,
@@ -25023,8 +28076,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AisVersionConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		17: { 
+			Id: "GnssType",
 			Name: "GNSS type",
 			BitLength: 4,
 			BitOffset: 586,
@@ -25033,8 +28088,10 @@ var pgnList = []PgnInfo{
 			GolangType:"PositionFixDeviceConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		18: { 
+			Id: "Dte",
 			Name: "DTE",
 			BitLength: 1,
 			BitOffset: 590,
@@ -25043,8 +28100,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AvailableConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		19: { 
+			Id: "XxReserved19",
 			Name: "Reserved",
 			BitLength: 1,
 			BitOffset: 591,
@@ -25053,8 +28112,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		20: { 
+			Id: "AisTransceiverInformation",
 			Name: "AIS Transceiver information",
 			BitLength: 5,
 			BitOffset: 592,
@@ -25063,8 +28124,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AisTransceiverConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		21: { 
+			Id: "XxReserved21",
 			Name: "Reserved",
 			BitLength: 3,
 			BitOffset: 597,
@@ -25073,6 +28136,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -25083,8 +28147,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeAisAddressedBinaryMessage,
+		Encoder: EncodeAisAddressedBinaryMessage,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "MessageId",
 			Name: "Message ID",
 			BitLength: 6,
 			BitOffset: 0,
@@ -25093,8 +28159,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AisMessageIdConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "RepeatIndicator",
 			Name: "Repeat Indicator",
 			BitLength: 2,
 			BitOffset: 6,
@@ -25103,8 +28171,10 @@ var pgnList = []PgnInfo{
 			GolangType:"RepeatIndicatorConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "SourceId",
 			Name: "Source ID",
 			BitLength: 32,
 			BitOffset: 8,
@@ -25113,8 +28183,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 1,
 			BitOffset: 40,
@@ -25123,8 +28195,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "AisTransceiverInformation",
 			Name: "AIS Transceiver information",
 			BitLength: 5,
 			BitOffset: 41,
@@ -25133,8 +28207,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AisTransceiverConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "SequenceNumber",
 			Name: "Sequence Number",
 			BitLength: 2,
 			BitOffset: 46,
@@ -25143,8 +28219,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "DestinationId",
 			Name: "Destination ID",
 			BitLength: 32,
 			BitOffset: 48,
@@ -25153,8 +28231,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "XxReserved8",
 			Name: "Reserved",
 			BitLength: 6,
 			BitOffset: 80,
@@ -25163,8 +28243,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "RetransmitFlag",
 			Name: "Retransmit flag",
 			BitLength: 1,
 			BitOffset: 86,
@@ -25173,8 +28255,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "XxReserved10",
 			Name: "Reserved",
 			BitLength: 1,
 			BitOffset: 87,
@@ -25183,8 +28267,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "NumberOfBitsInBinaryDataField",
 			Name: "Number of Bits in Binary Data Field",
 			BitLength: 16,
 			BitOffset: 88,
@@ -25193,8 +28279,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		12: { 
+			Id: "BinaryData",
 			Name: "Binary Data",
 			BitLength: 0,
 			BitOffset: 0,
@@ -25203,6 +28291,7 @@ var pgnList = []PgnInfo{
 			GolangType:"[]uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -25213,8 +28302,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeAisAcknowledge,
+		Encoder: EncodeAisAcknowledge,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "MessageId",
 			Name: "Message ID",
 			BitLength: 6,
 			BitOffset: 0,
@@ -25223,8 +28314,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AisMessageIdConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "RepeatIndicator",
 			Name: "Repeat Indicator",
 			BitLength: 2,
 			BitOffset: 6,
@@ -25233,8 +28326,10 @@ var pgnList = []PgnInfo{
 			GolangType:"RepeatIndicatorConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "SourceId",
 			Name: "Source ID",
 			BitLength: 32,
 			BitOffset: 8,
@@ -25243,8 +28338,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 1,
 			BitOffset: 40,
@@ -25253,8 +28350,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "AisTransceiverInformation",
 			Name: "AIS Transceiver information",
 			BitLength: 5,
 			BitOffset: 41,
@@ -25263,8 +28362,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AisTransceiverConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "XxReserved6",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 46,
@@ -25273,8 +28374,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "DestinationId1",
 			Name: "Destination ID #1",
 			BitLength: 32,
 			BitOffset: 48,
@@ -25283,8 +28386,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "SequenceNumberForId1",
 			Name: "Sequence Number for ID 1",
 			BitLength: 2,
 			BitOffset: 80,
@@ -25293,8 +28398,10 @@ var pgnList = []PgnInfo{
 			GolangType:"[]uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "XxReserved9",
 			Name: "Reserved",
 			BitLength: 6,
 			BitOffset: 82,
@@ -25303,8 +28410,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "SequenceNumberForIdN",
 			Name: "Sequence Number for ID n",
 			BitLength: 2,
 			BitOffset: 88,
@@ -25313,8 +28422,10 @@ var pgnList = []PgnInfo{
 			GolangType:"[]uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "XxReserved11",
 			Name: "Reserved",
 			BitLength: 6,
 			BitOffset: 90,
@@ -25323,6 +28434,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -25333,8 +28445,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeAisBinaryBroadcastMessage,
+		Encoder: EncodeAisBinaryBroadcastMessage,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "MessageId",
 			Name: "Message ID",
 			BitLength: 6,
 			BitOffset: 0,
@@ -25343,8 +28457,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AisMessageIdConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "RepeatIndicator",
 			Name: "Repeat Indicator",
 			BitLength: 2,
 			BitOffset: 6,
@@ -25353,8 +28469,10 @@ var pgnList = []PgnInfo{
 			GolangType:"RepeatIndicatorConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "SourceId",
 			Name: "Source ID",
 			BitLength: 32,
 			BitOffset: 8,
@@ -25363,8 +28481,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 1,
 			BitOffset: 40,
@@ -25373,8 +28493,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "AisTransceiverInformation",
 			Name: "AIS Transceiver information",
 			BitLength: 5,
 			BitOffset: 41,
@@ -25383,8 +28505,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AisTransceiverConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "XxReserved6",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 46,
@@ -25393,8 +28517,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "NumberOfBitsInBinaryDataField",
 			Name: "Number of Bits in Binary Data Field",
 			BitLength: 16,
 			BitOffset: 48,
@@ -25403,8 +28529,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "BinaryData",
 			Name: "Binary Data",
 			BitLength: 0,
 			BitOffset: 0,
@@ -25413,6 +28541,7 @@ var pgnList = []PgnInfo{
 			GolangType:"[]uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -25423,8 +28552,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeRadioFrequencyModePower,
+		Encoder: EncodeRadioFrequencyModePower,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "RxFrequency",
 			Name: "Rx Frequency",
 			BitLength: 32,
 			BitOffset: 0,
@@ -25433,8 +28564,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:10,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "TxFrequency",
 			Name: "Tx Frequency",
 			BitLength: 32,
 			BitOffset: 32,
@@ -25443,8 +28576,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:10,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "RadioChannel",
 			Name: "Radio Channel",
 			BitLength: 48,
 			BitOffset: 64,
@@ -25453,8 +28588,10 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "TxPower",
 			Name: "Tx Power",
 			BitLength: 8,
 			BitOffset: 112,
@@ -25463,8 +28600,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "Mode",
 			Name: "Mode",
 			BitLength: 16,
 			BitOffset: 120,
@@ -25473,8 +28612,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "ChannelBandwidth",
 			Name: "Channel Bandwidth",
 			BitLength: 16,
 			BitOffset: 136,
@@ -25483,6 +28624,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -25493,8 +28635,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeAisUtcDateInquiry,
+		Encoder: EncodeAisUtcDateInquiry,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "MessageId",
 			Name: "Message ID",
 			BitLength: 6,
 			BitOffset: 0,
@@ -25503,8 +28647,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AisMessageIdConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "RepeatIndicator",
 			Name: "Repeat Indicator",
 			BitLength: 2,
 			BitOffset: 6,
@@ -25513,8 +28659,10 @@ var pgnList = []PgnInfo{
 			GolangType:"RepeatIndicatorConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "SourceId",
 			Name: "Source ID",
 			BitLength: 32,
 			BitOffset: 8,
@@ -25523,8 +28671,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "AisTransceiverInformation",
 			Name: "AIS Transceiver information",
 			BitLength: 5,
 			BitOffset: 40,
@@ -25533,8 +28683,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AisTransceiverConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "XxReserved5",
 			Name: "Reserved",
 			BitLength: 3,
 			BitOffset: 45,
@@ -25543,8 +28695,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "DestinationId",
 			Name: "Destination ID",
 			BitLength: 32,
 			BitOffset: 48,
@@ -25553,6 +28707,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -25563,8 +28718,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeAisAddressedSafetyRelatedMessage,
+		Encoder: EncodeAisAddressedSafetyRelatedMessage,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "MessageId",
 			Name: "Message ID",
 			BitLength: 6,
 			BitOffset: 0,
@@ -25573,8 +28730,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AisMessageIdConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "RepeatIndicator",
 			Name: "Repeat Indicator",
 			BitLength: 2,
 			BitOffset: 6,
@@ -25583,8 +28742,10 @@ var pgnList = []PgnInfo{
 			GolangType:"RepeatIndicatorConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "SourceId",
 			Name: "Source ID",
 			BitLength: 32,
 			BitOffset: 8,
@@ -25593,8 +28754,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "AisTransceiverInformation",
 			Name: "AIS Transceiver information",
 			BitLength: 5,
 			BitOffset: 40,
@@ -25603,8 +28766,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AisTransceiverConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "SequenceNumber",
 			Name: "Sequence Number",
 			BitLength: 2,
 			BitOffset: 45,
@@ -25613,8 +28778,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "XxReserved6",
 			Name: "Reserved",
 			BitLength: 1,
 			BitOffset: 47,
@@ -25623,8 +28790,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "DestinationId",
 			Name: "Destination ID",
 			BitLength: 32,
 			BitOffset: 48,
@@ -25633,8 +28802,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "RetransmitFlag",
 			Name: "Retransmit flag",
 			BitLength: 1,
 			BitOffset: 80,
@@ -25643,8 +28814,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "XxReserved9",
 			Name: "Reserved",
 			BitLength: 7,
 			BitOffset: 81,
@@ -25653,8 +28826,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "SafetyRelatedText",
 			Name: "Safety Related Text",
 			BitLength: 936,
 			BitOffset: 88,
@@ -25663,6 +28838,7 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -25673,8 +28849,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeAisSafetyRelatedBroadcastMessage,
+		Encoder: EncodeAisSafetyRelatedBroadcastMessage,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "MessageId",
 			Name: "Message ID",
 			BitLength: 6,
 			BitOffset: 0,
@@ -25683,8 +28861,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AisMessageIdConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "RepeatIndicator",
 			Name: "Repeat Indicator",
 			BitLength: 2,
 			BitOffset: 6,
@@ -25693,8 +28873,10 @@ var pgnList = []PgnInfo{
 			GolangType:"RepeatIndicatorConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "SourceId",
 			Name: "Source ID",
 			BitLength: 32,
 			BitOffset: 8,
@@ -25703,8 +28885,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "AisTransceiverInformation",
 			Name: "AIS Transceiver information",
 			BitLength: 5,
 			BitOffset: 40,
@@ -25713,8 +28897,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AisTransceiverConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "XxReserved5",
 			Name: "Reserved",
 			BitLength: 3,
 			BitOffset: 45,
@@ -25723,8 +28909,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "SafetyRelatedText",
 			Name: "Safety Related Text",
 			BitLength: 1296,
 			BitOffset: 48,
@@ -25733,6 +28921,7 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -25743,8 +28932,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeAisInterrogation,
+		Encoder: EncodeAisInterrogation,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "MessageId",
 			Name: "Message ID",
 			BitLength: 6,
 			BitOffset: 0,
@@ -25753,8 +28944,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AisMessageIdConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "RepeatIndicator",
 			Name: "Repeat Indicator",
 			BitLength: 2,
 			BitOffset: 6,
@@ -25763,8 +28956,10 @@ var pgnList = []PgnInfo{
 			GolangType:"RepeatIndicatorConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "SourceId",
 			Name: "Source ID",
 			BitLength: 32,
 			BitOffset: 8,
@@ -25773,8 +28968,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 1,
 			BitOffset: 40,
@@ -25783,8 +28980,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "AisTransceiverInformation",
 			Name: "AIS Transceiver information",
 			BitLength: 5,
 			BitOffset: 41,
@@ -25793,8 +28992,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AisTransceiverConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "XxReserved6",
 			Name: "Spare",
 			BitLength: 2,
 			BitOffset: 46,
@@ -25803,8 +29004,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "DestinationId1",
 			Name: "Destination ID 1",
 			BitLength: 32,
 			BitOffset: 48,
@@ -25813,8 +29016,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "MessageId11",
 			Name: "Message ID 1.1",
 			BitLength: 6,
 			BitOffset: 80,
@@ -25823,8 +29028,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AisMessageIdConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "SlotOffset11",
 			Name: "Slot Offset 1.1",
 			BitLength: 12,
 			BitOffset: 86,
@@ -25833,8 +29040,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "XxReserved10",
 			Name: "Spare",
 			BitLength: 2,
 			BitOffset: 98,
@@ -25843,8 +29052,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "MessageId12",
 			Name: "Message ID 1.2",
 			BitLength: 6,
 			BitOffset: 100,
@@ -25853,8 +29064,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AisMessageIdConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		12: { 
+			Id: "SlotOffset12",
 			Name: "Slot Offset 1.2",
 			BitLength: 12,
 			BitOffset: 106,
@@ -25863,8 +29076,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		13: { 
+			Id: "XxReserved13",
 			Name: "Spare",
 			BitLength: 2,
 			BitOffset: 118,
@@ -25873,8 +29088,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		14: { 
+			Id: "DestinationId2",
 			Name: "Destination ID 2",
 			BitLength: 32,
 			BitOffset: 120,
@@ -25883,8 +29100,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		15: { 
+			Id: "MessageId21",
 			Name: "Message ID 2.1",
 			BitLength: 6,
 			BitOffset: 152,
@@ -25893,8 +29112,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AisMessageIdConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		16: { 
+			Id: "SlotOffset21",
 			Name: "Slot Offset 2.1",
 			BitLength: 12,
 			BitOffset: 158,
@@ -25903,8 +29124,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		17: { 
+			Id: "XxReserved17",
 			Name: "Spare",
 			BitLength: 2,
 			BitOffset: 170,
@@ -25913,8 +29136,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		18: { 
+			Id: "XxReserved18",
 			Name: "Reserved",
 			BitLength: 4,
 			BitOffset: 172,
@@ -25923,8 +29148,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		19: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 176,
@@ -25933,6 +29160,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -25943,8 +29171,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeAisDataLinkManagementMessage,
+		Encoder: EncodeAisDataLinkManagementMessage,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "MessageId",
 			Name: "Message ID",
 			BitLength: 6,
 			BitOffset: 0,
@@ -25953,8 +29183,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AisMessageIdConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "RepeatIndicator",
 			Name: "Repeat Indicator",
 			BitLength: 2,
 			BitOffset: 6,
@@ -25963,8 +29195,10 @@ var pgnList = []PgnInfo{
 			GolangType:"RepeatIndicatorConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "SourceId",
 			Name: "Source ID",
 			BitLength: 32,
 			BitOffset: 8,
@@ -25973,8 +29207,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "AisTransceiverInformation",
 			Name: "AIS Transceiver information",
 			BitLength: 5,
 			BitOffset: 40,
@@ -25983,8 +29219,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AisTransceiverConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "XxReserved5",
 			Name: "Reserved",
 			BitLength: 3,
 			BitOffset: 45,
@@ -25993,8 +29231,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Offset",
 			Name: "Offset",
 			BitLength: 16,
 			BitOffset: 48,
@@ -26003,8 +29243,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "NumberOfSlots",
 			Name: "Number of Slots",
 			BitLength: 8,
 			BitOffset: 64,
@@ -26013,8 +29255,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "Timeout",
 			Name: "Timeout",
 			BitLength: 8,
 			BitOffset: 72,
@@ -26023,8 +29267,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "Increment",
 			Name: "Increment",
 			BitLength: 16,
 			BitOffset: 80,
@@ -26033,6 +29279,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -26043,8 +29290,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeAisChannelManagement,
+		Encoder: EncodeAisChannelManagement,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "MessageId",
 			Name: "Message ID",
 			BitLength: 6,
 			BitOffset: 0,
@@ -26053,8 +29302,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AisMessageIdConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "RepeatIndicator",
 			Name: "Repeat Indicator",
 			BitLength: 2,
 			BitOffset: 6,
@@ -26063,8 +29314,10 @@ var pgnList = []PgnInfo{
 			GolangType:"RepeatIndicatorConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "SourceId",
 			Name: "Source ID",
 			BitLength: 32,
 			BitOffset: 8,
@@ -26073,8 +29326,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "AisTransceiverInformation",
 			Name: "AIS Transceiver information",
 			BitLength: 5,
 			BitOffset: 40,
@@ -26083,8 +29338,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AisTransceiverConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "XxReserved5",
 			Name: "Reserved",
 			BitLength: 3,
 			BitOffset: 45,
@@ -26093,8 +29350,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "ChannelA",
 			Name: "Channel A",
 			BitLength: 7,
 			BitOffset: 48,
@@ -26103,8 +29362,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "ChannelB",
 			Name: "Channel B",
 			BitLength: 7,
 			BitOffset: 55,
@@ -26113,8 +29374,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "XxReserved8",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 62,
@@ -26123,8 +29386,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "Power",
 			Name: "Power",
 			BitLength: 8,
 			BitOffset: 64,
@@ -26133,8 +29398,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "TxRxMode",
 			Name: "Tx/Rx Mode",
 			BitLength: 8,
 			BitOffset: 72,
@@ -26143,8 +29410,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "NorthEastLongitudeCorner1",
 			Name: "North East Longitude Corner 1",
 			BitLength: 32,
 			BitOffset: 80,
@@ -26153,8 +29422,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float64",
 			Resolution:1e-07,
 			Signed: true,
+			Unit: "",
 			},
 		12: { 
+			Id: "NorthEastLatitudeCorner1",
 			Name: "North East Latitude Corner 1",
 			BitLength: 32,
 			BitOffset: 112,
@@ -26163,8 +29434,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float64",
 			Resolution:1e-07,
 			Signed: true,
+			Unit: "",
 			},
 		13: { 
+			Id: "SouthWestLongitudeCorner1",
 			Name: "South West Longitude Corner 1",
 			BitLength: 32,
 			BitOffset: 144,
@@ -26173,8 +29446,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float64",
 			Resolution:1e-07,
 			Signed: true,
+			Unit: "",
 			},
 		14: { 
+			Id: "SouthWestLatitudeCorner2",
 			Name: "South West Latitude Corner 2",
 			BitLength: 32,
 			BitOffset: 176,
@@ -26183,8 +29458,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float64",
 			Resolution:1e-07,
 			Signed: true,
+			Unit: "",
 			},
 		15: { 
+			Id: "XxReserved15",
 			Name: "Reserved",
 			BitLength: 6,
 			BitOffset: 208,
@@ -26193,8 +29470,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		16: { 
+			Id: "AddressedOrBroadcastMessageIndicator",
 			Name: "Addressed or Broadcast Message Indicator",
 			BitLength: 2,
 			BitOffset: 214,
@@ -26203,8 +29482,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		17: { 
+			Id: "ChannelABandwidth",
 			Name: "Channel A Bandwidth",
 			BitLength: 7,
 			BitOffset: 216,
@@ -26213,8 +29494,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		18: { 
+			Id: "ChannelBBandwidth",
 			Name: "Channel B Bandwidth",
 			BitLength: 7,
 			BitOffset: 223,
@@ -26223,8 +29506,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		19: { 
+			Id: "XxReserved19",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 230,
@@ -26233,8 +29518,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		20: { 
+			Id: "TransitionalZoneSize",
 			Name: "Transitional Zone Size",
 			BitLength: 8,
 			BitOffset: 232,
@@ -26243,6 +29530,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -26253,8 +29541,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeAisClassBStaticDataMsg24PartA,
+		Encoder: EncodeAisClassBStaticDataMsg24PartA,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "MessageId",
 			Name: "Message ID",
 			BitLength: 6,
 			BitOffset: 0,
@@ -26263,8 +29553,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AisMessageIdConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "RepeatIndicator",
 			Name: "Repeat Indicator",
 			BitLength: 2,
 			BitOffset: 6,
@@ -26273,8 +29565,10 @@ var pgnList = []PgnInfo{
 			GolangType:"RepeatIndicatorConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "UserId",
 			Name: "User ID",
 			BitLength: 32,
 			BitOffset: 8,
@@ -26283,8 +29577,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Name",
 			Name: "Name",
 			BitLength: 160,
 			BitOffset: 40,
@@ -26293,8 +29589,10 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "AisTransceiverInformation",
 			Name: "AIS Transceiver information",
 			BitLength: 5,
 			BitOffset: 200,
@@ -26303,8 +29601,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AisTransceiverConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "XxReserved6",
 			Name: "Reserved",
 			BitLength: 3,
 			BitOffset: 205,
@@ -26313,8 +29613,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "SequenceId",
 			Name: "Sequence ID",
 			BitLength: 8,
 			BitOffset: 208,
@@ -26323,6 +29625,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -26333,8 +29636,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeAisClassBStaticDataMsg24PartB,
+		Encoder: EncodeAisClassBStaticDataMsg24PartB,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "MessageId",
 			Name: "Message ID",
 			BitLength: 6,
 			BitOffset: 0,
@@ -26343,8 +29648,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AisMessageIdConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "RepeatIndicator",
 			Name: "Repeat Indicator",
 			BitLength: 2,
 			BitOffset: 6,
@@ -26353,8 +29660,10 @@ var pgnList = []PgnInfo{
 			GolangType:"RepeatIndicatorConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "UserId",
 			Name: "User ID",
 			BitLength: 32,
 			BitOffset: 8,
@@ -26363,8 +29672,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "TypeOfShip",
 			Name: "Type of ship",
 			BitLength: 8,
 			BitOffset: 40,
@@ -26373,8 +29684,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ShipTypeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "VendorId",
 			Name: "Vendor ID",
 			BitLength: 56,
 			BitOffset: 48,
@@ -26383,8 +29696,10 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Callsign",
 			Name: "Callsign",
 			BitLength: 56,
 			BitOffset: 104,
@@ -26393,8 +29708,10 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Length",
 			Name: "Length",
 			BitLength: 16,
 			BitOffset: 160,
@@ -26403,8 +29720,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Distance",
 			Resolution:0.1,
 			Signed: false,
+			Unit: "m",
 			},
 		8: { 
+			Id: "Beam",
 			Name: "Beam",
 			BitLength: 16,
 			BitOffset: 176,
@@ -26413,8 +29732,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Distance",
 			Resolution:0.1,
 			Signed: false,
+			Unit: "m",
 			},
 		9: { 
+			Id: "PositionReferenceFromStarboard",
 			Name: "Position reference from Starboard",
 			BitLength: 16,
 			BitOffset: 192,
@@ -26423,8 +29744,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Distance",
 			Resolution:0.1,
 			Signed: false,
+			Unit: "m",
 			},
 		10: { 
+			Id: "PositionReferenceFromBow",
 			Name: "Position reference from Bow",
 			BitLength: 16,
 			BitOffset: 208,
@@ -26433,8 +29756,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Distance",
 			Resolution:0.1,
 			Signed: false,
+			Unit: "m",
 			},
 		11: { 
+			Id: "MothershipUserId",
 			Name: "Mothership User ID",
 			BitLength: 32,
 			BitOffset: 224,
@@ -26443,8 +29768,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		12: { 
+			Id: "XxReserved12",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 256,
@@ -26453,8 +29780,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		13: { 
+			Id: "XxReserved13",
 			Name: "Spare",
 			BitLength: 6,
 			BitOffset: 258,
@@ -26463,8 +29792,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		14: { 
+			Id: "AisTransceiverInformation",
 			Name: "AIS Transceiver information",
 			BitLength: 5,
 			BitOffset: 264,
@@ -26473,8 +29804,10 @@ var pgnList = []PgnInfo{
 			GolangType:"AisTransceiverConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		15: { 
+			Id: "XxReserved15",
 			Name: "Reserved",
 			BitLength: 3,
 			BitOffset: 269,
@@ -26483,8 +29816,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		16: { 
+			Id: "SequenceId",
 			Name: "Sequence ID",
 			BitLength: 8,
 			BitOffset: 272,
@@ -26493,6 +29828,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -26503,8 +29839,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeWindData,
+		Encoder: EncodeWindData,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 0,
@@ -26513,8 +29851,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "WindSpeed",
 			Name: "Wind Speed",
 			BitLength: 16,
 			BitOffset: 8,
@@ -26523,8 +29863,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Velocity",
 			Resolution:0.01,
 			Signed: false,
+			Unit: "m/s",
 			},
 		3: { 
+			Id: "WindAngle",
 			Name: "Wind Angle",
 			BitLength: 16,
 			BitOffset: 24,
@@ -26533,8 +29875,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Reference",
 			Name: "Reference",
 			BitLength: 3,
 			BitOffset: 40,
@@ -26543,8 +29887,10 @@ var pgnList = []PgnInfo{
 			GolangType:"WindReferenceConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "XxReserved5",
 			Name: "Reserved",
 			BitLength: 21,
 			BitOffset: 43,
@@ -26553,6 +29899,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -26563,8 +29910,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeEnvironmentalParametersObsolete,
+		Encoder: EncodeEnvironmentalParametersObsolete,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 0,
@@ -26573,8 +29922,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "WaterTemperature",
 			Name: "Water Temperature",
 			BitLength: 16,
 			BitOffset: 8,
@@ -26583,8 +29934,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Temperature",
 			Resolution:0.01,
 			Signed: false,
+			Unit: "K",
 			},
 		3: { 
+			Id: "OutsideAmbientAirTemperature",
 			Name: "Outside Ambient Air Temperature",
 			BitLength: 16,
 			BitOffset: 24,
@@ -26593,8 +29946,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Temperature",
 			Resolution:0.01,
 			Signed: false,
+			Unit: "K",
 			},
 		4: { 
+			Id: "AtmosphericPressure",
 			Name: "Atmospheric Pressure",
 			BitLength: 16,
 			BitOffset: 40,
@@ -26603,8 +29958,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Pressure",
 			Resolution:100,
 			Signed: false,
+			Unit: "Pa",
 			},
 		5: { 
+			Id: "XxReserved5",
 			Name: "Reserved",
 			BitLength: 8,
 			BitOffset: 56,
@@ -26613,6 +29970,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -26623,8 +29981,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeEnvironmentalParameters,
+		Encoder: EncodeEnvironmentalParameters,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 0,
@@ -26633,8 +29993,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "TemperatureSource",
 			Name: "Temperature Source",
 			BitLength: 6,
 			BitOffset: 8,
@@ -26643,8 +30005,10 @@ var pgnList = []PgnInfo{
 			GolangType:"TemperatureSourceConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "HumiditySource",
 			Name: "Humidity Source",
 			BitLength: 2,
 			BitOffset: 14,
@@ -26653,8 +30017,10 @@ var pgnList = []PgnInfo{
 			GolangType:"HumiditySourceConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Temperature",
 			Name: "Temperature",
 			BitLength: 16,
 			BitOffset: 16,
@@ -26663,8 +30029,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Temperature",
 			Resolution:0.01,
 			Signed: false,
+			Unit: "K",
 			},
 		5: { 
+			Id: "Humidity",
 			Name: "Humidity",
 			BitLength: 16,
 			BitOffset: 32,
@@ -26673,8 +30041,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.004,
 			Signed: true,
+			Unit: "",
 			},
 		6: { 
+			Id: "AtmosphericPressure",
 			Name: "Atmospheric Pressure",
 			BitLength: 16,
 			BitOffset: 48,
@@ -26683,6 +30053,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Pressure",
 			Resolution:100,
 			Signed: false,
+			Unit: "Pa",
 			},
 		},
 	},
@@ -26693,8 +30064,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeTemperature,
+		Encoder: EncodeTemperature,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 0,
@@ -26703,8 +30076,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "Instance",
 			Name: "Instance",
 			BitLength: 8,
 			BitOffset: 8,
@@ -26713,8 +30088,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "Source",
 			Name: "Source",
 			BitLength: 8,
 			BitOffset: 16,
@@ -26723,8 +30100,10 @@ var pgnList = []PgnInfo{
 			GolangType:"TemperatureSourceConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "ActualTemperature",
 			Name: "Actual Temperature",
 			BitLength: 16,
 			BitOffset: 24,
@@ -26733,8 +30112,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Temperature",
 			Resolution:0.01,
 			Signed: false,
+			Unit: "K",
 			},
 		5: { 
+			Id: "SetTemperature",
 			Name: "Set Temperature",
 			BitLength: 16,
 			BitOffset: 40,
@@ -26743,8 +30124,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Temperature",
 			Resolution:0.01,
 			Signed: false,
+			Unit: "K",
 			},
 		6: { 
+			Id: "XxReserved6",
 			Name: "Reserved",
 			BitLength: 8,
 			BitOffset: 56,
@@ -26753,6 +30136,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -26763,8 +30147,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeHumidity,
+		Encoder: EncodeHumidity,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 0,
@@ -26773,8 +30159,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "Instance",
 			Name: "Instance",
 			BitLength: 8,
 			BitOffset: 8,
@@ -26783,8 +30171,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "Source",
 			Name: "Source",
 			BitLength: 8,
 			BitOffset: 16,
@@ -26793,8 +30183,10 @@ var pgnList = []PgnInfo{
 			GolangType:"HumiditySourceConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "ActualHumidity",
 			Name: "Actual Humidity",
 			BitLength: 16,
 			BitOffset: 24,
@@ -26803,8 +30195,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.004,
 			Signed: true,
+			Unit: "",
 			},
 		5: { 
+			Id: "SetHumidity",
 			Name: "Set Humidity",
 			BitLength: 16,
 			BitOffset: 40,
@@ -26813,8 +30207,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.004,
 			Signed: true,
+			Unit: "",
 			},
 		6: { 
+			Id: "XxReserved6",
 			Name: "Reserved",
 			BitLength: 8,
 			BitOffset: 56,
@@ -26823,6 +30219,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -26833,8 +30230,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeActualPressure,
+		Encoder: EncodeActualPressure,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 0,
@@ -26843,8 +30242,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "Instance",
 			Name: "Instance",
 			BitLength: 8,
 			BitOffset: 8,
@@ -26853,8 +30254,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "Source",
 			Name: "Source",
 			BitLength: 8,
 			BitOffset: 16,
@@ -26863,8 +30266,10 @@ var pgnList = []PgnInfo{
 			GolangType:"PressureSourceConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Pressure",
 			Name: "Pressure",
 			BitLength: 32,
 			BitOffset: 24,
@@ -26873,8 +30278,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Pressure",
 			Resolution:0.1,
 			Signed: true,
+			Unit: "Pa",
 			},
 		5: { 
+			Id: "XxReserved5",
 			Name: "Reserved",
 			BitLength: 8,
 			BitOffset: 56,
@@ -26883,6 +30290,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -26893,8 +30301,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeSetPressure,
+		Encoder: EncodeSetPressure,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 0,
@@ -26903,8 +30313,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "Instance",
 			Name: "Instance",
 			BitLength: 8,
 			BitOffset: 8,
@@ -26913,8 +30325,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "Source",
 			Name: "Source",
 			BitLength: 8,
 			BitOffset: 16,
@@ -26923,8 +30337,10 @@ var pgnList = []PgnInfo{
 			GolangType:"PressureSourceConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Pressure",
 			Name: "Pressure",
 			BitLength: 32,
 			BitOffset: 24,
@@ -26933,8 +30349,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Pressure",
 			Resolution:0.1,
 			Signed: false,
+			Unit: "Pa",
 			},
 		5: { 
+			Id: "XxReserved5",
 			Name: "Reserved",
 			BitLength: 8,
 			BitOffset: 56,
@@ -26943,6 +30361,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -26953,8 +30372,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeTemperatureExtendedRange,
+		Encoder: EncodeTemperatureExtendedRange,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 0,
@@ -26963,8 +30384,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "Instance",
 			Name: "Instance",
 			BitLength: 8,
 			BitOffset: 8,
@@ -26973,8 +30396,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "Source",
 			Name: "Source",
 			BitLength: 8,
 			BitOffset: 16,
@@ -26983,8 +30408,10 @@ var pgnList = []PgnInfo{
 			GolangType:"TemperatureSourceConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Temperature",
 			Name: "Temperature",
 			BitLength: 24,
 			BitOffset: 24,
@@ -26993,8 +30420,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Temperature",
 			Resolution:0.001,
 			Signed: false,
+			Unit: "K",
 			},
 		5: { 
+			Id: "SetTemperature",
 			Name: "Set Temperature",
 			BitLength: 16,
 			BitOffset: 48,
@@ -27003,6 +30432,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Temperature",
 			Resolution:0.1,
 			Signed: false,
+			Unit: "K",
 			},
 		},
 	},
@@ -27013,8 +30443,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeTideStationData,
+		Encoder: EncodeTideStationData,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Mode",
 			Name: "Mode",
 			BitLength: 4,
 			BitOffset: 0,
@@ -27023,8 +30455,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ResidualModeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "TideTendency",
 			Name: "Tide Tendency",
 			BitLength: 2,
 			BitOffset: 4,
@@ -27033,8 +30467,10 @@ var pgnList = []PgnInfo{
 			GolangType:"TideConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "XxReserved3",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 6,
@@ -27043,8 +30479,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "MeasurementDate",
 			Name: "Measurement Date",
 			BitLength: 16,
 			BitOffset: 8,
@@ -27053,8 +30491,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "MeasurementTime",
 			Name: "Measurement Time",
 			BitLength: 32,
 			BitOffset: 24,
@@ -27063,8 +30503,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "StationLatitude",
 			Name: "Station Latitude",
 			BitLength: 32,
 			BitOffset: 56,
@@ -27073,8 +30515,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float64",
 			Resolution:1e-07,
 			Signed: true,
+			Unit: "",
 			},
 		7: { 
+			Id: "StationLongitude",
 			Name: "Station Longitude",
 			BitLength: 32,
 			BitOffset: 88,
@@ -27083,8 +30527,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float64",
 			Resolution:1e-07,
 			Signed: true,
+			Unit: "",
 			},
 		8: { 
+			Id: "TideLevel",
 			Name: "Tide Level",
 			BitLength: 16,
 			BitOffset: 120,
@@ -27093,8 +30539,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Distance",
 			Resolution:0.001,
 			Signed: true,
+			Unit: "m",
 			},
 		9: { 
+			Id: "TideLevelStandardDeviation",
 			Name: "Tide Level standard deviation",
 			BitLength: 16,
 			BitOffset: 136,
@@ -27103,8 +30551,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Distance",
 			Resolution:0.01,
 			Signed: false,
+			Unit: "m",
 			},
 		10: { 
+			Id: "StationId",
 			Name: "Station ID",
 			BitLength: 0,
 			BitOffset: 0,
@@ -27113,8 +30563,10 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "StationName",
 			Name: "Station Name",
 			BitLength: 0,
 			BitOffset: 0,
@@ -27123,6 +30575,7 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -27133,8 +30586,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeSalinityStationData,
+		Encoder: EncodeSalinityStationData,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "Mode",
 			Name: "Mode",
 			BitLength: 4,
 			BitOffset: 0,
@@ -27143,8 +30598,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ResidualModeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 4,
 			BitOffset: 4,
@@ -27153,8 +30610,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "MeasurementDate",
 			Name: "Measurement Date",
 			BitLength: 16,
 			BitOffset: 8,
@@ -27163,8 +30622,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "MeasurementTime",
 			Name: "Measurement Time",
 			BitLength: 32,
 			BitOffset: 24,
@@ -27173,8 +30634,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "StationLatitude",
 			Name: "Station Latitude",
 			BitLength: 32,
 			BitOffset: 56,
@@ -27183,8 +30646,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float64",
 			Resolution:1e-07,
 			Signed: true,
+			Unit: "",
 			},
 		6: { 
+			Id: "StationLongitude",
 			Name: "Station Longitude",
 			BitLength: 32,
 			BitOffset: 88,
@@ -27193,8 +30658,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float64",
 			Resolution:1e-07,
 			Signed: true,
+			Unit: "",
 			},
 		7: { 
+			Id: "Salinity",
 			Name: "Salinity",
 			BitLength: 32,
 			BitOffset: 120,
@@ -27203,8 +30670,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		8: { 
+			Id: "WaterTemperature",
 			Name: "Water Temperature",
 			BitLength: 16,
 			BitOffset: 152,
@@ -27213,8 +30682,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Temperature",
 			Resolution:0.01,
 			Signed: false,
+			Unit: "K",
 			},
 		9: { 
+			Id: "StationId",
 			Name: "Station ID",
 			BitLength: 0,
 			BitOffset: 0,
@@ -27223,8 +30694,10 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "StationName",
 			Name: "Station Name",
 			BitLength: 0,
 			BitOffset: 0,
@@ -27233,6 +30706,7 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -27243,8 +30717,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeWatermakerInputSettingAndStatus,
+		Encoder: EncodeWatermakerInputSettingAndStatus,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "WatermakerOperatingState",
 			Name: "Watermaker Operating State",
 			BitLength: 6,
 			BitOffset: 0,
@@ -27253,8 +30729,10 @@ var pgnList = []PgnInfo{
 			GolangType:"WatermakerStateConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "ProductionStartStop",
 			Name: "Production Start/Stop",
 			BitLength: 2,
 			BitOffset: 6,
@@ -27263,8 +30741,10 @@ var pgnList = []PgnInfo{
 			GolangType:"YesNoConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "RinseStartStop",
 			Name: "Rinse Start/Stop",
 			BitLength: 2,
 			BitOffset: 8,
@@ -27273,8 +30753,10 @@ var pgnList = []PgnInfo{
 			GolangType:"YesNoConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "LowPressurePumpStatus",
 			Name: "Low Pressure Pump Status",
 			BitLength: 2,
 			BitOffset: 10,
@@ -27283,8 +30765,10 @@ var pgnList = []PgnInfo{
 			GolangType:"YesNoConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "HighPressurePumpStatus",
 			Name: "High Pressure Pump Status",
 			BitLength: 2,
 			BitOffset: 12,
@@ -27293,8 +30777,10 @@ var pgnList = []PgnInfo{
 			GolangType:"YesNoConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "EmergencyStop",
 			Name: "Emergency Stop",
 			BitLength: 2,
 			BitOffset: 14,
@@ -27303,8 +30789,10 @@ var pgnList = []PgnInfo{
 			GolangType:"YesNoConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "ProductSolenoidValveStatus",
 			Name: "Product Solenoid Valve Status",
 			BitLength: 2,
 			BitOffset: 16,
@@ -27313,8 +30801,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OkWarningConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "FlushModeStatus",
 			Name: "Flush Mode Status",
 			BitLength: 2,
 			BitOffset: 18,
@@ -27323,8 +30813,10 @@ var pgnList = []PgnInfo{
 			GolangType:"YesNoConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "SalinityStatus",
 			Name: "Salinity Status",
 			BitLength: 2,
 			BitOffset: 20,
@@ -27333,8 +30825,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OkWarningConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "SensorStatus",
 			Name: "Sensor Status",
 			BitLength: 2,
 			BitOffset: 22,
@@ -27343,8 +30837,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OkWarningConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "OilChangeIndicatorStatus",
 			Name: "Oil Change Indicator Status",
 			BitLength: 2,
 			BitOffset: 24,
@@ -27353,8 +30849,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OkWarningConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		12: { 
+			Id: "FilterStatus",
 			Name: "Filter Status",
 			BitLength: 2,
 			BitOffset: 26,
@@ -27363,8 +30861,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OkWarningConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		13: { 
+			Id: "SystemStatus",
 			Name: "System Status",
 			BitLength: 2,
 			BitOffset: 28,
@@ -27373,8 +30873,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OkWarningConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		14: { 
+			Id: "XxReserved14",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 30,
@@ -27383,8 +30885,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		15: { 
+			Id: "Salinity",
 			Name: "Salinity",
 			BitLength: 16,
 			BitOffset: 32,
@@ -27393,8 +30897,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		16: { 
+			Id: "ProductWaterTemperature",
 			Name: "Product Water Temperature",
 			BitLength: 16,
 			BitOffset: 48,
@@ -27403,8 +30909,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Temperature",
 			Resolution:0.01,
 			Signed: false,
+			Unit: "K",
 			},
 		17: { 
+			Id: "PreFilterPressure",
 			Name: "Pre-filter Pressure",
 			BitLength: 16,
 			BitOffset: 64,
@@ -27413,8 +30921,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Pressure",
 			Resolution:100,
 			Signed: false,
+			Unit: "Pa",
 			},
 		18: { 
+			Id: "PostFilterPressure",
 			Name: "Post-filter Pressure",
 			BitLength: 16,
 			BitOffset: 80,
@@ -27423,8 +30933,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Pressure",
 			Resolution:100,
 			Signed: false,
+			Unit: "Pa",
 			},
 		19: { 
+			Id: "FeedPressure",
 			Name: "Feed Pressure",
 			BitLength: 16,
 			BitOffset: 96,
@@ -27433,8 +30945,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Pressure",
 			Resolution:1000,
 			Signed: true,
+			Unit: "Pa",
 			},
 		20: { 
+			Id: "SystemHighPressure",
 			Name: "System High Pressure",
 			BitLength: 16,
 			BitOffset: 112,
@@ -27443,8 +30957,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Pressure",
 			Resolution:1000,
 			Signed: false,
+			Unit: "Pa",
 			},
 		21: { 
+			Id: "ProductWaterFlow",
 			Name: "Product Water Flow",
 			BitLength: 16,
 			BitOffset: 128,
@@ -27453,8 +30969,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Flow",
 			Resolution:0.1,
 			Signed: true,
+			Unit: "L/h",
 			},
 		22: { 
+			Id: "BrineWaterFlow",
 			Name: "Brine Water Flow",
 			BitLength: 16,
 			BitOffset: 144,
@@ -27463,8 +30981,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Flow",
 			Resolution:0.1,
 			Signed: true,
+			Unit: "L/h",
 			},
 		23: { 
+			Id: "RunTime",
 			Name: "Run Time",
 			BitLength: 32,
 			BitOffset: 160,
@@ -27473,6 +30993,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -27483,8 +31004,10 @@ var pgnList = []PgnInfo{
 		Fast: false,
 		ManId: 0,
 		Decoder: DecodeSmallCraftStatus,
+		Encoder: EncodeSmallCraftStatus,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "PortTrimTab",
 			Name: "Port trim tab",
 			BitLength: 8,
 			BitOffset: 0,
@@ -27493,8 +31016,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*int8",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		2: { 
+			Id: "StarboardTrimTab",
 			Name: "Starboard trim tab",
 			BitLength: 8,
 			BitOffset: 8,
@@ -27503,8 +31028,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*int8",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		3: { 
+			Id: "XxReserved3",
 			Name: "Reserved",
 			BitLength: 48,
 			BitOffset: 16,
@@ -27513,6 +31040,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -27523,8 +31051,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 0,
 		Decoder: DecodeVesselSpeedComponents,
+		Encoder: EncodeVesselSpeedComponents,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "LongitudinalSpeedWaterReferenced",
 			Name: "Longitudinal Speed, Water-referenced",
 			BitLength: 16,
 			BitOffset: 0,
@@ -27533,8 +31063,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Velocity",
 			Resolution:0.001,
 			Signed: true,
+			Unit: "m/s",
 			},
 		2: { 
+			Id: "TransverseSpeedWaterReferenced",
 			Name: "Transverse Speed, Water-referenced",
 			BitLength: 16,
 			BitOffset: 16,
@@ -27543,8 +31075,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Velocity",
 			Resolution:0.001,
 			Signed: true,
+			Unit: "m/s",
 			},
 		3: { 
+			Id: "LongitudinalSpeedGroundReferenced",
 			Name: "Longitudinal Speed, Ground-referenced",
 			BitLength: 16,
 			BitOffset: 32,
@@ -27553,8 +31087,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Velocity",
 			Resolution:0.001,
 			Signed: true,
+			Unit: "m/s",
 			},
 		4: { 
+			Id: "TransverseSpeedGroundReferenced",
 			Name: "Transverse Speed, Ground-referenced",
 			BitLength: 16,
 			BitOffset: 48,
@@ -27563,8 +31099,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Velocity",
 			Resolution:0.001,
 			Signed: true,
+			Unit: "m/s",
 			},
 		5: { 
+			Id: "SternSpeedWaterReferenced",
 			Name: "Stern Speed, Water-referenced",
 			BitLength: 16,
 			BitOffset: 64,
@@ -27573,8 +31111,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Velocity",
 			Resolution:0.001,
 			Signed: true,
+			Unit: "m/s",
 			},
 		6: { 
+			Id: "SternSpeedGroundReferenced",
 			Name: "Stern Speed, Ground-referenced",
 			BitLength: 16,
 			BitOffset: 80,
@@ -27583,6 +31123,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Velocity",
 			Resolution:0.001,
 			Signed: true,
+			Unit: "m/s",
 			},
 		},
 	},
@@ -27593,8 +31134,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 275,
 		Decoder: DecodeSonichubZoneInfo,
+		Encoder: EncodeSonichubZoneInfo,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -27603,8 +31146,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -27613,8 +31158,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -27623,8 +31170,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 8,
 			BitOffset: 16,
@@ -27633,8 +31182,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "ProprietaryId",
 			Name: "Proprietary ID",
 			BitLength: 8,
 			BitOffset: 24,
@@ -27643,8 +31194,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SonichubCommandConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Control",
 			Name: "Control",
 			BitLength: 8,
 			BitOffset: 32,
@@ -27653,8 +31206,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SonichubControlConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Zone",
 			Name: "Zone",
 			BitLength: 8,
 			BitOffset: 40,
@@ -27663,6 +31218,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -27673,8 +31229,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 275,
 		Decoder: DecodeSonichubSource,
+		Encoder: EncodeSonichubSource,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -27683,8 +31241,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -27693,8 +31253,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -27703,8 +31265,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 8,
 			BitOffset: 16,
@@ -27713,8 +31277,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "ProprietaryId",
 			Name: "Proprietary ID",
 			BitLength: 8,
 			BitOffset: 24,
@@ -27723,8 +31289,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SonichubCommandConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Control",
 			Name: "Control",
 			BitLength: 8,
 			BitOffset: 32,
@@ -27733,8 +31301,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SonichubControlConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Source",
 			Name: "Source",
 			BitLength: 8,
 			BitOffset: 40,
@@ -27743,6 +31313,7 @@ var pgnList = []PgnInfo{
 			GolangType:"SonichubSourceConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -27753,8 +31324,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 275,
 		Decoder: DecodeSonichubSourceList,
+		Encoder: EncodeSonichubSourceList,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -27763,8 +31336,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -27773,8 +31348,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -27783,8 +31360,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 8,
 			BitOffset: 16,
@@ -27793,8 +31372,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "ProprietaryId",
 			Name: "Proprietary ID",
 			BitLength: 8,
 			BitOffset: 24,
@@ -27803,8 +31384,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SonichubCommandConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Control",
 			Name: "Control",
 			BitLength: 8,
 			BitOffset: 32,
@@ -27813,8 +31396,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SonichubControlConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "SourceId",
 			Name: "Source ID",
 			BitLength: 8,
 			BitOffset: 40,
@@ -27823,8 +31408,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "A",
 			Name: "A",
 			BitLength: 8,
 			BitOffset: 48,
@@ -27833,8 +31420,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "Text",
 			Name: "Text",
 			BitLength: 256,
 			BitOffset: 56,
@@ -27843,6 +31432,7 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -27853,8 +31443,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 275,
 		Decoder: DecodeSonichubControl,
+		Encoder: EncodeSonichubControl,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -27863,8 +31455,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -27873,8 +31467,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -27883,8 +31479,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 8,
 			BitOffset: 16,
@@ -27893,8 +31491,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "ProprietaryId",
 			Name: "Proprietary ID",
 			BitLength: 8,
 			BitOffset: 24,
@@ -27903,8 +31503,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SonichubCommandConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Control",
 			Name: "Control",
 			BitLength: 8,
 			BitOffset: 32,
@@ -27913,8 +31515,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SonichubControlConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Item",
 			Name: "Item",
 			BitLength: 8,
 			BitOffset: 40,
@@ -27923,6 +31527,7 @@ var pgnList = []PgnInfo{
 			GolangType:"FusionMuteCommandConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -27933,8 +31538,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 275,
 		Decoder: DecodeSonichubFmRadio,
+		Encoder: EncodeSonichubFmRadio,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -27943,8 +31550,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -27953,8 +31562,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -27963,8 +31574,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 8,
 			BitOffset: 16,
@@ -27973,8 +31586,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "ProprietaryId",
 			Name: "Proprietary ID",
 			BitLength: 8,
 			BitOffset: 24,
@@ -27983,8 +31598,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SonichubCommandConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Control",
 			Name: "Control",
 			BitLength: 8,
 			BitOffset: 32,
@@ -27993,8 +31610,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SonichubControlConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Item",
 			Name: "Item",
 			BitLength: 8,
 			BitOffset: 40,
@@ -28003,8 +31622,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SonichubTuningConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "Frequency",
 			Name: "Frequency",
 			BitLength: 32,
 			BitOffset: 48,
@@ -28013,8 +31634,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "NoiseLevel",
 			Name: "Noise level",
 			BitLength: 2,
 			BitOffset: 80,
@@ -28023,8 +31646,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "SignalLevel",
 			Name: "Signal level",
 			BitLength: 4,
 			BitOffset: 82,
@@ -28033,8 +31658,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "XxReserved11",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 86,
@@ -28043,8 +31670,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		12: { 
+			Id: "Text",
 			Name: "Text",
 			BitLength: 256,
 			BitOffset: 88,
@@ -28053,6 +31682,7 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -28063,8 +31693,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 275,
 		Decoder: DecodeSonichubPlaylist,
+		Encoder: EncodeSonichubPlaylist,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -28073,8 +31705,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -28083,8 +31717,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -28093,8 +31729,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 8,
 			BitOffset: 16,
@@ -28103,8 +31741,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "ProprietaryId",
 			Name: "Proprietary ID",
 			BitLength: 8,
 			BitOffset: 24,
@@ -28113,8 +31753,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SonichubCommandConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Control",
 			Name: "Control",
 			BitLength: 8,
 			BitOffset: 32,
@@ -28123,8 +31765,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SonichubControlConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Item",
 			Name: "Item",
 			BitLength: 8,
 			BitOffset: 40,
@@ -28133,8 +31777,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SonichubPlaylistConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "A",
 			Name: "A",
 			BitLength: 8,
 			BitOffset: 48,
@@ -28143,8 +31789,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "CurrentTrack",
 			Name: "Current Track",
 			BitLength: 32,
 			BitOffset: 56,
@@ -28153,8 +31801,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "Tracks",
 			Name: "Tracks",
 			BitLength: 32,
 			BitOffset: 88,
@@ -28163,8 +31813,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "Length",
 			Name: "Length",
 			BitLength: 32,
 			BitOffset: 120,
@@ -28173,8 +31825,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.001,
 			Signed: false,
+			Unit: "",
 			},
 		12: { 
+			Id: "PositionInTrack",
 			Name: "Position in track",
 			BitLength: 32,
 			BitOffset: 152,
@@ -28183,6 +31837,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.001,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -28193,8 +31848,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 275,
 		Decoder: DecodeSonichubTrack,
+		Encoder: EncodeSonichubTrack,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -28203,8 +31860,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -28213,8 +31872,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -28223,8 +31884,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 8,
 			BitOffset: 16,
@@ -28233,8 +31896,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "ProprietaryId",
 			Name: "Proprietary ID",
 			BitLength: 8,
 			BitOffset: 24,
@@ -28243,8 +31908,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SonichubCommandConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Control",
 			Name: "Control",
 			BitLength: 8,
 			BitOffset: 32,
@@ -28253,8 +31920,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SonichubControlConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Item",
 			Name: "Item",
 			BitLength: 32,
 			BitOffset: 40,
@@ -28263,8 +31932,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "Text",
 			Name: "Text",
 			BitLength: 256,
 			BitOffset: 72,
@@ -28273,6 +31944,7 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -28283,8 +31955,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 275,
 		Decoder: DecodeSonichubArtist,
+		Encoder: EncodeSonichubArtist,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -28293,8 +31967,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -28303,8 +31979,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -28313,8 +31991,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 8,
 			BitOffset: 16,
@@ -28323,8 +32003,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "ProprietaryId",
 			Name: "Proprietary ID",
 			BitLength: 8,
 			BitOffset: 24,
@@ -28333,8 +32015,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SonichubCommandConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Control",
 			Name: "Control",
 			BitLength: 8,
 			BitOffset: 32,
@@ -28343,8 +32027,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SonichubControlConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Item",
 			Name: "Item",
 			BitLength: 32,
 			BitOffset: 40,
@@ -28353,8 +32039,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "Text",
 			Name: "Text",
 			BitLength: 256,
 			BitOffset: 72,
@@ -28363,6 +32051,7 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -28373,8 +32062,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 275,
 		Decoder: DecodeSonichubAlbum,
+		Encoder: EncodeSonichubAlbum,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -28383,8 +32074,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -28393,8 +32086,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -28403,8 +32098,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 8,
 			BitOffset: 16,
@@ -28413,8 +32110,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "ProprietaryId",
 			Name: "Proprietary ID",
 			BitLength: 8,
 			BitOffset: 24,
@@ -28423,8 +32122,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SonichubCommandConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Control",
 			Name: "Control",
 			BitLength: 8,
 			BitOffset: 32,
@@ -28433,8 +32134,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SonichubControlConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Item",
 			Name: "Item",
 			BitLength: 32,
 			BitOffset: 40,
@@ -28443,8 +32146,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "Text",
 			Name: "Text",
 			BitLength: 256,
 			BitOffset: 72,
@@ -28453,6 +32158,7 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -28463,8 +32169,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 275,
 		Decoder: DecodeSonichubMenuItem,
+		Encoder: EncodeSonichubMenuItem,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -28473,8 +32181,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -28483,8 +32193,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -28493,8 +32205,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 8,
 			BitOffset: 16,
@@ -28503,8 +32217,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "ProprietaryId",
 			Name: "Proprietary ID",
 			BitLength: 8,
 			BitOffset: 24,
@@ -28513,8 +32229,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SonichubCommandConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Control",
 			Name: "Control",
 			BitLength: 8,
 			BitOffset: 32,
@@ -28523,8 +32241,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SonichubControlConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Item",
 			Name: "Item",
 			BitLength: 32,
 			BitOffset: 40,
@@ -28533,8 +32253,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "C",
 			Name: "C",
 			BitLength: 8,
 			BitOffset: 72,
@@ -28543,8 +32265,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "D",
 			Name: "D",
 			BitLength: 8,
 			BitOffset: 80,
@@ -28553,8 +32277,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "E",
 			Name: "E",
 			BitLength: 8,
 			BitOffset: 88,
@@ -28563,8 +32289,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "Text",
 			Name: "Text",
 			BitLength: 256,
 			BitOffset: 96,
@@ -28573,6 +32301,7 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -28583,8 +32312,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 275,
 		Decoder: DecodeSonichubZones,
+		Encoder: EncodeSonichubZones,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -28593,8 +32324,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -28603,8 +32336,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -28613,8 +32348,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 8,
 			BitOffset: 16,
@@ -28623,8 +32360,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "ProprietaryId",
 			Name: "Proprietary ID",
 			BitLength: 8,
 			BitOffset: 24,
@@ -28633,8 +32372,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SonichubCommandConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Control",
 			Name: "Control",
 			BitLength: 8,
 			BitOffset: 32,
@@ -28643,8 +32384,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SonichubControlConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Zones",
 			Name: "Zones",
 			BitLength: 8,
 			BitOffset: 40,
@@ -28653,6 +32396,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -28663,8 +32407,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 275,
 		Decoder: DecodeSonichubMaxVolume,
+		Encoder: EncodeSonichubMaxVolume,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -28673,8 +32419,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -28683,8 +32431,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -28693,8 +32443,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 8,
 			BitOffset: 16,
@@ -28703,8 +32455,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "ProprietaryId",
 			Name: "Proprietary ID",
 			BitLength: 8,
 			BitOffset: 24,
@@ -28713,8 +32467,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SonichubCommandConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Control",
 			Name: "Control",
 			BitLength: 8,
 			BitOffset: 32,
@@ -28723,8 +32479,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SonichubControlConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Zone",
 			Name: "Zone",
 			BitLength: 8,
 			BitOffset: 40,
@@ -28733,8 +32491,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "Level",
 			Name: "Level",
 			BitLength: 8,
 			BitOffset: 48,
@@ -28743,6 +32503,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -28753,8 +32514,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 275,
 		Decoder: DecodeSonichubVolume,
+		Encoder: EncodeSonichubVolume,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -28763,8 +32526,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -28773,8 +32538,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -28783,8 +32550,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 8,
 			BitOffset: 16,
@@ -28793,8 +32562,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "ProprietaryId",
 			Name: "Proprietary ID",
 			BitLength: 8,
 			BitOffset: 24,
@@ -28803,8 +32574,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SonichubCommandConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Control",
 			Name: "Control",
 			BitLength: 8,
 			BitOffset: 32,
@@ -28813,8 +32586,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SonichubControlConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Zone",
 			Name: "Zone",
 			BitLength: 8,
 			BitOffset: 40,
@@ -28823,8 +32598,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "Level",
 			Name: "Level",
 			BitLength: 8,
 			BitOffset: 48,
@@ -28833,6 +32610,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -28843,8 +32621,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 275,
 		Decoder: DecodeSonichubInit1,
+		Encoder: EncodeSonichubInit1,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -28853,8 +32633,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -28863,8 +32645,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -28873,8 +32657,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 8,
 			BitOffset: 16,
@@ -28883,8 +32669,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "ProprietaryId",
 			Name: "Proprietary ID",
 			BitLength: 8,
 			BitOffset: 24,
@@ -28893,8 +32681,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SonichubCommandConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Control",
 			Name: "Control",
 			BitLength: 8,
 			BitOffset: 32,
@@ -28903,6 +32693,7 @@ var pgnList = []PgnInfo{
 			GolangType:"SonichubControlConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -28913,8 +32704,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 275,
 		Decoder: DecodeSonichubPosition,
+		Encoder: EncodeSonichubPosition,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -28923,8 +32716,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -28933,8 +32728,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -28943,8 +32740,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 8,
 			BitOffset: 16,
@@ -28953,8 +32752,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "ProprietaryId",
 			Name: "Proprietary ID",
 			BitLength: 8,
 			BitOffset: 24,
@@ -28963,8 +32764,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SonichubCommandConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Control",
 			Name: "Control",
 			BitLength: 8,
 			BitOffset: 32,
@@ -28973,8 +32776,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SonichubControlConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Position",
 			Name: "Position",
 			BitLength: 32,
 			BitOffset: 40,
@@ -28983,6 +32788,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.001,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -28993,8 +32799,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 1857,
 		Decoder: DecodeSimradTextMessage,
+		Encoder: EncodeSimradTextMessage,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -29003,8 +32811,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -29013,8 +32823,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -29023,8 +32835,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "XxReserved4",
 			Name: "Reserved",
 			BitLength: 8,
 			BitOffset: 16,
@@ -29033,8 +32847,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "ProprietaryId",
 			Name: "Proprietary ID",
 			BitLength: 8,
 			BitOffset: 24,
@@ -29043,8 +32859,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SimnetCommandConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "A",
 			Name: "A",
 			BitLength: 8,
 			BitOffset: 32,
@@ -29053,8 +32871,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "B",
 			Name: "B",
 			BitLength: 8,
 			BitOffset: 40,
@@ -29063,8 +32883,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "C",
 			Name: "C",
 			BitLength: 8,
 			BitOffset: 48,
@@ -29073,8 +32895,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 56,
@@ -29083,8 +32907,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "Prio",
 			Name: "Prio",
 			BitLength: 8,
 			BitOffset: 64,
@@ -29093,8 +32919,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "Text",
 			Name: "Text",
 			BitLength: 256,
 			BitOffset: 72,
@@ -29103,6 +32931,7 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -29113,8 +32942,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 275,
 		Decoder: DecodeNavicoProductInformation,
+		Encoder: EncodeNavicoProductInformation,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -29123,8 +32954,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -29133,8 +32966,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -29143,8 +32978,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "ProductCode",
 			Name: "Product Code",
 			BitLength: 16,
 			BitOffset: 16,
@@ -29153,8 +32990,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "Model",
 			Name: "Model",
 			BitLength: 256,
 			BitOffset: 32,
@@ -29163,8 +33002,10 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "A",
 			Name: "A",
 			BitLength: 8,
 			BitOffset: 288,
@@ -29173,8 +33014,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "B",
 			Name: "B",
 			BitLength: 8,
 			BitOffset: 296,
@@ -29183,8 +33026,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "C",
 			Name: "C",
 			BitLength: 8,
 			BitOffset: 304,
@@ -29193,8 +33038,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "FirmwareVersion",
 			Name: "Firmware version",
 			BitLength: 80,
 			BitOffset: 312,
@@ -29203,8 +33050,10 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "FirmwareDate",
 			Name: "Firmware date",
 			BitLength: 256,
 			BitOffset: 392,
@@ -29213,8 +33062,10 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "FirmwareTime",
 			Name: "Firmware time",
 			BitLength: 256,
 			BitOffset: 648,
@@ -29223,6 +33074,7 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -29233,8 +33085,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 140,
 		Decoder: DecodeLowranceProductInformation,
+		Encoder: EncodeLowranceProductInformation,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -29243,8 +33097,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -29253,8 +33109,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -29263,8 +33121,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "ProductCode",
 			Name: "Product Code",
 			BitLength: 16,
 			BitOffset: 16,
@@ -29273,8 +33133,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "Model",
 			Name: "Model",
 			BitLength: 256,
 			BitOffset: 32,
@@ -29283,8 +33145,10 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "A",
 			Name: "A",
 			BitLength: 8,
 			BitOffset: 288,
@@ -29293,8 +33157,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "B",
 			Name: "B",
 			BitLength: 8,
 			BitOffset: 296,
@@ -29303,8 +33169,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "C",
 			Name: "C",
 			BitLength: 8,
 			BitOffset: 304,
@@ -29313,8 +33181,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "FirmwareVersion",
 			Name: "Firmware version",
 			BitLength: 80,
 			BitOffset: 312,
@@ -29323,8 +33193,10 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "FirmwareDate",
 			Name: "Firmware date",
 			BitLength: 256,
 			BitOffset: 392,
@@ -29333,8 +33205,10 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "FirmwareTime",
 			Name: "Firmware time",
 			BitLength: 256,
 			BitOffset: 648,
@@ -29343,6 +33217,7 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -29353,8 +33228,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 1857,
 		Decoder: DecodeSimnetReprogramData,
+		Encoder: EncodeSimnetReprogramData,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -29363,8 +33240,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -29373,8 +33252,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -29383,8 +33264,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Version",
 			Name: "Version",
 			BitLength: 16,
 			BitOffset: 16,
@@ -29393,8 +33276,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "Sequence",
 			Name: "Sequence",
 			BitLength: 16,
 			BitOffset: 32,
@@ -29403,8 +33288,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Data",
 			Name: "Data",
 			BitLength: 1736,
 			BitOffset: 48,
@@ -29413,6 +33300,7 @@ var pgnList = []PgnInfo{
 			GolangType:"[]uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -29423,8 +33311,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 1855,
 		Decoder: DecodeFurunoUnknown130820,
+		Encoder: EncodeFurunoUnknown130820,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -29433,8 +33323,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -29443,8 +33335,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -29453,8 +33347,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "A",
 			Name: "A",
 			BitLength: 8,
 			BitOffset: 16,
@@ -29463,8 +33359,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "B",
 			Name: "B",
 			BitLength: 8,
 			BitOffset: 24,
@@ -29473,8 +33371,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "C",
 			Name: "C",
 			BitLength: 8,
 			BitOffset: 32,
@@ -29483,8 +33383,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "D",
 			Name: "D",
 			BitLength: 8,
 			BitOffset: 40,
@@ -29493,8 +33395,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "E",
 			Name: "E",
 			BitLength: 8,
 			BitOffset: 48,
@@ -29503,6 +33407,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -29513,8 +33418,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 419,
 		Decoder: DecodeFusionSourceName,
+		Encoder: EncodeFusionSourceName,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -29523,8 +33430,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -29533,8 +33442,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -29543,8 +33454,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "MessageId",
 			Name: "Message ID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -29553,8 +33466,10 @@ var pgnList = []PgnInfo{
 			GolangType:"FusionMessageIdConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "A",
 			Name: "A",
 			BitLength: 8,
 			BitOffset: 24,
@@ -29563,8 +33478,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "SourceId",
 			Name: "Source ID",
 			BitLength: 8,
 			BitOffset: 32,
@@ -29573,8 +33490,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "CurrentSourceId",
 			Name: "Current Source ID",
 			BitLength: 8,
 			BitOffset: 40,
@@ -29583,8 +33502,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "D",
 			Name: "D",
 			BitLength: 8,
 			BitOffset: 48,
@@ -29593,8 +33514,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "E",
 			Name: "E",
 			BitLength: 8,
 			BitOffset: 56,
@@ -29603,8 +33526,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "Source",
 			Name: "Source",
 			BitLength: 40,
 			BitOffset: 64,
@@ -29613,6 +33538,7 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -29623,8 +33549,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 419,
 		Decoder: DecodeFusionTrackInfo,
+		Encoder: EncodeFusionTrackInfo,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -29633,8 +33561,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -29643,8 +33573,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -29653,8 +33585,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "MessageId",
 			Name: "Message ID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -29663,8 +33597,10 @@ var pgnList = []PgnInfo{
 			GolangType:"FusionMessageIdConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "A",
 			Name: "A",
 			BitLength: 16,
 			BitOffset: 24,
@@ -29673,8 +33609,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Transport",
 			Name: "Transport",
 			BitLength: 4,
 			BitOffset: 40,
@@ -29683,8 +33621,10 @@ var pgnList = []PgnInfo{
 			GolangType:"EntertainmentPlayStatusConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "X",
 			Name: "X",
 			BitLength: 4,
 			BitOffset: 44,
@@ -29693,8 +33633,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "B",
 			Name: "B",
 			BitLength: 8,
 			BitOffset: 48,
@@ -29703,8 +33645,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "Track",
 			Name: "Track #",
 			BitLength: 16,
 			BitOffset: 56,
@@ -29713,8 +33657,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "C",
 			Name: "C",
 			BitLength: 16,
 			BitOffset: 72,
@@ -29723,8 +33669,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "TrackCount",
 			Name: "Track Count",
 			BitLength: 16,
 			BitOffset: 88,
@@ -29733,8 +33681,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		12: { 
+			Id: "E",
 			Name: "E",
 			BitLength: 16,
 			BitOffset: 104,
@@ -29743,8 +33693,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		13: { 
+			Id: "Length",
 			Name: "Length",
 			BitLength: 24,
 			BitOffset: 120,
@@ -29753,8 +33705,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.001,
 			Signed: false,
+			Unit: "",
 			},
 		14: { 
+			Id: "PositionInTrack",
 			Name: "Position in track",
 			BitLength: 24,
 			BitOffset: 144,
@@ -29763,8 +33717,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.001,
 			Signed: false,
+			Unit: "",
 			},
 		15: { 
+			Id: "H",
 			Name: "H",
 			BitLength: 16,
 			BitOffset: 168,
@@ -29773,6 +33729,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -29783,8 +33740,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 419,
 		Decoder: DecodeFusionTrack,
+		Encoder: EncodeFusionTrack,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -29793,8 +33752,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -29803,8 +33764,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -29813,8 +33776,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "MessageId",
 			Name: "Message ID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -29823,8 +33788,10 @@ var pgnList = []PgnInfo{
 			GolangType:"FusionMessageIdConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "A",
 			Name: "A",
 			BitLength: 8,
 			BitOffset: 24,
@@ -29833,8 +33800,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "B",
 			Name: "B",
 			BitLength: 40,
 			BitOffset: 32,
@@ -29843,8 +33812,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint64",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Track",
 			Name: "Track",
 			BitLength: 80,
 			BitOffset: 72,
@@ -29853,6 +33824,7 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -29863,8 +33835,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 419,
 		Decoder: DecodeFusionArtist,
+		Encoder: EncodeFusionArtist,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -29873,8 +33847,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -29883,8 +33859,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -29893,8 +33871,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "MessageId",
 			Name: "Message ID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -29903,8 +33883,10 @@ var pgnList = []PgnInfo{
 			GolangType:"FusionMessageIdConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "A",
 			Name: "A",
 			BitLength: 8,
 			BitOffset: 24,
@@ -29913,8 +33895,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "B",
 			Name: "B",
 			BitLength: 40,
 			BitOffset: 32,
@@ -29923,8 +33907,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint64",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Artist",
 			Name: "Artist",
 			BitLength: 80,
 			BitOffset: 72,
@@ -29933,6 +33919,7 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -29943,8 +33930,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 419,
 		Decoder: DecodeFusionAlbum,
+		Encoder: EncodeFusionAlbum,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -29953,8 +33942,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -29963,8 +33954,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -29973,8 +33966,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "MessageId",
 			Name: "Message ID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -29983,8 +33978,10 @@ var pgnList = []PgnInfo{
 			GolangType:"FusionMessageIdConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "A",
 			Name: "A",
 			BitLength: 8,
 			BitOffset: 24,
@@ -29993,8 +33990,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "B",
 			Name: "B",
 			BitLength: 40,
 			BitOffset: 32,
@@ -30003,8 +34002,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint64",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Album",
 			Name: "Album",
 			BitLength: 80,
 			BitOffset: 72,
@@ -30013,6 +34014,7 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -30023,8 +34025,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 419,
 		Decoder: DecodeFusionUnitName,
+		Encoder: EncodeFusionUnitName,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -30033,8 +34037,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -30043,8 +34049,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -30053,8 +34061,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "MessageId",
 			Name: "Message ID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -30063,8 +34073,10 @@ var pgnList = []PgnInfo{
 			GolangType:"FusionMessageIdConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "A",
 			Name: "A",
 			BitLength: 8,
 			BitOffset: 24,
@@ -30073,8 +34085,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Name",
 			Name: "Name",
 			BitLength: 112,
 			BitOffset: 32,
@@ -30083,6 +34097,7 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -30093,8 +34108,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 419,
 		Decoder: DecodeFusionZoneName,
+		Encoder: EncodeFusionZoneName,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -30103,8 +34120,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -30113,8 +34132,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -30123,8 +34144,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "MessageId",
 			Name: "Message ID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -30133,8 +34156,10 @@ var pgnList = []PgnInfo{
 			GolangType:"FusionMessageIdConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "A",
 			Name: "A",
 			BitLength: 8,
 			BitOffset: 24,
@@ -30143,8 +34168,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Number",
 			Name: "Number",
 			BitLength: 8,
 			BitOffset: 32,
@@ -30153,8 +34180,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Name",
 			Name: "Name",
 			BitLength: 104,
 			BitOffset: 40,
@@ -30163,6 +34192,7 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -30173,8 +34203,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 419,
 		Decoder: DecodeFusionPlayProgress,
+		Encoder: EncodeFusionPlayProgress,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -30183,8 +34215,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -30193,8 +34227,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -30203,8 +34239,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "MessageId",
 			Name: "Message ID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -30213,8 +34251,10 @@ var pgnList = []PgnInfo{
 			GolangType:"FusionMessageIdConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "A",
 			Name: "A",
 			BitLength: 8,
 			BitOffset: 24,
@@ -30223,8 +34263,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "B",
 			Name: "B",
 			BitLength: 8,
 			BitOffset: 32,
@@ -30233,8 +34275,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Progress",
 			Name: "Progress",
 			BitLength: 24,
 			BitOffset: 40,
@@ -30243,6 +34287,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.001,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -30253,8 +34298,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 419,
 		Decoder: DecodeFusionAmFmStation,
+		Encoder: EncodeFusionAmFmStation,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -30263,8 +34310,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -30273,8 +34322,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -30283,8 +34334,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "MessageId",
 			Name: "Message ID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -30293,8 +34346,10 @@ var pgnList = []PgnInfo{
 			GolangType:"FusionMessageIdConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "A",
 			Name: "A",
 			BitLength: 8,
 			BitOffset: 24,
@@ -30303,8 +34358,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "AmFm",
 			Name: "AM/FM",
 			BitLength: 8,
 			BitOffset: 32,
@@ -30313,8 +34370,10 @@ var pgnList = []PgnInfo{
 			GolangType:"FusionRadioSourceConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "B",
 			Name: "B",
 			BitLength: 8,
 			BitOffset: 40,
@@ -30323,8 +34382,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "Frequency",
 			Name: "Frequency",
 			BitLength: 32,
 			BitOffset: 48,
@@ -30333,8 +34394,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "C",
 			Name: "C",
 			BitLength: 8,
 			BitOffset: 80,
@@ -30343,8 +34406,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "Track",
 			Name: "Track",
 			BitLength: 80,
 			BitOffset: 88,
@@ -30353,6 +34418,7 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -30363,8 +34429,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 419,
 		Decoder: DecodeFusionVhf,
+		Encoder: EncodeFusionVhf,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -30373,8 +34441,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -30383,8 +34453,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -30393,8 +34465,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "MessageId",
 			Name: "Message ID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -30403,8 +34477,10 @@ var pgnList = []PgnInfo{
 			GolangType:"FusionMessageIdConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "A",
 			Name: "A",
 			BitLength: 8,
 			BitOffset: 24,
@@ -30413,8 +34489,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "B",
 			Name: "B",
 			BitLength: 8,
 			BitOffset: 32,
@@ -30423,8 +34501,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Channel",
 			Name: "Channel",
 			BitLength: 8,
 			BitOffset: 40,
@@ -30433,8 +34513,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "D",
 			Name: "D",
 			BitLength: 24,
 			BitOffset: 48,
@@ -30443,6 +34525,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -30453,8 +34536,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 419,
 		Decoder: DecodeFusionSquelch,
+		Encoder: EncodeFusionSquelch,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -30463,8 +34548,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -30473,8 +34560,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -30483,8 +34572,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "MessageId",
 			Name: "Message ID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -30493,8 +34584,10 @@ var pgnList = []PgnInfo{
 			GolangType:"FusionMessageIdConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "A",
 			Name: "A",
 			BitLength: 8,
 			BitOffset: 24,
@@ -30503,8 +34596,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "B",
 			Name: "B",
 			BitLength: 8,
 			BitOffset: 32,
@@ -30513,8 +34608,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Squelch",
 			Name: "Squelch",
 			BitLength: 8,
 			BitOffset: 40,
@@ -30523,6 +34620,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -30533,8 +34631,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 419,
 		Decoder: DecodeFusionScan,
+		Encoder: EncodeFusionScan,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -30543,8 +34643,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -30553,8 +34655,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -30563,8 +34667,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "MessageId",
 			Name: "Message ID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -30573,8 +34679,10 @@ var pgnList = []PgnInfo{
 			GolangType:"FusionMessageIdConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "A",
 			Name: "A",
 			BitLength: 8,
 			BitOffset: 24,
@@ -30583,8 +34691,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "B",
 			Name: "B",
 			BitLength: 8,
 			BitOffset: 32,
@@ -30593,8 +34703,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Scan",
 			Name: "Scan",
 			BitLength: 2,
 			BitOffset: 40,
@@ -30603,8 +34715,10 @@ var pgnList = []PgnInfo{
 			GolangType:"YesNoConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "C",
 			Name: "C",
 			BitLength: 6,
 			BitOffset: 42,
@@ -30613,6 +34727,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -30623,8 +34738,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 419,
 		Decoder: DecodeFusionMenuItem,
+		Encoder: EncodeFusionMenuItem,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -30633,8 +34750,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -30643,8 +34762,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -30653,8 +34774,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "MessageId",
 			Name: "Message ID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -30663,8 +34786,10 @@ var pgnList = []PgnInfo{
 			GolangType:"FusionMessageIdConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "A",
 			Name: "A",
 			BitLength: 8,
 			BitOffset: 24,
@@ -30673,8 +34798,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "B",
 			Name: "B",
 			BitLength: 8,
 			BitOffset: 32,
@@ -30683,8 +34810,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Line",
 			Name: "Line",
 			BitLength: 8,
 			BitOffset: 40,
@@ -30693,8 +34822,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "E",
 			Name: "E",
 			BitLength: 8,
 			BitOffset: 48,
@@ -30703,8 +34834,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "F",
 			Name: "F",
 			BitLength: 8,
 			BitOffset: 56,
@@ -30713,8 +34846,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "G",
 			Name: "G",
 			BitLength: 8,
 			BitOffset: 64,
@@ -30723,8 +34858,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "H",
 			Name: "H",
 			BitLength: 8,
 			BitOffset: 72,
@@ -30733,8 +34870,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		12: { 
+			Id: "I",
 			Name: "I",
 			BitLength: 8,
 			BitOffset: 80,
@@ -30743,8 +34882,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		13: { 
+			Id: "Text",
 			Name: "Text",
 			BitLength: 40,
 			BitOffset: 88,
@@ -30753,6 +34894,7 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -30763,8 +34905,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 419,
 		Decoder: DecodeFusionReplay,
+		Encoder: EncodeFusionReplay,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -30773,8 +34917,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -30783,8 +34929,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -30793,8 +34941,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "MessageId",
 			Name: "Message ID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -30803,8 +34953,10 @@ var pgnList = []PgnInfo{
 			GolangType:"FusionMessageIdConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "A",
 			Name: "A",
 			BitLength: 8,
 			BitOffset: 24,
@@ -30813,8 +34965,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Mode",
 			Name: "Mode",
 			BitLength: 8,
 			BitOffset: 32,
@@ -30823,8 +34977,10 @@ var pgnList = []PgnInfo{
 			GolangType:"FusionReplayModeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "C",
 			Name: "C",
 			BitLength: 24,
 			BitOffset: 40,
@@ -30833,8 +34989,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "D",
 			Name: "D",
 			BitLength: 8,
 			BitOffset: 64,
@@ -30843,8 +35001,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "E",
 			Name: "E",
 			BitLength: 8,
 			BitOffset: 72,
@@ -30853,8 +35013,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "Status",
 			Name: "Status",
 			BitLength: 8,
 			BitOffset: 80,
@@ -30863,8 +35025,10 @@ var pgnList = []PgnInfo{
 			GolangType:"FusionReplayStatusConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "H",
 			Name: "H",
 			BitLength: 8,
 			BitOffset: 88,
@@ -30873,8 +35037,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		12: { 
+			Id: "I",
 			Name: "I",
 			BitLength: 8,
 			BitOffset: 96,
@@ -30883,8 +35049,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		13: { 
+			Id: "J",
 			Name: "J",
 			BitLength: 8,
 			BitOffset: 104,
@@ -30893,6 +35061,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -30903,8 +35072,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 419,
 		Decoder: DecodeFusionMute,
+		Encoder: EncodeFusionMute,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -30913,8 +35084,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -30923,8 +35096,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -30933,8 +35108,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "MessageId",
 			Name: "Message ID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -30943,8 +35120,10 @@ var pgnList = []PgnInfo{
 			GolangType:"FusionMessageIdConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "A",
 			Name: "A",
 			BitLength: 8,
 			BitOffset: 24,
@@ -30953,8 +35132,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Mute",
 			Name: "Mute",
 			BitLength: 8,
 			BitOffset: 32,
@@ -30963,6 +35144,7 @@ var pgnList = []PgnInfo{
 			GolangType:"FusionMuteCommandConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -30973,8 +35155,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 419,
 		Decoder: DecodeFusionSubVolume,
+		Encoder: EncodeFusionSubVolume,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -30983,8 +35167,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -30993,8 +35179,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -31003,8 +35191,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "MessageId",
 			Name: "Message ID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -31013,8 +35203,10 @@ var pgnList = []PgnInfo{
 			GolangType:"FusionMessageIdConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "A",
 			Name: "A",
 			BitLength: 8,
 			BitOffset: 24,
@@ -31023,8 +35215,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Zone1",
 			Name: "Zone 1",
 			BitLength: 8,
 			BitOffset: 32,
@@ -31033,8 +35227,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Zone2",
 			Name: "Zone 2",
 			BitLength: 8,
 			BitOffset: 40,
@@ -31043,8 +35239,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "Zone3",
 			Name: "Zone 3",
 			BitLength: 8,
 			BitOffset: 48,
@@ -31053,8 +35251,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "Zone4",
 			Name: "Zone 4",
 			BitLength: 8,
 			BitOffset: 56,
@@ -31063,6 +35263,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -31073,8 +35274,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 275,
 		Decoder: DecodeNavicoAsciiData,
+		Encoder: EncodeNavicoAsciiData,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -31083,8 +35286,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -31093,8 +35298,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -31103,8 +35310,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "A",
 			Name: "A",
 			BitLength: 8,
 			BitOffset: 16,
@@ -31113,8 +35322,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "Message",
 			Name: "Message",
 			BitLength: 2048,
 			BitOffset: 24,
@@ -31123,6 +35334,7 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -31133,8 +35345,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 1855,
 		Decoder: DecodeFurunoUnknown130821,
+		Encoder: EncodeFurunoUnknown130821,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -31143,8 +35357,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -31153,8 +35369,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -31163,8 +35381,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -31173,8 +35393,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "A",
 			Name: "A",
 			BitLength: 8,
 			BitOffset: 24,
@@ -31183,8 +35405,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "B",
 			Name: "B",
 			BitLength: 8,
 			BitOffset: 32,
@@ -31193,8 +35417,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "C",
 			Name: "C",
 			BitLength: 8,
 			BitOffset: 40,
@@ -31203,8 +35429,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "D",
 			Name: "D",
 			BitLength: 8,
 			BitOffset: 48,
@@ -31213,8 +35441,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "E",
 			Name: "E",
 			BitLength: 8,
 			BitOffset: 56,
@@ -31223,8 +35453,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "F",
 			Name: "F",
 			BitLength: 8,
 			BitOffset: 64,
@@ -31233,8 +35465,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "G",
 			Name: "G",
 			BitLength: 8,
 			BitOffset: 72,
@@ -31243,8 +35477,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		12: { 
+			Id: "H",
 			Name: "H",
 			BitLength: 8,
 			BitOffset: 80,
@@ -31253,8 +35489,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		13: { 
+			Id: "I",
 			Name: "I",
 			BitLength: 8,
 			BitOffset: 88,
@@ -31263,6 +35501,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -31273,8 +35512,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 275,
 		Decoder: DecodeNavicoUnknown1,
+		Encoder: EncodeNavicoUnknown1,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -31283,8 +35524,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -31293,8 +35536,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -31303,8 +35548,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Data",
 			Name: "Data",
 			BitLength: 1848,
 			BitOffset: 16,
@@ -31313,6 +35560,7 @@ var pgnList = []PgnInfo{
 			GolangType:"[]uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -31323,8 +35571,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 137,
 		Decoder: DecodeMaretronProprietaryTemperatureHighRange,
+		Encoder: EncodeMaretronProprietaryTemperatureHighRange,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -31333,8 +35583,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -31343,8 +35595,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -31353,8 +35607,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Sid",
 			Name: "SID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -31363,8 +35619,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "Instance",
 			Name: "Instance",
 			BitLength: 8,
 			BitOffset: 24,
@@ -31373,8 +35631,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Source",
 			Name: "Source",
 			BitLength: 8,
 			BitOffset: 32,
@@ -31383,8 +35643,10 @@ var pgnList = []PgnInfo{
 			GolangType:"TemperatureSourceConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "ActualTemperature",
 			Name: "Actual Temperature",
 			BitLength: 16,
 			BitOffset: 40,
@@ -31393,8 +35655,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Temperature",
 			Resolution:0.1,
 			Signed: false,
+			Unit: "K",
 			},
 		8: { 
+			Id: "SetTemperature",
 			Name: "Set Temperature",
 			BitLength: 16,
 			BitOffset: 56,
@@ -31403,6 +35667,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Temperature",
 			Resolution:0.1,
 			Signed: false,
+			Unit: "K",
 			},
 		},
 	},
@@ -31413,8 +35678,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 381,
 		Decoder: DecodeBGKeyValueData,
+		Encoder: EncodeBGKeyValueData,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -31423,8 +35690,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -31433,8 +35702,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -31443,8 +35714,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Key",
 			Name: "Key",
 			BitLength: 12,
 			BitOffset: 16,
@@ -31453,8 +35726,10 @@ var pgnList = []PgnInfo{
 			GolangType:"BandgKeyValueConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "Length",
 			Name: "Length",
 			BitLength: 4,
 			BitOffset: 28,
@@ -31463,8 +35738,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Value",
 			Name: "Value",
 			BitLength: 0,
 			BitOffset: 0,
@@ -31473,6 +35750,7 @@ var pgnList = []PgnInfo{
 			GolangType:"[]uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -31483,8 +35761,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 137,
 		Decoder: DecodeMaretronAnnunciator,
+		Encoder: EncodeMaretronAnnunciator,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -31493,8 +35773,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -31503,8 +35785,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -31513,8 +35797,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Field4",
 			Name: "Field 4",
 			BitLength: 8,
 			BitOffset: 16,
@@ -31523,8 +35809,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "Field5",
 			Name: "Field 5",
 			BitLength: 8,
 			BitOffset: 24,
@@ -31533,8 +35821,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Field6",
 			Name: "Field 6",
 			BitLength: 16,
 			BitOffset: 32,
@@ -31543,8 +35833,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Field7",
 			Name: "Field 7",
 			BitLength: 8,
 			BitOffset: 48,
@@ -31553,8 +35845,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "Field8",
 			Name: "Field 8",
 			BitLength: 16,
 			BitOffset: 56,
@@ -31563,6 +35857,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -31573,8 +35868,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 275,
 		Decoder: DecodeNavicoUnknown2,
+		Encoder: EncodeNavicoUnknown2,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -31583,8 +35880,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -31593,8 +35892,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -31603,8 +35904,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Data",
 			Name: "Data",
 			BitLength: 80,
 			BitOffset: 16,
@@ -31613,6 +35916,7 @@ var pgnList = []PgnInfo{
 			GolangType:"[]uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -31623,8 +35927,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 381,
 		Decoder: DecodeBGUserAndRemoteRename,
+		Encoder: EncodeBGUserAndRemoteRename,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -31633,8 +35939,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -31643,8 +35951,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -31653,8 +35963,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "DataType",
 			Name: "Data Type",
 			BitLength: 12,
 			BitOffset: 16,
@@ -31663,8 +35975,10 @@ var pgnList = []PgnInfo{
 			GolangType:"BandgKeyValueConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "Length",
 			Name: "Length",
 			BitLength: 4,
 			BitOffset: 28,
@@ -31673,8 +35987,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "XxReserved6",
 			Name: "Reserved",
 			BitLength: 8,
 			BitOffset: 32,
@@ -31683,8 +35999,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Decimals",
 			Name: "Decimals",
 			BitLength: 8,
 			BitOffset: 40,
@@ -31693,8 +36011,10 @@ var pgnList = []PgnInfo{
 			GolangType:"BandgDecimalsConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "ShortName",
 			Name: "Short name",
 			BitLength: 64,
 			BitOffset: 48,
@@ -31703,8 +36023,10 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "LongName",
 			Name: "Long name",
 			BitLength: 128,
 			BitOffset: 112,
@@ -31713,6 +36035,7 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -31723,8 +36046,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 1857,
 		Decoder: DecodeSimnetFluidLevelSensorConfiguration,
+		Encoder: EncodeSimnetFluidLevelSensorConfiguration,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -31733,8 +36058,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -31743,8 +36070,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -31753,8 +36082,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "C",
 			Name: "C",
 			BitLength: 8,
 			BitOffset: 16,
@@ -31763,8 +36094,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "Device",
 			Name: "Device",
 			BitLength: 8,
 			BitOffset: 24,
@@ -31773,8 +36106,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Instance",
 			Name: "Instance",
 			BitLength: 8,
 			BitOffset: 32,
@@ -31783,8 +36118,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "F",
 			Name: "F",
 			BitLength: 4,
 			BitOffset: 40,
@@ -31793,8 +36130,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "TankType",
 			Name: "Tank type",
 			BitLength: 4,
 			BitOffset: 44,
@@ -31803,8 +36142,10 @@ var pgnList = []PgnInfo{
 			GolangType:"TankTypeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "Capacity",
 			Name: "Capacity",
 			BitLength: 32,
 			BitOffset: 48,
@@ -31813,8 +36154,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Volume",
 			Resolution:0.1,
 			Signed: false,
+			Unit: "L",
 			},
 		10: { 
+			Id: "G",
 			Name: "G",
 			BitLength: 8,
 			BitOffset: 80,
@@ -31823,8 +36166,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "H",
 			Name: "H",
 			BitLength: 16,
 			BitOffset: 88,
@@ -31833,8 +36178,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*int16",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		12: { 
+			Id: "I",
 			Name: "I",
 			BitLength: 8,
 			BitOffset: 104,
@@ -31843,6 +36190,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*int8",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		},
 	},
@@ -31853,8 +36201,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 137,
 		Decoder: DecodeMaretronSwitchStatusCounter,
+		Encoder: EncodeMaretronSwitchStatusCounter,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -31863,8 +36213,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -31873,8 +36225,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -31883,8 +36237,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Instance",
 			Name: "Instance",
 			BitLength: 8,
 			BitOffset: 16,
@@ -31893,8 +36249,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "IndicatorNumber",
 			Name: "Indicator Number",
 			BitLength: 8,
 			BitOffset: 24,
@@ -31903,8 +36261,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "StartDate",
 			Name: "Start Date",
 			BitLength: 16,
 			BitOffset: 32,
@@ -31913,8 +36273,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "StartTime",
 			Name: "Start Time",
 			BitLength: 32,
 			BitOffset: 48,
@@ -31923,8 +36285,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "OffCounter",
 			Name: "OFF Counter",
 			BitLength: 8,
 			BitOffset: 80,
@@ -31933,8 +36297,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "OnCounter",
 			Name: "ON Counter",
 			BitLength: 8,
 			BitOffset: 88,
@@ -31943,8 +36309,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "ErrorCounter",
 			Name: "ERROR Counter",
 			BitLength: 8,
 			BitOffset: 96,
@@ -31953,8 +36321,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "SwitchStatus",
 			Name: "Switch Status",
 			BitLength: 2,
 			BitOffset: 104,
@@ -31963,8 +36333,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		12: { 
+			Id: "XxReserved12",
 			Name: "Reserved",
 			BitLength: 6,
 			BitOffset: 106,
@@ -31973,6 +36345,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -31983,8 +36356,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 137,
 		Decoder: DecodeMaretronSwitchStatusTimer,
+		Encoder: EncodeMaretronSwitchStatusTimer,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -31993,8 +36368,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -32003,8 +36380,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -32013,8 +36392,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Instance",
 			Name: "Instance",
 			BitLength: 8,
 			BitOffset: 16,
@@ -32023,8 +36404,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "IndicatorNumber",
 			Name: "Indicator Number",
 			BitLength: 8,
 			BitOffset: 24,
@@ -32033,8 +36416,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "StartDate",
 			Name: "Start Date",
 			BitLength: 16,
 			BitOffset: 32,
@@ -32043,8 +36428,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "StartTime",
 			Name: "Start Time",
 			BitLength: 32,
 			BitOffset: 48,
@@ -32053,8 +36440,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "AccumulatedOffPeriod",
 			Name: "Accumulated OFF Period",
 			BitLength: 32,
 			BitOffset: 80,
@@ -32063,8 +36452,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "AccumulatedOnPeriod",
 			Name: "Accumulated ON Period",
 			BitLength: 32,
 			BitOffset: 112,
@@ -32073,8 +36464,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "AccumulatedErrorPeriod",
 			Name: "Accumulated ERROR Period",
 			BitLength: 32,
 			BitOffset: 144,
@@ -32083,8 +36476,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "SwitchStatus",
 			Name: "Switch Status",
 			BitLength: 2,
 			BitOffset: 176,
@@ -32093,8 +36488,10 @@ var pgnList = []PgnInfo{
 			GolangType:"OffOnConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		12: { 
+			Id: "XxReserved12",
 			Name: "Reserved",
 			BitLength: 6,
 			BitOffset: 178,
@@ -32103,6 +36500,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -32113,8 +36511,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 1855,
 		Decoder: DecodeFurunoSixDegreesOfFreedomMovement,
+		Encoder: EncodeFurunoSixDegreesOfFreedomMovement,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -32123,8 +36523,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -32133,8 +36535,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -32143,8 +36547,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "A",
 			Name: "A",
 			BitLength: 32,
 			BitOffset: 16,
@@ -32153,8 +36559,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*int32",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		5: { 
+			Id: "B",
 			Name: "B",
 			BitLength: 32,
 			BitOffset: 48,
@@ -32163,8 +36571,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*int32",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		6: { 
+			Id: "C",
 			Name: "C",
 			BitLength: 32,
 			BitOffset: 80,
@@ -32173,8 +36583,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*int32",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		7: { 
+			Id: "D",
 			Name: "D",
 			BitLength: 8,
 			BitOffset: 112,
@@ -32183,8 +36595,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*int8",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		8: { 
+			Id: "E",
 			Name: "E",
 			BitLength: 32,
 			BitOffset: 120,
@@ -32193,8 +36607,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*int32",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		9: { 
+			Id: "F",
 			Name: "F",
 			BitLength: 32,
 			BitOffset: 152,
@@ -32203,8 +36619,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*int32",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		10: { 
+			Id: "G",
 			Name: "G",
 			BitLength: 16,
 			BitOffset: 184,
@@ -32213,8 +36631,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*int16",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		11: { 
+			Id: "H",
 			Name: "H",
 			BitLength: 16,
 			BitOffset: 200,
@@ -32223,8 +36643,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*int16",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		12: { 
+			Id: "I",
 			Name: "I",
 			BitLength: 16,
 			BitOffset: 216,
@@ -32233,6 +36655,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*int16",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		},
 	},
@@ -32243,8 +36666,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 1857,
 		Decoder: DecodeSimnetAisClassBStaticDataMsg24PartB,
+		Encoder: EncodeSimnetAisClassBStaticDataMsg24PartB,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -32253,8 +36678,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -32263,8 +36690,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -32273,8 +36702,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "MessageId",
 			Name: "Message ID",
 			BitLength: 6,
 			BitOffset: 16,
@@ -32283,8 +36714,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "RepeatIndicator",
 			Name: "Repeat Indicator",
 			BitLength: 2,
 			BitOffset: 22,
@@ -32293,8 +36726,10 @@ var pgnList = []PgnInfo{
 			GolangType:"RepeatIndicatorConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "D",
 			Name: "D",
 			BitLength: 8,
 			BitOffset: 24,
@@ -32303,8 +36738,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "E",
 			Name: "E",
 			BitLength: 8,
 			BitOffset: 32,
@@ -32313,8 +36750,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "UserId",
 			Name: "User ID",
 			BitLength: 32,
 			BitOffset: 40,
@@ -32323,8 +36762,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "TypeOfShip",
 			Name: "Type of ship",
 			BitLength: 8,
 			BitOffset: 72,
@@ -32333,8 +36774,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ShipTypeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "VendorId",
 			Name: "Vendor ID",
 			BitLength: 56,
 			BitOffset: 80,
@@ -32343,8 +36786,10 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "Callsign",
 			Name: "Callsign",
 			BitLength: 56,
 			BitOffset: 136,
@@ -32353,8 +36798,10 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		12: { 
+			Id: "Length",
 			Name: "Length",
 			BitLength: 16,
 			BitOffset: 192,
@@ -32363,8 +36810,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Distance",
 			Resolution:0.1,
 			Signed: false,
+			Unit: "m",
 			},
 		13: { 
+			Id: "Beam",
 			Name: "Beam",
 			BitLength: 16,
 			BitOffset: 208,
@@ -32373,8 +36822,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Distance",
 			Resolution:0.1,
 			Signed: false,
+			Unit: "m",
 			},
 		14: { 
+			Id: "PositionReferenceFromStarboard",
 			Name: "Position reference from Starboard",
 			BitLength: 16,
 			BitOffset: 224,
@@ -32383,8 +36834,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Distance",
 			Resolution:0.1,
 			Signed: false,
+			Unit: "m",
 			},
 		15: { 
+			Id: "PositionReferenceFromBow",
 			Name: "Position reference from Bow",
 			BitLength: 16,
 			BitOffset: 240,
@@ -32393,8 +36846,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*units.Distance",
 			Resolution:0.1,
 			Signed: false,
+			Unit: "m",
 			},
 		16: { 
+			Id: "MothershipUserId",
 			Name: "Mothership User ID",
 			BitLength: 32,
 			BitOffset: 256,
@@ -32403,8 +36858,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		17: { 
+			Id: "XxReserved17",
 			Name: "Spare",
 			BitLength: 6,
 			BitOffset: 288,
@@ -32413,8 +36870,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		18: { 
+			Id: "XxReserved18",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 294,
@@ -32423,6 +36882,7 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -32433,8 +36893,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 1855,
 		Decoder: DecodeFurunoHeelAngleRollInformation,
+		Encoder: EncodeFurunoHeelAngleRollInformation,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -32443,8 +36905,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -32453,8 +36917,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -32463,8 +36929,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "A",
 			Name: "A",
 			BitLength: 8,
 			BitOffset: 16,
@@ -32473,8 +36941,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "B",
 			Name: "B",
 			BitLength: 8,
 			BitOffset: 24,
@@ -32483,8 +36953,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Yaw",
 			Name: "Yaw",
 			BitLength: 16,
 			BitOffset: 32,
@@ -32493,8 +36965,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: true,
+			Unit: "",
 			},
 		7: { 
+			Id: "Pitch",
 			Name: "Pitch",
 			BitLength: 16,
 			BitOffset: 48,
@@ -32503,8 +36977,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: true,
+			Unit: "",
 			},
 		8: { 
+			Id: "Roll",
 			Name: "Roll",
 			BitLength: 16,
 			BitOffset: 64,
@@ -32513,6 +36989,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: true,
+			Unit: "",
 			},
 		},
 	},
@@ -32523,8 +37000,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 1855,
 		Decoder: DecodeFurunoMultiSatsInViewExtended,
+		Encoder: EncodeFurunoMultiSatsInViewExtended,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -32533,8 +37012,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -32543,8 +37024,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -32553,6 +37036,7 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -32563,8 +37047,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 1857,
 		Decoder: DecodeSimnetKeyValue,
+		Encoder: EncodeSimnetKeyValue,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -32573,8 +37059,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -32583,8 +37071,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -32593,8 +37083,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Address",
 			Name: "Address",
 			BitLength: 8,
 			BitOffset: 16,
@@ -32603,8 +37095,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "RepeatIndicator",
 			Name: "Repeat Indicator",
 			BitLength: 8,
 			BitOffset: 24,
@@ -32613,8 +37107,10 @@ var pgnList = []PgnInfo{
 			GolangType:"RepeatIndicatorConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "DisplayGroup",
 			Name: "Display Group",
 			BitLength: 8,
 			BitOffset: 32,
@@ -32623,8 +37119,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SimnetDisplayGroupConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "XxReserved7",
 			Name: "Reserved",
 			BitLength: 8,
 			BitOffset: 40,
@@ -32633,8 +37131,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "Key",
 			Name: "Key",
 			BitLength: 16,
 			BitOffset: 48,
@@ -32643,8 +37143,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SimnetKeyValueConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "XxReserved9",
 			Name: "Spare",
 			BitLength: 8,
 			BitOffset: 64,
@@ -32653,8 +37155,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "Minlength",
 			Name: "MinLength",
 			BitLength: 8,
 			BitOffset: 72,
@@ -32663,8 +37167,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "Value",
 			Name: "Value",
 			BitLength: 0,
 			BitOffset: 0,
@@ -32673,6 +37179,7 @@ var pgnList = []PgnInfo{
 			GolangType:"[]uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -32683,8 +37190,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 1857,
 		Decoder: DecodeSimnetParameterSet,
+		Encoder: EncodeSimnetParameterSet,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -32693,8 +37202,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -32703,8 +37214,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -32713,8 +37226,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Address",
 			Name: "Address",
 			BitLength: 8,
 			BitOffset: 16,
@@ -32723,8 +37238,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "B",
 			Name: "B",
 			BitLength: 8,
 			BitOffset: 24,
@@ -32733,8 +37250,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "DisplayGroup",
 			Name: "Display Group",
 			BitLength: 8,
 			BitOffset: 32,
@@ -32743,8 +37262,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SimnetDisplayGroupConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "D",
 			Name: "D",
 			BitLength: 16,
 			BitOffset: 40,
@@ -32753,8 +37274,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "Key",
 			Name: "Key",
 			BitLength: 16,
 			BitOffset: 56,
@@ -32763,8 +37286,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SimnetKeyValueConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "XxReserved9",
 			Name: "Spare",
 			BitLength: 8,
 			BitOffset: 72,
@@ -32773,8 +37298,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "Length",
 			Name: "Length",
 			BitLength: 8,
 			BitOffset: 80,
@@ -32783,8 +37310,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "Value",
 			Name: "Value",
 			BitLength: 0,
 			BitOffset: 0,
@@ -32793,6 +37322,7 @@ var pgnList = []PgnInfo{
 			GolangType:"[]uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -32803,8 +37333,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 1855,
 		Decoder: DecodeFurunoMotionSensorStatusExtended,
+		Encoder: EncodeFurunoMotionSensorStatusExtended,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -32813,8 +37345,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -32823,8 +37357,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -32833,6 +37369,7 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -32843,8 +37380,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 1857,
 		Decoder: DecodeSimnetApCommand,
+		Encoder: EncodeSimnetApCommand,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -32853,8 +37392,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -32863,8 +37404,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -32873,8 +37416,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Address",
 			Name: "Address",
 			BitLength: 8,
 			BitOffset: 16,
@@ -32883,8 +37428,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "XxReserved5",
 			Name: "Reserved",
 			BitLength: 8,
 			BitOffset: 24,
@@ -32893,8 +37440,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "ProprietaryId",
 			Name: "Proprietary ID",
 			BitLength: 8,
 			BitOffset: 32,
@@ -32903,8 +37452,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SimnetEventCommandConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "ApStatus",
 			Name: "AP status",
 			BitLength: 8,
 			BitOffset: 40,
@@ -32913,8 +37464,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SimnetApStatusConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "ApCommand",
 			Name: "AP Command",
 			BitLength: 8,
 			BitOffset: 48,
@@ -32923,8 +37476,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SimnetApEventsConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "XxReserved9",
 			Name: "Spare",
 			BitLength: 8,
 			BitOffset: 56,
@@ -32933,8 +37488,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "Direction",
 			Name: "Direction",
 			BitLength: 8,
 			BitOffset: 64,
@@ -32943,8 +37500,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SimnetDirectionConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "Angle",
 			Name: "Angle",
 			BitLength: 16,
 			BitOffset: 72,
@@ -32953,6 +37512,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -32963,8 +37523,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 1857,
 		Decoder: DecodeSimnetEventCommandApCommand,
+		Encoder: EncodeSimnetEventCommandApCommand,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -32973,8 +37535,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -32983,8 +37547,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -32993,8 +37559,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "ProprietaryId",
 			Name: "Proprietary ID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -33003,8 +37571,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SimnetEventCommandConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "UnusedA",
 			Name: "Unused A",
 			BitLength: 16,
 			BitOffset: 24,
@@ -33013,8 +37583,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "ControllingDevice",
 			Name: "Controlling Device",
 			BitLength: 8,
 			BitOffset: 40,
@@ -33023,8 +37595,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Event",
 			Name: "Event",
 			BitLength: 8,
 			BitOffset: 48,
@@ -33033,8 +37607,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SimnetApEventsConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "UnusedB",
 			Name: "Unused B",
 			BitLength: 8,
 			BitOffset: 56,
@@ -33043,8 +37619,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "Direction",
 			Name: "Direction",
 			BitLength: 8,
 			BitOffset: 64,
@@ -33053,8 +37631,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SimnetDirectionConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "Angle",
 			Name: "Angle",
 			BitLength: 16,
 			BitOffset: 72,
@@ -33063,8 +37643,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "UnusedC",
 			Name: "Unused C",
 			BitLength: 8,
 			BitOffset: 88,
@@ -33073,6 +37655,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -33083,8 +37666,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 1857,
 		Decoder: DecodeSimnetAlarm,
+		Encoder: EncodeSimnetAlarm,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -33093,8 +37678,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -33103,8 +37690,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -33113,8 +37702,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "Address",
 			Name: "Address",
 			BitLength: 8,
 			BitOffset: 16,
@@ -33123,8 +37714,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "XxReserved5",
 			Name: "Reserved",
 			BitLength: 8,
 			BitOffset: 24,
@@ -33133,8 +37726,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "ProprietaryId",
 			Name: "Proprietary ID",
 			BitLength: 8,
 			BitOffset: 32,
@@ -33143,8 +37738,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SimnetEventCommandConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "XxReserved7",
 			Name: "Reserved",
 			BitLength: 8,
 			BitOffset: 40,
@@ -33153,8 +37750,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "Alarm",
 			Name: "Alarm",
 			BitLength: 16,
 			BitOffset: 48,
@@ -33163,8 +37762,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SimnetAlarmConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "MessageId",
 			Name: "Message ID",
 			BitLength: 16,
 			BitOffset: 64,
@@ -33173,8 +37774,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "F",
 			Name: "F",
 			BitLength: 8,
 			BitOffset: 80,
@@ -33183,8 +37786,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "G",
 			Name: "G",
 			BitLength: 8,
 			BitOffset: 88,
@@ -33193,6 +37798,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -33203,8 +37809,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 1857,
 		Decoder: DecodeSimnetEventReplyApCommand,
+		Encoder: EncodeSimnetEventReplyApCommand,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -33213,8 +37821,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -33223,8 +37833,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -33233,8 +37845,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "ProprietaryId",
 			Name: "Proprietary ID",
 			BitLength: 8,
 			BitOffset: 16,
@@ -33243,8 +37857,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SimnetEventCommandConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "B",
 			Name: "B",
 			BitLength: 16,
 			BitOffset: 24,
@@ -33253,8 +37869,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "Address",
 			Name: "Address",
 			BitLength: 8,
 			BitOffset: 40,
@@ -33263,8 +37881,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Event",
 			Name: "Event",
 			BitLength: 8,
 			BitOffset: 48,
@@ -33273,8 +37893,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SimnetApEventsConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "C",
 			Name: "C",
 			BitLength: 8,
 			BitOffset: 56,
@@ -33283,8 +37905,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		9: { 
+			Id: "Direction",
 			Name: "Direction",
 			BitLength: 8,
 			BitOffset: 64,
@@ -33293,8 +37917,10 @@ var pgnList = []PgnInfo{
 			GolangType:"SimnetDirectionConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		10: { 
+			Id: "Angle",
 			Name: "Angle",
 			BitLength: 16,
 			BitOffset: 72,
@@ -33303,8 +37929,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*float32",
 			Resolution:0.0001,
 			Signed: false,
+			Unit: "",
 			},
 		11: { 
+			Id: "G",
 			Name: "G",
 			BitLength: 8,
 			BitOffset: 88,
@@ -33313,6 +37941,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -33323,8 +37952,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 1857,
 		Decoder: DecodeSimnetAlarmMessage,
+		Encoder: EncodeSimnetAlarmMessage,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -33333,8 +37964,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -33343,8 +37976,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -33353,8 +37988,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "MessageId",
 			Name: "Message ID",
 			BitLength: 16,
 			BitOffset: 16,
@@ -33363,8 +38000,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint16",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "B",
 			Name: "B",
 			BitLength: 8,
 			BitOffset: 32,
@@ -33373,8 +38012,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		6: { 
+			Id: "C",
 			Name: "C",
 			BitLength: 8,
 			BitOffset: 40,
@@ -33383,8 +38024,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		7: { 
+			Id: "Text",
 			Name: "Text",
 			BitLength: 1784,
 			BitOffset: 48,
@@ -33393,6 +38036,7 @@ var pgnList = []PgnInfo{
 			GolangType:"string",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -33403,8 +38047,10 @@ var pgnList = []PgnInfo{
 		Fast: true,
 		ManId: 1857,
 		Decoder: DecodeSimnetApUnknown4,
+		Encoder: EncodeSimnetApUnknown4,
 		Fields: map[int]*FieldDescriptor{
 		1: { 
+			Id: "ManufacturerCode",
 			Name: "Manufacturer Code",
 			BitLength: 11,
 			BitOffset: 0,
@@ -33413,8 +38059,10 @@ var pgnList = []PgnInfo{
 			GolangType:"ManufacturerCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		2: { 
+			Id: "XxReserved2",
 			Name: "Reserved",
 			BitLength: 2,
 			BitOffset: 11,
@@ -33423,8 +38071,10 @@ var pgnList = []PgnInfo{
 			GolangType:"",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		3: { 
+			Id: "IndustryCode",
 			Name: "Industry Code",
 			BitLength: 3,
 			BitOffset: 13,
@@ -33433,8 +38083,10 @@ var pgnList = []PgnInfo{
 			GolangType:"IndustryCodeConst",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		4: { 
+			Id: "A",
 			Name: "A",
 			BitLength: 8,
 			BitOffset: 16,
@@ -33443,8 +38095,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint8",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		5: { 
+			Id: "B",
 			Name: "B",
 			BitLength: 32,
 			BitOffset: 24,
@@ -33453,8 +38107,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*int32",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		6: { 
+			Id: "C",
 			Name: "C",
 			BitLength: 32,
 			BitOffset: 56,
@@ -33463,8 +38119,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*int32",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		7: { 
+			Id: "D",
 			Name: "D",
 			BitLength: 32,
 			BitOffset: 88,
@@ -33473,8 +38131,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		8: { 
+			Id: "E",
 			Name: "E",
 			BitLength: 32,
 			BitOffset: 120,
@@ -33483,8 +38143,10 @@ var pgnList = []PgnInfo{
 			GolangType:"*int32",
 			Resolution:1,
 			Signed: true,
+			Unit: "",
 			},
 		9: { 
+			Id: "F",
 			Name: "F",
 			BitLength: 32,
 			BitOffset: 152,
@@ -33493,6 +38155,7 @@ var pgnList = []PgnInfo{
 			GolangType:"*uint32",
 			Resolution:1,
 			Signed: false,
+			Unit: "",
 			},
 		},
 	},
@@ -33540,6 +38203,23 @@ func DecodeIsoAcknowledgement(Info MessageInfo, stream *PGNDataStream) (any, err
 	}	
 	return val, nil
 }
+func EncodeIsoAcknowledgement(p any, stream *PGNWriter) error {
+	val, ok := p.(IsoAcknowledgement)
+	if !ok {
+		return fmt.Errorf("EncodeIsoAcknowledgement called with %T", p)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Control)); err != nil {
+		return fmt.Errorf("encode failed for IsoAcknowledgement-Control: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.GroupFunction); err != nil {
+		return fmt.Errorf("encode failed for IsoAcknowledgement-GroupFunction: %w", err)
+	}
+	stream.writeReserved(24)
+	if err := stream.writeUInt32(24, val.Pgn); err != nil {
+		return fmt.Errorf("encode failed for IsoAcknowledgement-Pgn: %w", err)
+	}
+	return nil
+}
 type IsoRequest struct {
 	Info MessageInfo
 	Pgn *uint32
@@ -33558,6 +38238,16 @@ func DecodeIsoRequest(Info MessageInfo, stream *PGNDataStream) (any, error) {
 	}	
 	return val, nil
 }
+func EncodeIsoRequest(p any, stream *PGNWriter) error {
+	val, ok := p.(IsoRequest)
+	if !ok {
+		return fmt.Errorf("EncodeIsoRequest called with %T", p)
+	}
+	if err := stream.writeUInt32(24, val.Pgn); err != nil {
+		return fmt.Errorf("encode failed for IsoRequest-Pgn: %w", err)
+	}
+	return nil
+}
 type IsoTransportProtocolDataTransfer struct {
 	Info MessageInfo
 	Sid *uint8
@@ -33586,6 +38276,19 @@ func DecodeIsoTransportProtocolDataTransfer(Info MessageInfo, stream *PGNDataStr
 	}	
 	return val, nil
 }
+func EncodeIsoTransportProtocolDataTransfer(p any, stream *PGNWriter) error {
+	val, ok := p.(IsoTransportProtocolDataTransfer)
+	if !ok {
+		return fmt.Errorf("EncodeIsoTransportProtocolDataTransfer called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for IsoTransportProtocolDataTransfer-Sid: %w", err)
+	}
+	if err := stream.writeBinaryData(56, val.Data); err != nil {
+		return fmt.Errorf("encode failed for IsoTransportProtocolDataTransfer-Data: %w", err)
+	}
+	return nil
+}
 type IsoTransportProtocolConnectionManagementRequestToSend struct {
 	Info MessageInfo
 	GroupFunctionCode IsoCommandConst
@@ -33647,6 +38350,28 @@ func DecodeIsoTransportProtocolConnectionManagementRequestToSend(Info MessageInf
 	}	
 	return val, nil
 }
+func EncodeIsoTransportProtocolConnectionManagementRequestToSend(p any, stream *PGNWriter) error {
+	val, ok := p.(IsoTransportProtocolConnectionManagementRequestToSend)
+	if !ok {
+		return fmt.Errorf("EncodeIsoTransportProtocolConnectionManagementRequestToSend called with %T", p)
+	}
+	if err := stream.writeLookupField(8, 16); err != nil {
+		return fmt.Errorf("encode failed for IsoTransportProtocolConnectionManagementRequestToSend-GroupFunctionCode: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.MessageSize); err != nil {
+		return fmt.Errorf("encode failed for IsoTransportProtocolConnectionManagementRequestToSend-MessageSize: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Packets); err != nil {
+		return fmt.Errorf("encode failed for IsoTransportProtocolConnectionManagementRequestToSend-Packets: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.PacketsReply); err != nil {
+		return fmt.Errorf("encode failed for IsoTransportProtocolConnectionManagementRequestToSend-PacketsReply: %w", err)
+	}
+	if err := stream.writeUInt32(24, val.Pgn); err != nil {
+		return fmt.Errorf("encode failed for IsoTransportProtocolConnectionManagementRequestToSend-Pgn: %w", err)
+	}
+	return nil
+}
 type IsoTransportProtocolConnectionManagementClearToSend struct {
 	Info MessageInfo
 	GroupFunctionCode IsoCommandConst
@@ -33702,6 +38427,26 @@ func DecodeIsoTransportProtocolConnectionManagementClearToSend(Info MessageInfo,
 	}	
 	return val, nil
 }
+func EncodeIsoTransportProtocolConnectionManagementClearToSend(p any, stream *PGNWriter) error {
+	val, ok := p.(IsoTransportProtocolConnectionManagementClearToSend)
+	if !ok {
+		return fmt.Errorf("EncodeIsoTransportProtocolConnectionManagementClearToSend called with %T", p)
+	}
+	if err := stream.writeLookupField(8, 17); err != nil {
+		return fmt.Errorf("encode failed for IsoTransportProtocolConnectionManagementClearToSend-GroupFunctionCode: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.MaxPackets); err != nil {
+		return fmt.Errorf("encode failed for IsoTransportProtocolConnectionManagementClearToSend-MaxPackets: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.NextSid); err != nil {
+		return fmt.Errorf("encode failed for IsoTransportProtocolConnectionManagementClearToSend-NextSid: %w", err)
+	}
+	stream.writeReserved(16)
+	if err := stream.writeUInt32(24, val.Pgn); err != nil {
+		return fmt.Errorf("encode failed for IsoTransportProtocolConnectionManagementClearToSend-Pgn: %w", err)
+	}
+	return nil
+}
 type IsoTransportProtocolConnectionManagementEndOfMessage struct {
 	Info MessageInfo
 	GroupFunctionCode IsoCommandConst
@@ -33757,6 +38502,26 @@ func DecodeIsoTransportProtocolConnectionManagementEndOfMessage(Info MessageInfo
 	}	
 	return val, nil
 }
+func EncodeIsoTransportProtocolConnectionManagementEndOfMessage(p any, stream *PGNWriter) error {
+	val, ok := p.(IsoTransportProtocolConnectionManagementEndOfMessage)
+	if !ok {
+		return fmt.Errorf("EncodeIsoTransportProtocolConnectionManagementEndOfMessage called with %T", p)
+	}
+	if err := stream.writeLookupField(8, 19); err != nil {
+		return fmt.Errorf("encode failed for IsoTransportProtocolConnectionManagementEndOfMessage-GroupFunctionCode: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.TotalMessageSize); err != nil {
+		return fmt.Errorf("encode failed for IsoTransportProtocolConnectionManagementEndOfMessage-TotalMessageSize: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.TotalNumberOfFramesReceived); err != nil {
+		return fmt.Errorf("encode failed for IsoTransportProtocolConnectionManagementEndOfMessage-TotalNumberOfFramesReceived: %w", err)
+	}
+	stream.writeReserved(8)
+	if err := stream.writeUInt32(24, val.Pgn); err != nil {
+		return fmt.Errorf("encode failed for IsoTransportProtocolConnectionManagementEndOfMessage-Pgn: %w", err)
+	}
+	return nil
+}
 type IsoTransportProtocolConnectionManagementBroadcastAnnounce struct {
 	Info MessageInfo
 	GroupFunctionCode IsoCommandConst
@@ -33812,6 +38577,26 @@ func DecodeIsoTransportProtocolConnectionManagementBroadcastAnnounce(Info Messag
 	}	
 	return val, nil
 }
+func EncodeIsoTransportProtocolConnectionManagementBroadcastAnnounce(p any, stream *PGNWriter) error {
+	val, ok := p.(IsoTransportProtocolConnectionManagementBroadcastAnnounce)
+	if !ok {
+		return fmt.Errorf("EncodeIsoTransportProtocolConnectionManagementBroadcastAnnounce called with %T", p)
+	}
+	if err := stream.writeLookupField(8, 32); err != nil {
+		return fmt.Errorf("encode failed for IsoTransportProtocolConnectionManagementBroadcastAnnounce-GroupFunctionCode: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.MessageSize); err != nil {
+		return fmt.Errorf("encode failed for IsoTransportProtocolConnectionManagementBroadcastAnnounce-MessageSize: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Packets); err != nil {
+		return fmt.Errorf("encode failed for IsoTransportProtocolConnectionManagementBroadcastAnnounce-Packets: %w", err)
+	}
+	stream.writeReserved(8)
+	if err := stream.writeUInt32(24, val.Pgn); err != nil {
+		return fmt.Errorf("encode failed for IsoTransportProtocolConnectionManagementBroadcastAnnounce-Pgn: %w", err)
+	}
+	return nil
+}
 type IsoTransportProtocolConnectionManagementAbort struct {
 	Info MessageInfo
 	GroupFunctionCode IsoCommandConst
@@ -33857,6 +38642,23 @@ func DecodeIsoTransportProtocolConnectionManagementAbort(Info MessageInfo, strea
 	}	
 	return val, nil
 }
+func EncodeIsoTransportProtocolConnectionManagementAbort(p any, stream *PGNWriter) error {
+	val, ok := p.(IsoTransportProtocolConnectionManagementAbort)
+	if !ok {
+		return fmt.Errorf("EncodeIsoTransportProtocolConnectionManagementAbort called with %T", p)
+	}
+	if err := stream.writeLookupField(8, 255); err != nil {
+		return fmt.Errorf("encode failed for IsoTransportProtocolConnectionManagementAbort-GroupFunctionCode: %w", err)
+	}
+	if err := stream.writeBinaryData(8, val.Reason); err != nil {
+		return fmt.Errorf("encode failed for IsoTransportProtocolConnectionManagementAbort-Reason: %w", err)
+	}
+	stream.writeReserved(24)
+	if err := stream.writeUInt32(24, val.Pgn); err != nil {
+		return fmt.Errorf("encode failed for IsoTransportProtocolConnectionManagementAbort-Pgn: %w", err)
+	}
+	return nil
+}
 type IsoAddressClaim struct {
 	Info MessageInfo
 	UniqueNumber *uint32
@@ -33959,6 +38761,41 @@ func DecodeIsoAddressClaim(Info MessageInfo, stream *PGNDataStream) (any, error)
 	}	
 	return val, nil
 }
+func EncodeIsoAddressClaim(p any, stream *PGNWriter) error {
+	val, ok := p.(IsoAddressClaim)
+	if !ok {
+		return fmt.Errorf("EncodeIsoAddressClaim called with %T", p)
+	}
+	if err := stream.writeUInt32(21, val.UniqueNumber); err != nil {
+		return fmt.Errorf("encode failed for IsoAddressClaim-UniqueNumber: %w", err)
+	}
+	if err := stream.writeLookupField(11, uint64(val.ManufacturerCode)); err != nil {
+		return fmt.Errorf("encode failed for IsoAddressClaim-ManufacturerCode: %w", err)
+	}
+	if err := stream.writeUInt8(3, val.DeviceInstanceLower); err != nil {
+		return fmt.Errorf("encode failed for IsoAddressClaim-DeviceInstanceLower: %w", err)
+	}
+	if err := stream.writeUInt8(5, val.DeviceInstanceUpper); err != nil {
+		return fmt.Errorf("encode failed for IsoAddressClaim-DeviceInstanceUpper: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.DeviceFunction)); err != nil {
+		return fmt.Errorf("encode failed for IsoAddressClaim-DeviceFunction: %w", err)
+	}
+	stream.writeReserved(1)
+	if err := stream.writeLookupField(7, uint64(val.DeviceClass)); err != nil {
+		return fmt.Errorf("encode failed for IsoAddressClaim-DeviceClass: %w", err)
+	}
+	if err := stream.writeUInt8(4, val.SystemInstance); err != nil {
+		return fmt.Errorf("encode failed for IsoAddressClaim-SystemInstance: %w", err)
+	}
+	if err := stream.writeLookupField(3, uint64(val.IndustryGroup)); err != nil {
+		return fmt.Errorf("encode failed for IsoAddressClaim-IndustryGroup: %w", err)
+	}
+	if err := stream.writeUInt8(1, val.ArbitraryAddressCapable); err != nil {
+		return fmt.Errorf("encode failed for IsoAddressClaim-ArbitraryAddressCapable: %w", err)
+	}
+	return nil
+}
 type SeatalkWirelessKeypadLightControl struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -34044,6 +38881,33 @@ func DecodeSeatalkWirelessKeypadLightControl(Info MessageInfo, stream *PGNDataSt
 		}	
 	return val, nil
 }
+func EncodeSeatalkWirelessKeypadLightControl(p any, stream *PGNWriter) error {
+	val, ok := p.(SeatalkWirelessKeypadLightControl)
+	if !ok {
+		return fmt.Errorf("EncodeSeatalkWirelessKeypadLightControl called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 1851); err != nil {
+		return fmt.Errorf("encode failed for SeatalkWirelessKeypadLightControl-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SeatalkWirelessKeypadLightControl-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(8, 1); err != nil {
+		return fmt.Errorf("encode failed for SeatalkWirelessKeypadLightControl-ProprietaryId: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Variant); err != nil {
+		return fmt.Errorf("encode failed for SeatalkWirelessKeypadLightControl-Variant: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.WirelessSetting); err != nil {
+		return fmt.Errorf("encode failed for SeatalkWirelessKeypadLightControl-WirelessSetting: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.WiredSetting); err != nil {
+		return fmt.Errorf("encode failed for SeatalkWirelessKeypadLightControl-WiredSetting: %w", err)
+	}
+	stream.writeReserved(16)
+	return nil
+}
 type SeatalkWirelessKeypadControl struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -34116,6 +38980,30 @@ func DecodeSeatalkWirelessKeypadControl(Info MessageInfo, stream *PGNDataStream)
 		}	
 	return val, nil
 }
+func EncodeSeatalkWirelessKeypadControl(p any, stream *PGNWriter) error {
+	val, ok := p.(SeatalkWirelessKeypadControl)
+	if !ok {
+		return fmt.Errorf("EncodeSeatalkWirelessKeypadControl called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 1851); err != nil {
+		return fmt.Errorf("encode failed for SeatalkWirelessKeypadControl-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SeatalkWirelessKeypadControl-IndustryCode: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Pid); err != nil {
+		return fmt.Errorf("encode failed for SeatalkWirelessKeypadControl-Pid: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Variant); err != nil {
+		return fmt.Errorf("encode failed for SeatalkWirelessKeypadControl-Variant: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.BeepControl); err != nil {
+		return fmt.Errorf("encode failed for SeatalkWirelessKeypadControl-BeepControl: %w", err)
+	}
+	stream.writeReserved(24)
+	return nil
+}
 type VictronBatteryRegister struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -34174,6 +39062,26 @@ func DecodeVictronBatteryRegister(Info MessageInfo, stream *PGNDataStream) (any,
 	}	
 	return val, nil
 }
+func EncodeVictronBatteryRegister(p any, stream *PGNWriter) error {
+	val, ok := p.(VictronBatteryRegister)
+	if !ok {
+		return fmt.Errorf("EncodeVictronBatteryRegister called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 358); err != nil {
+		return fmt.Errorf("encode failed for VictronBatteryRegister-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for VictronBatteryRegister-IndustryCode: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.RegisterId); err != nil {
+		return fmt.Errorf("encode failed for VictronBatteryRegister-RegisterId: %w", err)
+	}
+	if err := stream.writeUInt32(32, val.Payload); err != nil {
+		return fmt.Errorf("encode failed for VictronBatteryRegister-Payload: %w", err)
+	}
+	return nil
+}
 type Bus1PhaseCBasicAcQuantities struct {
 	Info MessageInfo
 	LineLineAcRmsVoltage *uint16
@@ -34216,6 +39124,23 @@ func DecodeBus1PhaseCBasicAcQuantities(Info MessageInfo, stream *PGNDataStream)
 		}	
 	return val, nil
 }
+func EncodeBus1PhaseCBasicAcQuantities(p any, stream *PGNWriter) error {
+	val, ok := p.(Bus1PhaseCBasicAcQuantities)
+	if !ok {
+		return fmt.Errorf("EncodeBus1PhaseCBasicAcQuantities called with %T", p)
+	}
+	if err := stream.writeUInt16(16, val.LineLineAcRmsVoltage); err != nil {
+		return fmt.Errorf("encode failed for Bus1PhaseCBasicAcQuantities-LineLineAcRmsVoltage: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.LineNeutralAcRmsVoltage); err != nil {
+		return fmt.Errorf("encode failed for Bus1PhaseCBasicAcQuantities-LineNeutralAcRmsVoltage: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.0078125, val.AcFrequency); err != nil {
+		return fmt.Errorf("encode failed for Bus1PhaseCBasicAcQuantities-AcFrequency: %w", err)
+	}
+	stream.writeReserved(16)
+	return nil
+}
 type Bus1PhaseBBasicAcQuantities struct {
 	Info MessageInfo
 	LineLineAcRmsVoltage *uint16
@@ -34258,6 +39183,23 @@ func DecodeBus1PhaseBBasicAcQuantities(Info MessageInfo, stream *PGNDataStream)
 		}	
 	return val, nil
 }
+func EncodeBus1PhaseBBasicAcQuantities(p any, stream *PGNWriter) error {
+	val, ok := p.(Bus1PhaseBBasicAcQuantities)
+	if !ok {
+		return fmt.Errorf("EncodeBus1PhaseBBasicAcQuantities called with %T", p)
+	}
+	if err := stream.writeUInt16(16, val.LineLineAcRmsVoltage); err != nil {
+		return fmt.Errorf("encode failed for Bus1PhaseBBasicAcQuantities-LineLineAcRmsVoltage: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.LineNeutralAcRmsVoltage); err != nil {
+		return fmt.Errorf("encode failed for Bus1PhaseBBasicAcQuantities-LineNeutralAcRmsVoltage: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.0078125, val.AcFrequency); err != nil {
+		return fmt.Errorf("encode failed for Bus1PhaseBBasicAcQuantities-AcFrequency: %w", err)
+	}
+	stream.writeReserved(16)
+	return nil
+}
 type Bus1PhaseABasicAcQuantities struct {
 	Info MessageInfo
 	LineLineAcRmsVoltage *uint16
@@ -34300,6 +39242,23 @@ func DecodeBus1PhaseABasicAcQuantities(Info MessageInfo, stream *PGNDataStream)
 		}	
 	return val, nil
 }
+func EncodeBus1PhaseABasicAcQuantities(p any, stream *PGNWriter) error {
+	val, ok := p.(Bus1PhaseABasicAcQuantities)
+	if !ok {
+		return fmt.Errorf("EncodeBus1PhaseABasicAcQuantities called with %T", p)
+	}
+	if err := stream.writeUInt16(16, val.LineLineAcRmsVoltage); err != nil {
+		return fmt.Errorf("encode failed for Bus1PhaseABasicAcQuantities-LineLineAcRmsVoltage: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.LineNeutralAcRmsVoltage); err != nil {
+		return fmt.Errorf("encode failed for Bus1PhaseABasicAcQuantities-LineNeutralAcRmsVoltage: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.0078125, val.AcFrequency); err != nil {
+		return fmt.Errorf("encode failed for Bus1PhaseABasicAcQuantities-AcFrequency: %w", err)
+	}
+	stream.writeReserved(16)
+	return nil
+}
 type Bus1AverageBasicAcQuantities struct {
 	Info MessageInfo
 	LineLineAcRmsVoltage *uint16
@@ -34342,6 +39301,23 @@ func DecodeBus1AverageBasicAcQuantities(Info MessageInfo, stream *PGNDataStream)
 		}	
 	return val, nil
 }
+func EncodeBus1AverageBasicAcQuantities(p any, stream *PGNWriter) error {
+	val, ok := p.(Bus1AverageBasicAcQuantities)
+	if !ok {
+		return fmt.Errorf("EncodeBus1AverageBasicAcQuantities called with %T", p)
+	}
+	if err := stream.writeUInt16(16, val.LineLineAcRmsVoltage); err != nil {
+		return fmt.Errorf("encode failed for Bus1AverageBasicAcQuantities-LineLineAcRmsVoltage: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.LineNeutralAcRmsVoltage); err != nil {
+		return fmt.Errorf("encode failed for Bus1AverageBasicAcQuantities-LineNeutralAcRmsVoltage: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.0078125, val.AcFrequency); err != nil {
+		return fmt.Errorf("encode failed for Bus1AverageBasicAcQuantities-AcFrequency: %w", err)
+	}
+	stream.writeReserved(16)
+	return nil
+}
 type UtilityTotalAcEnergy struct {
 	Info MessageInfo
 	TotalEnergyExport *uint32
@@ -34370,6 +39346,19 @@ func DecodeUtilityTotalAcEnergy(Info MessageInfo, stream *PGNDataStream) (any, e
 	}	
 	return val, nil
 }
+func EncodeUtilityTotalAcEnergy(p any, stream *PGNWriter) error {
+	val, ok := p.(UtilityTotalAcEnergy)
+	if !ok {
+		return fmt.Errorf("EncodeUtilityTotalAcEnergy called with %T", p)
+	}
+	if err := stream.writeUInt32(32, val.TotalEnergyExport); err != nil {
+		return fmt.Errorf("encode failed for UtilityTotalAcEnergy-TotalEnergyExport: %w", err)
+	}
+	if err := stream.writeUInt32(32, val.TotalEnergyImport); err != nil {
+		return fmt.Errorf("encode failed for UtilityTotalAcEnergy-TotalEnergyImport: %w", err)
+	}
+	return nil
+}
 type UtilityPhaseCAcReactivePower struct {
 	Info MessageInfo
 	ReactivePower *uint16
@@ -34412,6 +39401,23 @@ func DecodeUtilityPhaseCAcReactivePower(Info MessageInfo, stream *PGNDataStream)
 		}	
 	return val, nil
 }
+func EncodeUtilityPhaseCAcReactivePower(p any, stream *PGNWriter) error {
+	val, ok := p.(UtilityPhaseCAcReactivePower)
+	if !ok {
+		return fmt.Errorf("EncodeUtilityPhaseCAcReactivePower called with %T", p)
+	}
+	if err := stream.writeUInt16(16, val.ReactivePower); err != nil {
+		return fmt.Errorf("encode failed for UtilityPhaseCAcReactivePower-ReactivePower: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 6.10352e-05, val.PowerFactor); err != nil {
+		return fmt.Errorf("encode failed for UtilityPhaseCAcReactivePower-PowerFactor: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.PowerFactorLagging)); err != nil {
+		return fmt.Errorf("encode failed for UtilityPhaseCAcReactivePower-PowerFactorLagging: %w", err)
+	}
+	stream.writeReserved(30)
+	return nil
+}
 type UtilityPhaseCAcPower struct {
 	Info MessageInfo
 	RealPower *int32
@@ -34440,6 +39446,19 @@ func DecodeUtilityPhaseCAcPower(Info MessageInfo, stream *PGNDataStream) (any, e
 	}	
 	return val, nil
 }
+func EncodeUtilityPhaseCAcPower(p any, stream *PGNWriter) error {
+	val, ok := p.(UtilityPhaseCAcPower)
+	if !ok {
+		return fmt.Errorf("EncodeUtilityPhaseCAcPower called with %T", p)
+	}
+	if err := stream.writeInt32(32, val.RealPower); err != nil {
+		return fmt.Errorf("encode failed for UtilityPhaseCAcPower-RealPower: %w", err)
+	}
+	if err := stream.writeInt32(32, val.ApparentPower); err != nil {
+		return fmt.Errorf("encode failed for UtilityPhaseCAcPower-ApparentPower: %w", err)
+	}
+	return nil
+}
 type UtilityPhaseCBasicAcQuantities struct {
 	Info MessageInfo
 	LineLineAcRmsVoltage *uint16
@@ -34488,6 +39507,25 @@ func DecodeUtilityPhaseCBasicAcQuantities(Info MessageInfo, stream *PGNDataStrea
 	}	
 	return val, nil
 }
+func EncodeUtilityPhaseCBasicAcQuantities(p any, stream *PGNWriter) error {
+	val, ok := p.(UtilityPhaseCBasicAcQuantities)
+	if !ok {
+		return fmt.Errorf("EncodeUtilityPhaseCBasicAcQuantities called with %T", p)
+	}
+	if err := stream.writeUInt16(16, val.LineLineAcRmsVoltage); err != nil {
+		return fmt.Errorf("encode failed for UtilityPhaseCBasicAcQuantities-LineLineAcRmsVoltage: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.LineNeutralAcRmsVoltage); err != nil {
+		return fmt.Errorf("encode failed for UtilityPhaseCBasicAcQuantities-LineNeutralAcRmsVoltage: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.0078125, val.AcFrequency); err != nil {
+		return fmt.Errorf("encode failed for UtilityPhaseCBasicAcQuantities-AcFrequency: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.AcRmsCurrent); err != nil {
+		return fmt.Errorf("encode failed for UtilityPhaseCBasicAcQuantities-AcRmsCurrent: %w", err)
+	}
+	return nil
+}
 type UtilityPhaseBAcReactivePower struct {
 	Info MessageInfo
 	ReactivePower *uint16
@@ -34530,6 +39568,23 @@ func DecodeUtilityPhaseBAcReactivePower(Info MessageInfo, stream *PGNDataStream)
 		}	
 	return val, nil
 }
+func EncodeUtilityPhaseBAcReactivePower(p any, stream *PGNWriter) error {
+	val, ok := p.(UtilityPhaseBAcReactivePower)
+	if !ok {
+		return fmt.Errorf("EncodeUtilityPhaseBAcReactivePower called with %T", p)
+	}
+	if err := stream.writeUInt16(16, val.ReactivePower); err != nil {
+		return fmt.Errorf("encode failed for UtilityPhaseBAcReactivePower-ReactivePower: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 6.10352e-05, val.PowerFactor); err != nil {
+		return fmt.Errorf("encode failed for UtilityPhaseBAcReactivePower-PowerFactor: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.PowerFactorLagging)); err != nil {
+		return fmt.Errorf("encode failed for UtilityPhaseBAcReactivePower-PowerFactorLagging: %w", err)
+	}
+	stream.writeReserved(30)
+	return nil
+}
 type UtilityPhaseBAcPower struct {
 	Info MessageInfo
 	RealPower *int32
@@ -34558,6 +39613,19 @@ func DecodeUtilityPhaseBAcPower(Info MessageInfo, stream *PGNDataStream) (any, e
 	}	
 	return val, nil
 }
+func EncodeUtilityPhaseBAcPower(p any, stream *PGNWriter) error {
+	val, ok := p.(UtilityPhaseBAcPower)
+	if !ok {
+		return fmt.Errorf("EncodeUtilityPhaseBAcPower called with %T", p)
+	}
+	if err := stream.writeInt32(32, val.RealPower); err != nil {
+		return fmt.Errorf("encode failed for UtilityPhaseBAcPower-RealPower: %w", err)
+	}
+	if err := stream.writeInt32(32, val.ApparentPower); err != nil {
+		return fmt.Errorf("encode failed for UtilityPhaseBAcPower-ApparentPower: %w", err)
+	}
+	return nil
+}
 type UtilityPhaseBBasicAcQuantities struct {
 	Info MessageInfo
 	LineLineAcRmsVoltage *uint16
@@ -34606,6 +39674,25 @@ func DecodeUtilityPhaseBBasicAcQuantities(Info MessageInfo, stream *PGNDataStrea
 	}	
 	return val, nil
 }
+func EncodeUtilityPhaseBBasicAcQuantities(p any, stream *PGNWriter) error {
+	val, ok := p.(UtilityPhaseBBasicAcQuantities)
+	if !ok {
+		return fmt.Errorf("EncodeUtilityPhaseBBasicAcQuantities called with %T", p)
+	}
+	if err := stream.writeUInt16(16, val.LineLineAcRmsVoltage); err != nil {
+		return fmt.Errorf("encode failed for UtilityPhaseBBasicAcQuantities-LineLineAcRmsVoltage: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.LineNeutralAcRmsVoltage); err != nil {
+		return fmt.Errorf("encode failed for UtilityPhaseBBasicAcQuantities-LineNeutralAcRmsVoltage: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.0078125, val.AcFrequency); err != nil {
+		return fmt.Errorf("encode failed for UtilityPhaseBBasicAcQuantities-AcFrequency: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.AcRmsCurrent); err != nil {
+		return fmt.Errorf("encode failed for UtilityPhaseBBasicAcQuantities-AcRmsCurrent: %w", err)
+	}
+	return nil
+}
 type UtilityPhaseAAcReactivePower struct {
 	Info MessageInfo
 	ReactivePower *int32
@@ -34648,6 +39735,23 @@ func DecodeUtilityPhaseAAcReactivePower(Info MessageInfo, stream *PGNDataStream)
 		}	
 	return val, nil
 }
+func EncodeUtilityPhaseAAcReactivePower(p any, stream *PGNWriter) error {
+	val, ok := p.(UtilityPhaseAAcReactivePower)
+	if !ok {
+		return fmt.Errorf("EncodeUtilityPhaseAAcReactivePower called with %T", p)
+	}
+	if err := stream.writeInt32(32, val.ReactivePower); err != nil {
+		return fmt.Errorf("encode failed for UtilityPhaseAAcReactivePower-ReactivePower: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 6.10352e-05, val.PowerFactor); err != nil {
+		return fmt.Errorf("encode failed for UtilityPhaseAAcReactivePower-PowerFactor: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.PowerFactorLagging)); err != nil {
+		return fmt.Errorf("encode failed for UtilityPhaseAAcReactivePower-PowerFactorLagging: %w", err)
+	}
+	stream.writeReserved(14)
+	return nil
+}
 type UtilityPhaseAAcPower struct {
 	Info MessageInfo
 	RealPower *int32
@@ -34676,6 +39780,19 @@ func DecodeUtilityPhaseAAcPower(Info MessageInfo, stream *PGNDataStream) (any, e
 	}	
 	return val, nil
 }
+func EncodeUtilityPhaseAAcPower(p any, stream *PGNWriter) error {
+	val, ok := p.(UtilityPhaseAAcPower)
+	if !ok {
+		return fmt.Errorf("EncodeUtilityPhaseAAcPower called with %T", p)
+	}
+	if err := stream.writeInt32(32, val.RealPower); err != nil {
+		return fmt.Errorf("encode failed for UtilityPhaseAAcPower-RealPower: %w", err)
+	}
+	if err := stream.writeInt32(32, val.ApparentPower); err != nil {
+		return fmt.Errorf("encode failed for UtilityPhaseAAcPower-ApparentPower: %w", err)
+	}
+	return nil
+}
 type UtilityPhaseABasicAcQuantities struct {
 	Info MessageInfo
 	LineLineAcRmsVoltage *uint16
@@ -34724,6 +39841,25 @@ func DecodeUtilityPhaseABasicAcQuantities(Info MessageInfo, stream *PGNDataStrea
 	}	
 	return val, nil
 }
+func EncodeUtilityPhaseABasicAcQuantities(p any, stream *PGNWriter) error {
+	val, ok := p.(UtilityPhaseABasicAcQuantities)
+	if !ok {
+		return fmt.Errorf("EncodeUtilityPhaseABasicAcQuantities called with %T", p)
+	}
+	if err := stream.writeUInt16(16, val.LineLineAcRmsVoltage); err != nil {
+		return fmt.Errorf("encode failed for UtilityPhaseABasicAcQuantities-LineLineAcRmsVoltage: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.LineNeutralAcRmsVoltage); err != nil {
+		return fmt.Errorf("encode failed for UtilityPhaseABasicAcQuantities-LineNeutralAcRmsVoltage: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.0078125, val.AcFrequency); err != nil {
+		return fmt.Errorf("encode failed for UtilityPhaseABasicAcQuantities-AcFrequency: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.AcRmsCurrent); err != nil {
+		return fmt.Errorf("encode failed for UtilityPhaseABasicAcQuantities-AcRmsCurrent: %w", err)
+	}
+	return nil
+}
 type UtilityTotalAcReactivePower struct {
 	Info MessageInfo
 	ReactivePower *int32
@@ -34766,6 +39902,23 @@ func DecodeUtilityTotalAcReactivePower(Info MessageInfo, stream *PGNDataStream)
 		}	
 	return val, nil
 }
+func EncodeUtilityTotalAcReactivePower(p any, stream *PGNWriter) error {
+	val, ok := p.(UtilityTotalAcReactivePower)
+	if !ok {
+		return fmt.Errorf("EncodeUtilityTotalAcReactivePower called with %T", p)
+	}
+	if err := stream.writeInt32(32, val.ReactivePower); err != nil {
+		return fmt.Errorf("encode failed for UtilityTotalAcReactivePower-ReactivePower: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 6.10352e-05, val.PowerFactor); err != nil {
+		return fmt.Errorf("encode failed for UtilityTotalAcReactivePower-PowerFactor: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.PowerFactorLagging)); err != nil {
+		return fmt.Errorf("encode failed for UtilityTotalAcReactivePower-PowerFactorLagging: %w", err)
+	}
+	stream.writeReserved(14)
+	return nil
+}
 type UtilityTotalAcPower struct {
 	Info MessageInfo
 	RealPower *int32
@@ -34794,6 +39947,19 @@ func DecodeUtilityTotalAcPower(Info MessageInfo, stream *PGNDataStream) (any, er
 	}	
 	return val, nil
 }
+func EncodeUtilityTotalAcPower(p any, stream *PGNWriter) error {
+	val, ok := p.(UtilityTotalAcPower)
+	if !ok {
+		return fmt.Errorf("EncodeUtilityTotalAcPower called with %T", p)
+	}
+	if err := stream.writeInt32(32, val.RealPower); err != nil {
+		return fmt.Errorf("encode failed for UtilityTotalAcPower-RealPower: %w", err)
+	}
+	if err := stream.writeInt32(32, val.ApparentPower); err != nil {
+		return fmt.Errorf("encode failed for UtilityTotalAcPower-ApparentPower: %w", err)
+	}
+	return nil
+}
 type UtilityAverageBasicAcQuantities struct {
 	Info MessageInfo
 	LineLineAcRmsVoltage *uint16
@@ -34842,6 +40008,25 @@ func DecodeUtilityAverageBasicAcQuantities(Info MessageInfo, stream *PGNDataStre
 	}	
 	return val, nil
 }
+func EncodeUtilityAverageBasicAcQuantities(p any, stream *PGNWriter) error {
+	val, ok := p.(UtilityAverageBasicAcQuantities)
+	if !ok {
+		return fmt.Errorf("EncodeUtilityAverageBasicAcQuantities called with %T", p)
+	}
+	if err := stream.writeUInt16(16, val.LineLineAcRmsVoltage); err != nil {
+		return fmt.Errorf("encode failed for UtilityAverageBasicAcQuantities-LineLineAcRmsVoltage: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.LineNeutralAcRmsVoltage); err != nil {
+		return fmt.Errorf("encode failed for UtilityAverageBasicAcQuantities-LineNeutralAcRmsVoltage: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.0078125, val.AcFrequency); err != nil {
+		return fmt.Errorf("encode failed for UtilityAverageBasicAcQuantities-AcFrequency: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.AcRmsCurrent); err != nil {
+		return fmt.Errorf("encode failed for UtilityAverageBasicAcQuantities-AcRmsCurrent: %w", err)
+	}
+	return nil
+}
 type GeneratorTotalAcEnergy struct {
 	Info MessageInfo
 	TotalEnergyExport *uint32
@@ -34870,6 +40055,19 @@ func DecodeGeneratorTotalAcEnergy(Info MessageInfo, stream *PGNDataStream) (any,
 	}	
 	return val, nil
 }
+func EncodeGeneratorTotalAcEnergy(p any, stream *PGNWriter) error {
+	val, ok := p.(GeneratorTotalAcEnergy)
+	if !ok {
+		return fmt.Errorf("EncodeGeneratorTotalAcEnergy called with %T", p)
+	}
+	if err := stream.writeUInt32(32, val.TotalEnergyExport); err != nil {
+		return fmt.Errorf("encode failed for GeneratorTotalAcEnergy-TotalEnergyExport: %w", err)
+	}
+	if err := stream.writeUInt32(32, val.TotalEnergyImport); err != nil {
+		return fmt.Errorf("encode failed for GeneratorTotalAcEnergy-TotalEnergyImport: %w", err)
+	}
+	return nil
+}
 type GeneratorPhaseCAcReactivePower struct {
 	Info MessageInfo
 	ReactivePower *int32
@@ -34912,6 +40110,23 @@ func DecodeGeneratorPhaseCAcReactivePower(Info MessageInfo, stream *PGNDataStrea
 		}	
 	return val, nil
 }
+func EncodeGeneratorPhaseCAcReactivePower(p any, stream *PGNWriter) error {
+	val, ok := p.(GeneratorPhaseCAcReactivePower)
+	if !ok {
+		return fmt.Errorf("EncodeGeneratorPhaseCAcReactivePower called with %T", p)
+	}
+	if err := stream.writeInt32(32, val.ReactivePower); err != nil {
+		return fmt.Errorf("encode failed for GeneratorPhaseCAcReactivePower-ReactivePower: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 6.10352e-05, val.PowerFactor); err != nil {
+		return fmt.Errorf("encode failed for GeneratorPhaseCAcReactivePower-PowerFactor: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.PowerFactorLagging)); err != nil {
+		return fmt.Errorf("encode failed for GeneratorPhaseCAcReactivePower-PowerFactorLagging: %w", err)
+	}
+	stream.writeReserved(14)
+	return nil
+}
 type GeneratorPhaseCAcPower struct {
 	Info MessageInfo
 	RealPower *int32
@@ -34940,6 +40155,19 @@ func DecodeGeneratorPhaseCAcPower(Info MessageInfo, stream *PGNDataStream) (any,
 	}	
 	return val, nil
 }
+func EncodeGeneratorPhaseCAcPower(p any, stream *PGNWriter) error {
+	val, ok := p.(GeneratorPhaseCAcPower)
+	if !ok {
+		return fmt.Errorf("EncodeGeneratorPhaseCAcPower called with %T", p)
+	}
+	if err := stream.writeInt32(32, val.RealPower); err != nil {
+		return fmt.Errorf("encode failed for GeneratorPhaseCAcPower-RealPower: %w", err)
+	}
+	if err := stream.writeInt32(32, val.ApparentPower); err != nil {
+		return fmt.Errorf("encode failed for GeneratorPhaseCAcPower-ApparentPower: %w", err)
+	}
+	return nil
+}
 type GeneratorPhaseCBasicAcQuantities struct {
 	Info MessageInfo
 	LineLineAcRmsVoltage *uint16
@@ -34988,6 +40216,25 @@ func DecodeGeneratorPhaseCBasicAcQuantities(Info MessageInfo, stream *PGNDataStr
 	}	
 	return val, nil
 }
+func EncodeGeneratorPhaseCBasicAcQuantities(p any, stream *PGNWriter) error {
+	val, ok := p.(GeneratorPhaseCBasicAcQuantities)
+	if !ok {
+		return fmt.Errorf("EncodeGeneratorPhaseCBasicAcQuantities called with %T", p)
+	}
+	if err := stream.writeUInt16(16, val.LineLineAcRmsVoltage); err != nil {
+		return fmt.Errorf("encode failed for GeneratorPhaseCBasicAcQuantities-LineLineAcRmsVoltage: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.LineNeutralAcRmsVoltage); err != nil {
+		return fmt.Errorf("encode failed for GeneratorPhaseCBasicAcQuantities-LineNeutralAcRmsVoltage: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.0078125, val.AcFrequency); err != nil {
+		return fmt.Errorf("encode failed for GeneratorPhaseCBasicAcQuantities-AcFrequency: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.AcRmsCurrent); err != nil {
+		return fmt.Errorf("encode failed for GeneratorPhaseCBasicAcQuantities-AcRmsCurrent: %w", err)
+	}
+	return nil
+}
 type GeneratorPhaseBAcReactivePower struct {
 	Info MessageInfo
 	ReactivePower *int32
@@ -35030,6 +40277,23 @@ func DecodeGeneratorPhaseBAcReactivePower(Info MessageInfo, stream *PGNDataStrea
 		}	
 	return val, nil
 }
+func EncodeGeneratorPhaseBAcReactivePower(p any, stream *PGNWriter) error {
+	val, ok := p.(GeneratorPhaseBAcReactivePower)
+	if !ok {
+		return fmt.Errorf("EncodeGeneratorPhaseBAcReactivePower called with %T", p)
+	}
+	if err := stream.writeInt32(32, val.ReactivePower); err != nil {
+		return fmt.Errorf("encode failed for GeneratorPhaseBAcReactivePower-ReactivePower: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 6.10352e-05, val.PowerFactor); err != nil {
+		return fmt.Errorf("encode failed for GeneratorPhaseBAcReactivePower-PowerFactor: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.PowerFactorLagging)); err != nil {
+		return fmt.Errorf("encode failed for GeneratorPhaseBAcReactivePower-PowerFactorLagging: %w", err)
+	}
+	stream.writeReserved(14)
+	return nil
+}
 type GeneratorPhaseBAcPower struct {
 	Info MessageInfo
 	RealPower *int32
@@ -35058,6 +40322,19 @@ func DecodeGeneratorPhaseBAcPower(Info MessageInfo, stream *PGNDataStream) (any,
 	}	
 	return val, nil
 }
+func EncodeGeneratorPhaseBAcPower(p any, stream *PGNWriter) error {
+	val, ok := p.(GeneratorPhaseBAcPower)
+	if !ok {
+		return fmt.Errorf("EncodeGeneratorPhaseBAcPower called with %T", p)
+	}
+	if err := stream.writeInt32(32, val.RealPower); err != nil {
+		return fmt.Errorf("encode failed for GeneratorPhaseBAcPower-RealPower: %w", err)
+	}
+	if err := stream.writeInt32(32, val.ApparentPower); err != nil {
+		return fmt.Errorf("encode failed for GeneratorPhaseBAcPower-ApparentPower: %w", err)
+	}
+	return nil
+}
 type GeneratorPhaseBBasicAcQuantities struct {
 	Info MessageInfo
 	LineLineAcRmsVoltage *uint16
@@ -35106,6 +40383,25 @@ func DecodeGeneratorPhaseBBasicAcQuantities(Info MessageInfo, stream *PGNDataStr
 	}	
 	return val, nil
 }
+func EncodeGeneratorPhaseBBasicAcQuantities(p any, stream *PGNWriter) error {
+	val, ok := p.(GeneratorPhaseBBasicAcQuantities)
+	if !ok {
+		return fmt.Errorf("EncodeGeneratorPhaseBBasicAcQuantities called with %T", p)
+	}
+	if err := stream.writeUInt16(16, val.LineLineAcRmsVoltage); err != nil {
+		return fmt.Errorf("encode failed for GeneratorPhaseBBasicAcQuantities-LineLineAcRmsVoltage: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.LineNeutralAcRmsVoltage); err != nil {
+		return fmt.Errorf("encode failed for GeneratorPhaseBBasicAcQuantities-LineNeutralAcRmsVoltage: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.0078125, val.AcFrequency); err != nil {
+		return fmt.Errorf("encode failed for GeneratorPhaseBBasicAcQuantities-AcFrequency: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.AcRmsCurrent); err != nil {
+		return fmt.Errorf("encode failed for GeneratorPhaseBBasicAcQuantities-AcRmsCurrent: %w", err)
+	}
+	return nil
+}
 type GeneratorPhaseAAcReactivePower struct {
 	Info MessageInfo
 	ReactivePower *int32
@@ -35148,6 +40444,23 @@ func DecodeGeneratorPhaseAAcReactivePower(Info MessageInfo, stream *PGNDataStrea
 		}	
 	return val, nil
 }
+func EncodeGeneratorPhaseAAcReactivePower(p any, stream *PGNWriter) error {
+	val, ok := p.(GeneratorPhaseAAcReactivePower)
+	if !ok {
+		return fmt.Errorf("EncodeGeneratorPhaseAAcReactivePower called with %T", p)
+	}
+	if err := stream.writeInt32(32, val.ReactivePower); err != nil {
+		return fmt.Errorf("encode failed for GeneratorPhaseAAcReactivePower-ReactivePower: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 6.10352e-05, val.PowerFactor); err != nil {
+		return fmt.Errorf("encode failed for GeneratorPhaseAAcReactivePower-PowerFactor: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.PowerFactorLagging)); err != nil {
+		return fmt.Errorf("encode failed for GeneratorPhaseAAcReactivePower-PowerFactorLagging: %w", err)
+	}
+	stream.writeReserved(14)
+	return nil
+}
 type GeneratorPhaseAAcPower struct {
 	Info MessageInfo
 	RealPower *int32
@@ -35176,6 +40489,19 @@ func DecodeGeneratorPhaseAAcPower(Info MessageInfo, stream *PGNDataStream) (any,
 	}	
 	return val, nil
 }
+func EncodeGeneratorPhaseAAcPower(p any, stream *PGNWriter) error {
+	val, ok := p.(GeneratorPhaseAAcPower)
+	if !ok {
+		return fmt.Errorf("EncodeGeneratorPhaseAAcPower called with %T", p)
+	}
+	if err := stream.writeInt32(32, val.RealPower); err != nil {
+		return fmt.Errorf("encode failed for GeneratorPhaseAAcPower-RealPower: %w", err)
+	}
+	if err := stream.writeInt32(32, val.ApparentPower); err != nil {
+		return fmt.Errorf("encode failed for GeneratorPhaseAAcPower-ApparentPower: %w", err)
+	}
+	return nil
+}
 type GeneratorPhaseABasicAcQuantities struct {
 	Info MessageInfo
 	LineLineAcRmsVoltage *uint16
@@ -35224,6 +40550,25 @@ func DecodeGeneratorPhaseABasicAcQuantities(Info MessageInfo, stream *PGNDataStr
 	}	
 	return val, nil
 }
+func EncodeGeneratorPhaseABasicAcQuantities(p any, stream *PGNWriter) error {
+	val, ok := p.(GeneratorPhaseABasicAcQuantities)
+	if !ok {
+		return fmt.Errorf("EncodeGeneratorPhaseABasicAcQuantities called with %T", p)
+	}
+	if err := stream.writeUInt16(16, val.LineLineAcRmsVoltage); err != nil {
+		return fmt.Errorf("encode failed for GeneratorPhaseABasicAcQuantities-LineLineAcRmsVoltage: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.LineNeutralAcRmsVoltage); err != nil {
+		return fmt.Errorf("encode failed for GeneratorPhaseABasicAcQuantities-LineNeutralAcRmsVoltage: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.0078125, val.AcFrequency); err != nil {
+		return fmt.Errorf("encode failed for GeneratorPhaseABasicAcQuantities-AcFrequency: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.AcRmsCurrent); err != nil {
+		return fmt.Errorf("encode failed for GeneratorPhaseABasicAcQuantities-AcRmsCurrent: %w", err)
+	}
+	return nil
+}
 type GeneratorTotalAcReactivePower struct {
 	Info MessageInfo
 	ReactivePower *int32
@@ -35266,6 +40611,23 @@ func DecodeGeneratorTotalAcReactivePower(Info MessageInfo, stream *PGNDataStream
 		}	
 	return val, nil
 }
+func EncodeGeneratorTotalAcReactivePower(p any, stream *PGNWriter) error {
+	val, ok := p.(GeneratorTotalAcReactivePower)
+	if !ok {
+		return fmt.Errorf("EncodeGeneratorTotalAcReactivePower called with %T", p)
+	}
+	if err := stream.writeInt32(32, val.ReactivePower); err != nil {
+		return fmt.Errorf("encode failed for GeneratorTotalAcReactivePower-ReactivePower: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 6.10352e-05, val.PowerFactor); err != nil {
+		return fmt.Errorf("encode failed for GeneratorTotalAcReactivePower-PowerFactor: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.PowerFactorLagging)); err != nil {
+		return fmt.Errorf("encode failed for GeneratorTotalAcReactivePower-PowerFactorLagging: %w", err)
+	}
+	stream.writeReserved(14)
+	return nil
+}
 type GeneratorTotalAcPower struct {
 	Info MessageInfo
 	RealPower *int32
@@ -35294,6 +40656,19 @@ func DecodeGeneratorTotalAcPower(Info MessageInfo, stream *PGNDataStream) (any,
 	}	
 	return val, nil
 }
+func EncodeGeneratorTotalAcPower(p any, stream *PGNWriter) error {
+	val, ok := p.(GeneratorTotalAcPower)
+	if !ok {
+		return fmt.Errorf("EncodeGeneratorTotalAcPower called with %T", p)
+	}
+	if err := stream.writeInt32(32, val.RealPower); err != nil {
+		return fmt.Errorf("encode failed for GeneratorTotalAcPower-RealPower: %w", err)
+	}
+	if err := stream.writeInt32(32, val.ApparentPower); err != nil {
+		return fmt.Errorf("encode failed for GeneratorTotalAcPower-ApparentPower: %w", err)
+	}
+	return nil
+}
 type GeneratorAverageBasicAcQuantities struct {
 	Info MessageInfo
 	LineLineAcRmsVoltage *uint16
@@ -35342,6 +40717,25 @@ func DecodeGeneratorAverageBasicAcQuantities(Info MessageInfo, stream *PGNDataSt
 	}	
 	return val, nil
 }
+func EncodeGeneratorAverageBasicAcQuantities(p any, stream *PGNWriter) error {
+	val, ok := p.(GeneratorAverageBasicAcQuantities)
+	if !ok {
+		return fmt.Errorf("EncodeGeneratorAverageBasicAcQuantities called with %T", p)
+	}
+	if err := stream.writeUInt16(16, val.LineLineAcRmsVoltage); err != nil {
+		return fmt.Errorf("encode failed for GeneratorAverageBasicAcQuantities-LineLineAcRmsVoltage: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.LineNeutralAcRmsVoltage); err != nil {
+		return fmt.Errorf("encode failed for GeneratorAverageBasicAcQuantities-LineNeutralAcRmsVoltage: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.0078125, val.AcFrequency); err != nil {
+		return fmt.Errorf("encode failed for GeneratorAverageBasicAcQuantities-AcFrequency: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.AcRmsCurrent); err != nil {
+		return fmt.Errorf("encode failed for GeneratorAverageBasicAcQuantities-AcRmsCurrent: %w", err)
+	}
+	return nil
+}
 type IsoCommandedAddress struct {
 	Info MessageInfo
 	UniqueNumber []uint8
@@ -35448,6 +40842,42 @@ func DecodeIsoCommandedAddress(Info MessageInfo, stream *PGNDataStream) (any, er
 	}	
 	return val, nil
 }
+func EncodeIsoCommandedAddress(p any, stream *PGNWriter) error {
+	val, ok := p.(IsoCommandedAddress)
+	if !ok {
+		return fmt.Errorf("EncodeIsoCommandedAddress called with %T", p)
+	}
+	if err := stream.writeBinaryData(21, val.UniqueNumber); err != nil {
+		return fmt.Errorf("encode failed for IsoCommandedAddress-UniqueNumber: %w", err)
+	}
+	if err := stream.writeLookupField(11, uint64(val.ManufacturerCode)); err != nil {
+		return fmt.Errorf("encode failed for IsoCommandedAddress-ManufacturerCode: %w", err)
+	}
+	if err := stream.writeUInt8(3, val.DeviceInstanceLower); err != nil {
+		return fmt.Errorf("encode failed for IsoCommandedAddress-DeviceInstanceLower: %w", err)
+	}
+	if err := stream.writeUInt8(5, val.DeviceInstanceUpper); err != nil {
+		return fmt.Errorf("encode failed for IsoCommandedAddress-DeviceInstanceUpper: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.DeviceFunction)); err != nil {
+		return fmt.Errorf("encode failed for IsoCommandedAddress-DeviceFunction: %w", err)
+	}
+	stream.writeReserved(1)
+	if err := stream.writeLookupField(7, uint64(val.DeviceClass)); err != nil {
+		return fmt.Errorf("encode failed for IsoCommandedAddress-DeviceClass: %w", err)
+	}
+	if err := stream.writeUInt8(4, val.SystemInstance); err != nil {
+		return fmt.Errorf("encode failed for IsoCommandedAddress-SystemInstance: %w", err)
+	}
+	if err := stream.writeLookupField(3, uint64(val.IndustryCode)); err != nil {
+		return fmt.Errorf("encode failed for IsoCommandedAddress-IndustryCode: %w", err)
+	}
+	stream.writeReserved(1)
+	if err := stream.writeUInt8(8, val.NewSourceAddress); err != nil {
+		return fmt.Errorf("encode failed for IsoCommandedAddress-NewSourceAddress: %w", err)
+	}
+	return nil
+}
 type FurunoHeave struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -35500,6 +40930,24 @@ func DecodeFurunoHeave(Info MessageInfo, stream *PGNDataStream) (any, error) {
 		}	
 	return val, nil
 }
+func EncodeFurunoHeave(p any, stream *PGNWriter) error {
+	val, ok := p.(FurunoHeave)
+	if !ok {
+		return fmt.Errorf("EncodeFurunoHeave called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 1855); err != nil {
+		return fmt.Errorf("encode failed for FurunoHeave-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for FurunoHeave-IndustryCode: %w", err)
+	}
+	if err := stream.writeSignedResolution(32, 0.001, nullableUnitValue(val.Heave, units.Meter, units.Distance.Convert)); err != nil {
+		return fmt.Errorf("encode failed for FurunoHeave-Heave: %w", err)
+	}
+	stream.writeReserved(16)
+	return nil
+}
 type MaretronProprietaryDcBreakerCurrent struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -35572,6 +41020,30 @@ func DecodeMaretronProprietaryDcBreakerCurrent(Info MessageInfo, stream *PGNData
 		}	
 	return val, nil
 }
+func EncodeMaretronProprietaryDcBreakerCurrent(p any, stream *PGNWriter) error {
+	val, ok := p.(MaretronProprietaryDcBreakerCurrent)
+	if !ok {
+		return fmt.Errorf("EncodeMaretronProprietaryDcBreakerCurrent called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 137); err != nil {
+		return fmt.Errorf("encode failed for MaretronProprietaryDcBreakerCurrent-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for MaretronProprietaryDcBreakerCurrent-IndustryCode: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.BankInstance); err != nil {
+		return fmt.Errorf("encode failed for MaretronProprietaryDcBreakerCurrent-BankInstance: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.IndicatorNumber); err != nil {
+		return fmt.Errorf("encode failed for MaretronProprietaryDcBreakerCurrent-IndicatorNumber: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.1, val.BreakerCurrent); err != nil {
+		return fmt.Errorf("encode failed for MaretronProprietaryDcBreakerCurrent-BreakerCurrent: %w", err)
+	}
+	stream.writeReserved(16)
+	return nil
+}
 type AirmarBootStateAcknowledgment struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -35624,6 +41096,24 @@ func DecodeAirmarBootStateAcknowledgment(Info MessageInfo, stream *PGNDataStream
 		}	
 	return val, nil
 }
+func EncodeAirmarBootStateAcknowledgment(p any, stream *PGNWriter) error {
+	val, ok := p.(AirmarBootStateAcknowledgment)
+	if !ok {
+		return fmt.Errorf("EncodeAirmarBootStateAcknowledgment called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 135); err != nil {
+		return fmt.Errorf("encode failed for AirmarBootStateAcknowledgment-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for AirmarBootStateAcknowledgment-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(3, uint64(val.BootState)); err != nil {
+		return fmt.Errorf("encode failed for AirmarBootStateAcknowledgment-BootState: %w", err)
+	}
+	stream.writeReserved(45)
+	return nil
+}
 type LowranceTemperature struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -35686,6 +41176,27 @@ func DecodeLowranceTemperature(Info MessageInfo, stream *PGNDataStream) (any, er
 		}	
 	return val, nil
 }
+func EncodeLowranceTemperature(p any, stream *PGNWriter) error {
+	val, ok := p.(LowranceTemperature)
+	if !ok {
+		return fmt.Errorf("EncodeLowranceTemperature called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 140); err != nil {
+		return fmt.Errorf("encode failed for LowranceTemperature-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for LowranceTemperature-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.TemperatureSource)); err != nil {
+		return fmt.Errorf("encode failed for LowranceTemperature-TemperatureSource: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.01, nullableUnitValue(val.ActualTemperature, units.Kelvin, units.Temperature.Convert)); err != nil {
+		return fmt.Errorf("encode failed for LowranceTemperature-ActualTemperature: %w", err)
+	}
+	stream.writeReserved(24)
+	return nil
+}
 type ChetcoDimmer struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -35784,6 +41295,38 @@ func DecodeChetcoDimmer(Info MessageInfo, stream *PGNDataStream) (any, error) {
 	}	
 	return val, nil
 }
+func EncodeChetcoDimmer(p any, stream *PGNWriter) error {
+	val, ok := p.(ChetcoDimmer)
+	if !ok {
+		return fmt.Errorf("EncodeChetcoDimmer called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 409); err != nil {
+		return fmt.Errorf("encode failed for ChetcoDimmer-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for ChetcoDimmer-IndustryCode: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Instance); err != nil {
+		return fmt.Errorf("encode failed for ChetcoDimmer-Instance: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Dimmer1); err != nil {
+		return fmt.Errorf("encode failed for ChetcoDimmer-Dimmer1: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Dimmer2); err != nil {
+		return fmt.Errorf("encode failed for ChetcoDimmer-Dimmer2: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Dimmer3); err != nil {
+		return fmt.Errorf("encode failed for ChetcoDimmer-Dimmer3: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Dimmer4); err != nil {
+		return fmt.Errorf("encode failed for ChetcoDimmer-Dimmer4: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Control); err != nil {
+		return fmt.Errorf("encode failed for ChetcoDimmer-Control: %w", err)
+	}
+	return nil
+}
 type AirmarBootStateRequest struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -35826,6 +41369,20 @@ func DecodeAirmarBootStateRequest(Info MessageInfo, stream *PGNDataStream) (any,
 		}	
 	return val, nil
 }
+func EncodeAirmarBootStateRequest(p any, stream *PGNWriter) error {
+	if _, ok := p.(AirmarBootStateRequest); !ok {
+		return fmt.Errorf("EncodeAirmarBootStateRequest called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 135); err != nil {
+		return fmt.Errorf("encode failed for AirmarBootStateRequest-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for AirmarBootStateRequest-IndustryCode: %w", err)
+	}
+	stream.writeReserved(48)
+	return nil
+}
 type AirmarAccessLevel struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -35898,6 +41455,30 @@ func DecodeAirmarAccessLevel(Info MessageInfo, stream *PGNDataStream) (any, erro
 	}	
 	return val, nil
 }
+func EncodeAirmarAccessLevel(p any, stream *PGNWriter) error {
+	val, ok := p.(AirmarAccessLevel)
+	if !ok {
+		return fmt.Errorf("EncodeAirmarAccessLevel called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 135); err != nil {
+		return fmt.Errorf("encode failed for AirmarAccessLevel-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for AirmarAccessLevel-IndustryCode: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.FormatCode); err != nil {
+		return fmt.Errorf("encode failed for AirmarAccessLevel-FormatCode: %w", err)
+	}
+	if err := stream.writeLookupField(3, uint64(val.AccessLevel)); err != nil {
+		return fmt.Errorf("encode failed for AirmarAccessLevel-AccessLevel: %w", err)
+	}
+	stream.writeReserved(5)
+	if err := stream.writeUInt32(32, val.AccessSeedKey); err != nil {
+		return fmt.Errorf("encode failed for AirmarAccessLevel-AccessSeedKey: %w", err)
+	}
+	return nil
+}
 type SimnetConfigureTemperatureSensor struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -35940,6 +41521,20 @@ func DecodeSimnetConfigureTemperatureSensor(Info MessageInfo, stream *PGNDataStr
 		}	
 	return val, nil
 }
+func EncodeSimnetConfigureTemperatureSensor(p any, stream *PGNWriter) error {
+	if _, ok := p.(SimnetConfigureTemperatureSensor); !ok {
+		return fmt.Errorf("EncodeSimnetConfigureTemperatureSensor called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 1857); err != nil {
+		return fmt.Errorf("encode failed for SimnetConfigureTemperatureSensor-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SimnetConfigureTemperatureSensor-IndustryCode: %w", err)
+	}
+	stream.writeReserved(48)
+	return nil
+}
 type SeatalkAlarm struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -36028,6 +41623,35 @@ func DecodeSeatalkAlarm(Info MessageInfo, stream *PGNDataStream) (any, error) {
 	}	
 	return val, nil
 }
+func EncodeSeatalkAlarm(p any, stream *PGNWriter) error {
+	val, ok := p.(SeatalkAlarm)
+	if !ok {
+		return fmt.Errorf("EncodeSeatalkAlarm called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 1851); err != nil {
+		return fmt.Errorf("encode failed for SeatalkAlarm-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SeatalkAlarm-IndustryCode: %w", err)
+	}
+	if err := stream.writeBinaryData(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for SeatalkAlarm-Sid: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.AlarmStatus)); err != nil {
+		return fmt.Errorf("encode failed for SeatalkAlarm-AlarmStatus: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.AlarmId)); err != nil {
+		return fmt.Errorf("encode failed for SeatalkAlarm-AlarmId: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.AlarmGroup)); err != nil {
+		return fmt.Errorf("encode failed for SeatalkAlarm-AlarmGroup: %w", err)
+	}
+	if err := stream.writeBinaryData(16, val.AlarmPriority); err != nil {
+		return fmt.Errorf("encode failed for SeatalkAlarm-AlarmPriority: %w", err)
+	}
+	return nil
+}
 type SimnetTrimTabSensorCalibration struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -36070,6 +41694,20 @@ func DecodeSimnetTrimTabSensorCalibration(Info MessageInfo, stream *PGNDataStrea
 		}	
 	return val, nil
 }
+func EncodeSimnetTrimTabSensorCalibration(p any, stream *PGNWriter) error {
+	if _, ok := p.(SimnetTrimTabSensorCalibration); !ok {
+		return fmt.Errorf("EncodeSimnetTrimTabSensorCalibration called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 1857); err != nil {
+		return fmt.Errorf("encode failed for SimnetTrimTabSensorCalibration-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SimnetTrimTabSensorCalibration-IndustryCode: %w", err)
+	}
+	stream.writeReserved(48)
+	return nil
+}
 type SimnetPaddleWheelSpeedConfiguration struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -36112,6 +41750,20 @@ func DecodeSimnetPaddleWheelSpeedConfiguration(Info MessageInfo, stream *PGNData
 		}	
 	return val, nil
 }
+func EncodeSimnetPaddleWheelSpeedConfiguration(p any, stream *PGNWriter) error {
+	if _, ok := p.(SimnetPaddleWheelSpeedConfiguration); !ok {
+		return fmt.Errorf("EncodeSimnetPaddleWheelSpeedConfiguration called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 1857); err != nil {
+		return fmt.Errorf("encode failed for SimnetPaddleWheelSpeedConfiguration-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SimnetPaddleWheelSpeedConfiguration-IndustryCode: %w", err)
+	}
+	stream.writeReserved(48)
+	return nil
+}
 type SimnetClearFluidLevelWarnings struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -36154,6 +41806,20 @@ func DecodeSimnetClearFluidLevelWarnings(Info MessageInfo, stream *PGNDataStream
 		}	
 	return val, nil
 }
+func EncodeSimnetClearFluidLevelWarnings(p any, stream *PGNWriter) error {
+	if _, ok := p.(SimnetClearFluidLevelWarnings); !ok {
+		return fmt.Errorf("EncodeSimnetClearFluidLevelWarnings called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 1857); err != nil {
+		return fmt.Errorf("encode failed for SimnetClearFluidLevelWarnings-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SimnetClearFluidLevelWarnings-IndustryCode: %w", err)
+	}
+	stream.writeReserved(48)
+	return nil
+}
 type SimnetLgc2000Configuration struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -36196,6 +41862,20 @@ func DecodeSimnetLgc2000Configuration(Info MessageInfo, stream *PGNDataStream) (
 		}	
 	return val, nil
 }
+func EncodeSimnetLgc2000Configuration(p any, stream *PGNWriter) error {
+	if _, ok := p.(SimnetLgc2000Configuration); !ok {
+		return fmt.Errorf("EncodeSimnetLgc2000Configuration called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 1857); err != nil {
+		return fmt.Errorf("encode failed for SimnetLgc2000Configuration-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SimnetLgc2000Configuration-IndustryCode: %w", err)
+	}
+	stream.writeReserved(48)
+	return nil
+}
 type DiverseYachtServicesLoadCell struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -36258,6 +41938,27 @@ func DecodeDiverseYachtServicesLoadCell(Info MessageInfo, stream *PGNDataStream)
 	}	
 	return val, nil
 }
+func EncodeDiverseYachtServicesLoadCell(p any, stream *PGNWriter) error {
+	val, ok := p.(DiverseYachtServicesLoadCell)
+	if !ok {
+		return fmt.Errorf("EncodeDiverseYachtServicesLoadCell called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 641); err != nil {
+		return fmt.Errorf("encode failed for DiverseYachtServicesLoadCell-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for DiverseYachtServicesLoadCell-IndustryCode: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Instance); err != nil {
+		return fmt.Errorf("encode failed for DiverseYachtServicesLoadCell-Instance: %w", err)
+	}
+	stream.writeReserved(8)
+	if err := stream.writeUInt32(32, val.LoadCell); err != nil {
+		return fmt.Errorf("encode failed for DiverseYachtServicesLoadCell-LoadCell: %w", err)
+	}
+	return nil
+}
 type SimnetApUnknown1 struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -36340,6 +42041,33 @@ func DecodeSimnetApUnknown1(Info MessageInfo, stream *PGNDataStream) (any, error
 		}	
 	return val, nil
 }
+func EncodeSimnetApUnknown1(p any, stream *PGNWriter) error {
+	val, ok := p.(SimnetApUnknown1)
+	if !ok {
+		return fmt.Errorf("EncodeSimnetApUnknown1 called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 1857); err != nil {
+		return fmt.Errorf("encode failed for SimnetApUnknown1-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SimnetApUnknown1-IndustryCode: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.A); err != nil {
+		return fmt.Errorf("encode failed for SimnetApUnknown1-A: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.B); err != nil {
+		return fmt.Errorf("encode failed for SimnetApUnknown1-B: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.C); err != nil {
+		return fmt.Errorf("encode failed for SimnetApUnknown1-C: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.D); err != nil {
+		return fmt.Errorf("encode failed for SimnetApUnknown1-D: %w", err)
+	}
+	stream.writeReserved(8)
+	return nil
+}
 type SimnetDeviceStatus struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -36415,6 +42143,30 @@ func DecodeSimnetDeviceStatus(Info MessageInfo, stream *PGNDataStream) (any, err
 		}	
 	return val, nil
 }
+func EncodeSimnetDeviceStatus(p any, stream *PGNWriter) error {
+	val, ok := p.(SimnetDeviceStatus)
+	if !ok {
+		return fmt.Errorf("EncodeSimnetDeviceStatus called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 1857); err != nil {
+		return fmt.Errorf("encode failed for SimnetDeviceStatus-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SimnetDeviceStatus-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Model)); err != nil {
+		return fmt.Errorf("encode failed for SimnetDeviceStatus-Model: %w", err)
+	}
+	if err := stream.writeLookupField(8, 2); err != nil {
+		return fmt.Errorf("encode failed for SimnetDeviceStatus-Report: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Status)); err != nil {
+		return fmt.Errorf("encode failed for SimnetDeviceStatus-Status: %w", err)
+	}
+	stream.writeReserved(24)
+	return nil
+}
 type SimnetDeviceStatusRequest struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -36480,6 +42232,27 @@ func DecodeSimnetDeviceStatusRequest(Info MessageInfo, stream *PGNDataStream) (a
 		}	
 	return val, nil
 }
+func EncodeSimnetDeviceStatusRequest(p any, stream *PGNWriter) error {
+	val, ok := p.(SimnetDeviceStatusRequest)
+	if !ok {
+		return fmt.Errorf("EncodeSimnetDeviceStatusRequest called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 1857); err != nil {
+		return fmt.Errorf("encode failed for SimnetDeviceStatusRequest-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SimnetDeviceStatusRequest-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Model)); err != nil {
+		return fmt.Errorf("encode failed for SimnetDeviceStatusRequest-Model: %w", err)
+	}
+	if err := stream.writeLookupField(8, 3); err != nil {
+		return fmt.Errorf("encode failed for SimnetDeviceStatusRequest-Report: %w", err)
+	}
+	stream.writeReserved(32)
+	return nil
+}
 type SimnetPilotMode struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -36555,6 +42328,30 @@ func DecodeSimnetPilotMode(Info MessageInfo, stream *PGNDataStream) (any, error)
 		}	
 	return val, nil
 }
+func EncodeSimnetPilotMode(p any, stream *PGNWriter) error {
+	val, ok := p.(SimnetPilotMode)
+	if !ok {
+		return fmt.Errorf("EncodeSimnetPilotMode called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 1857); err != nil {
+		return fmt.Errorf("encode failed for SimnetPilotMode-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SimnetPilotMode-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Model)); err != nil {
+		return fmt.Errorf("encode failed for SimnetPilotMode-Model: %w", err)
+	}
+	if err := stream.writeLookupField(8, 10); err != nil {
+		return fmt.Errorf("encode failed for SimnetPilotMode-Report: %w", err)
+	}
+	if err := stream.writeLookupField(16, uint64(val.Mode)); err != nil {
+		return fmt.Errorf("encode failed for SimnetPilotMode-Mode: %w", err)
+	}
+	stream.writeReserved(16)
+	return nil
+}
 type SimnetDeviceModeRequest struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -36620,6 +42417,27 @@ func DecodeSimnetDeviceModeRequest(Info MessageInfo, stream *PGNDataStream) (any
 		}	
 	return val, nil
 }
+func EncodeSimnetDeviceModeRequest(p any, stream *PGNWriter) error {
+	val, ok := p.(SimnetDeviceModeRequest)
+	if !ok {
+		return fmt.Errorf("EncodeSimnetDeviceModeRequest called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 1857); err != nil {
+		return fmt.Errorf("encode failed for SimnetDeviceModeRequest-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SimnetDeviceModeRequest-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Model)); err != nil {
+		return fmt.Errorf("encode failed for SimnetDeviceModeRequest-Model: %w", err)
+	}
+	if err := stream.writeLookupField(8, 11); err != nil {
+		return fmt.Errorf("encode failed for SimnetDeviceModeRequest-Report: %w", err)
+	}
+	stream.writeReserved(32)
+	return nil
+}
 type SimnetSailingProcessorStatus struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -36691,6 +42509,29 @@ func DecodeSimnetSailingProcessorStatus(Info MessageInfo, stream *PGNDataStream)
 	}	
 	return val, nil
 }
+func EncodeSimnetSailingProcessorStatus(p any, stream *PGNWriter) error {
+	val, ok := p.(SimnetSailingProcessorStatus)
+	if !ok {
+		return fmt.Errorf("EncodeSimnetSailingProcessorStatus called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 1857); err != nil {
+		return fmt.Errorf("encode failed for SimnetSailingProcessorStatus-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SimnetSailingProcessorStatus-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Model)); err != nil {
+		return fmt.Errorf("encode failed for SimnetSailingProcessorStatus-Model: %w", err)
+	}
+	if err := stream.writeLookupField(8, 23); err != nil {
+		return fmt.Errorf("encode failed for SimnetSailingProcessorStatus-Report: %w", err)
+	}
+	if err := stream.writeBinaryData(32, val.Data); err != nil {
+		return fmt.Errorf("encode failed for SimnetSailingProcessorStatus-Data: %w", err)
+	}
+	return nil
+}
 type NavicoWirelessBatteryStatus struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -36763,6 +42604,30 @@ func DecodeNavicoWirelessBatteryStatus(Info MessageInfo, stream *PGNDataStream)
 		}	
 	return val, nil
 }
+func EncodeNavicoWirelessBatteryStatus(p any, stream *PGNWriter) error {
+	val, ok := p.(NavicoWirelessBatteryStatus)
+	if !ok {
+		return fmt.Errorf("EncodeNavicoWirelessBatteryStatus called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 275); err != nil {
+		return fmt.Errorf("encode failed for NavicoWirelessBatteryStatus-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for NavicoWirelessBatteryStatus-IndustryCode: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Status); err != nil {
+		return fmt.Errorf("encode failed for NavicoWirelessBatteryStatus-Status: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.BatteryStatus); err != nil {
+		return fmt.Errorf("encode failed for NavicoWirelessBatteryStatus-BatteryStatus: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.BatteryChargeStatus); err != nil {
+		return fmt.Errorf("encode failed for NavicoWirelessBatteryStatus-BatteryChargeStatus: %w", err)
+	}
+	stream.writeReserved(24)
+	return nil
+}
 type NavicoWirelessSignalStatus struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -36825,6 +42690,27 @@ func DecodeNavicoWirelessSignalStatus(Info MessageInfo, stream *PGNDataStream) (
 		}	
 	return val, nil
 }
+func EncodeNavicoWirelessSignalStatus(p any, stream *PGNWriter) error {
+	val, ok := p.(NavicoWirelessSignalStatus)
+	if !ok {
+		return fmt.Errorf("EncodeNavicoWirelessSignalStatus called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 275); err != nil {
+		return fmt.Errorf("encode failed for NavicoWirelessSignalStatus-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for NavicoWirelessSignalStatus-IndustryCode: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Unknown); err != nil {
+		return fmt.Errorf("encode failed for NavicoWirelessSignalStatus-Unknown: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.SignalStrength); err != nil {
+		return fmt.Errorf("encode failed for NavicoWirelessSignalStatus-SignalStrength: %w", err)
+	}
+	stream.writeReserved(32)
+	return nil
+}
 type SimnetApUnknown2 struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -36917,6 +42803,36 @@ func DecodeSimnetApUnknown2(Info MessageInfo, stream *PGNDataStream) (any, error
 		}	
 	return val, nil
 }
+func EncodeSimnetApUnknown2(p any, stream *PGNWriter) error {
+	val, ok := p.(SimnetApUnknown2)
+	if !ok {
+		return fmt.Errorf("EncodeSimnetApUnknown2 called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 1857); err != nil {
+		return fmt.Errorf("encode failed for SimnetApUnknown2-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SimnetApUnknown2-IndustryCode: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.A); err != nil {
+		return fmt.Errorf("encode failed for SimnetApUnknown2-A: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.B); err != nil {
+		return fmt.Errorf("encode failed for SimnetApUnknown2-B: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.C); err != nil {
+		return fmt.Errorf("encode failed for SimnetApUnknown2-C: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.D); err != nil {
+		return fmt.Errorf("encode failed for SimnetApUnknown2-D: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.E); err != nil {
+		return fmt.Errorf("encode failed for SimnetApUnknown2-E: %w", err)
+	}
+	stream.writeReserved(8)
+	return nil
+}
 type SimnetAutopilotAngle struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -36983,6 +42899,28 @@ func DecodeSimnetAutopilotAngle(Info MessageInfo, stream *PGNDataStream) (any, e
 	}	
 	return val, nil
 }
+func EncodeSimnetAutopilotAngle(p any, stream *PGNWriter) error {
+	val, ok := p.(SimnetAutopilotAngle)
+	if !ok {
+		return fmt.Errorf("EncodeSimnetAutopilotAngle called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 1857); err != nil {
+		return fmt.Errorf("encode failed for SimnetAutopilotAngle-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SimnetAutopilotAngle-IndustryCode: %w", err)
+	}
+	stream.writeReserved(16)
+	if err := stream.writeLookupField(8, uint64(val.Mode)); err != nil {
+		return fmt.Errorf("encode failed for SimnetAutopilotAngle-Mode: %w", err)
+	}
+	stream.writeReserved(8)
+	if err := stream.writeUnsignedResolution(16, 0.0001, val.Angle); err != nil {
+		return fmt.Errorf("encode failed for SimnetAutopilotAngle-Angle: %w", err)
+	}
+	return nil
+}
 type SeatalkPilotWindDatum struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -37045,6 +42983,27 @@ func DecodeSeatalkPilotWindDatum(Info MessageInfo, stream *PGNDataStream) (any,
 		}	
 	return val, nil
 }
+func EncodeSeatalkPilotWindDatum(p any, stream *PGNWriter) error {
+	val, ok := p.(SeatalkPilotWindDatum)
+	if !ok {
+		return fmt.Errorf("EncodeSeatalkPilotWindDatum called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 1851); err != nil {
+		return fmt.Errorf("encode failed for SeatalkPilotWindDatum-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SeatalkPilotWindDatum-IndustryCode: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.0001, val.WindDatum); err != nil {
+		return fmt.Errorf("encode failed for SeatalkPilotWindDatum-WindDatum: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.0001, val.RollingAverageWindAngle); err != nil {
+		return fmt.Errorf("encode failed for SeatalkPilotWindDatum-RollingAverageWindAngle: %w", err)
+	}
+	stream.writeReserved(16)
+	return nil
+}
 type SimnetMagneticField struct {
 	Info MessageInfo
 	A *float32
@@ -37097,6 +43056,26 @@ func DecodeSimnetMagneticField(Info MessageInfo, stream *PGNDataStream) (any, er
 		}	
 	return val, nil
 }
+func EncodeSimnetMagneticField(p any, stream *PGNWriter) error {
+	val, ok := p.(SimnetMagneticField)
+	if !ok {
+		return fmt.Errorf("EncodeSimnetMagneticField called with %T", p)
+	}
+	if err := stream.writeSignedResolution(16, 0.0001, val.A); err != nil {
+		return fmt.Errorf("encode failed for SimnetMagneticField-A: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.B); err != nil {
+		return fmt.Errorf("encode failed for SimnetMagneticField-B: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.0001, val.C); err != nil {
+		return fmt.Errorf("encode failed for SimnetMagneticField-C: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.0001, val.D); err != nil {
+		return fmt.Errorf("encode failed for SimnetMagneticField-D: %w", err)
+	}
+	stream.writeReserved(8)
+	return nil
+}
 type SeatalkPilotHeading struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -37169,6 +43148,30 @@ func DecodeSeatalkPilotHeading(Info MessageInfo, stream *PGNDataStream) (any, er
 		}	
 	return val, nil
 }
+func EncodeSeatalkPilotHeading(p any, stream *PGNWriter) error {
+	val, ok := p.(SeatalkPilotHeading)
+	if !ok {
+		return fmt.Errorf("EncodeSeatalkPilotHeading called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 1851); err != nil {
+		return fmt.Errorf("encode failed for SeatalkPilotHeading-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SeatalkPilotHeading-IndustryCode: %w", err)
+	}
+	if err := stream.writeBinaryData(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for SeatalkPilotHeading-Sid: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.0001, val.HeadingTrue); err != nil {
+		return fmt.Errorf("encode failed for SeatalkPilotHeading-HeadingTrue: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.0001, val.HeadingMagnetic); err != nil {
+		return fmt.Errorf("encode failed for SeatalkPilotHeading-HeadingMagnetic: %w", err)
+	}
+	stream.writeReserved(8)
+	return nil
+}
 type SeatalkPilotLockedHeading struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -37241,6 +43244,30 @@ func DecodeSeatalkPilotLockedHeading(Info MessageInfo, stream *PGNDataStream) (a
 		}	
 	return val, nil
 }
+func EncodeSeatalkPilotLockedHeading(p any, stream *PGNWriter) error {
+	val, ok := p.(SeatalkPilotLockedHeading)
+	if !ok {
+		return fmt.Errorf("EncodeSeatalkPilotLockedHeading called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 1851); err != nil {
+		return fmt.Errorf("encode failed for SeatalkPilotLockedHeading-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SeatalkPilotLockedHeading-IndustryCode: %w", err)
+	}
+	if err := stream.writeBinaryData(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for SeatalkPilotLockedHeading-Sid: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.0001, val.TargetHeadingTrue); err != nil {
+		return fmt.Errorf("encode failed for SeatalkPilotLockedHeading-TargetHeadingTrue: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.0001, val.TargetHeadingMagnetic); err != nil {
+		return fmt.Errorf("encode failed for SeatalkPilotLockedHeading-TargetHeadingMagnetic: %w", err)
+	}
+	stream.writeReserved(8)
+	return nil
+}
 type SeatalkSilenceAlarm struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -37303,6 +43330,27 @@ func DecodeSeatalkSilenceAlarm(Info MessageInfo, stream *PGNDataStream) (any, er
 		}	
 	return val, nil
 }
+func EncodeSeatalkSilenceAlarm(p any, stream *PGNWriter) error {
+	val, ok := p.(SeatalkSilenceAlarm)
+	if !ok {
+		return fmt.Errorf("EncodeSeatalkSilenceAlarm called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 1851); err != nil {
+		return fmt.Errorf("encode failed for SeatalkSilenceAlarm-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SeatalkSilenceAlarm-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.AlarmId)); err != nil {
+		return fmt.Errorf("encode failed for SeatalkSilenceAlarm-AlarmId: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.AlarmGroup)); err != nil {
+		return fmt.Errorf("encode failed for SeatalkSilenceAlarm-AlarmGroup: %w", err)
+	}
+	stream.writeReserved(32)
+	return nil
+}
 type SeatalkKeypadMessage struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -37409,6 +43457,40 @@ func DecodeSeatalkKeypadMessage(Info MessageInfo, stream *PGNDataStream) (any, e
 		}	
 	return val, nil
 }
+func EncodeSeatalkKeypadMessage(p any, stream *PGNWriter) error {
+	val, ok := p.(SeatalkKeypadMessage)
+	if !ok {
+		return fmt.Errorf("EncodeSeatalkKeypadMessage called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 1851); err != nil {
+		return fmt.Errorf("encode failed for SeatalkKeypadMessage-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SeatalkKeypadMessage-IndustryCode: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.ProprietaryId); err != nil {
+		return fmt.Errorf("encode failed for SeatalkKeypadMessage-ProprietaryId: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.FirstKey); err != nil {
+		return fmt.Errorf("encode failed for SeatalkKeypadMessage-FirstKey: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.SecondKey); err != nil {
+		return fmt.Errorf("encode failed for SeatalkKeypadMessage-SecondKey: %w", err)
+	}
+	if err := stream.writeUInt8(2, val.FirstKeyState); err != nil {
+		return fmt.Errorf("encode failed for SeatalkKeypadMessage-FirstKeyState: %w", err)
+	}
+	if err := stream.writeUInt8(2, val.SecondKeyState); err != nil {
+		return fmt.Errorf("encode failed for SeatalkKeypadMessage-SecondKeyState: %w", err)
+	}
+	stream.writeReserved(4)
+	if err := stream.writeUInt8(8, val.EncoderPosition); err != nil {
+		return fmt.Errorf("encode failed for SeatalkKeypadMessage-EncoderPosition: %w", err)
+	}
+	stream.writeReserved(8)
+	return nil
+}
 type SeatalkKeypadHeartbeat struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -37481,6 +43563,30 @@ func DecodeSeatalkKeypadHeartbeat(Info MessageInfo, stream *PGNDataStream) (any,
 		}	
 	return val, nil
 }
+func EncodeSeatalkKeypadHeartbeat(p any, stream *PGNWriter) error {
+	val, ok := p.(SeatalkKeypadHeartbeat)
+	if !ok {
+		return fmt.Errorf("EncodeSeatalkKeypadHeartbeat called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 1851); err != nil {
+		return fmt.Errorf("encode failed for SeatalkKeypadHeartbeat-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SeatalkKeypadHeartbeat-IndustryCode: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.ProprietaryId); err != nil {
+		return fmt.Errorf("encode failed for SeatalkKeypadHeartbeat-ProprietaryId: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Variant); err != nil {
+		return fmt.Errorf("encode failed for SeatalkKeypadHeartbeat-Variant: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Status); err != nil {
+		return fmt.Errorf("encode failed for SeatalkKeypadHeartbeat-Status: %w", err)
+	}
+	stream.writeReserved(24)
+	return nil
+}
 type SeatalkPilotMode struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -37553,6 +43659,30 @@ func DecodeSeatalkPilotMode(Info MessageInfo, stream *PGNDataStream) (any, error
 		}	
 	return val, nil
 }
+func EncodeSeatalkPilotMode(p any, stream *PGNWriter) error {
+	val, ok := p.(SeatalkPilotMode)
+	if !ok {
+		return fmt.Errorf("EncodeSeatalkPilotMode called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 1851); err != nil {
+		return fmt.Errorf("encode failed for SeatalkPilotMode-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SeatalkPilotMode-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(16, uint64(val.PilotMode)); err != nil {
+		return fmt.Errorf("encode failed for SeatalkPilotMode-PilotMode: %w", err)
+	}
+	if err := stream.writeBinaryData(16, val.SubMode); err != nil {
+		return fmt.Errorf("encode failed for SeatalkPilotMode-SubMode: %w", err)
+	}
+	if err := stream.writeBinaryData(8, val.PilotModeData); err != nil {
+		return fmt.Errorf("encode failed for SeatalkPilotMode-PilotModeData: %w", err)
+	}
+	stream.writeReserved(8)
+	return nil
+}
 type AirmarDepthQualityFactor struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -37615,6 +43745,27 @@ func DecodeAirmarDepthQualityFactor(Info MessageInfo, stream *PGNDataStream) (an
 		}	
 	return val, nil
 }
+func EncodeAirmarDepthQualityFactor(p any, stream *PGNWriter) error {
+	val, ok := p.(AirmarDepthQualityFactor)
+	if !ok {
+		return fmt.Errorf("EncodeAirmarDepthQualityFactor called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 135); err != nil {
+		return fmt.Errorf("encode failed for AirmarDepthQualityFactor-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for AirmarDepthQualityFactor-IndustryCode: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for AirmarDepthQualityFactor-Sid: %w", err)
+	}
+	if err := stream.writeLookupField(4, uint64(val.DepthQualityFactor)); err != nil {
+		return fmt.Errorf("encode failed for AirmarDepthQualityFactor-DepthQualityFactor: %w", err)
+	}
+	stream.writeReserved(36)
+	return nil
+}
 type AirmarSpeedPulseCount struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -37687,6 +43838,30 @@ func DecodeAirmarSpeedPulseCount(Info MessageInfo, stream *PGNDataStream) (any,
 		}	
 	return val, nil
 }
+func EncodeAirmarSpeedPulseCount(p any, stream *PGNWriter) error {
+	val, ok := p.(AirmarSpeedPulseCount)
+	if !ok {
+		return fmt.Errorf("EncodeAirmarSpeedPulseCount called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 135); err != nil {
+		return fmt.Errorf("encode failed for AirmarSpeedPulseCount-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for AirmarSpeedPulseCount-IndustryCode: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for AirmarSpeedPulseCount-Sid: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.001, val.DurationOfInterval); err != nil {
+		return fmt.Errorf("encode failed for AirmarSpeedPulseCount-DurationOfInterval: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.NumberOfPulsesReceived); err != nil {
+		return fmt.Errorf("encode failed for AirmarSpeedPulseCount-NumberOfPulsesReceived: %w", err)
+	}
+	stream.writeReserved(8)
+	return nil
+}
 type AirmarDeviceInformation struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -37759,6 +43934,30 @@ func DecodeAirmarDeviceInformation(Info MessageInfo, stream *PGNDataStream) (any
 		}	
 	return val, nil
 }
+func EncodeAirmarDeviceInformation(p any, stream *PGNWriter) error {
+	val, ok := p.(AirmarDeviceInformation)
+	if !ok {
+		return fmt.Errorf("EncodeAirmarDeviceInformation called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 135); err != nil {
+		return fmt.Errorf("encode failed for AirmarDeviceInformation-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for AirmarDeviceInformation-IndustryCode: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for AirmarDeviceInformation-Sid: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.01, nullableUnitValue(val.InternalDeviceTemperature, units.Kelvin, units.Temperature.Convert)); err != nil {
+		return fmt.Errorf("encode failed for AirmarDeviceInformation-InternalDeviceTemperature: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.01, val.SupplyVoltage); err != nil {
+		return fmt.Errorf("encode failed for AirmarDeviceInformation-SupplyVoltage: %w", err)
+	}
+	stream.writeReserved(8)
+	return nil
+}
 type SimnetApUnknown3 struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -37851,6 +44050,36 @@ func DecodeSimnetApUnknown3(Info MessageInfo, stream *PGNDataStream) (any, error
 		}	
 	return val, nil
 }
+func EncodeSimnetApUnknown3(p any, stream *PGNWriter) error {
+	val, ok := p.(SimnetApUnknown3)
+	if !ok {
+		return fmt.Errorf("EncodeSimnetApUnknown3 called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 1857); err != nil {
+		return fmt.Errorf("encode failed for SimnetApUnknown3-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SimnetApUnknown3-IndustryCode: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.A); err != nil {
+		return fmt.Errorf("encode failed for SimnetApUnknown3-A: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.B); err != nil {
+		return fmt.Errorf("encode failed for SimnetApUnknown3-B: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.C); err != nil {
+		return fmt.Errorf("encode failed for SimnetApUnknown3-C: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.D); err != nil {
+		return fmt.Errorf("encode failed for SimnetApUnknown3-D: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.E); err != nil {
+		return fmt.Errorf("encode failed for SimnetApUnknown3-E: %w", err)
+	}
+	stream.writeReserved(8)
+	return nil
+}
 type SimnetAutopilotMode struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -37893,6 +44122,20 @@ func DecodeSimnetAutopilotMode(Info MessageInfo, stream *PGNDataStream) (any, er
 		}	
 	return val, nil
 }
+func EncodeSimnetAutopilotMode(p any, stream *PGNWriter) error {
+	if _, ok := p.(SimnetAutopilotMode); !ok {
+		return fmt.Errorf("EncodeSimnetAutopilotMode called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 1857); err != nil {
+		return fmt.Errorf("encode failed for SimnetAutopilotMode-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SimnetAutopilotMode-IndustryCode: %w", err)
+	}
+	stream.writeReserved(48)
+	return nil
+}
 type NmeaRequestGroupFunction struct {
 	Info MessageInfo
 	FunctionCode GroupFunctionConst
@@ -37997,6 +44240,45 @@ func DecodeNmeaRequestGroupFunction(Info MessageInfo, stream *PGNDataStream) (an
 	}	
 	return val, nil
 }
+func EncodeNmeaRequestGroupFunction(p any, stream *PGNWriter) error {
+	val, ok := p.(NmeaRequestGroupFunction)
+	if !ok {
+		return fmt.Errorf("EncodeNmeaRequestGroupFunction called with %T", p)
+	}
+		var fieldIndex uint8
+		var manufacturer ManufacturerCodeConst
+	if val.Pgn == nil {
+		return fmt.Errorf("encode failed for NmeaRequestGroupFunction-Pgn: Pgn is required")
+	}
+	if err := stream.writeLookupField(8, 0); err != nil {
+		return fmt.Errorf("encode failed for NmeaRequestGroupFunction-FunctionCode: %w", err)
+	}
+	if err := stream.writeUInt32(24, val.Pgn); err != nil {
+		return fmt.Errorf("encode failed for NmeaRequestGroupFunction-Pgn: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(32, 0.001, val.TransmissionInterval); err != nil {
+		return fmt.Errorf("encode failed for NmeaRequestGroupFunction-TransmissionInterval: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.01, val.TransmissionIntervalOffset); err != nil {
+		return fmt.Errorf("encode failed for NmeaRequestGroupFunction-TransmissionIntervalOffset: %w", err)
+	}
+	repeat1Count := uint8(len(val.Repeating1))
+	if err := stream.writeUInt8(8, &repeat1Count); err != nil {
+		return fmt.Errorf("encode failed for NmeaRequestGroupFunction-NumberOfParameters: %w", err)
+	}
+	for _, rep := range val.Repeating1 {
+		if err := stream.writeUInt8(8, rep.Parameter); err != nil {
+			return fmt.Errorf("encode failed for NmeaRequestGroupFunction-Parameter: %w", err)
+		}
+		if rep.Parameter != nil {
+			fieldIndex = *rep.Parameter
+		}
+		if err := stream.writeVariableData(*val.Pgn, manufacturer, fieldIndex, rep.Value); err != nil {
+			return fmt.Errorf("encode failed for NmeaRequestGroupFunction-Value: %w", err)
+		}
+	}
+	return nil
+}
 type NmeaCommandGroupFunction struct {
 	Info MessageInfo
 	FunctionCode GroupFunctionConst
@@ -38095,6 +44377,43 @@ func DecodeNmeaCommandGroupFunction(Info MessageInfo, stream *PGNDataStream) (an
 	}	
 	return val, nil
 }
+func EncodeNmeaCommandGroupFunction(p any, stream *PGNWriter) error {
+	val, ok := p.(NmeaCommandGroupFunction)
+	if !ok {
+		return fmt.Errorf("EncodeNmeaCommandGroupFunction called with %T", p)
+	}
+		var fieldIndex uint8
+		var manufacturer ManufacturerCodeConst
+	if val.Pgn == nil {
+		return fmt.Errorf("encode failed for NmeaCommandGroupFunction-Pgn: Pgn is required")
+	}
+	if err := stream.writeLookupField(8, 1); err != nil {
+		return fmt.Errorf("encode failed for NmeaCommandGroupFunction-FunctionCode: %w", err)
+	}
+	if err := stream.writeUInt32(24, val.Pgn); err != nil {
+		return fmt.Errorf("encode failed for NmeaCommandGroupFunction-Pgn: %w", err)
+	}
+	if err := stream.writeLookupField(4, uint64(val.Priority)); err != nil {
+		return fmt.Errorf("encode failed for NmeaCommandGroupFunction-Priority: %w", err)
+	}
+	stream.writeReserved(4)
+	repeat1Count := uint8(len(val.Repeating1))
+	if err := stream.writeUInt8(8, &repeat1Count); err != nil {
+		return fmt.Errorf("encode failed for NmeaCommandGroupFunction-NumberOfParameters: %w", err)
+	}
+	for _, rep := range val.Repeating1 {
+		if err := stream.writeUInt8(8, rep.Parameter); err != nil {
+			return fmt.Errorf("encode failed for NmeaCommandGroupFunction-Parameter: %w", err)
+		}
+		if rep.Parameter != nil {
+			fieldIndex = *rep.Parameter
+		}
+		if err := stream.writeVariableData(*val.Pgn, manufacturer, fieldIndex, rep.Value); err != nil {
+			return fmt.Errorf("encode failed for NmeaCommandGroupFunction-Value: %w", err)
+		}
+	}
+	return nil
+}
 type NmeaAcknowledgeGroupFunction struct {
 	Info MessageInfo
 	FunctionCode GroupFunctionConst
@@ -38188,6 +44507,34 @@ func DecodeNmeaAcknowledgeGroupFunction(Info MessageInfo, stream *PGNDataStream)
 	}	
 	return val, nil
 }
+func EncodeNmeaAcknowledgeGroupFunction(p any, stream *PGNWriter) error {
+	val, ok := p.(NmeaAcknowledgeGroupFunction)
+	if !ok {
+		return fmt.Errorf("EncodeNmeaAcknowledgeGroupFunction called with %T", p)
+	}
+	if err := stream.writeLookupField(8, 2); err != nil {
+		return fmt.Errorf("encode failed for NmeaAcknowledgeGroupFunction-FunctionCode: %w", err)
+	}
+	if err := stream.writeUInt32(24, val.Pgn); err != nil {
+		return fmt.Errorf("encode failed for NmeaAcknowledgeGroupFunction-Pgn: %w", err)
+	}
+	if err := stream.writeLookupField(4, uint64(val.PgnErrorCode)); err != nil {
+		return fmt.Errorf("encode failed for NmeaAcknowledgeGroupFunction-PgnErrorCode: %w", err)
+	}
+	if err := stream.writeLookupField(4, uint64(val.TransmissionIntervalPriorityErrorCode)); err != nil {
+		return fmt.Errorf("encode failed for NmeaAcknowledgeGroupFunction-TransmissionIntervalPriorityErrorCode: %w", err)
+	}
+	repeat1Count := uint8(len(val.Repeating1))
+	if err := stream.writeUInt8(8, &repeat1Count); err != nil {
+		return fmt.Errorf("encode failed for NmeaAcknowledgeGroupFunction-NumberOfParameters: %w", err)
+	}
+	for _, rep := range val.Repeating1 {
+		if err := stream.writeLookupField(4, uint64(rep.Parameter)); err != nil {
+			return fmt.Errorf("encode failed for NmeaAcknowledgeGroupFunction-Parameter: %w", err)
+		}
+	}
+	return nil
+}
 type NmeaReadFieldsGroupFunction struct {
 	Info MessageInfo
 	FunctionCode GroupFunctionConst
@@ -38350,6 +44697,60 @@ func DecodeNmeaReadFieldsGroupFunction(Info MessageInfo, stream *PGNDataStream)
 	}	
 	return val, nil
 }
+func EncodeNmeaReadFieldsGroupFunction(p any, stream *PGNWriter) error {
+	val, ok := p.(NmeaReadFieldsGroupFunction)
+	if !ok {
+		return fmt.Errorf("EncodeNmeaReadFieldsGroupFunction called with %T", p)
+	}
+		var fieldIndex uint8
+		var manufacturer ManufacturerCodeConst
+	if val.Pgn == nil {
+		return fmt.Errorf("encode failed for NmeaReadFieldsGroupFunction-Pgn: Pgn is required")
+	}
+	if err := stream.writeLookupField(8, 3); err != nil {
+		return fmt.Errorf("encode failed for NmeaReadFieldsGroupFunction-FunctionCode: %w", err)
+	}
+	if err := stream.writeUInt32(24, val.Pgn); err != nil {
+		return fmt.Errorf("encode failed for NmeaReadFieldsGroupFunction-Pgn: %w", err)
+	}
+	if IsProprietaryPGN(*val.Pgn) {
+	if err := stream.writeLookupField(11, uint64(val.ManufacturerCode)); err != nil {
+		return fmt.Errorf("encode failed for NmeaReadFieldsGroupFunction-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, uint64(val.IndustryCode)); err != nil {
+		return fmt.Errorf("encode failed for NmeaReadFieldsGroupFunction-IndustryCode: %w", err)
+	}
+	}
+	if err := stream.writeUInt8(8, val.UniqueId); err != nil {
+		return fmt.Errorf("encode failed for NmeaReadFieldsGroupFunction-UniqueId: %w", err)
+	}
+	repeat1Count := uint8(len(val.Repeating1))
+	if err := stream.writeUInt8(8, &repeat1Count); err != nil {
+		return fmt.Errorf("encode failed for NmeaReadFieldsGroupFunction-NumberOfSelectionPairs: %w", err)
+	}
+	repeat2Count := uint8(len(val.Repeating2))
+	if err := stream.writeUInt8(8, &repeat2Count); err != nil {
+		return fmt.Errorf("encode failed for NmeaReadFieldsGroupFunction-NumberOfParameters: %w", err)
+	}
+	for _, rep := range val.Repeating1 {
+		if err := stream.writeUInt8(8, rep.SelectionParameter); err != nil {
+			return fmt.Errorf("encode failed for NmeaReadFieldsGroupFunction-SelectionParameter: %w", err)
+		}
+		if rep.SelectionParameter != nil {
+			fieldIndex = *rep.SelectionParameter
+		}
+		if err := stream.writeVariableData(*val.Pgn, manufacturer, fieldIndex, rep.SelectionValue); err != nil {
+			return fmt.Errorf("encode failed for NmeaReadFieldsGroupFunction-SelectionValue: %w", err)
+		}
+	}
+	for _, rep := range val.Repeating2 {
+		if err := stream.writeUInt8(8, rep.Parameter); err != nil {
+			return fmt.Errorf("encode failed for NmeaReadFieldsGroupFunction-Parameter: %w", err)
+		}
+	}
+	return nil
+}
 type NmeaReadFieldsReplyGroupFunction struct {
 	Info MessageInfo
 	FunctionCode GroupFunctionConst
@@ -38518,6 +44919,63 @@ func DecodeNmeaReadFieldsReplyGroupFunction(Info MessageInfo, stream *PGNDataStr
 	}	
 	return val, nil
 }
+func EncodeNmeaReadFieldsReplyGroupFunction(p any, stream *PGNWriter) error {
+	val, ok := p.(NmeaReadFieldsReplyGroupFunction)
+	if !ok {
+		return fmt.Errorf("EncodeNmeaReadFieldsReplyGroupFunction called with %T", p)
+	}
+		var fieldIndex uint8
+		var manufacturer ManufacturerCodeConst
+	if val.Pgn == nil {
+		return fmt.Errorf("encode failed for NmeaReadFieldsReplyGroupFunction-Pgn: Pgn is required")
+	}
+	if err := stream.writeLookupField(8, 4); err != nil {
+		return fmt.Errorf("encode failed for NmeaReadFieldsReplyGroupFunction-FunctionCode: %w", err)
+	}
+	if err := stream.writeUInt32(24, val.Pgn); err != nil {
+		return fmt.Errorf("encode failed for NmeaReadFieldsReplyGroupFunction-Pgn: %w", err)
+	}
+	if IsProprietaryPGN(*val.Pgn) {
+	if err := stream.writeLookupField(11, uint64(val.ManufacturerCode)); err != nil {
+		return fmt.Errorf("encode failed for NmeaReadFieldsReplyGroupFunction-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, uint64(val.IndustryCode)); err != nil {
+		return fmt.Errorf("encode failed for NmeaReadFieldsReplyGroupFunction-IndustryCode: %w", err)
+	}
+	}
+	if err := stream.writeUInt8(8, val.UniqueId); err != nil {
+		return fmt.Errorf("encode failed for NmeaReadFieldsReplyGroupFunction-UniqueId: %w", err)
+	}
+	repeat1Count := uint8(len(val.Repeating1))
+	if err := stream.writeUInt8(8, &repeat1Count); err != nil {
+		return fmt.Errorf("encode failed for NmeaReadFieldsReplyGroupFunction-NumberOfSelectionPairs: %w", err)
+	}
+	repeat2Count := uint8(len(val.Repeating2))
+	if err := stream.writeUInt8(8, &repeat2Count); err != nil {
+		return fmt.Errorf("encode failed for NmeaReadFieldsReplyGroupFunction-NumberOfParameters: %w", err)
+	}
+	for _, rep := range val.Repeating1 {
+		if err := stream.writeUInt8(8, rep.SelectionParameter); err != nil {
+			return fmt.Errorf("encode failed for NmeaReadFieldsReplyGroupFunction-SelectionParameter: %w", err)
+		}
+		if rep.SelectionParameter != nil {
+			fieldIndex = *rep.SelectionParameter
+		}
+		if err := stream.writeVariableData(*val.Pgn, manufacturer, fieldIndex, rep.SelectionValue); err != nil {
+			return fmt.Errorf("encode failed for NmeaReadFieldsReplyGroupFunction-SelectionValue: %w", err)
+		}
+	}
+	for _, rep := range val.Repeating2 {
+		if err := stream.writeUInt8(8, rep.Parameter); err != nil {
+			return fmt.Errorf("encode failed for NmeaReadFieldsReplyGroupFunction-Parameter: %w", err)
+		}
+		if err := stream.writeVariableData(*val.Pgn, manufacturer, fieldIndex, rep.Value); err != nil {
+			return fmt.Errorf("encode failed for NmeaReadFieldsReplyGroupFunction-Value: %w", err)
+		}
+	}
+	return nil
+}
 type NmeaWriteFieldsGroupFunction struct {
 	Info MessageInfo
 	FunctionCode GroupFunctionConst
@@ -38686,6 +45144,63 @@ func DecodeNmeaWriteFieldsGroupFunction(Info MessageInfo, stream *PGNDataStream)
 	}	
 	return val, nil
 }
+func EncodeNmeaWriteFieldsGroupFunction(p any, stream *PGNWriter) error {
+	val, ok := p.(NmeaWriteFieldsGroupFunction)
+	if !ok {
+		return fmt.Errorf("EncodeNmeaWriteFieldsGroupFunction called with %T", p)
+	}
+		var fieldIndex uint8
+		var manufacturer ManufacturerCodeConst
+	if val.Pgn == nil {
+		return fmt.Errorf("encode failed for NmeaWriteFieldsGroupFunction-Pgn: Pgn is required")
+	}
+	if err := stream.writeLookupField(8, 5); err != nil {
+		return fmt.Errorf("encode failed for NmeaWriteFieldsGroupFunction-FunctionCode: %w", err)
+	}
+	if err := stream.writeUInt32(24, val.Pgn); err != nil {
+		return fmt.Errorf("encode failed for NmeaWriteFieldsGroupFunction-Pgn: %w", err)
+	}
+	if IsProprietaryPGN(*val.Pgn) {
+	if err := stream.writeLookupField(11, uint64(val.ManufacturerCode)); err != nil {
+		return fmt.Errorf("encode failed for NmeaWriteFieldsGroupFunction-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, uint64(val.IndustryCode)); err != nil {
+		return fmt.Errorf("encode failed for NmeaWriteFieldsGroupFunction-IndustryCode: %w", err)
+	}
+	}
+	if err := stream.writeUInt8(8, val.UniqueId); err != nil {
+		return fmt.Errorf("encode failed for NmeaWriteFieldsGroupFunction-UniqueId: %w", err)
+	}
+	repeat1Count := uint8(len(val.Repeating1))
+	if err := stream.writeUInt8(8, &repeat1Count); err != nil {
+		return fmt.Errorf("encode failed for NmeaWriteFieldsGroupFunction-NumberOfSelectionPairs: %w", err)
+	}
+	repeat2Count := uint8(len(val.Repeating2))
+	if err := stream.writeUInt8(8, &repeat2Count); err != nil {
+		return fmt.Errorf("encode failed for NmeaWriteFieldsGroupFunction-NumberOfParameters: %w", err)
+	}
+	for _, rep := range val.Repeating1 {
+		if err := stream.writeUInt8(8, rep.SelectionParameter); err != nil {
+			return fmt.Errorf("encode failed for NmeaWriteFieldsGroupFunction-SelectionParameter: %w", err)
+		}
+		if rep.SelectionParameter != nil {
+			fieldIndex = *rep.SelectionParameter
+		}
+		if err := stream.writeVariableData(*val.Pgn, manufacturer, fieldIndex, rep.SelectionValue); err != nil {
+			return fmt.Errorf("encode failed for NmeaWriteFieldsGroupFunction-SelectionValue: %w", err)
+		}
+	}
+	for _, rep := range val.Repeating2 {
+		if err := stream.writeUInt8(8, rep.Parameter); err != nil {
+			return fmt.Errorf("encode failed for NmeaWriteFieldsGroupFunction-Parameter: %w", err)
+		}
+		if err := stream.writeVariableData(*val.Pgn, manufacturer, fieldIndex, rep.Value); err != nil {
+			return fmt.Errorf("encode failed for NmeaWriteFieldsGroupFunction-Value: %w", err)
+		}
+	}
+	return nil
+}
 type NmeaWriteFieldsReplyGroupFunction struct {
 	Info MessageInfo
 	FunctionCode GroupFunctionConst
@@ -38854,6 +45369,63 @@ func DecodeNmeaWriteFieldsReplyGroupFunction(Info MessageInfo, stream *PGNDataSt
 	}	
 	return val, nil
 }
+func EncodeNmeaWriteFieldsReplyGroupFunction(p any, stream *PGNWriter) error {
+	val, ok := p.(NmeaWriteFieldsReplyGroupFunction)
+	if !ok {
+		return fmt.Errorf("EncodeNmeaWriteFieldsReplyGroupFunction called with %T", p)
+	}
+		var fieldIndex uint8
+		var manufacturer ManufacturerCodeConst
+	if val.Pgn == nil {
+		return fmt.Errorf("encode failed for NmeaWriteFieldsReplyGroupFunction-Pgn: Pgn is required")
+	}
+	if err := stream.writeLookupField(8, 6); err != nil {
+		return fmt.Errorf("encode failed for NmeaWriteFieldsReplyGroupFunction-FunctionCode: %w", err)
+	}
+	if err := stream.writeUInt32(24, val.Pgn); err != nil {
+		return fmt.Errorf("encode failed for NmeaWriteFieldsReplyGroupFunction-Pgn: %w", err)
+	}
+	if IsProprietaryPGN(*val.Pgn) {
+	if err := stream.writeLookupField(11, uint64(val.ManufacturerCode)); err != nil {
+		return fmt.Errorf("encode failed for NmeaWriteFieldsReplyGroupFunction-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, uint64(val.IndustryCode)); err != nil {
+		return fmt.Errorf("encode failed for NmeaWriteFieldsReplyGroupFunction-IndustryCode: %w", err)
+	}
+	}
+	if err := stream.writeUInt8(8, val.UniqueId); err != nil {
+		return fmt.Errorf("encode failed for NmeaWriteFieldsReplyGroupFunction-UniqueId: %w", err)
+	}
+	repeat1Count := uint8(len(val.Repeating1))
+	if err := stream.writeUInt8(8, &repeat1Count); err != nil {
+		return fmt.Errorf("encode failed for NmeaWriteFieldsReplyGroupFunction-NumberOfSelectionPairs: %w", err)
+	}
+	repeat2Count := uint8(len(val.Repeating2))
+	if err := stream.writeUInt8(8, &repeat2Count); err != nil {
+		return fmt.Errorf("encode failed for NmeaWriteFieldsReplyGroupFunction-NumberOfParameters: %w", err)
+	}
+	for _, rep := range val.Repeating1 {
+		if err := stream.writeUInt8(8, rep.SelectionParameter); err != nil {
+			return fmt.Errorf("encode failed for NmeaWriteFieldsReplyGroupFunction-SelectionParameter: %w", err)
+		}
+		if rep.SelectionParameter != nil {
+			fieldIndex = *rep.SelectionParameter
+		}
+		if err := stream.writeVariableData(*val.Pgn, manufacturer, fieldIndex, rep.SelectionValue); err != nil {
+			return fmt.Errorf("encode failed for NmeaWriteFieldsReplyGroupFunction-SelectionValue: %w", err)
+		}
+	}
+	for _, rep := range val.Repeating2 {
+		if err := stream.writeUInt8(8, rep.Parameter); err != nil {
+			return fmt.Errorf("encode failed for NmeaWriteFieldsReplyGroupFunction-Parameter: %w", err)
+		}
+		if err := stream.writeVariableData(*val.Pgn, manufacturer, fieldIndex, rep.Value); err != nil {
+			return fmt.Errorf("encode failed for NmeaWriteFieldsReplyGroupFunction-Value: %w", err)
+		}
+	}
+	return nil
+}
 type PgnListTransmitAndReceive struct {
 	Info MessageInfo
 	FunctionCode PgnListFunctionConst
@@ -38898,6 +45470,21 @@ func DecodePgnListTransmitAndReceive(Info MessageInfo, stream *PGNDataStream) (a
 	}	
 	return val, nil
 }
+func EncodePgnListTransmitAndReceive(p any, stream *PGNWriter) error {
+	val, ok := p.(PgnListTransmitAndReceive)
+	if !ok {
+		return fmt.Errorf("EncodePgnListTransmitAndReceive called with %T", p)
+	}
+	if err := stream.writeLookupField(8, uint64(val.FunctionCode)); err != nil {
+		return fmt.Errorf("encode failed for PgnListTransmitAndReceive-FunctionCode: %w", err)
+	}
+	for _, rep := range val.Repeating1 {
+		if err := stream.writeUInt32(24, rep.Pgn); err != nil {
+			return fmt.Errorf("encode failed for PgnListTransmitAndReceive-Pgn: %w", err)
+		}
+	}
+	return nil
+}
 type Seatalk1PilotMode struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -39012,6 +45599,41 @@ func DecodeSeatalk1PilotMode(Info MessageInfo, stream *PGNDataStream) (any, erro
 	}	
 	return val, nil
 }
+func EncodeSeatalk1PilotMode(p any, stream *PGNWriter) error {
+	val, ok := p.(Seatalk1PilotMode)
+	if !ok {
+		return fmt.Errorf("EncodeSeatalk1PilotMode called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 1851); err != nil {
+		return fmt.Errorf("encode failed for Seatalk1PilotMode-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for Seatalk1PilotMode-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(16, 33264); err != nil {
+		return fmt.Errorf("encode failed for Seatalk1PilotMode-ProprietaryId: %w", err)
+	}
+	if err := stream.writeLookupField(8, 132); err != nil {
+		return fmt.Errorf("encode failed for Seatalk1PilotMode-Command: %w", err)
+	}
+	if err := stream.writeBinaryData(24, val.Unknown1); err != nil {
+		return fmt.Errorf("encode failed for Seatalk1PilotMode-Unknown1: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.PilotMode)); err != nil {
+		return fmt.Errorf("encode failed for Seatalk1PilotMode-PilotMode: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.SubMode); err != nil {
+		return fmt.Errorf("encode failed for Seatalk1PilotMode-SubMode: %w", err)
+	}
+	if err := stream.writeBinaryData(8, val.PilotModeData); err != nil {
+		return fmt.Errorf("encode failed for Seatalk1PilotMode-PilotModeData: %w", err)
+	}
+	if err := stream.writeBinaryData(80, val.Unknown2); err != nil {
+		return fmt.Errorf("encode failed for Seatalk1PilotMode-Unknown2: %w", err)
+	}
+	return nil
+}
 type FusionMediaControl struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -39093,6 +45715,32 @@ func DecodeFusionMediaControl(Info MessageInfo, stream *PGNDataStream) (any, err
 	}	
 	return val, nil
 }
+func EncodeFusionMediaControl(p any, stream *PGNWriter) error {
+	val, ok := p.(FusionMediaControl)
+	if !ok {
+		return fmt.Errorf("EncodeFusionMediaControl called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 419); err != nil {
+		return fmt.Errorf("encode failed for FusionMediaControl-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for FusionMediaControl-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(8, 3); err != nil {
+		return fmt.Errorf("encode failed for FusionMediaControl-ProprietaryId: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Unknown); err != nil {
+		return fmt.Errorf("encode failed for FusionMediaControl-Unknown: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.SourceId); err != nil {
+		return fmt.Errorf("encode failed for FusionMediaControl-SourceId: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Command)); err != nil {
+		return fmt.Errorf("encode failed for FusionMediaControl-Command: %w", err)
+	}
+	return nil
+}
 type FusionSiriusControl struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -39174,6 +45822,32 @@ func DecodeFusionSiriusControl(Info MessageInfo, stream *PGNDataStream) (any, er
 	}	
 	return val, nil
 }
+func EncodeFusionSiriusControl(p any, stream *PGNWriter) error {
+	val, ok := p.(FusionSiriusControl)
+	if !ok {
+		return fmt.Errorf("EncodeFusionSiriusControl called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 419); err != nil {
+		return fmt.Errorf("encode failed for FusionSiriusControl-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for FusionSiriusControl-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(8, 30); err != nil {
+		return fmt.Errorf("encode failed for FusionSiriusControl-ProprietaryId: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Unknown); err != nil {
+		return fmt.Errorf("encode failed for FusionSiriusControl-Unknown: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.SourceId); err != nil {
+		return fmt.Errorf("encode failed for FusionSiriusControl-SourceId: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Command)); err != nil {
+		return fmt.Errorf("encode failed for FusionSiriusControl-Command: %w", err)
+	}
+	return nil
+}
 type FusionRequestStatus struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -39235,6 +45909,26 @@ func DecodeFusionRequestStatus(Info MessageInfo, stream *PGNDataStream) (any, er
 	}	
 	return val, nil
 }
+func EncodeFusionRequestStatus(p any, stream *PGNWriter) error {
+	val, ok := p.(FusionRequestStatus)
+	if !ok {
+		return fmt.Errorf("EncodeFusionRequestStatus called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 419); err != nil {
+		return fmt.Errorf("encode failed for FusionRequestStatus-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for FusionRequestStatus-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(8, 1); err != nil {
+		return fmt.Errorf("encode failed for FusionRequestStatus-ProprietaryId: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Unknown); err != nil {
+		return fmt.Errorf("encode failed for FusionRequestStatus-Unknown: %w", err)
+	}
+	return nil
+}
 type FusionSetSource struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -39306,6 +46000,29 @@ func DecodeFusionSetSource(Info MessageInfo, stream *PGNDataStream) (any, error)
 	}	
 	return val, nil
 }
+func EncodeFusionSetSource(p any, stream *PGNWriter) error {
+	val, ok := p.(FusionSetSource)
+	if !ok {
+		return fmt.Errorf("EncodeFusionSetSource called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 419); err != nil {
+		return fmt.Errorf("encode failed for FusionSetSource-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for FusionSetSource-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(8, 2); err != nil {
+		return fmt.Errorf("encode failed for FusionSetSource-ProprietaryId: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Unknown); err != nil {
+		return fmt.Errorf("encode failed for FusionSetSource-Unknown: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.SourceId); err != nil {
+		return fmt.Errorf("encode failed for FusionSetSource-SourceId: %w", err)
+	}
+	return nil
+}
 type FusionSetMute struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -39367,6 +46084,26 @@ func DecodeFusionSetMute(Info MessageInfo, stream *PGNDataStream) (any, error) {
 	}	
 	return val, nil
 }
+func EncodeFusionSetMute(p any, stream *PGNWriter) error {
+	val, ok := p.(FusionSetMute)
+	if !ok {
+		return fmt.Errorf("EncodeFusionSetMute called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 419); err != nil {
+		return fmt.Errorf("encode failed for FusionSetMute-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for FusionSetMute-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(8, 23); err != nil {
+		return fmt.Errorf("encode failed for FusionSetMute-ProprietaryId: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Command)); err != nil {
+		return fmt.Errorf("encode failed for FusionSetMute-Command: %w", err)
+	}
+	return nil
+}
 type FusionSetZoneVolume struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -39448,6 +46185,32 @@ func DecodeFusionSetZoneVolume(Info MessageInfo, stream *PGNDataStream) (any, er
 	}	
 	return val, nil
 }
+func EncodeFusionSetZoneVolume(p any, stream *PGNWriter) error {
+	val, ok := p.(FusionSetZoneVolume)
+	if !ok {
+		return fmt.Errorf("EncodeFusionSetZoneVolume called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 419); err != nil {
+		return fmt.Errorf("encode failed for FusionSetZoneVolume-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for FusionSetZoneVolume-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(8, 24); err != nil {
+		return fmt.Errorf("encode failed for FusionSetZoneVolume-ProprietaryId: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Unknown); err != nil {
+		return fmt.Errorf("encode failed for FusionSetZoneVolume-Unknown: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Zone); err != nil {
+		return fmt.Errorf("encode failed for FusionSetZoneVolume-Zone: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Volume); err != nil {
+		return fmt.Errorf("encode failed for FusionSetZoneVolume-Volume: %w", err)
+	}
+	return nil
+}
 type FusionSetAllVolumes struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -39549,6 +46312,38 @@ func DecodeFusionSetAllVolumes(Info MessageInfo, stream *PGNDataStream) (any, er
 	}	
 	return val, nil
 }
+func EncodeFusionSetAllVolumes(p any, stream *PGNWriter) error {
+	val, ok := p.(FusionSetAllVolumes)
+	if !ok {
+		return fmt.Errorf("EncodeFusionSetAllVolumes called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 419); err != nil {
+		return fmt.Errorf("encode failed for FusionSetAllVolumes-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for FusionSetAllVolumes-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(8, 25); err != nil {
+		return fmt.Errorf("encode failed for FusionSetAllVolumes-ProprietaryId: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Unknown); err != nil {
+		return fmt.Errorf("encode failed for FusionSetAllVolumes-Unknown: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Zone1); err != nil {
+		return fmt.Errorf("encode failed for FusionSetAllVolumes-Zone1: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Zone2); err != nil {
+		return fmt.Errorf("encode failed for FusionSetAllVolumes-Zone2: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Zone3); err != nil {
+		return fmt.Errorf("encode failed for FusionSetAllVolumes-Zone3: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Zone4); err != nil {
+		return fmt.Errorf("encode failed for FusionSetAllVolumes-Zone4: %w", err)
+	}
+	return nil
+}
 type Seatalk1Keystroke struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -39653,6 +46448,38 @@ func DecodeSeatalk1Keystroke(Info MessageInfo, stream *PGNDataStream) (any, erro
 	}	
 	return val, nil
 }
+func EncodeSeatalk1Keystroke(p any, stream *PGNWriter) error {
+	val, ok := p.(Seatalk1Keystroke)
+	if !ok {
+		return fmt.Errorf("EncodeSeatalk1Keystroke called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 1851); err != nil {
+		return fmt.Errorf("encode failed for Seatalk1Keystroke-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for Seatalk1Keystroke-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(16, 33264); err != nil {
+		return fmt.Errorf("encode failed for Seatalk1Keystroke-ProprietaryId: %w", err)
+	}
+	if err := stream.writeLookupField(8, 134); err != nil {
+		return fmt.Errorf("encode failed for Seatalk1Keystroke-Command: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Device); err != nil {
+		return fmt.Errorf("encode failed for Seatalk1Keystroke-Device: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Key)); err != nil {
+		return fmt.Errorf("encode failed for Seatalk1Keystroke-Key: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Keyinverted); err != nil {
+		return fmt.Errorf("encode failed for Seatalk1Keystroke-Keyinverted: %w", err)
+	}
+	if err := stream.writeBinaryData(112, val.UnknownData); err != nil {
+		return fmt.Errorf("encode failed for Seatalk1Keystroke-UnknownData: %w", err)
+	}
+	return nil
+}
 type Seatalk1DeviceIdentification struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -39731,6 +46558,30 @@ func DecodeSeatalk1DeviceIdentification(Info MessageInfo, stream *PGNDataStream)
 	}	
 	return val, nil
 }
+func EncodeSeatalk1DeviceIdentification(p any, stream *PGNWriter) error {
+	val, ok := p.(Seatalk1DeviceIdentification)
+	if !ok {
+		return fmt.Errorf("EncodeSeatalk1DeviceIdentification called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 1851); err != nil {
+		return fmt.Errorf("encode failed for Seatalk1DeviceIdentification-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for Seatalk1DeviceIdentification-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(16, 33264); err != nil {
+		return fmt.Errorf("encode failed for Seatalk1DeviceIdentification-ProprietaryId: %w", err)
+	}
+	if err := stream.writeLookupField(8, 144); err != nil {
+		return fmt.Errorf("encode failed for Seatalk1DeviceIdentification-Command: %w", err)
+	}
+	stream.writeReserved(8)
+	if err := stream.writeLookupField(8, uint64(val.Device)); err != nil {
+		return fmt.Errorf("encode failed for Seatalk1DeviceIdentification-Device: %w", err)
+	}
+	return nil
+}
 type Seatalk1DisplayBrightness struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -39835,6 +46686,38 @@ func DecodeSeatalk1DisplayBrightness(Info MessageInfo, stream *PGNDataStream) (a
 	}	
 	return val, nil
 }
+func EncodeSeatalk1DisplayBrightness(p any, stream *PGNWriter) error {
+	val, ok := p.(Seatalk1DisplayBrightness)
+	if !ok {
+		return fmt.Errorf("EncodeSeatalk1DisplayBrightness called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 1851); err != nil {
+		return fmt.Errorf("encode failed for Seatalk1DisplayBrightness-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for Seatalk1DisplayBrightness-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(16, 3212); err != nil {
+		return fmt.Errorf("encode failed for Seatalk1DisplayBrightness-ProprietaryId: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Group)); err != nil {
+		return fmt.Errorf("encode failed for Seatalk1DisplayBrightness-Group: %w", err)
+	}
+	if err := stream.writeBinaryData(8, val.Unknown1); err != nil {
+		return fmt.Errorf("encode failed for Seatalk1DisplayBrightness-Unknown1: %w", err)
+	}
+	if err := stream.writeLookupField(8, 0); err != nil {
+		return fmt.Errorf("encode failed for Seatalk1DisplayBrightness-Command: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Brightness); err != nil {
+		return fmt.Errorf("encode failed for Seatalk1DisplayBrightness-Brightness: %w", err)
+	}
+	if err := stream.writeBinaryData(8, val.Unknown2); err != nil {
+		return fmt.Errorf("encode failed for Seatalk1DisplayBrightness-Unknown2: %w", err)
+	}
+	return nil
+}
 type Seatalk1DisplayColor struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -39939,6 +46822,38 @@ func DecodeSeatalk1DisplayColor(Info MessageInfo, stream *PGNDataStream) (any, e
 	}	
 	return val, nil
 }
+func EncodeSeatalk1DisplayColor(p any, stream *PGNWriter) error {
+	val, ok := p.(Seatalk1DisplayColor)
+	if !ok {
+		return fmt.Errorf("EncodeSeatalk1DisplayColor called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 1851); err != nil {
+		return fmt.Errorf("encode failed for Seatalk1DisplayColor-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for Seatalk1DisplayColor-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(16, 3212); err != nil {
+		return fmt.Errorf("encode failed for Seatalk1DisplayColor-ProprietaryId: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Group)); err != nil {
+		return fmt.Errorf("encode failed for Seatalk1DisplayColor-Group: %w", err)
+	}
+	if err := stream.writeBinaryData(8, val.Unknown1); err != nil {
+		return fmt.Errorf("encode failed for Seatalk1DisplayColor-Unknown1: %w", err)
+	}
+	if err := stream.writeLookupField(8, 1); err != nil {
+		return fmt.Errorf("encode failed for Seatalk1DisplayColor-Command: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Color)); err != nil {
+		return fmt.Errorf("encode failed for Seatalk1DisplayColor-Color: %w", err)
+	}
+	if err := stream.writeBinaryData(8, val.Unknown2); err != nil {
+		return fmt.Errorf("encode failed for Seatalk1DisplayColor-Unknown2: %w", err)
+	}
+	return nil
+}
 type AirmarAttitudeOffset struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -40020,6 +46935,32 @@ func DecodeAirmarAttitudeOffset(Info MessageInfo, stream *PGNDataStream) (any, e
 	}	
 	return val, nil
 }
+func EncodeAirmarAttitudeOffset(p any, stream *PGNWriter) error {
+	val, ok := p.(AirmarAttitudeOffset)
+	if !ok {
+		return fmt.Errorf("EncodeAirmarAttitudeOffset called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 135); err != nil {
+		return fmt.Errorf("encode failed for AirmarAttitudeOffset-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for AirmarAttitudeOffset-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(8, 32); err != nil {
+		return fmt.Errorf("encode failed for AirmarAttitudeOffset-ProprietaryId: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.0001, val.AzimuthOffset); err != nil {
+		return fmt.Errorf("encode failed for AirmarAttitudeOffset-AzimuthOffset: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.0001, val.PitchOffset); err != nil {
+		return fmt.Errorf("encode failed for AirmarAttitudeOffset-PitchOffset: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.0001, val.RollOffset); err != nil {
+		return fmt.Errorf("encode failed for AirmarAttitudeOffset-RollOffset: %w", err)
+	}
+	return nil
+}
 type AirmarCalibrateCompass struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -40191,6 +47132,59 @@ func DecodeAirmarCalibrateCompass(Info MessageInfo, stream *PGNDataStream) (any,
 	}	
 	return val, nil
 }
+func EncodeAirmarCalibrateCompass(p any, stream *PGNWriter) error {
+	val, ok := p.(AirmarCalibrateCompass)
+	if !ok {
+		return fmt.Errorf("EncodeAirmarCalibrateCompass called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 135); err != nil {
+		return fmt.Errorf("encode failed for AirmarCalibrateCompass-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for AirmarCalibrateCompass-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(8, 33); err != nil {
+		return fmt.Errorf("encode failed for AirmarCalibrateCompass-ProprietaryId: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.CalibrateFunction)); err != nil {
+		return fmt.Errorf("encode failed for AirmarCalibrateCompass-CalibrateFunction: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.CalibrationStatus)); err != nil {
+		return fmt.Errorf("encode failed for AirmarCalibrateCompass-CalibrationStatus: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.VerifyScore); err != nil {
+		return fmt.Errorf("encode failed for AirmarCalibrateCompass-VerifyScore: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.01, val.XAxisGainValue); err != nil {
+		return fmt.Errorf("encode failed for AirmarCalibrateCompass-XAxisGainValue: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.01, val.YAxisGainValue); err != nil {
+		return fmt.Errorf("encode failed for AirmarCalibrateCompass-YAxisGainValue: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.01, val.ZAxisGainValue); err != nil {
+		return fmt.Errorf("encode failed for AirmarCalibrateCompass-ZAxisGainValue: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.01, val.XAxisLinearOffset); err != nil {
+		return fmt.Errorf("encode failed for AirmarCalibrateCompass-XAxisLinearOffset: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.01, val.YAxisLinearOffset); err != nil {
+		return fmt.Errorf("encode failed for AirmarCalibrateCompass-YAxisLinearOffset: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.01, val.ZAxisLinearOffset); err != nil {
+		return fmt.Errorf("encode failed for AirmarCalibrateCompass-ZAxisLinearOffset: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.1, val.XAxisAngularOffset); err != nil {
+		return fmt.Errorf("encode failed for AirmarCalibrateCompass-XAxisAngularOffset: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.05, val.PitchAndRollDamping); err != nil {
+		return fmt.Errorf("encode failed for AirmarCalibrateCompass-PitchAndRollDamping: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.05, val.CompassRateGyroDamping); err != nil {
+		return fmt.Errorf("encode failed for AirmarCalibrateCompass-CompassRateGyroDamping: %w", err)
+	}
+	return nil
+}
 type AirmarTrueWindOptions struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -40256,6 +47250,27 @@ func DecodeAirmarTrueWindOptions(Info MessageInfo, stream *PGNDataStream) (any,
 		}	
 	return val, nil
 }
+func EncodeAirmarTrueWindOptions(p any, stream *PGNWriter) error {
+	val, ok := p.(AirmarTrueWindOptions)
+	if !ok {
+		return fmt.Errorf("EncodeAirmarTrueWindOptions called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 135); err != nil {
+		return fmt.Errorf("encode failed for AirmarTrueWindOptions-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for AirmarTrueWindOptions-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(8, 34); err != nil {
+		return fmt.Errorf("encode failed for AirmarTrueWindOptions-ProprietaryId: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.CogSubstitutionForHdg)); err != nil {
+		return fmt.Errorf("encode failed for AirmarTrueWindOptions-CogSubstitutionForHdg: %w", err)
+	}
+	stream.writeReserved(22)
+	return nil
+}
 type AirmarSimulateMode struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -40321,6 +47336,27 @@ func DecodeAirmarSimulateMode(Info MessageInfo, stream *PGNDataStream) (any, err
 		}	
 	return val, nil
 }
+func EncodeAirmarSimulateMode(p any, stream *PGNWriter) error {
+	val, ok := p.(AirmarSimulateMode)
+	if !ok {
+		return fmt.Errorf("EncodeAirmarSimulateMode called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 135); err != nil {
+		return fmt.Errorf("encode failed for AirmarSimulateMode-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for AirmarSimulateMode-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(8, 35); err != nil {
+		return fmt.Errorf("encode failed for AirmarSimulateMode-ProprietaryId: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.SimulateMode)); err != nil {
+		return fmt.Errorf("encode failed for AirmarSimulateMode-SimulateMode: %w", err)
+	}
+	stream.writeReserved(22)
+	return nil
+}
 type AirmarCalibrateDepth struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -40386,6 +47422,27 @@ func DecodeAirmarCalibrateDepth(Info MessageInfo, stream *PGNDataStream) (any, e
 		}	
 	return val, nil
 }
+func EncodeAirmarCalibrateDepth(p any, stream *PGNWriter) error {
+	val, ok := p.(AirmarCalibrateDepth)
+	if !ok {
+		return fmt.Errorf("EncodeAirmarCalibrateDepth called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 135); err != nil {
+		return fmt.Errorf("encode failed for AirmarCalibrateDepth-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for AirmarCalibrateDepth-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(8, 40); err != nil {
+		return fmt.Errorf("encode failed for AirmarCalibrateDepth-ProprietaryId: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.1, nullableUnitValue(val.SpeedOfSoundMode, units.MetersPerSecond, units.Velocity.Convert)); err != nil {
+		return fmt.Errorf("encode failed for AirmarCalibrateDepth-SpeedOfSoundMode: %w", err)
+	}
+	stream.writeReserved(8)
+	return nil
+}
 type AirmarCalibrateSpeed struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -40485,6 +47542,35 @@ func DecodeAirmarCalibrateSpeed(Info MessageInfo, stream *PGNDataStream) (any, e
 	}	
 	return val, nil
 }
+func EncodeAirmarCalibrateSpeed(p any, stream *PGNWriter) error {
+	val, ok := p.(AirmarCalibrateSpeed)
+	if !ok {
+		return fmt.Errorf("EncodeAirmarCalibrateSpeed called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 135); err != nil {
+		return fmt.Errorf("encode failed for AirmarCalibrateSpeed-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for AirmarCalibrateSpeed-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(8, 41); err != nil {
+		return fmt.Errorf("encode failed for AirmarCalibrateSpeed-ProprietaryId: %w", err)
+	}
+	repeat1Count := uint8(len(val.Repeating1))
+	if err := stream.writeUInt8(8, &repeat1Count); err != nil {
+		return fmt.Errorf("encode failed for AirmarCalibrateSpeed-NumberOfPairsOfDataPoints: %w", err)
+	}
+	for _, rep := range val.Repeating1 {
+		if err := stream.writeUnsignedResolution(16, 0.1, rep.InputFrequency); err != nil {
+			return fmt.Errorf("encode failed for AirmarCalibrateSpeed-InputFrequency: %w", err)
+		}
+		if err := stream.writeUnsignedResolution(16, 0.01, nullableUnitValue(rep.OutputSpeed, units.MetersPerSecond, units.Velocity.Convert)); err != nil {
+			return fmt.Errorf("encode failed for AirmarCalibrateSpeed-OutputSpeed: %w", err)
+		}
+	}
+	return nil
+}
 type AirmarCalibrateTemperature struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -40560,6 +47646,30 @@ func DecodeAirmarCalibrateTemperature(Info MessageInfo, stream *PGNDataStream) (
 	}	
 	return val, nil
 }
+func EncodeAirmarCalibrateTemperature(p any, stream *PGNWriter) error {
+	val, ok := p.(AirmarCalibrateTemperature)
+	if !ok {
+		return fmt.Errorf("EncodeAirmarCalibrateTemperature called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 135); err != nil {
+		return fmt.Errorf("encode failed for AirmarCalibrateTemperature-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for AirmarCalibrateTemperature-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(8, 42); err != nil {
+		return fmt.Errorf("encode failed for AirmarCalibrateTemperature-ProprietaryId: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.TemperatureInstance)); err != nil {
+		return fmt.Errorf("encode failed for AirmarCalibrateTemperature-TemperatureInstance: %w", err)
+	}
+	stream.writeReserved(6)
+	if err := stream.writeSignedResolution(16, 0.001, nullableUnitValue(val.TemperatureOffset, units.Kelvin, units.Temperature.Convert)); err != nil {
+		return fmt.Errorf("encode failed for AirmarCalibrateTemperature-TemperatureOffset: %w", err)
+	}
+	return nil
+}
 type AirmarSpeedFilterNone struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -40638,6 +47748,30 @@ func DecodeAirmarSpeedFilterNone(Info MessageInfo, stream *PGNDataStream) (any,
 	}	
 	return val, nil
 }
+func EncodeAirmarSpeedFilterNone(p any, stream *PGNWriter) error {
+	val, ok := p.(AirmarSpeedFilterNone)
+	if !ok {
+		return fmt.Errorf("EncodeAirmarSpeedFilterNone called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 135); err != nil {
+		return fmt.Errorf("encode failed for AirmarSpeedFilterNone-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for AirmarSpeedFilterNone-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(8, 43); err != nil {
+		return fmt.Errorf("encode failed for AirmarSpeedFilterNone-ProprietaryId: %w", err)
+	}
+	if err := stream.writeLookupField(4, 0); err != nil {
+		return fmt.Errorf("encode failed for AirmarSpeedFilterNone-FilterType: %w", err)
+	}
+	stream.writeReserved(4)
+	if err := stream.writeUnsignedResolution(16, 0.01, val.SampleInterval); err != nil {
+		return fmt.Errorf("encode failed for AirmarSpeedFilterNone-SampleInterval: %w", err)
+	}
+	return nil
+}
 type AirmarSpeedFilterIir struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -40726,6 +47860,33 @@ func DecodeAirmarSpeedFilterIir(Info MessageInfo, stream *PGNDataStream) (any, e
 	}	
 	return val, nil
 }
+func EncodeAirmarSpeedFilterIir(p any, stream *PGNWriter) error {
+	val, ok := p.(AirmarSpeedFilterIir)
+	if !ok {
+		return fmt.Errorf("EncodeAirmarSpeedFilterIir called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 135); err != nil {
+		return fmt.Errorf("encode failed for AirmarSpeedFilterIir-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for AirmarSpeedFilterIir-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(8, 43); err != nil {
+		return fmt.Errorf("encode failed for AirmarSpeedFilterIir-ProprietaryId: %w", err)
+	}
+	if err := stream.writeLookupField(4, 1); err != nil {
+		return fmt.Errorf("encode failed for AirmarSpeedFilterIir-FilterType: %w", err)
+	}
+	stream.writeReserved(4)
+	if err := stream.writeUnsignedResolution(16, 0.01, val.SampleInterval); err != nil {
+		return fmt.Errorf("encode failed for AirmarSpeedFilterIir-SampleInterval: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.01, val.FilterDuration); err != nil {
+		return fmt.Errorf("encode failed for AirmarSpeedFilterIir-FilterDuration: %w", err)
+	}
+	return nil
+}
 type AirmarTemperatureFilterNone struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -40804,6 +47965,30 @@ func DecodeAirmarTemperatureFilterNone(Info MessageInfo, stream *PGNDataStream)
 	}	
 	return val, nil
 }
+func EncodeAirmarTemperatureFilterNone(p any, stream *PGNWriter) error {
+	val, ok := p.(AirmarTemperatureFilterNone)
+	if !ok {
+		return fmt.Errorf("EncodeAirmarTemperatureFilterNone called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 135); err != nil {
+		return fmt.Errorf("encode failed for AirmarTemperatureFilterNone-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for AirmarTemperatureFilterNone-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(8, 44); err != nil {
+		return fmt.Errorf("encode failed for AirmarTemperatureFilterNone-ProprietaryId: %w", err)
+	}
+	if err := stream.writeLookupField(4, 0); err != nil {
+		return fmt.Errorf("encode failed for AirmarTemperatureFilterNone-FilterType: %w", err)
+	}
+	stream.writeReserved(4)
+	if err := stream.writeUnsignedResolution(16, 0.01, val.SampleInterval); err != nil {
+		return fmt.Errorf("encode failed for AirmarTemperatureFilterNone-SampleInterval: %w", err)
+	}
+	return nil
+}
 type AirmarTemperatureFilterIir struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -40892,6 +48077,33 @@ func DecodeAirmarTemperatureFilterIir(Info MessageInfo, stream *PGNDataStream) (
 	}	
 	return val, nil
 }
+func EncodeAirmarTemperatureFilterIir(p any, stream *PGNWriter) error {
+	val, ok := p.(AirmarTemperatureFilterIir)
+	if !ok {
+		return fmt.Errorf("EncodeAirmarTemperatureFilterIir called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 135); err != nil {
+		return fmt.Errorf("encode failed for AirmarTemperatureFilterIir-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for AirmarTemperatureFilterIir-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(8, 44); err != nil {
+		return fmt.Errorf("encode failed for AirmarTemperatureFilterIir-ProprietaryId: %w", err)
+	}
+	if err := stream.writeLookupField(4, 1); err != nil {
+		return fmt.Errorf("encode failed for AirmarTemperatureFilterIir-FilterType: %w", err)
+	}
+	stream.writeReserved(4)
+	if err := stream.writeUnsignedResolution(16, 0.01, val.SampleInterval); err != nil {
+		return fmt.Errorf("encode failed for AirmarTemperatureFilterIir-SampleInterval: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.01, val.FilterDuration); err != nil {
+		return fmt.Errorf("encode failed for AirmarTemperatureFilterIir-FilterDuration: %w", err)
+	}
+	return nil
+}
 type AirmarNmea2000Options struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -40957,6 +48169,27 @@ func DecodeAirmarNmea2000Options(Info MessageInfo, stream *PGNDataStream) (any,
 		}	
 	return val, nil
 }
+func EncodeAirmarNmea2000Options(p any, stream *PGNWriter) error {
+	val, ok := p.(AirmarNmea2000Options)
+	if !ok {
+		return fmt.Errorf("EncodeAirmarNmea2000Options called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 135); err != nil {
+		return fmt.Errorf("encode failed for AirmarNmea2000Options-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for AirmarNmea2000Options-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(8, 46); err != nil {
+		return fmt.Errorf("encode failed for AirmarNmea2000Options-ProprietaryId: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.TransmissionInterval)); err != nil {
+		return fmt.Errorf("encode failed for AirmarNmea2000Options-TransmissionInterval: %w", err)
+	}
+	stream.writeReserved(22)
+	return nil
+}
 type AirmarAddressableMultiFrame struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -41005,6 +48238,23 @@ func DecodeAirmarAddressableMultiFrame(Info MessageInfo, stream *PGNDataStream)
 	}	
 	return val, nil
 }
+func EncodeAirmarAddressableMultiFrame(p any, stream *PGNWriter) error {
+	val, ok := p.(AirmarAddressableMultiFrame)
+	if !ok {
+		return fmt.Errorf("EncodeAirmarAddressableMultiFrame called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 135); err != nil {
+		return fmt.Errorf("encode failed for AirmarAddressableMultiFrame-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for AirmarAddressableMultiFrame-IndustryCode: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.ProprietaryId); err != nil {
+		return fmt.Errorf("encode failed for AirmarAddressableMultiFrame-ProprietaryId: %w", err)
+	}
+	return nil
+}
 type MaretronSlaveResponse struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -41083,6 +48333,32 @@ func DecodeMaretronSlaveResponse(Info MessageInfo, stream *PGNDataStream) (any,
 	}	
 	return val, nil
 }
+func EncodeMaretronSlaveResponse(p any, stream *PGNWriter) error {
+	val, ok := p.(MaretronSlaveResponse)
+	if !ok {
+		return fmt.Errorf("EncodeMaretronSlaveResponse called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 137); err != nil {
+		return fmt.Errorf("encode failed for MaretronSlaveResponse-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for MaretronSlaveResponse-IndustryCode: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.ProductCode); err != nil {
+		return fmt.Errorf("encode failed for MaretronSlaveResponse-ProductCode: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.SoftwareCode); err != nil {
+		return fmt.Errorf("encode failed for MaretronSlaveResponse-SoftwareCode: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Command); err != nil {
+		return fmt.Errorf("encode failed for MaretronSlaveResponse-Command: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Status); err != nil {
+		return fmt.Errorf("encode failed for MaretronSlaveResponse-Status: %w", err)
+	}
+	return nil
+}
 type GarminDayMode struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -41204,6 +48480,40 @@ func DecodeGarminDayMode(Info MessageInfo, stream *PGNDataStream) (any, error) {
 	}	
 	return val, nil
 }
+func EncodeGarminDayMode(p any, stream *PGNWriter) error {
+	val, ok := p.(GarminDayMode)
+	if !ok {
+		return fmt.Errorf("EncodeGarminDayMode called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 229); err != nil {
+		return fmt.Errorf("encode failed for GarminDayMode-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for GarminDayMode-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(8, 222); err != nil {
+		return fmt.Errorf("encode failed for GarminDayMode-UnknownId1: %w", err)
+	}
+	if err := stream.writeLookupField(8, 5); err != nil {
+		return fmt.Errorf("encode failed for GarminDayMode-UnknownId2: %w", err)
+	}
+	if err := stream.writeLookupField(8, 5); err != nil {
+		return fmt.Errorf("encode failed for GarminDayMode-UnknownId3: %w", err)
+	}
+	if err := stream.writeLookupField(8, 5); err != nil {
+		return fmt.Errorf("encode failed for GarminDayMode-UnknownId4: %w", err)
+	}
+	stream.writeReserved(16)
+	if err := stream.writeLookupField(8, 0); err != nil {
+		return fmt.Errorf("encode failed for GarminDayMode-Mode: %w", err)
+	}
+	stream.writeReserved(8)
+	if err := stream.writeLookupField(8, uint64(val.Backlight)); err != nil {
+		return fmt.Errorf("encode failed for GarminDayMode-Backlight: %w", err)
+	}
+	return nil
+}
 type GarminNightMode struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -41325,6 +48635,40 @@ func DecodeGarminNightMode(Info MessageInfo, stream *PGNDataStream) (any, error)
 	}	
 	return val, nil
 }
+func EncodeGarminNightMode(p any, stream *PGNWriter) error {
+	val, ok := p.(GarminNightMode)
+	if !ok {
+		return fmt.Errorf("EncodeGarminNightMode called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 229); err != nil {
+		return fmt.Errorf("encode failed for GarminNightMode-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for GarminNightMode-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(8, 222); err != nil {
+		return fmt.Errorf("encode failed for GarminNightMode-UnknownId1: %w", err)
+	}
+	if err := stream.writeLookupField(8, 5); err != nil {
+		return fmt.Errorf("encode failed for GarminNightMode-UnknownId2: %w", err)
+	}
+	if err := stream.writeLookupField(8, 5); err != nil {
+		return fmt.Errorf("encode failed for GarminNightMode-UnknownId3: %w", err)
+	}
+	if err := stream.writeLookupField(8, 5); err != nil {
+		return fmt.Errorf("encode failed for GarminNightMode-UnknownId4: %w", err)
+	}
+	stream.writeReserved(16)
+	if err := stream.writeLookupField(8, 1); err != nil {
+		return fmt.Errorf("encode failed for GarminNightMode-Mode: %w", err)
+	}
+	stream.writeReserved(8)
+	if err := stream.writeLookupField(8, uint64(val.Backlight)); err != nil {
+		return fmt.Errorf("encode failed for GarminNightMode-Backlight: %w", err)
+	}
+	return nil
+}
 type GarminColorMode struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -41446,6 +48790,40 @@ func DecodeGarminColorMode(Info MessageInfo, stream *PGNDataStream) (any, error)
 	}	
 	return val, nil
 }
+func EncodeGarminColorMode(p any, stream *PGNWriter) error {
+	val, ok := p.(GarminColorMode)
+	if !ok {
+		return fmt.Errorf("EncodeGarminColorMode called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 229); err != nil {
+		return fmt.Errorf("encode failed for GarminColorMode-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for GarminColorMode-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(8, 222); err != nil {
+		return fmt.Errorf("encode failed for GarminColorMode-UnknownId1: %w", err)
+	}
+	if err := stream.writeLookupField(8, 5); err != nil {
+		return fmt.Errorf("encode failed for GarminColorMode-UnknownId2: %w", err)
+	}
+	if err := stream.writeLookupField(8, 5); err != nil {
+		return fmt.Errorf("encode failed for GarminColorMode-UnknownId3: %w", err)
+	}
+	if err := stream.writeLookupField(8, 5); err != nil {
+		return fmt.Errorf("encode failed for GarminColorMode-UnknownId4: %w", err)
+	}
+	stream.writeReserved(16)
+	if err := stream.writeLookupField(8, 13); err != nil {
+		return fmt.Errorf("encode failed for GarminColorMode-Mode: %w", err)
+	}
+	stream.writeReserved(8)
+	if err := stream.writeLookupField(8, uint64(val.Color)); err != nil {
+		return fmt.Errorf("encode failed for GarminColorMode-Color: %w", err)
+	}
+	return nil
+}
 type Alert struct {
 	Info MessageInfo
 	AlertType AlertTypeConst
@@ -41658,6 +49036,74 @@ func DecodeAlert(Info MessageInfo, stream *PGNDataStream) (any, error) {
 	}	
 	return val, nil
 }
+func EncodeAlert(p any, stream *PGNWriter) error {
+	val, ok := p.(Alert)
+	if !ok {
+		return fmt.Errorf("EncodeAlert called with %T", p)
+	}
+	if err := stream.writeLookupField(4, uint64(val.AlertType)); err != nil {
+		return fmt.Errorf("encode failed for Alert-AlertType: %w", err)
+	}
+	if err := stream.writeLookupField(4, uint64(val.AlertCategory)); err != nil {
+		return fmt.Errorf("encode failed for Alert-AlertCategory: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.AlertSystem); err != nil {
+		return fmt.Errorf("encode failed for Alert-AlertSystem: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.AlertSubSystem); err != nil {
+		return fmt.Errorf("encode failed for Alert-AlertSubSystem: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.AlertId); err != nil {
+		return fmt.Errorf("encode failed for Alert-AlertId: %w", err)
+	}
+	if err := stream.writeUInt64(64, val.DataSourceNetworkIdName); err != nil {
+		return fmt.Errorf("encode failed for Alert-DataSourceNetworkIdName: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.DataSourceInstance); err != nil {
+		return fmt.Errorf("encode failed for Alert-DataSourceInstance: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.DataSourceIndexSource); err != nil {
+		return fmt.Errorf("encode failed for Alert-DataSourceIndexSource: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.AlertOccurrenceNumber); err != nil {
+		return fmt.Errorf("encode failed for Alert-AlertOccurrenceNumber: %w", err)
+	}
+	if err := stream.writeLookupField(1, uint64(val.TemporarySilenceStatus)); err != nil {
+		return fmt.Errorf("encode failed for Alert-TemporarySilenceStatus: %w", err)
+	}
+	if err := stream.writeLookupField(1, uint64(val.AcknowledgeStatus)); err != nil {
+		return fmt.Errorf("encode failed for Alert-AcknowledgeStatus: %w", err)
+	}
+	if err := stream.writeLookupField(1, uint64(val.EscalationStatus)); err != nil {
+		return fmt.Errorf("encode failed for Alert-EscalationStatus: %w", err)
+	}
+	if err := stream.writeLookupField(1, uint64(val.TemporarySilenceSupport)); err != nil {
+		return fmt.Errorf("encode failed for Alert-TemporarySilenceSupport: %w", err)
+	}
+	if err := stream.writeLookupField(1, uint64(val.AcknowledgeSupport)); err != nil {
+		return fmt.Errorf("encode failed for Alert-AcknowledgeSupport: %w", err)
+	}
+	if err := stream.writeLookupField(1, uint64(val.EscalationSupport)); err != nil {
+		return fmt.Errorf("encode failed for Alert-EscalationSupport: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeUInt64(64, val.AcknowledgeSourceNetworkIdName); err != nil {
+		return fmt.Errorf("encode failed for Alert-AcknowledgeSourceNetworkIdName: %w", err)
+	}
+	if err := stream.writeLookupField(4, uint64(val.TriggerCondition)); err != nil {
+		return fmt.Errorf("encode failed for Alert-TriggerCondition: %w", err)
+	}
+	if err := stream.writeLookupField(4, uint64(val.ThresholdStatus)); err != nil {
+		return fmt.Errorf("encode failed for Alert-ThresholdStatus: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.AlertPriority); err != nil {
+		return fmt.Errorf("encode failed for Alert-AlertPriority: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.AlertState)); err != nil {
+		return fmt.Errorf("encode failed for Alert-AlertState: %w", err)
+	}
+	return nil
+}
 type AlertResponse struct {
 	Info MessageInfo
 	AlertType AlertTypeConst
@@ -41780,6 +49226,47 @@ func DecodeAlertResponse(Info MessageInfo, stream *PGNDataStream) (any, error) {
 		}	
 	return val, nil
 }
+func EncodeAlertResponse(p any, stream *PGNWriter) error {
+	val, ok := p.(AlertResponse)
+	if !ok {
+		return fmt.Errorf("EncodeAlertResponse called with %T", p)
+	}
+	if err := stream.writeLookupField(4, uint64(val.AlertType)); err != nil {
+		return fmt.Errorf("encode failed for AlertResponse-AlertType: %w", err)
+	}
+	if err := stream.writeLookupField(4, uint64(val.AlertCategory)); err != nil {
+		return fmt.Errorf("encode failed for AlertResponse-AlertCategory: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.AlertSystem); err != nil {
+		return fmt.Errorf("encode failed for AlertResponse-AlertSystem: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.AlertSubSystem); err != nil {
+		return fmt.Errorf("encode failed for AlertResponse-AlertSubSystem: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.AlertId); err != nil {
+		return fmt.Errorf("encode failed for AlertResponse-AlertId: %w", err)
+	}
+	if err := stream.writeUInt64(64, val.DataSourceNetworkIdName); err != nil {
+		return fmt.Errorf("encode failed for AlertResponse-DataSourceNetworkIdName: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.DataSourceInstance); err != nil {
+		return fmt.Errorf("encode failed for AlertResponse-DataSourceInstance: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.DataSourceIndexSource); err != nil {
+		return fmt.Errorf("encode failed for AlertResponse-DataSourceIndexSource: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.AlertOccurrenceNumber); err != nil {
+		return fmt.Errorf("encode failed for AlertResponse-AlertOccurrenceNumber: %w", err)
+	}
+	if err := stream.writeUInt64(64, val.AcknowledgeSourceNetworkIdName); err != nil {
+		return fmt.Errorf("encode failed for AlertResponse-AcknowledgeSourceNetworkIdName: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.ResponseCommand)); err != nil {
+		return fmt.Errorf("encode failed for AlertResponse-ResponseCommand: %w", err)
+	}
+	stream.writeReserved(6)
+	return nil
+}
 type AlertText struct {
 	Info MessageInfo
 	AlertType AlertTypeConst
@@ -41908,6 +49395,49 @@ func DecodeAlertText(Info MessageInfo, stream *PGNDataStream) (any, error) {
 	}	
 	return val, nil
 }
+func EncodeAlertText(p any, stream *PGNWriter) error {
+	val, ok := p.(AlertText)
+	if !ok {
+		return fmt.Errorf("EncodeAlertText called with %T", p)
+	}
+	if err := stream.writeLookupField(4, uint64(val.AlertType)); err != nil {
+		return fmt.Errorf("encode failed for AlertText-AlertType: %w", err)
+	}
+	if err := stream.writeLookupField(4, uint64(val.AlertCategory)); err != nil {
+		return fmt.Errorf("encode failed for AlertText-AlertCategory: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.AlertSystem); err != nil {
+		return fmt.Errorf("encode failed for AlertText-AlertSystem: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.AlertSubSystem); err != nil {
+		return fmt.Errorf("encode failed for AlertText-AlertSubSystem: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.AlertId); err != nil {
+		return fmt.Errorf("encode failed for AlertText-AlertId: %w", err)
+	}
+	if err := stream.writeUInt64(64, val.DataSourceNetworkIdName); err != nil {
+		return fmt.Errorf("encode failed for AlertText-DataSourceNetworkIdName: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.DataSourceInstance); err != nil {
+		return fmt.Errorf("encode failed for AlertText-DataSourceInstance: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.DataSourceIndexSource); err != nil {
+		return fmt.Errorf("encode failed for AlertText-DataSourceIndexSource: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.AlertOccurrenceNumber); err != nil {
+		return fmt.Errorf("encode failed for AlertText-AlertOccurrenceNumber: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.LanguageId)); err != nil {
+		return fmt.Errorf("encode failed for AlertText-LanguageId: %w", err)
+	}
+	if err := stream.writeStringWithLengthAndControl(val.AlertTextDescription); err != nil {
+		return fmt.Errorf("encode failed for AlertText-AlertTextDescription: %w", err)
+	}
+	if err := stream.writeStringWithLengthAndControl(val.AlertLocationTextDescription); err != nil {
+		return fmt.Errorf("encode failed for AlertText-AlertLocationTextDescription: %w", err)
+	}
+	return nil
+}
 type AlertConfiguration struct {
 	Info MessageInfo
 	AlertType AlertTypeConst
@@ -42060,6 +49590,56 @@ func DecodeAlertConfiguration(Info MessageInfo, stream *PGNDataStream) (any, err
 	}	
 	return val, nil
 }
+func EncodeAlertConfiguration(p any, stream *PGNWriter) error {
+	val, ok := p.(AlertConfiguration)
+	if !ok {
+		return fmt.Errorf("EncodeAlertConfiguration called with %T", p)
+	}
+	if err := stream.writeLookupField(4, uint64(val.AlertType)); err != nil {
+		return fmt.Errorf("encode failed for AlertConfiguration-AlertType: %w", err)
+	}
+	if err := stream.writeLookupField(4, uint64(val.AlertCategory)); err != nil {
+		return fmt.Errorf("encode failed for AlertConfiguration-AlertCategory: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.AlertSystem); err != nil {
+		return fmt.Errorf("encode failed for AlertConfiguration-AlertSystem: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.AlertSubSystem); err != nil {
+		return fmt.Errorf("encode failed for AlertConfiguration-AlertSubSystem: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.AlertId); err != nil {
+		return fmt.Errorf("encode failed for AlertConfiguration-AlertId: %w", err)
+	}
+	if err := stream.writeUInt64(64, val.DataSourceNetworkIdName); err != nil {
+		return fmt.Errorf("encode failed for AlertConfiguration-DataSourceNetworkIdName: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.DataSourceInstance); err != nil {
+		return fmt.Errorf("encode failed for AlertConfiguration-DataSourceInstance: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.DataSourceIndexSource); err != nil {
+		return fmt.Errorf("encode failed for AlertConfiguration-DataSourceIndexSource: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.AlertOccurrenceNumber); err != nil {
+		return fmt.Errorf("encode failed for AlertConfiguration-AlertOccurrenceNumber: %w", err)
+	}
+	if err := stream.writeUInt8(2, val.AlertControl); err != nil {
+		return fmt.Errorf("encode failed for AlertConfiguration-AlertControl: %w", err)
+	}
+	if err := stream.writeUInt8(2, val.UserDefinedAlertAssignment); err != nil {
+		return fmt.Errorf("encode failed for AlertConfiguration-UserDefinedAlertAssignment: %w", err)
+	}
+	stream.writeReserved(4)
+	if err := stream.writeUInt8(8, val.ReactivationPeriod); err != nil {
+		return fmt.Errorf("encode failed for AlertConfiguration-ReactivationPeriod: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.TemporarySilencePeriod); err != nil {
+		return fmt.Errorf("encode failed for AlertConfiguration-TemporarySilencePeriod: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.EscalationPeriod); err != nil {
+		return fmt.Errorf("encode failed for AlertConfiguration-EscalationPeriod: %w", err)
+	}
+	return nil
+}
 type AlertThreshold struct {
 	Info MessageInfo
 	AlertType AlertTypeConst
@@ -42218,6 +49798,58 @@ func DecodeAlertThreshold(Info MessageInfo, stream *PGNDataStream) (any, error)
 	}	
 	return val, nil
 }
+func EncodeAlertThreshold(p any, stream *PGNWriter) error {
+	val, ok := p.(AlertThreshold)
+	if !ok {
+		return fmt.Errorf("EncodeAlertThreshold called with %T", p)
+	}
+	if err := stream.writeLookupField(4, uint64(val.AlertType)); err != nil {
+		return fmt.Errorf("encode failed for AlertThreshold-AlertType: %w", err)
+	}
+	if err := stream.writeLookupField(4, uint64(val.AlertCategory)); err != nil {
+		return fmt.Errorf("encode failed for AlertThreshold-AlertCategory: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.AlertSystem); err != nil {
+		return fmt.Errorf("encode failed for AlertThreshold-AlertSystem: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.AlertSubSystem); err != nil {
+		return fmt.Errorf("encode failed for AlertThreshold-AlertSubSystem: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.AlertId); err != nil {
+		return fmt.Errorf("encode failed for AlertThreshold-AlertId: %w", err)
+	}
+	if err := stream.writeUInt64(64, val.DataSourceNetworkIdName); err != nil {
+		return fmt.Errorf("encode failed for AlertThreshold-DataSourceNetworkIdName: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.DataSourceInstance); err != nil {
+		return fmt.Errorf("encode failed for AlertThreshold-DataSourceInstance: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.DataSourceIndexSource); err != nil {
+		return fmt.Errorf("encode failed for AlertThreshold-DataSourceIndexSource: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.AlertOccurrenceNumber); err != nil {
+		return fmt.Errorf("encode failed for AlertThreshold-AlertOccurrenceNumber: %w", err)
+	}
+	repeat1Count := uint8(len(val.Repeating1))
+	if err := stream.writeUInt8(8, &repeat1Count); err != nil {
+		return fmt.Errorf("encode failed for AlertThreshold-NumberOfParameters: %w", err)
+	}
+	for _, rep := range val.Repeating1 {
+		if err := stream.writeUInt8(8, rep.ParameterNumber); err != nil {
+			return fmt.Errorf("encode failed for AlertThreshold-ParameterNumber: %w", err)
+		}
+		if err := stream.writeUInt8(8, rep.TriggerMethod); err != nil {
+			return fmt.Errorf("encode failed for AlertThreshold-TriggerMethod: %w", err)
+		}
+		if err := stream.writeUInt8(8, rep.ThresholdDataFormat); err != nil {
+			return fmt.Errorf("encode failed for AlertThreshold-ThresholdDataFormat: %w", err)
+		}
+		if err := stream.writeUInt64(64, rep.ThresholdLevel); err != nil {
+			return fmt.Errorf("encode failed for AlertThreshold-ThresholdLevel: %w", err)
+		}
+	}
+	return nil
+}
 type AlertValue struct {
 	Info MessageInfo
 	AlertType AlertTypeConst
@@ -42370,6 +50002,55 @@ func DecodeAlertValue(Info MessageInfo, stream *PGNDataStream) (any, error) {
 	}	
 	return val, nil
 }
+func EncodeAlertValue(p any, stream *PGNWriter) error {
+	val, ok := p.(AlertValue)
+	if !ok {
+		return fmt.Errorf("EncodeAlertValue called with %T", p)
+	}
+	if err := stream.writeLookupField(4, uint64(val.AlertType)); err != nil {
+		return fmt.Errorf("encode failed for AlertValue-AlertType: %w", err)
+	}
+	if err := stream.writeLookupField(4, uint64(val.AlertCategory)); err != nil {
+		return fmt.Errorf("encode failed for AlertValue-AlertCategory: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.AlertSystem); err != nil {
+		return fmt.Errorf("encode failed for AlertValue-AlertSystem: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.AlertSubSystem); err != nil {
+		return fmt.Errorf("encode failed for AlertValue-AlertSubSystem: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.AlertId); err != nil {
+		return fmt.Errorf("encode failed for AlertValue-AlertId: %w", err)
+	}
+	if err := stream.writeUInt64(64, val.DataSourceNetworkIdName); err != nil {
+		return fmt.Errorf("encode failed for AlertValue-DataSourceNetworkIdName: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.DataSourceInstance); err != nil {
+		return fmt.Errorf("encode failed for AlertValue-DataSourceInstance: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.DataSourceIndexSource); err != nil {
+		return fmt.Errorf("encode failed for AlertValue-DataSourceIndexSource: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.AlertOccurrenceNumber); err != nil {
+		return fmt.Errorf("encode failed for AlertValue-AlertOccurrenceNumber: %w", err)
+	}
+	repeat1Count := uint8(len(val.Repeating1))
+	if err := stream.writeUInt8(8, &repeat1Count); err != nil {
+		return fmt.Errorf("encode failed for AlertValue-NumberOfParameters: %w", err)
+	}
+	for _, rep := range val.Repeating1 {
+		if err := stream.writeUInt8(8, rep.ValueParameterNumber); err != nil {
+			return fmt.Errorf("encode failed for AlertValue-ValueParameterNumber: %w", err)
+		}
+		if err := stream.writeUInt8(8, rep.ValueDataFormat); err != nil {
+			return fmt.Errorf("encode failed for AlertValue-ValueDataFormat: %w", err)
+		}
+		if err := stream.writeUInt64(64, rep.ValueData); err != nil {
+			return fmt.Errorf("encode failed for AlertValue-ValueData: %w", err)
+		}
+	}
+	return nil
+}
 type SystemTime struct {
 	Info MessageInfo
 	Sid *uint8
@@ -42422,6 +50103,26 @@ func DecodeSystemTime(Info MessageInfo, stream *PGNDataStream) (any, error) {
 	}	
 	return val, nil
 }
+func EncodeSystemTime(p any, stream *PGNWriter) error {
+	val, ok := p.(SystemTime)
+	if !ok {
+		return fmt.Errorf("EncodeSystemTime called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for SystemTime-Sid: %w", err)
+	}
+	if err := stream.writeLookupField(4, uint64(val.Source)); err != nil {
+		return fmt.Errorf("encode failed for SystemTime-Source: %w", err)
+	}
+	stream.writeReserved(4)
+	if err := stream.writeUInt16(16, val.Date); err != nil {
+		return fmt.Errorf("encode failed for SystemTime-Date: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(32, 0.0001, val.Time); err != nil {
+		return fmt.Errorf("encode failed for SystemTime-Time: %w", err)
+	}
+	return nil
+}
 type Heartbeat struct {
 	Info MessageInfo
 	DataTransmitOffset *float32
@@ -42484,6 +50185,29 @@ func DecodeHeartbeat(Info MessageInfo, stream *PGNDataStream) (any, error) {
 		}	
 	return val, nil
 }
+func EncodeHeartbeat(p any, stream *PGNWriter) error {
+	val, ok := p.(Heartbeat)
+	if !ok {
+		return fmt.Errorf("EncodeHeartbeat called with %T", p)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.001, val.DataTransmitOffset); err != nil {
+		return fmt.Errorf("encode failed for Heartbeat-DataTransmitOffset: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.SequenceCounter); err != nil {
+		return fmt.Errorf("encode failed for Heartbeat-SequenceCounter: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Controller1State)); err != nil {
+		return fmt.Errorf("encode failed for Heartbeat-Controller1State: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Controller2State)); err != nil {
+		return fmt.Errorf("encode failed for Heartbeat-Controller2State: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.EquipmentStatus)); err != nil {
+		return fmt.Errorf("encode failed for Heartbeat-EquipmentStatus: %w", err)
+	}
+	stream.writeReserved(34)
+	return nil
+}
 type ProductInformation struct {
 	Info MessageInfo
 	Nmea2000Version *float32
@@ -42572,6 +50296,37 @@ func DecodeProductInformation(Info MessageInfo, stream *PGNDataStream) (any, err
 	}	
 	return val, nil
 }
+func EncodeProductInformation(p any, stream *PGNWriter) error {
+	val, ok := p.(ProductInformation)
+	if !ok {
+		return fmt.Errorf("EncodeProductInformation called with %T", p)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.001, val.Nmea2000Version); err != nil {
+		return fmt.Errorf("encode failed for ProductInformation-Nmea2000Version: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.ProductCode); err != nil {
+		return fmt.Errorf("encode failed for ProductInformation-ProductCode: %w", err)
+	}
+	if err := stream.writeFixedString(256, val.ModelId); err != nil {
+		return fmt.Errorf("encode failed for ProductInformation-ModelId: %w", err)
+	}
+	if err := stream.writeFixedString(256, val.SoftwareVersionCode); err != nil {
+		return fmt.Errorf("encode failed for ProductInformation-SoftwareVersionCode: %w", err)
+	}
+	if err := stream.writeFixedString(256, val.ModelVersion); err != nil {
+		return fmt.Errorf("encode failed for ProductInformation-ModelVersion: %w", err)
+	}
+	if err := stream.writeFixedString(256, val.ModelSerialCode); err != nil {
+		return fmt.Errorf("encode failed for ProductInformation-ModelSerialCode: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.CertificationLevel); err != nil {
+		return fmt.Errorf("encode failed for ProductInformation-CertificationLevel: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.LoadEquivalency); err != nil {
+		return fmt.Errorf("encode failed for ProductInformation-LoadEquivalency: %w", err)
+	}
+	return nil
+}
 type ConfigurationInformation struct {
 	Info MessageInfo
 	InstallationDescription1 string
@@ -42610,6 +50365,22 @@ func DecodeConfigurationInformation(Info MessageInfo, stream *PGNDataStream) (an
 	}	
 	return val, nil
 }
+func EncodeConfigurationInformation(p any, stream *PGNWriter) error {
+	val, ok := p.(ConfigurationInformation)
+	if !ok {
+		return fmt.Errorf("EncodeConfigurationInformation called with %T", p)
+	}
+	if err := stream.writeStringWithLengthAndControl(val.InstallationDescription1); err != nil {
+		return fmt.Errorf("encode failed for ConfigurationInformation-InstallationDescription1: %w", err)
+	}
+	if err := stream.writeStringWithLengthAndControl(val.InstallationDescription2); err != nil {
+		return fmt.Errorf("encode failed for ConfigurationInformation-InstallationDescription2: %w", err)
+	}
+	if err := stream.writeStringWithLengthAndControl(val.ManufacturerInformation); err != nil {
+		return fmt.Errorf("encode failed for ConfigurationInformation-ManufacturerInformation: %w", err)
+	}
+	return nil
+}
 type ManOverboardNotification struct {
 	Info MessageInfo
 	Sid *uint8
@@ -42774,6 +50545,59 @@ func DecodeManOverboardNotification(Info MessageInfo, stream *PGNDataStream) (an
 		}	
 	return val, nil
 }
+func EncodeManOverboardNotification(p any, stream *PGNWriter) error {
+	val, ok := p.(ManOverboardNotification)
+	if !ok {
+		return fmt.Errorf("EncodeManOverboardNotification called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for ManOverboardNotification-Sid: %w", err)
+	}
+	if err := stream.writeUInt32(32, val.MobEmitterId); err != nil {
+		return fmt.Errorf("encode failed for ManOverboardNotification-MobEmitterId: %w", err)
+	}
+	if err := stream.writeLookupField(3, uint64(val.ManOverboardStatus)); err != nil {
+		return fmt.Errorf("encode failed for ManOverboardNotification-ManOverboardStatus: %w", err)
+	}
+	stream.writeReserved(5)
+	if err := stream.writeUnsignedResolution(32, 0.0001, val.ActivationTime); err != nil {
+		return fmt.Errorf("encode failed for ManOverboardNotification-ActivationTime: %w", err)
+	}
+	if err := stream.writeLookupField(3, uint64(val.PositionSource)); err != nil {
+		return fmt.Errorf("encode failed for ManOverboardNotification-PositionSource: %w", err)
+	}
+	stream.writeReserved(5)
+	if err := stream.writeUInt16(16, val.PositionDate); err != nil {
+		return fmt.Errorf("encode failed for ManOverboardNotification-PositionDate: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(32, 0.0001, val.PositionTime); err != nil {
+		return fmt.Errorf("encode failed for ManOverboardNotification-PositionTime: %w", err)
+	}
+	if err := stream.writeSignedResolution64Override(32, 1e-07, val.Latitude); err != nil {
+		return fmt.Errorf("encode failed for ManOverboardNotification-Latitude: %w", err)
+	}
+	if err := stream.writeSignedResolution64Override(32, 1e-07, val.Longitude); err != nil {
+		return fmt.Errorf("encode failed for ManOverboardNotification-Longitude: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.CogReference)); err != nil {
+		return fmt.Errorf("encode failed for ManOverboardNotification-CogReference: %w", err)
+	}
+	stream.writeReserved(6)
+	if err := stream.writeUnsignedResolution(16, 0.0001, val.Cog); err != nil {
+		return fmt.Errorf("encode failed for ManOverboardNotification-Cog: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.01, nullableUnitValue(val.Sog, units.MetersPerSecond, units.Velocity.Convert)); err != nil {
+		return fmt.Errorf("encode failed for ManOverboardNotification-Sog: %w", err)
+	}
+	if err := stream.writeUInt32(32, val.MmsiOfVesselOfOrigin); err != nil {
+		return fmt.Errorf("encode failed for ManOverboardNotification-MmsiOfVesselOfOrigin: %w", err)
+	}
+	if err := stream.writeLookupField(3, uint64(val.MobEmitterBatteryLowStatus)); err != nil {
+		return fmt.Errorf("encode failed for ManOverboardNotification-MobEmitterBatteryLowStatus: %w", err)
+	}
+	stream.writeReserved(5)
+	return nil
+}
 type HeadingTrackControl struct {
 	Info MessageInfo
 	RudderLimitExceeded YesNoConst
@@ -42956,6 +50780,65 @@ func DecodeHeadingTrackControl(Info MessageInfo, stream *PGNDataStream) (any, er
 	}	
 	return val, nil
 }
+func EncodeHeadingTrackControl(p any, stream *PGNWriter) error {
+	val, ok := p.(HeadingTrackControl)
+	if !ok {
+		return fmt.Errorf("EncodeHeadingTrackControl called with %T", p)
+	}
+	if err := stream.writeLookupField(2, uint64(val.RudderLimitExceeded)); err != nil {
+		return fmt.Errorf("encode failed for HeadingTrackControl-RudderLimitExceeded: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.OffHeadingLimitExceeded)); err != nil {
+		return fmt.Errorf("encode failed for HeadingTrackControl-OffHeadingLimitExceeded: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.OffTrackLimitExceeded)); err != nil {
+		return fmt.Errorf("encode failed for HeadingTrackControl-OffTrackLimitExceeded: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Override)); err != nil {
+		return fmt.Errorf("encode failed for HeadingTrackControl-Override: %w", err)
+	}
+	if err := stream.writeLookupField(3, uint64(val.SteeringMode)); err != nil {
+		return fmt.Errorf("encode failed for HeadingTrackControl-SteeringMode: %w", err)
+	}
+	if err := stream.writeLookupField(3, uint64(val.TurnMode)); err != nil {
+		return fmt.Errorf("encode failed for HeadingTrackControl-TurnMode: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.HeadingReference)); err != nil {
+		return fmt.Errorf("encode failed for HeadingTrackControl-HeadingReference: %w", err)
+	}
+	stream.writeReserved(5)
+	if err := stream.writeLookupField(3, uint64(val.CommandedRudderDirection)); err != nil {
+		return fmt.Errorf("encode failed for HeadingTrackControl-CommandedRudderDirection: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.0001, val.CommandedRudderAngle); err != nil {
+		return fmt.Errorf("encode failed for HeadingTrackControl-CommandedRudderAngle: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.0001, val.HeadingToSteerCourse); err != nil {
+		return fmt.Errorf("encode failed for HeadingTrackControl-HeadingToSteerCourse: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.0001, val.Track); err != nil {
+		return fmt.Errorf("encode failed for HeadingTrackControl-Track: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.0001, val.RudderLimit); err != nil {
+		return fmt.Errorf("encode failed for HeadingTrackControl-RudderLimit: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.0001, val.OffHeadingLimit); err != nil {
+		return fmt.Errorf("encode failed for HeadingTrackControl-OffHeadingLimit: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.0001, val.RadiusOfTurnOrder); err != nil {
+		return fmt.Errorf("encode failed for HeadingTrackControl-RadiusOfTurnOrder: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 3.125e-05, val.RateOfTurnOrder); err != nil {
+		return fmt.Errorf("encode failed for HeadingTrackControl-RateOfTurnOrder: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 1, nullableUnitValue(val.OffTrackLimit, units.Meter, units.Distance.Convert)); err != nil {
+		return fmt.Errorf("encode failed for HeadingTrackControl-OffTrackLimit: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.0001, val.VesselHeading); err != nil {
+		return fmt.Errorf("encode failed for HeadingTrackControl-VesselHeading: %w", err)
+	}
+	return nil
+}
 type Rudder struct {
 	Info MessageInfo
 	Instance *uint8
@@ -43012,6 +50895,27 @@ func DecodeRudder(Info MessageInfo, stream *PGNDataStream) (any, error) {
 		}	
 	return val, nil
 }
+func EncodeRudder(p any, stream *PGNWriter) error {
+	val, ok := p.(Rudder)
+	if !ok {
+		return fmt.Errorf("EncodeRudder called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Instance); err != nil {
+		return fmt.Errorf("encode failed for Rudder-Instance: %w", err)
+	}
+	if err := stream.writeLookupField(3, uint64(val.DirectionOrder)); err != nil {
+		return fmt.Errorf("encode failed for Rudder-DirectionOrder: %w", err)
+	}
+	stream.writeReserved(5)
+	if err := stream.writeSignedResolution(16, 0.0001, val.AngleOrder); err != nil {
+		return fmt.Errorf("encode failed for Rudder-AngleOrder: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.0001, val.Position); err != nil {
+		return fmt.Errorf("encode failed for Rudder-Position: %w", err)
+	}
+	stream.writeReserved(16)
+	return nil
+}
 type VesselHeading struct {
 	Info MessageInfo
 	Sid *uint8
@@ -43074,6 +50978,29 @@ func DecodeVesselHeading(Info MessageInfo, stream *PGNDataStream) (any, error) {
 		}	
 	return val, nil
 }
+func EncodeVesselHeading(p any, stream *PGNWriter) error {
+	val, ok := p.(VesselHeading)
+	if !ok {
+		return fmt.Errorf("EncodeVesselHeading called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for VesselHeading-Sid: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.0001, val.Heading); err != nil {
+		return fmt.Errorf("encode failed for VesselHeading-Heading: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.0001, val.Deviation); err != nil {
+		return fmt.Errorf("encode failed for VesselHeading-Deviation: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.0001, val.Variation); err != nil {
+		return fmt.Errorf("encode failed for VesselHeading-Variation: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Reference)); err != nil {
+		return fmt.Errorf("encode failed for VesselHeading-Reference: %w", err)
+	}
+	stream.writeReserved(6)
+	return nil
+}
 type RateOfTurn struct {
 	Info MessageInfo
 	Sid *uint8
@@ -43106,6 +51033,20 @@ func DecodeRateOfTurn(Info MessageInfo, stream *PGNDataStream) (any, error) {
 		}	
 	return val, nil
 }
+func EncodeRateOfTurn(p any, stream *PGNWriter) error {
+	val, ok := p.(RateOfTurn)
+	if !ok {
+		return fmt.Errorf("EncodeRateOfTurn called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for RateOfTurn-Sid: %w", err)
+	}
+	if err := stream.writeSignedResolution64Override(32, 3.125e-08, val.Rate); err != nil {
+		return fmt.Errorf("encode failed for RateOfTurn-Rate: %w", err)
+	}
+	stream.writeReserved(24)
+	return nil
+}
 type Heave struct {
 	Info MessageInfo
 	Sid *uint8
@@ -43138,6 +51079,20 @@ func DecodeHeave(Info MessageInfo, stream *PGNDataStream) (any, error) {
 		}	
 	return val, nil
 }
+func EncodeHeave(p any, stream *PGNWriter) error {
+	val, ok := p.(Heave)
+	if !ok {
+		return fmt.Errorf("EncodeHeave called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for Heave-Sid: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.01, nullableUnitValue(val.Heave, units.Meter, units.Distance.Convert)); err != nil {
+		return fmt.Errorf("encode failed for Heave-Heave: %w", err)
+	}
+	stream.writeReserved(40)
+	return nil
+}
 type Attitude struct {
 	Info MessageInfo
 	Sid *uint8
@@ -43190,6 +51145,26 @@ func DecodeAttitude(Info MessageInfo, stream *PGNDataStream) (any, error) {
 		}	
 	return val, nil
 }
+func EncodeAttitude(p any, stream *PGNWriter) error {
+	val, ok := p.(Attitude)
+	if !ok {
+		return fmt.Errorf("EncodeAttitude called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for Attitude-Sid: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.0001, val.Yaw); err != nil {
+		return fmt.Errorf("encode failed for Attitude-Yaw: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.0001, val.Pitch); err != nil {
+		return fmt.Errorf("encode failed for Attitude-Pitch: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.0001, val.Roll); err != nil {
+		return fmt.Errorf("encode failed for Attitude-Roll: %w", err)
+	}
+	stream.writeReserved(8)
+	return nil
+}
 type MagneticVariation struct {
 	Info MessageInfo
 	Sid *uint8
@@ -43246,6 +51221,27 @@ func DecodeMagneticVariation(Info MessageInfo, stream *PGNDataStream) (any, erro
 		}	
 	return val, nil
 }
+func EncodeMagneticVariation(p any, stream *PGNWriter) error {
+	val, ok := p.(MagneticVariation)
+	if !ok {
+		return fmt.Errorf("EncodeMagneticVariation called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for MagneticVariation-Sid: %w", err)
+	}
+	if err := stream.writeLookupField(4, uint64(val.Source)); err != nil {
+		return fmt.Errorf("encode failed for MagneticVariation-Source: %w", err)
+	}
+	stream.writeReserved(4)
+	if err := stream.writeUInt16(16, val.AgeOfService); err != nil {
+		return fmt.Errorf("encode failed for MagneticVariation-AgeOfService: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.0001, val.Variation); err != nil {
+		return fmt.Errorf("encode failed for MagneticVariation-Variation: %w", err)
+	}
+	stream.writeReserved(16)
+	return nil
+}
 type EngineParametersRapidUpdate struct {
 	Info MessageInfo
 	Instance EngineInstanceConst
@@ -43298,6 +51294,26 @@ func DecodeEngineParametersRapidUpdate(Info MessageInfo, stream *PGNDataStream)
 		}	
 	return val, nil
 }
+func EncodeEngineParametersRapidUpdate(p any, stream *PGNWriter) error {
+	val, ok := p.(EngineParametersRapidUpdate)
+	if !ok {
+		return fmt.Errorf("EncodeEngineParametersRapidUpdate called with %T", p)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Instance)); err != nil {
+		return fmt.Errorf("encode failed for EngineParametersRapidUpdate-Instance: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.25, val.Speed); err != nil {
+		return fmt.Errorf("encode failed for EngineParametersRapidUpdate-Speed: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 100, nullableUnitValue(val.BoostPressure, units.Pa, units.Pressure.Convert)); err != nil {
+		return fmt.Errorf("encode failed for EngineParametersRapidUpdate-BoostPressure: %w", err)
+	}
+	if err := stream.writeInt8(8, val.TiltTrim); err != nil {
+		return fmt.Errorf("encode failed for EngineParametersRapidUpdate-TiltTrim: %w", err)
+	}
+	stream.writeReserved(16)
+	return nil
+}
 type EngineParametersDynamic struct {
 	Info MessageInfo
 	Instance EngineInstanceConst
@@ -43440,6 +51456,53 @@ func DecodeEngineParametersDynamic(Info MessageInfo, stream *PGNDataStream) (any
 	}	
 	return val, nil
 }
+func EncodeEngineParametersDynamic(p any, stream *PGNWriter) error {
+	val, ok := p.(EngineParametersDynamic)
+	if !ok {
+		return fmt.Errorf("EncodeEngineParametersDynamic called with %T", p)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Instance)); err != nil {
+		return fmt.Errorf("encode failed for EngineParametersDynamic-Instance: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 100, nullableUnitValue(val.OilPressure, units.Pa, units.Pressure.Convert)); err != nil {
+		return fmt.Errorf("encode failed for EngineParametersDynamic-OilPressure: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.1, nullableUnitValue(val.OilTemperature, units.Kelvin, units.Temperature.Convert)); err != nil {
+		return fmt.Errorf("encode failed for EngineParametersDynamic-OilTemperature: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.01, nullableUnitValue(val.Temperature, units.Kelvin, units.Temperature.Convert)); err != nil {
+		return fmt.Errorf("encode failed for EngineParametersDynamic-Temperature: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.01, val.AlternatorPotential); err != nil {
+		return fmt.Errorf("encode failed for EngineParametersDynamic-AlternatorPotential: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.1, nullableUnitValue(val.FuelRate, units.LitersPerHour, units.Flow.Convert)); err != nil {
+		return fmt.Errorf("encode failed for EngineParametersDynamic-FuelRate: %w", err)
+	}
+	if err := stream.writeUInt32(32, val.TotalEngineHours); err != nil {
+		return fmt.Errorf("encode failed for EngineParametersDynamic-TotalEngineHours: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 100, nullableUnitValue(val.CoolantPressure, units.Pa, units.Pressure.Convert)); err != nil {
+		return fmt.Errorf("encode failed for EngineParametersDynamic-CoolantPressure: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 1000, nullableUnitValue(val.FuelPressure, units.Pa, units.Pressure.Convert)); err != nil {
+		return fmt.Errorf("encode failed for EngineParametersDynamic-FuelPressure: %w", err)
+	}
+	stream.writeReserved(8)
+	if err := stream.writeLookupField(16, uint64(val.DiscreteStatus1)); err != nil {
+		return fmt.Errorf("encode failed for EngineParametersDynamic-DiscreteStatus1: %w", err)
+	}
+	if err := stream.writeLookupField(16, uint64(val.DiscreteStatus2)); err != nil {
+		return fmt.Errorf("encode failed for EngineParametersDynamic-DiscreteStatus2: %w", err)
+	}
+	if err := stream.writeInt8(8, val.EngineLoad); err != nil {
+		return fmt.Errorf("encode failed for EngineParametersDynamic-EngineLoad: %w", err)
+	}
+	if err := stream.writeInt8(8, val.EngineTorque); err != nil {
+		return fmt.Errorf("encode failed for EngineParametersDynamic-EngineTorque: %w", err)
+	}
+	return nil
+}
 type TransmissionParametersDynamic struct {
 	Info MessageInfo
 	Instance EngineInstanceConst
@@ -43506,6 +51569,30 @@ func DecodeTransmissionParametersDynamic(Info MessageInfo, stream *PGNDataStream
 		}	
 	return val, nil
 }
+func EncodeTransmissionParametersDynamic(p any, stream *PGNWriter) error {
+	val, ok := p.(TransmissionParametersDynamic)
+	if !ok {
+		return fmt.Errorf("EncodeTransmissionParametersDynamic called with %T", p)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Instance)); err != nil {
+		return fmt.Errorf("encode failed for TransmissionParametersDynamic-Instance: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.TransmissionGear)); err != nil {
+		return fmt.Errorf("encode failed for TransmissionParametersDynamic-TransmissionGear: %w", err)
+	}
+	stream.writeReserved(6)
+	if err := stream.writeUnsignedResolution(16, 100, nullableUnitValue(val.OilPressure, units.Pa, units.Pressure.Convert)); err != nil {
+		return fmt.Errorf("encode failed for TransmissionParametersDynamic-OilPressure: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.1, nullableUnitValue(val.OilTemperature, units.Kelvin, units.Temperature.Convert)); err != nil {
+		return fmt.Errorf("encode failed for TransmissionParametersDynamic-OilTemperature: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.DiscreteStatus1); err != nil {
+		return fmt.Errorf("encode failed for TransmissionParametersDynamic-DiscreteStatus1: %w", err)
+	}
+	stream.writeReserved(8)
+	return nil
+}
 type TripParametersVessel struct {
 	Info MessageInfo
 	TimeToEmpty *float32
@@ -43554,6 +51641,25 @@ func DecodeTripParametersVessel(Info MessageInfo, stream *PGNDataStream) (any, e
 	}	
 	return val, nil
 }
+func EncodeTripParametersVessel(p any, stream *PGNWriter) error {
+	val, ok := p.(TripParametersVessel)
+	if !ok {
+		return fmt.Errorf("EncodeTripParametersVessel called with %T", p)
+	}
+	if err := stream.writeUnsignedResolution(32, 0.001, val.TimeToEmpty); err != nil {
+		return fmt.Errorf("encode failed for TripParametersVessel-TimeToEmpty: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(32, 0.01, nullableUnitValue(val.DistanceToEmpty, units.Meter, units.Distance.Convert)); err != nil {
+		return fmt.Errorf("encode failed for TripParametersVessel-DistanceToEmpty: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 1, nullableUnitValue(val.EstimatedFuelRemaining, units.Liter, units.Volume.Convert)); err != nil {
+		return fmt.Errorf("encode failed for TripParametersVessel-EstimatedFuelRemaining: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(32, 0.001, val.TripRunTime); err != nil {
+		return fmt.Errorf("encode failed for TripParametersVessel-TripRunTime: %w", err)
+	}
+	return nil
+}
 type TripParametersEngine struct {
 	Info MessageInfo
 	Instance EngineInstanceConst
@@ -43612,6 +51718,28 @@ func DecodeTripParametersEngine(Info MessageInfo, stream *PGNDataStream) (any, e
 	}	
 	return val, nil
 }
+func EncodeTripParametersEngine(p any, stream *PGNWriter) error {
+	val, ok := p.(TripParametersEngine)
+	if !ok {
+		return fmt.Errorf("EncodeTripParametersEngine called with %T", p)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Instance)); err != nil {
+		return fmt.Errorf("encode failed for TripParametersEngine-Instance: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 1, nullableUnitValue(val.TripFuelUsed, units.Liter, units.Volume.Convert)); err != nil {
+		return fmt.Errorf("encode failed for TripParametersEngine-TripFuelUsed: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.1, nullableUnitValue(val.FuelRateAverage, units.LitersPerHour, units.Flow.Convert)); err != nil {
+		return fmt.Errorf("encode failed for TripParametersEngine-FuelRateAverage: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.1, nullableUnitValue(val.FuelRateEconomy, units.LitersPerHour, units.Flow.Convert)); err != nil {
+		return fmt.Errorf("encode failed for TripParametersEngine-FuelRateEconomy: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.1, nullableUnitValue(val.InstantaneousFuelEconomy, units.LitersPerHour, units.Flow.Convert)); err != nil {
+		return fmt.Errorf("encode failed for TripParametersEngine-InstantaneousFuelEconomy: %w", err)
+	}
+	return nil
+}
 type EngineParametersStatic struct {
 	Info MessageInfo
 	Instance EngineInstanceConst
@@ -43660,6 +51788,25 @@ func DecodeEngineParametersStatic(Info MessageInfo, stream *PGNDataStream) (any,
 	}	
 	return val, nil
 }
+func EncodeEngineParametersStatic(p any, stream *PGNWriter) error {
+	val, ok := p.(EngineParametersStatic)
+	if !ok {
+		return fmt.Errorf("EncodeEngineParametersStatic called with %T", p)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Instance)); err != nil {
+		return fmt.Errorf("encode failed for EngineParametersStatic-Instance: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.25, val.RatedEngineSpeed); err != nil {
+		return fmt.Errorf("encode failed for EngineParametersStatic-RatedEngineSpeed: %w", err)
+	}
+	if err := stream.writeFixedString(136, val.Vin); err != nil {
+		return fmt.Errorf("encode failed for EngineParametersStatic-Vin: %w", err)
+	}
+	if err := stream.writeFixedString(256, val.SoftwareId); err != nil {
+		return fmt.Errorf("encode failed for EngineParametersStatic-SoftwareId: %w", err)
+	}
+	return nil
+}
 type LoadControllerConnectionStateControl struct {
 	Info MessageInfo
 	SequenceId *uint8
@@ -43748,6 +51895,37 @@ func DecodeLoadControllerConnectionStateControl(Info MessageInfo, stream *PGNDat
 	}	
 	return val, nil
 }
+func EncodeLoadControllerConnectionStateControl(p any, stream *PGNWriter) error {
+	val, ok := p.(LoadControllerConnectionStateControl)
+	if !ok {
+		return fmt.Errorf("EncodeLoadControllerConnectionStateControl called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.SequenceId); err != nil {
+		return fmt.Errorf("encode failed for LoadControllerConnectionStateControl-SequenceId: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.ConnectionId); err != nil {
+		return fmt.Errorf("encode failed for LoadControllerConnectionStateControl-ConnectionId: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.State); err != nil {
+		return fmt.Errorf("encode failed for LoadControllerConnectionStateControl-State: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Status); err != nil {
+		return fmt.Errorf("encode failed for LoadControllerConnectionStateControl-Status: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.OperationalStatusControl); err != nil {
+		return fmt.Errorf("encode failed for LoadControllerConnectionStateControl-OperationalStatusControl: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.PwmDutyCycle); err != nil {
+		return fmt.Errorf("encode failed for LoadControllerConnectionStateControl-PwmDutyCycle: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Timeon); err != nil {
+		return fmt.Errorf("encode failed for LoadControllerConnectionStateControl-Timeon: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Timeoff); err != nil {
+		return fmt.Errorf("encode failed for LoadControllerConnectionStateControl-Timeoff: %w", err)
+	}
+	return nil
+}
 type BinarySwitchBankStatus struct {
 	Info MessageInfo
 	Instance *uint8
@@ -44046,6 +52224,100 @@ func DecodeBinarySwitchBankStatus(Info MessageInfo, stream *PGNDataStream) (any,
 	}	
 	return val, nil
 }
+func EncodeBinarySwitchBankStatus(p any, stream *PGNWriter) error {
+	val, ok := p.(BinarySwitchBankStatus)
+	if !ok {
+		return fmt.Errorf("EncodeBinarySwitchBankStatus called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Instance); err != nil {
+		return fmt.Errorf("encode failed for BinarySwitchBankStatus-Instance: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Indicator1)); err != nil {
+		return fmt.Errorf("encode failed for BinarySwitchBankStatus-Indicator1: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Indicator2)); err != nil {
+		return fmt.Errorf("encode failed for BinarySwitchBankStatus-Indicator2: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Indicator3)); err != nil {
+		return fmt.Errorf("encode failed for BinarySwitchBankStatus-Indicator3: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Indicator4)); err != nil {
+		return fmt.Errorf("encode failed for BinarySwitchBankStatus-Indicator4: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Indicator5)); err != nil {
+		return fmt.Errorf("encode failed for BinarySwitchBankStatus-Indicator5: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Indicator6)); err != nil {
+		return fmt.Errorf("encode failed for BinarySwitchBankStatus-Indicator6: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Indicator7)); err != nil {
+		return fmt.Errorf("encode failed for BinarySwitchBankStatus-Indicator7: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Indicator8)); err != nil {
+		return fmt.Errorf("encode failed for BinarySwitchBankStatus-Indicator8: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Indicator9)); err != nil {
+		return fmt.Errorf("encode failed for BinarySwitchBankStatus-Indicator9: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Indicator10)); err != nil {
+		return fmt.Errorf("encode failed for BinarySwitchBankStatus-Indicator10: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Indicator11)); err != nil {
+		return fmt.Errorf("encode failed for BinarySwitchBankStatus-Indicator11: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Indicator12)); err != nil {
+		return fmt.Errorf("encode failed for BinarySwitchBankStatus-Indicator12: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Indicator13)); err != nil {
+		return fmt.Errorf("encode failed for BinarySwitchBankStatus-Indicator13: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Indicator14)); err != nil {
+		return fmt.Errorf("encode failed for BinarySwitchBankStatus-Indicator14: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Indicator15)); err != nil {
+		return fmt.Errorf("encode failed for BinarySwitchBankStatus-Indicator15: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Indicator16)); err != nil {
+		return fmt.Errorf("encode failed for BinarySwitchBankStatus-Indicator16: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Indicator17)); err != nil {
+		return fmt.Errorf("encode failed for BinarySwitchBankStatus-Indicator17: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Indicator18)); err != nil {
+		return fmt.Errorf("encode failed for BinarySwitchBankStatus-Indicator18: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Indicator19)); err != nil {
+		return fmt.Errorf("encode failed for BinarySwitchBankStatus-Indicator19: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Indicator20)); err != nil {
+		return fmt.Errorf("encode failed for BinarySwitchBankStatus-Indicator20: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Indicator21)); err != nil {
+		return fmt.Errorf("encode failed for BinarySwitchBankStatus-Indicator21: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Indicator22)); err != nil {
+		return fmt.Errorf("encode failed for BinarySwitchBankStatus-Indicator22: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Indicator23)); err != nil {
+		return fmt.Errorf("encode failed for BinarySwitchBankStatus-Indicator23: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Indicator24)); err != nil {
+		return fmt.Errorf("encode failed for BinarySwitchBankStatus-Indicator24: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Indicator25)); err != nil {
+		return fmt.Errorf("encode failed for BinarySwitchBankStatus-Indicator25: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Indicator26)); err != nil {
+		return fmt.Errorf("encode failed for BinarySwitchBankStatus-Indicator26: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Indicator27)); err != nil {
+		return fmt.Errorf("encode failed for BinarySwitchBankStatus-Indicator27: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Indicator28)); err != nil {
+		return fmt.Errorf("encode failed for BinarySwitchBankStatus-Indicator28: %w", err)
+	}
+	return nil
+}
 type SwitchBankControl struct {
 	Info MessageInfo
 	Instance *uint8
@@ -44344,6 +52616,100 @@ func DecodeSwitchBankControl(Info MessageInfo, stream *PGNDataStream) (any, erro
 	}	
 	return val, nil
 }
+func EncodeSwitchBankControl(p any, stream *PGNWriter) error {
+	val, ok := p.(SwitchBankControl)
+	if !ok {
+		return fmt.Errorf("EncodeSwitchBankControl called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Instance); err != nil {
+		return fmt.Errorf("encode failed for SwitchBankControl-Instance: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Switch1)); err != nil {
+		return fmt.Errorf("encode failed for SwitchBankControl-Switch1: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Switch2)); err != nil {
+		return fmt.Errorf("encode failed for SwitchBankControl-Switch2: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Switch3)); err != nil {
+		return fmt.Errorf("encode failed for SwitchBankControl-Switch3: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Switch4)); err != nil {
+		return fmt.Errorf("encode failed for SwitchBankControl-Switch4: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Switch5)); err != nil {
+		return fmt.Errorf("encode failed for SwitchBankControl-Switch5: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Switch6)); err != nil {
+		return fmt.Errorf("encode failed for SwitchBankControl-Switch6: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Switch7)); err != nil {
+		return fmt.Errorf("encode failed for SwitchBankControl-Switch7: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Switch8)); err != nil {
+		return fmt.Errorf("encode failed for SwitchBankControl-Switch8: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Switch9)); err != nil {
+		return fmt.Errorf("encode failed for SwitchBankControl-Switch9: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Switch10)); err != nil {
+		return fmt.Errorf("encode failed for SwitchBankControl-Switch10: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Switch11)); err != nil {
+		return fmt.Errorf("encode failed for SwitchBankControl-Switch11: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Switch12)); err != nil {
+		return fmt.Errorf("encode failed for SwitchBankControl-Switch12: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Switch13)); err != nil {
+		return fmt.Errorf("encode failed for SwitchBankControl-Switch13: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Switch14)); err != nil {
+		return fmt.Errorf("encode failed for SwitchBankControl-Switch14: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Switch15)); err != nil {
+		return fmt.Errorf("encode failed for SwitchBankControl-Switch15: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Switch16)); err != nil {
+		return fmt.Errorf("encode failed for SwitchBankControl-Switch16: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Switch17)); err != nil {
+		return fmt.Errorf("encode failed for SwitchBankControl-Switch17: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Switch18)); err != nil {
+		return fmt.Errorf("encode failed for SwitchBankControl-Switch18: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Switch19)); err != nil {
+		return fmt.Errorf("encode failed for SwitchBankControl-Switch19: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Switch20)); err != nil {
+		return fmt.Errorf("encode failed for SwitchBankControl-Switch20: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Switch21)); err != nil {
+		return fmt.Errorf("encode failed for SwitchBankControl-Switch21: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Switch22)); err != nil {
+		return fmt.Errorf("encode failed for SwitchBankControl-Switch22: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Switch23)); err != nil {
+		return fmt.Errorf("encode failed for SwitchBankControl-Switch23: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Switch24)); err != nil {
+		return fmt.Errorf("encode failed for SwitchBankControl-Switch24: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Switch25)); err != nil {
+		return fmt.Errorf("encode failed for SwitchBankControl-Switch25: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Switch26)); err != nil {
+		return fmt.Errorf("encode failed for SwitchBankControl-Switch26: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Switch27)); err != nil {
+		return fmt.Errorf("encode failed for SwitchBankControl-Switch27: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Switch28)); err != nil {
+		return fmt.Errorf("encode failed for SwitchBankControl-Switch28: %w", err)
+	}
+	return nil
+}
 type AcInputStatus struct {
 	Info MessageInfo
 	Instance *uint8
@@ -44453,6 +52819,50 @@ func DecodeAcInputStatus(Info MessageInfo, stream *PGNDataStream) (any, error) {
 	}	
 	return val, nil
 }
+func EncodeAcInputStatus(p any, stream *PGNWriter) error {
+	val, ok := p.(AcInputStatus)
+	if !ok {
+		return fmt.Errorf("EncodeAcInputStatus called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Instance); err != nil {
+		return fmt.Errorf("encode failed for AcInputStatus-Instance: %w", err)
+	}
+	repeat1Count := uint8(len(val.Repeating1))
+	if err := stream.writeUInt8(8, &repeat1Count); err != nil {
+		return fmt.Errorf("encode failed for AcInputStatus-NumberOfLines: %w", err)
+	}
+	for _, rep := range val.Repeating1 {
+		if err := stream.writeUInt8(2, rep.Line); err != nil {
+			return fmt.Errorf("encode failed for AcInputStatus-Line: %w", err)
+		}
+		if err := stream.writeLookupField(2, uint64(rep.Acceptability)); err != nil {
+			return fmt.Errorf("encode failed for AcInputStatus-Acceptability: %w", err)
+		}
+		stream.writeReserved(4)
+		if err := stream.writeUnsignedResolution(16, 0.01, rep.Voltage); err != nil {
+			return fmt.Errorf("encode failed for AcInputStatus-Voltage: %w", err)
+		}
+		if err := stream.writeUnsignedResolution(16, 0.1, rep.Current); err != nil {
+			return fmt.Errorf("encode failed for AcInputStatus-Current: %w", err)
+		}
+		if err := stream.writeUnsignedResolution(16, 0.01, rep.Frequency); err != nil {
+			return fmt.Errorf("encode failed for AcInputStatus-Frequency: %w", err)
+		}
+		if err := stream.writeUnsignedResolution(16, 0.1, rep.BreakerSize); err != nil {
+			return fmt.Errorf("encode failed for AcInputStatus-BreakerSize: %w", err)
+		}
+		if err := stream.writeUInt32(32, rep.RealPower); err != nil {
+			return fmt.Errorf("encode failed for AcInputStatus-RealPower: %w", err)
+		}
+		if err := stream.writeUInt32(32, rep.ReactivePower); err != nil {
+			return fmt.Errorf("encode failed for AcInputStatus-ReactivePower: %w", err)
+		}
+		if err := stream.writeUnsignedResolution(8, 0.01, rep.PowerFactor); err != nil {
+			return fmt.Errorf("encode failed for AcInputStatus-PowerFactor: %w", err)
+		}
+	}
+	return nil
+}
 type AcOutputStatus struct {
 	Info MessageInfo
 	Instance *uint8
@@ -44562,6 +52972,50 @@ func DecodeAcOutputStatus(Info MessageInfo, stream *PGNDataStream) (any, error)
 	}	
 	return val, nil
 }
+func EncodeAcOutputStatus(p any, stream *PGNWriter) error {
+	val, ok := p.(AcOutputStatus)
+	if !ok {
+		return fmt.Errorf("EncodeAcOutputStatus called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Instance); err != nil {
+		return fmt.Errorf("encode failed for AcOutputStatus-Instance: %w", err)
+	}
+	repeat1Count := uint8(len(val.Repeating1))
+	if err := stream.writeUInt8(8, &repeat1Count); err != nil {
+		return fmt.Errorf("encode failed for AcOutputStatus-NumberOfLines: %w", err)
+	}
+	for _, rep := range val.Repeating1 {
+		if err := stream.writeLookupField(2, uint64(rep.Line)); err != nil {
+			return fmt.Errorf("encode failed for AcOutputStatus-Line: %w", err)
+		}
+		if err := stream.writeLookupField(3, uint64(rep.Waveform)); err != nil {
+			return fmt.Errorf("encode failed for AcOutputStatus-Waveform: %w", err)
+		}
+		stream.writeReserved(3)
+		if err := stream.writeUnsignedResolution(16, 0.01, rep.Voltage); err != nil {
+			return fmt.Errorf("encode failed for AcOutputStatus-Voltage: %w", err)
+		}
+		if err := stream.writeUnsignedResolution(16, 0.1, rep.Current); err != nil {
+			return fmt.Errorf("encode failed for AcOutputStatus-Current: %w", err)
+		}
+		if err := stream.writeUnsignedResolution(16, 0.01, rep.Frequency); err != nil {
+			return fmt.Errorf("encode failed for AcOutputStatus-Frequency: %w", err)
+		}
+		if err := stream.writeUnsignedResolution(16, 0.1, rep.BreakerSize); err != nil {
+			return fmt.Errorf("encode failed for AcOutputStatus-BreakerSize: %w", err)
+		}
+		if err := stream.writeUInt32(32, rep.RealPower); err != nil {
+			return fmt.Errorf("encode failed for AcOutputStatus-RealPower: %w", err)
+		}
+		if err := stream.writeUInt32(32, rep.ReactivePower); err != nil {
+			return fmt.Errorf("encode failed for AcOutputStatus-ReactivePower: %w", err)
+		}
+		if err := stream.writeUnsignedResolution(8, 0.01, rep.PowerFactor); err != nil {
+			return fmt.Errorf("encode failed for AcOutputStatus-PowerFactor: %w", err)
+		}
+	}
+	return nil
+}
 type FluidLevel struct {
 	Info MessageInfo
 	Instance *uint8
@@ -44614,6 +53068,26 @@ func DecodeFluidLevel(Info MessageInfo, stream *PGNDataStream) (any, error) {
 		}	
 	return val, nil
 }
+func EncodeFluidLevel(p any, stream *PGNWriter) error {
+	val, ok := p.(FluidLevel)
+	if !ok {
+		return fmt.Errorf("EncodeFluidLevel called with %T", p)
+	}
+	if err := stream.writeUInt8(4, val.Instance); err != nil {
+		return fmt.Errorf("encode failed for FluidLevel-Instance: %w", err)
+	}
+	if err := stream.writeLookupField(4, uint64(val.Type)); err != nil {
+		return fmt.Errorf("encode failed for FluidLevel-Type: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.004, val.Level); err != nil {
+		return fmt.Errorf("encode failed for FluidLevel-Level: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(32, 0.1, nullableUnitValue(val.Capacity, units.Liter, units.Volume.Convert)); err != nil {
+		return fmt.Errorf("encode failed for FluidLevel-Capacity: %w", err)
+	}
+	stream.writeReserved(8)
+	return nil
+}
 type DcDetailedStatus struct {
 	Info MessageInfo
 	Sid *uint8
@@ -44702,6 +53176,37 @@ func DecodeDcDetailedStatus(Info MessageInfo, stream *PGNDataStream) (any, error
 	}	
 	return val, nil
 }
+func EncodeDcDetailedStatus(p any, stream *PGNWriter) error {
+	val, ok := p.(DcDetailedStatus)
+	if !ok {
+		return fmt.Errorf("EncodeDcDetailedStatus called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for DcDetailedStatus-Sid: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Instance); err != nil {
+		return fmt.Errorf("encode failed for DcDetailedStatus-Instance: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.DcType)); err != nil {
+		return fmt.Errorf("encode failed for DcDetailedStatus-DcType: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.StateOfCharge); err != nil {
+		return fmt.Errorf("encode failed for DcDetailedStatus-StateOfCharge: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.StateOfHealth); err != nil {
+		return fmt.Errorf("encode failed for DcDetailedStatus-StateOfHealth: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 60, val.TimeRemaining); err != nil {
+		return fmt.Errorf("encode failed for DcDetailedStatus-TimeRemaining: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.01, val.RippleVoltage); err != nil {
+		return fmt.Errorf("encode failed for DcDetailedStatus-RippleVoltage: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.RemainingCapacity); err != nil {
+		return fmt.Errorf("encode failed for DcDetailedStatus-RemainingCapacity: %w", err)
+	}
+	return nil
+}
 type ChargerStatus struct {
 	Info MessageInfo
 	Instance *uint8
@@ -44784,6 +53289,35 @@ func DecodeChargerStatus(Info MessageInfo, stream *PGNDataStream) (any, error) {
 	}	
 	return val, nil
 }
+func EncodeChargerStatus(p any, stream *PGNWriter) error {
+	val, ok := p.(ChargerStatus)
+	if !ok {
+		return fmt.Errorf("EncodeChargerStatus called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Instance); err != nil {
+		return fmt.Errorf("encode failed for ChargerStatus-Instance: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.BatteryInstance); err != nil {
+		return fmt.Errorf("encode failed for ChargerStatus-BatteryInstance: %w", err)
+	}
+	if err := stream.writeLookupField(4, uint64(val.OperatingState)); err != nil {
+		return fmt.Errorf("encode failed for ChargerStatus-OperatingState: %w", err)
+	}
+	if err := stream.writeLookupField(4, uint64(val.ChargeMode)); err != nil {
+		return fmt.Errorf("encode failed for ChargerStatus-ChargeMode: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Enabled)); err != nil {
+		return fmt.Errorf("encode failed for ChargerStatus-Enabled: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.EqualizationPending)); err != nil {
+		return fmt.Errorf("encode failed for ChargerStatus-EqualizationPending: %w", err)
+	}
+	stream.writeReserved(4)
+	if err := stream.writeUnsignedResolution(16, 60, val.EqualizationTimeRemaining); err != nil {
+		return fmt.Errorf("encode failed for ChargerStatus-EqualizationTimeRemaining: %w", err)
+	}
+	return nil
+}
 type BatteryStatus struct {
 	Info MessageInfo
 	Instance *uint8
@@ -44842,6 +53376,28 @@ func DecodeBatteryStatus(Info MessageInfo, stream *PGNDataStream) (any, error) {
 	}	
 	return val, nil
 }
+func EncodeBatteryStatus(p any, stream *PGNWriter) error {
+	val, ok := p.(BatteryStatus)
+	if !ok {
+		return fmt.Errorf("EncodeBatteryStatus called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Instance); err != nil {
+		return fmt.Errorf("encode failed for BatteryStatus-Instance: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.01, val.Voltage); err != nil {
+		return fmt.Errorf("encode failed for BatteryStatus-Voltage: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.1, val.Current); err != nil {
+		return fmt.Errorf("encode failed for BatteryStatus-Current: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.01, nullableUnitValue(val.Temperature, units.Kelvin, units.Temperature.Convert)); err != nil {
+		return fmt.Errorf("encode failed for BatteryStatus-Temperature: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for BatteryStatus-Sid: %w", err)
+	}
+	return nil
+}
 type InverterStatus struct {
 	Info MessageInfo
 	Instance *uint8
@@ -44904,6 +53460,29 @@ func DecodeInverterStatus(Info MessageInfo, stream *PGNDataStream) (any, error)
 		}	
 	return val, nil
 }
+func EncodeInverterStatus(p any, stream *PGNWriter) error {
+	val, ok := p.(InverterStatus)
+	if !ok {
+		return fmt.Errorf("EncodeInverterStatus called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Instance); err != nil {
+		return fmt.Errorf("encode failed for InverterStatus-Instance: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.AcInstance); err != nil {
+		return fmt.Errorf("encode failed for InverterStatus-AcInstance: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.DcInstance); err != nil {
+		return fmt.Errorf("encode failed for InverterStatus-DcInstance: %w", err)
+	}
+	if err := stream.writeLookupField(4, uint64(val.OperatingState)); err != nil {
+		return fmt.Errorf("encode failed for InverterStatus-OperatingState: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.InverterEnable)); err != nil {
+		return fmt.Errorf("encode failed for InverterStatus-InverterEnable: %w", err)
+	}
+	stream.writeReserved(2)
+	return nil
+}
 type InverterConfigurationStatus struct {
 	Info MessageInfo
 	Instance *uint8
@@ -44996,6 +53575,38 @@ func DecodeInverterConfigurationStatus(Info MessageInfo, stream *PGNDataStream)
 	}	
 	return val, nil
 }
+func EncodeInverterConfigurationStatus(p any, stream *PGNWriter) error {
+	val, ok := p.(InverterConfigurationStatus)
+	if !ok {
+		return fmt.Errorf("EncodeInverterConfigurationStatus called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Instance); err != nil {
+		return fmt.Errorf("encode failed for InverterConfigurationStatus-Instance: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.AcInstance); err != nil {
+		return fmt.Errorf("encode failed for InverterConfigurationStatus-AcInstance: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.DcInstance); err != nil {
+		return fmt.Errorf("encode failed for InverterConfigurationStatus-DcInstance: %w", err)
+	}
+	if err := stream.writeUInt8(2, val.InverterEnableDisable); err != nil {
+		return fmt.Errorf("encode failed for InverterConfigurationStatus-InverterEnableDisable: %w", err)
+	}
+	stream.writeReserved(6)
+	if err := stream.writeUInt8(8, val.InverterMode); err != nil {
+		return fmt.Errorf("encode failed for InverterConfigurationStatus-InverterMode: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.LoadSenseEnableDisable); err != nil {
+		return fmt.Errorf("encode failed for InverterConfigurationStatus-LoadSenseEnableDisable: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.LoadSensePowerThreshold); err != nil {
+		return fmt.Errorf("encode failed for InverterConfigurationStatus-LoadSensePowerThreshold: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.LoadSenseInterval); err != nil {
+		return fmt.Errorf("encode failed for InverterConfigurationStatus-LoadSenseInterval: %w", err)
+	}
+	return nil
+}
 type AgsConfigurationStatus struct {
 	Info MessageInfo
 	Instance *uint8
@@ -45038,6 +53649,23 @@ func DecodeAgsConfigurationStatus(Info MessageInfo, stream *PGNDataStream) (any,
 		}	
 	return val, nil
 }
+func EncodeAgsConfigurationStatus(p any, stream *PGNWriter) error {
+	val, ok := p.(AgsConfigurationStatus)
+	if !ok {
+		return fmt.Errorf("EncodeAgsConfigurationStatus called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Instance); err != nil {
+		return fmt.Errorf("encode failed for AgsConfigurationStatus-Instance: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.GeneratorInstance); err != nil {
+		return fmt.Errorf("encode failed for AgsConfigurationStatus-GeneratorInstance: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.AgsMode); err != nil {
+		return fmt.Errorf("encode failed for AgsConfigurationStatus-AgsMode: %w", err)
+	}
+	stream.writeReserved(40)
+	return nil
+}
 type BatteryConfigurationStatus struct {
 	Info MessageInfo
 	Instance *uint8
@@ -45140,6 +53768,41 @@ func DecodeBatteryConfigurationStatus(Info MessageInfo, stream *PGNDataStream) (
 	}	
 	return val, nil
 }
+func EncodeBatteryConfigurationStatus(p any, stream *PGNWriter) error {
+	val, ok := p.(BatteryConfigurationStatus)
+	if !ok {
+		return fmt.Errorf("EncodeBatteryConfigurationStatus called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Instance); err != nil {
+		return fmt.Errorf("encode failed for BatteryConfigurationStatus-Instance: %w", err)
+	}
+	if err := stream.writeLookupField(4, uint64(val.BatteryType)); err != nil {
+		return fmt.Errorf("encode failed for BatteryConfigurationStatus-BatteryType: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.SupportsEqualization)); err != nil {
+		return fmt.Errorf("encode failed for BatteryConfigurationStatus-SupportsEqualization: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(4, uint64(val.NominalVoltage)); err != nil {
+		return fmt.Errorf("encode failed for BatteryConfigurationStatus-NominalVoltage: %w", err)
+	}
+	if err := stream.writeLookupField(4, uint64(val.Chemistry)); err != nil {
+		return fmt.Errorf("encode failed for BatteryConfigurationStatus-Chemistry: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.Capacity); err != nil {
+		return fmt.Errorf("encode failed for BatteryConfigurationStatus-Capacity: %w", err)
+	}
+	if err := stream.writeInt8(8, val.TemperatureCoefficient); err != nil {
+		return fmt.Errorf("encode failed for BatteryConfigurationStatus-TemperatureCoefficient: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(8, 0.002, val.PeukertExponent); err != nil {
+		return fmt.Errorf("encode failed for BatteryConfigurationStatus-PeukertExponent: %w", err)
+	}
+	if err := stream.writeInt8(8, val.ChargeEfficiencyFactor); err != nil {
+		return fmt.Errorf("encode failed for BatteryConfigurationStatus-ChargeEfficiencyFactor: %w", err)
+	}
+	return nil
+}
 type AgsStatus struct {
 	Info MessageInfo
 	Instance *uint8
@@ -45212,6 +53875,32 @@ func DecodeAgsStatus(Info MessageInfo, stream *PGNDataStream) (any, error) {
 		}	
 	return val, nil
 }
+func EncodeAgsStatus(p any, stream *PGNWriter) error {
+	val, ok := p.(AgsStatus)
+	if !ok {
+		return fmt.Errorf("EncodeAgsStatus called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Instance); err != nil {
+		return fmt.Errorf("encode failed for AgsStatus-Instance: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.GeneratorInstance); err != nil {
+		return fmt.Errorf("encode failed for AgsStatus-GeneratorInstance: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.AgsOperatingState); err != nil {
+		return fmt.Errorf("encode failed for AgsStatus-AgsOperatingState: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.GeneratorState); err != nil {
+		return fmt.Errorf("encode failed for AgsStatus-GeneratorState: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.GeneratorOnReason); err != nil {
+		return fmt.Errorf("encode failed for AgsStatus-GeneratorOnReason: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.GeneratorOffReason); err != nil {
+		return fmt.Errorf("encode failed for AgsStatus-GeneratorOffReason: %w", err)
+	}
+	stream.writeReserved(16)
+	return nil
+}
 type AcPowerCurrentPhaseA struct {
 	Info MessageInfo
 	Sid *uint8
@@ -45260,6 +53949,25 @@ func DecodeAcPowerCurrentPhaseA(Info MessageInfo, stream *PGNDataStream) (any, e
 	}	
 	return val, nil
 }
+func EncodeAcPowerCurrentPhaseA(p any, stream *PGNWriter) error {
+	val, ok := p.(AcPowerCurrentPhaseA)
+	if !ok {
+		return fmt.Errorf("EncodeAcPowerCurrentPhaseA called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for AcPowerCurrentPhaseA-Sid: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.ConnectionNumber); err != nil {
+		return fmt.Errorf("encode failed for AcPowerCurrentPhaseA-ConnectionNumber: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.1, val.AcRmsCurrent); err != nil {
+		return fmt.Errorf("encode failed for AcPowerCurrentPhaseA-AcRmsCurrent: %w", err)
+	}
+	if err := stream.writeInt32(32, val.Power); err != nil {
+		return fmt.Errorf("encode failed for AcPowerCurrentPhaseA-Power: %w", err)
+	}
+	return nil
+}
 type AcPowerCurrentPhaseB struct {
 	Info MessageInfo
 	Sid *uint8
@@ -45308,6 +54016,25 @@ func DecodeAcPowerCurrentPhaseB(Info MessageInfo, stream *PGNDataStream) (any, e
 	}	
 	return val, nil
 }
+func EncodeAcPowerCurrentPhaseB(p any, stream *PGNWriter) error {
+	val, ok := p.(AcPowerCurrentPhaseB)
+	if !ok {
+		return fmt.Errorf("EncodeAcPowerCurrentPhaseB called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for AcPowerCurrentPhaseB-Sid: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.ConnectionNumber); err != nil {
+		return fmt.Errorf("encode failed for AcPowerCurrentPhaseB-ConnectionNumber: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.1, val.AcRmsCurrent); err != nil {
+		return fmt.Errorf("encode failed for AcPowerCurrentPhaseB-AcRmsCurrent: %w", err)
+	}
+	if err := stream.writeInt32(32, val.Power); err != nil {
+		return fmt.Errorf("encode failed for AcPowerCurrentPhaseB-Power: %w", err)
+	}
+	return nil
+}
 type AcPowerCurrentPhaseC struct {
 	Info MessageInfo
 	Sid *uint8
@@ -45356,6 +54083,25 @@ func DecodeAcPowerCurrentPhaseC(Info MessageInfo, stream *PGNDataStream) (any, e
 	}	
 	return val, nil
 }
+func EncodeAcPowerCurrentPhaseC(p any, stream *PGNWriter) error {
+	val, ok := p.(AcPowerCurrentPhaseC)
+	if !ok {
+		return fmt.Errorf("EncodeAcPowerCurrentPhaseC called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for AcPowerCurrentPhaseC-Sid: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.ConnectionNumber); err != nil {
+		return fmt.Errorf("encode failed for AcPowerCurrentPhaseC-ConnectionNumber: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.1, val.AcRmsCurrent); err != nil {
+		return fmt.Errorf("encode failed for AcPowerCurrentPhaseC-AcRmsCurrent: %w", err)
+	}
+	if err := stream.writeInt32(32, val.Power); err != nil {
+		return fmt.Errorf("encode failed for AcPowerCurrentPhaseC-Power: %w", err)
+	}
+	return nil
+}
 type ConverterStatus struct {
 	Info MessageInfo
 	Sid []uint8
@@ -45438,6 +54184,35 @@ func DecodeConverterStatus(Info MessageInfo, stream *PGNDataStream) (any, error)
 		}	
 	return val, nil
 }
+func EncodeConverterStatus(p any, stream *PGNWriter) error {
+	val, ok := p.(ConverterStatus)
+	if !ok {
+		return fmt.Errorf("EncodeConverterStatus called with %T", p)
+	}
+	if err := stream.writeBinaryData(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for ConverterStatus-Sid: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.ConnectionNumber); err != nil {
+		return fmt.Errorf("encode failed for ConverterStatus-ConnectionNumber: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.OperatingState)); err != nil {
+		return fmt.Errorf("encode failed for ConverterStatus-OperatingState: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.TemperatureState)); err != nil {
+		return fmt.Errorf("encode failed for ConverterStatus-TemperatureState: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.OverloadState)); err != nil {
+		return fmt.Errorf("encode failed for ConverterStatus-OverloadState: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.LowDcVoltageState)); err != nil {
+		return fmt.Errorf("encode failed for ConverterStatus-LowDcVoltageState: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.RippleState)); err != nil {
+		return fmt.Errorf("encode failed for ConverterStatus-RippleState: %w", err)
+	}
+	stream.writeReserved(32)
+	return nil
+}
 type DcVoltageCurrent struct {
 	Info MessageInfo
 	Sid []uint8
@@ -45490,6 +54265,26 @@ func DecodeDcVoltageCurrent(Info MessageInfo, stream *PGNDataStream) (any, error
 		}	
 	return val, nil
 }
+func EncodeDcVoltageCurrent(p any, stream *PGNWriter) error {
+	val, ok := p.(DcVoltageCurrent)
+	if !ok {
+		return fmt.Errorf("EncodeDcVoltageCurrent called with %T", p)
+	}
+	if err := stream.writeBinaryData(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for DcVoltageCurrent-Sid: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.ConnectionNumber); err != nil {
+		return fmt.Errorf("encode failed for DcVoltageCurrent-ConnectionNumber: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.1, val.DcVoltage); err != nil {
+		return fmt.Errorf("encode failed for DcVoltageCurrent-DcVoltage: %w", err)
+	}
+	if err := stream.writeSignedResolution(24, 0.01, val.DcCurrent); err != nil {
+		return fmt.Errorf("encode failed for DcVoltageCurrent-DcCurrent: %w", err)
+	}
+	stream.writeReserved(8)
+	return nil
+}
 type LeewayAngle struct {
 	Info MessageInfo
 	Sid *uint8
@@ -45522,6 +54317,20 @@ func DecodeLeewayAngle(Info MessageInfo, stream *PGNDataStream) (any, error) {
 		}	
 	return val, nil
 }
+func EncodeLeewayAngle(p any, stream *PGNWriter) error {
+	val, ok := p.(LeewayAngle)
+	if !ok {
+		return fmt.Errorf("EncodeLeewayAngle called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for LeewayAngle-Sid: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.0001, val.LeewayAngle); err != nil {
+		return fmt.Errorf("encode failed for LeewayAngle-LeewayAngle: %w", err)
+	}
+	stream.writeReserved(40)
+	return nil
+}
 type ThrusterControlStatus struct {
 	Info MessageInfo
 	Sid *uint8
@@ -45620,6 +54429,40 @@ func DecodeThrusterControlStatus(Info MessageInfo, stream *PGNDataStream) (any,
 	}	
 	return val, nil
 }
+func EncodeThrusterControlStatus(p any, stream *PGNWriter) error {
+	val, ok := p.(ThrusterControlStatus)
+	if !ok {
+		return fmt.Errorf("EncodeThrusterControlStatus called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for ThrusterControlStatus-Sid: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Identifier); err != nil {
+		return fmt.Errorf("encode failed for ThrusterControlStatus-Identifier: %w", err)
+	}
+	if err := stream.writeLookupField(4, uint64(val.DirectionControl)); err != nil {
+		return fmt.Errorf("encode failed for ThrusterControlStatus-DirectionControl: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.PowerEnabled)); err != nil {
+		return fmt.Errorf("encode failed for ThrusterControlStatus-PowerEnabled: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.RetractControl)); err != nil {
+		return fmt.Errorf("encode failed for ThrusterControlStatus-RetractControl: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.SpeedControl); err != nil {
+		return fmt.Errorf("encode failed for ThrusterControlStatus-SpeedControl: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.ControlEvents)); err != nil {
+		return fmt.Errorf("encode failed for ThrusterControlStatus-ControlEvents: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(8, 0.005, val.CommandTimeout); err != nil {
+		return fmt.Errorf("encode failed for ThrusterControlStatus-CommandTimeout: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.0001, val.AzimuthControl); err != nil {
+		return fmt.Errorf("encode failed for ThrusterControlStatus-AzimuthControl: %w", err)
+	}
+	return nil
+}
 type ThrusterInformation struct {
 	Info MessageInfo
 	Identifier *uint8
@@ -45682,6 +54525,29 @@ func DecodeThrusterInformation(Info MessageInfo, stream *PGNDataStream) (any, er
 	}	
 	return val, nil
 }
+func EncodeThrusterInformation(p any, stream *PGNWriter) error {
+	val, ok := p.(ThrusterInformation)
+	if !ok {
+		return fmt.Errorf("EncodeThrusterInformation called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Identifier); err != nil {
+		return fmt.Errorf("encode failed for ThrusterInformation-Identifier: %w", err)
+	}
+	if err := stream.writeLookupField(4, uint64(val.MotorType)); err != nil {
+		return fmt.Errorf("encode failed for ThrusterInformation-MotorType: %w", err)
+	}
+	stream.writeReserved(4)
+	if err := stream.writeUInt16(16, val.PowerRating); err != nil {
+		return fmt.Errorf("encode failed for ThrusterInformation-PowerRating: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.01, nullableUnitValue(val.MaximumTemperatureRating, units.Kelvin, units.Temperature.Convert)); err != nil {
+		return fmt.Errorf("encode failed for ThrusterInformation-MaximumTemperatureRating: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.25, val.MaximumRotationalSpeed); err != nil {
+		return fmt.Errorf("encode failed for ThrusterInformation-MaximumRotationalSpeed: %w", err)
+	}
+	return nil
+}
 type ThrusterMotorStatus struct {
 	Info MessageInfo
 	Sid *uint8
@@ -45750,6 +54616,31 @@ func DecodeThrusterMotorStatus(Info MessageInfo, stream *PGNDataStream) (any, er
 	}	
 	return val, nil
 }
+func EncodeThrusterMotorStatus(p any, stream *PGNWriter) error {
+	val, ok := p.(ThrusterMotorStatus)
+	if !ok {
+		return fmt.Errorf("EncodeThrusterMotorStatus called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for ThrusterMotorStatus-Sid: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Identifier); err != nil {
+		return fmt.Errorf("encode failed for ThrusterMotorStatus-Identifier: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.MotorEvents)); err != nil {
+		return fmt.Errorf("encode failed for ThrusterMotorStatus-MotorEvents: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Current); err != nil {
+		return fmt.Errorf("encode failed for ThrusterMotorStatus-Current: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.01, nullableUnitValue(val.Temperature, units.Kelvin, units.Temperature.Convert)); err != nil {
+		return fmt.Errorf("encode failed for ThrusterMotorStatus-Temperature: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 60, val.OperatingTime); err != nil {
+		return fmt.Errorf("encode failed for ThrusterMotorStatus-OperatingTime: %w", err)
+	}
+	return nil
+}
 type Speed struct {
 	Info MessageInfo
 	Sid *uint8
@@ -45812,6 +54703,29 @@ func DecodeSpeed(Info MessageInfo, stream *PGNDataStream) (any, error) {
 		}	
 	return val, nil
 }
+func EncodeSpeed(p any, stream *PGNWriter) error {
+	val, ok := p.(Speed)
+	if !ok {
+		return fmt.Errorf("EncodeSpeed called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for Speed-Sid: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.01, nullableUnitValue(val.SpeedWaterReferenced, units.MetersPerSecond, units.Velocity.Convert)); err != nil {
+		return fmt.Errorf("encode failed for Speed-SpeedWaterReferenced: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.01, nullableUnitValue(val.SpeedGroundReferenced, units.MetersPerSecond, units.Velocity.Convert)); err != nil {
+		return fmt.Errorf("encode failed for Speed-SpeedGroundReferenced: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.SpeedWaterReferencedType)); err != nil {
+		return fmt.Errorf("encode failed for Speed-SpeedWaterReferencedType: %w", err)
+	}
+	if err := stream.writeUInt8(4, val.SpeedDirection); err != nil {
+		return fmt.Errorf("encode failed for Speed-SpeedDirection: %w", err)
+	}
+	stream.writeReserved(12)
+	return nil
+}
 type WaterDepth struct {
 	Info MessageInfo
 	Sid *uint8
@@ -45860,6 +54774,25 @@ func DecodeWaterDepth(Info MessageInfo, stream *PGNDataStream) (any, error) {
 	}	
 	return val, nil
 }
+func EncodeWaterDepth(p any, stream *PGNWriter) error {
+	val, ok := p.(WaterDepth)
+	if !ok {
+		return fmt.Errorf("EncodeWaterDepth called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for WaterDepth-Sid: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(32, 0.01, nullableUnitValue(val.Depth, units.Meter, units.Distance.Convert)); err != nil {
+		return fmt.Errorf("encode failed for WaterDepth-Depth: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.001, nullableUnitValue(val.Offset, units.Meter, units.Distance.Convert)); err != nil {
+		return fmt.Errorf("encode failed for WaterDepth-Offset: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(8, 10, nullableUnitValue(val.Range, units.Meter, units.Distance.Convert)); err != nil {
+		return fmt.Errorf("encode failed for WaterDepth-Range: %w", err)
+	}
+	return nil
+}
 type DistanceLog struct {
 	Info MessageInfo
 	Date *uint16
@@ -45908,6 +54841,25 @@ func DecodeDistanceLog(Info MessageInfo, stream *PGNDataStream) (any, error) {
 	}	
 	return val, nil
 }
+func EncodeDistanceLog(p any, stream *PGNWriter) error {
+	val, ok := p.(DistanceLog)
+	if !ok {
+		return fmt.Errorf("EncodeDistanceLog called with %T", p)
+	}
+	if err := stream.writeUInt16(16, val.Date); err != nil {
+		return fmt.Errorf("encode failed for DistanceLog-Date: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(32, 0.0001, val.Time); err != nil {
+		return fmt.Errorf("encode failed for DistanceLog-Time: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(32, 1, nullableUnitValue(val.Log, units.Meter, units.Distance.Convert)); err != nil {
+		return fmt.Errorf("encode failed for DistanceLog-Log: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(32, 1, nullableUnitValue(val.TripLog, units.Meter, units.Distance.Convert)); err != nil {
+		return fmt.Errorf("encode failed for DistanceLog-TripLog: %w", err)
+	}
+	return nil
+}
 type TrackedTargetData struct {
 	Info MessageInfo
 	Sid *uint8
@@ -46060,6 +55012,56 @@ func DecodeTrackedTargetData(Info MessageInfo, stream *PGNDataStream) (any, erro
 	}	
 	return val, nil
 }
+func EncodeTrackedTargetData(p any, stream *PGNWriter) error {
+	val, ok := p.(TrackedTargetData)
+	if !ok {
+		return fmt.Errorf("EncodeTrackedTargetData called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for TrackedTargetData-Sid: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.TargetId); err != nil {
+		return fmt.Errorf("encode failed for TrackedTargetData-TargetId: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.TrackStatus)); err != nil {
+		return fmt.Errorf("encode failed for TrackedTargetData-TrackStatus: %w", err)
+	}
+	if err := stream.writeLookupField(1, uint64(val.ReportedTarget)); err != nil {
+		return fmt.Errorf("encode failed for TrackedTargetData-ReportedTarget: %w", err)
+	}
+	if err := stream.writeLookupField(1, uint64(val.TargetAcquisition)); err != nil {
+		return fmt.Errorf("encode failed for TrackedTargetData-TargetAcquisition: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.BearingReference)); err != nil {
+		return fmt.Errorf("encode failed for TrackedTargetData-BearingReference: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeUnsignedResolution(16, 0.0001, val.Bearing); err != nil {
+		return fmt.Errorf("encode failed for TrackedTargetData-Bearing: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(32, 0.001, nullableUnitValue(val.Distance, units.Meter, units.Distance.Convert)); err != nil {
+		return fmt.Errorf("encode failed for TrackedTargetData-Distance: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.0001, val.Course); err != nil {
+		return fmt.Errorf("encode failed for TrackedTargetData-Course: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.01, nullableUnitValue(val.Speed, units.MetersPerSecond, units.Velocity.Convert)); err != nil {
+		return fmt.Errorf("encode failed for TrackedTargetData-Speed: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(32, 0.01, nullableUnitValue(val.Cpa, units.Meter, units.Distance.Convert)); err != nil {
+		return fmt.Errorf("encode failed for TrackedTargetData-Cpa: %w", err)
+	}
+	if err := stream.writeSignedResolution(32, 0.001, val.Tcpa); err != nil {
+		return fmt.Errorf("encode failed for TrackedTargetData-Tcpa: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(32, 0.0001, val.UtcOfFix); err != nil {
+		return fmt.Errorf("encode failed for TrackedTargetData-UtcOfFix: %w", err)
+	}
+	if err := stream.writeFixedString(1784, val.Name); err != nil {
+		return fmt.Errorf("encode failed for TrackedTargetData-Name: %w", err)
+	}
+	return nil
+}
 type WindlassControlStatus struct {
 	Info MessageInfo
 	Sid *uint8
@@ -46196,6 +55198,51 @@ func DecodeWindlassControlStatus(Info MessageInfo, stream *PGNDataStream) (any,
 		}	
 	return val, nil
 }
+func EncodeWindlassControlStatus(p any, stream *PGNWriter) error {
+	val, ok := p.(WindlassControlStatus)
+	if !ok {
+		return fmt.Errorf("EncodeWindlassControlStatus called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for WindlassControlStatus-Sid: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.WindlassId); err != nil {
+		return fmt.Errorf("encode failed for WindlassControlStatus-WindlassId: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.WindlassDirectionControl)); err != nil {
+		return fmt.Errorf("encode failed for WindlassControlStatus-WindlassDirectionControl: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.AnchorDockingControl)); err != nil {
+		return fmt.Errorf("encode failed for WindlassControlStatus-AnchorDockingControl: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.SpeedControlType)); err != nil {
+		return fmt.Errorf("encode failed for WindlassControlStatus-SpeedControlType: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeBinaryData(8, val.SpeedControl); err != nil {
+		return fmt.Errorf("encode failed for WindlassControlStatus-SpeedControl: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.PowerEnable)); err != nil {
+		return fmt.Errorf("encode failed for WindlassControlStatus-PowerEnable: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.MechanicalLock)); err != nil {
+		return fmt.Errorf("encode failed for WindlassControlStatus-MechanicalLock: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.DeckAndAnchorWash)); err != nil {
+		return fmt.Errorf("encode failed for WindlassControlStatus-DeckAndAnchorWash: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.AnchorLight)); err != nil {
+		return fmt.Errorf("encode failed for WindlassControlStatus-AnchorLight: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(8, 0.005, val.CommandTimeout); err != nil {
+		return fmt.Errorf("encode failed for WindlassControlStatus-CommandTimeout: %w", err)
+	}
+	if err := stream.writeLookupField(4, uint64(val.WindlassControlEvents)); err != nil {
+		return fmt.Errorf("encode failed for WindlassControlStatus-WindlassControlEvents: %w", err)
+	}
+	stream.writeReserved(12)
+	return nil
+}
 type AnchorWindlassOperatingStatus struct {
 	Info MessageInfo
 	Sid *uint8
@@ -46298,6 +55345,41 @@ func DecodeAnchorWindlassOperatingStatus(Info MessageInfo, stream *PGNDataStream
 	}	
 	return val, nil
 }
+func EncodeAnchorWindlassOperatingStatus(p any, stream *PGNWriter) error {
+	val, ok := p.(AnchorWindlassOperatingStatus)
+	if !ok {
+		return fmt.Errorf("EncodeAnchorWindlassOperatingStatus called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for AnchorWindlassOperatingStatus-Sid: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.WindlassId); err != nil {
+		return fmt.Errorf("encode failed for AnchorWindlassOperatingStatus-WindlassId: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.WindlassDirectionControl)); err != nil {
+		return fmt.Errorf("encode failed for AnchorWindlassOperatingStatus-WindlassDirectionControl: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.WindlassMotionStatus)); err != nil {
+		return fmt.Errorf("encode failed for AnchorWindlassOperatingStatus-WindlassMotionStatus: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.RodeTypeStatus)); err != nil {
+		return fmt.Errorf("encode failed for AnchorWindlassOperatingStatus-RodeTypeStatus: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeUnsignedResolution(16, 0.1, nullableUnitValue(val.RodeCounterValue, units.Meter, units.Distance.Convert)); err != nil {
+		return fmt.Errorf("encode failed for AnchorWindlassOperatingStatus-RodeCounterValue: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.01, nullableUnitValue(val.WindlassLineSpeed, units.MetersPerSecond, units.Velocity.Convert)); err != nil {
+		return fmt.Errorf("encode failed for AnchorWindlassOperatingStatus-WindlassLineSpeed: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.AnchorDockingStatus)); err != nil {
+		return fmt.Errorf("encode failed for AnchorWindlassOperatingStatus-AnchorDockingStatus: %w", err)
+	}
+	if err := stream.writeLookupField(6, uint64(val.WindlassOperatingEvents)); err != nil {
+		return fmt.Errorf("encode failed for AnchorWindlassOperatingStatus-WindlassOperatingEvents: %w", err)
+	}
+	return nil
+}
 type AnchorWindlassMonitoringStatus struct {
 	Info MessageInfo
 	Sid *uint8
@@ -46370,6 +55452,32 @@ func DecodeAnchorWindlassMonitoringStatus(Info MessageInfo, stream *PGNDataStrea
 		}	
 	return val, nil
 }
+func EncodeAnchorWindlassMonitoringStatus(p any, stream *PGNWriter) error {
+	val, ok := p.(AnchorWindlassMonitoringStatus)
+	if !ok {
+		return fmt.Errorf("EncodeAnchorWindlassMonitoringStatus called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for AnchorWindlassMonitoringStatus-Sid: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.WindlassId); err != nil {
+		return fmt.Errorf("encode failed for AnchorWindlassMonitoringStatus-WindlassId: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.WindlassMonitoringEvents)); err != nil {
+		return fmt.Errorf("encode failed for AnchorWindlassMonitoringStatus-WindlassMonitoringEvents: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(8, 0.2, val.ControllerVoltage); err != nil {
+		return fmt.Errorf("encode failed for AnchorWindlassMonitoringStatus-ControllerVoltage: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.MotorCurrent); err != nil {
+		return fmt.Errorf("encode failed for AnchorWindlassMonitoringStatus-MotorCurrent: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 60, val.TotalMotorTime); err != nil {
+		return fmt.Errorf("encode failed for AnchorWindlassMonitoringStatus-TotalMotorTime: %w", err)
+	}
+	stream.writeReserved(8)
+	return nil
+}
 type PositionRapidUpdate struct {
 	Info MessageInfo
 	Latitude *float64
@@ -46398,6 +55506,19 @@ func DecodePositionRapidUpdate(Info MessageInfo, stream *PGNDataStream) (any, er
 	}	
 	return val, nil
 }
+func EncodePositionRapidUpdate(p any, stream *PGNWriter) error {
+	val, ok := p.(PositionRapidUpdate)
+	if !ok {
+		return fmt.Errorf("EncodePositionRapidUpdate called with %T", p)
+	}
+	if err := stream.writeSignedResolution64Override(32, 1e-07, val.Latitude); err != nil {
+		return fmt.Errorf("encode failed for PositionRapidUpdate-Latitude: %w", err)
+	}
+	if err := stream.writeSignedResolution64Override(32, 1e-07, val.Longitude); err != nil {
+		return fmt.Errorf("encode failed for PositionRapidUpdate-Longitude: %w", err)
+	}
+	return nil
+}
 type CogSogRapidUpdate struct {
 	Info MessageInfo
 	Sid *uint8
@@ -46454,6 +55575,27 @@ func DecodeCogSogRapidUpdate(Info MessageInfo, stream *PGNDataStream) (any, erro
 		}	
 	return val, nil
 }
+func EncodeCogSogRapidUpdate(p any, stream *PGNWriter) error {
+	val, ok := p.(CogSogRapidUpdate)
+	if !ok {
+		return fmt.Errorf("EncodeCogSogRapidUpdate called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for CogSogRapidUpdate-Sid: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.CogReference)); err != nil {
+		return fmt.Errorf("encode failed for CogSogRapidUpdate-CogReference: %w", err)
+	}
+	stream.writeReserved(6)
+	if err := stream.writeUnsignedResolution(16, 0.0001, val.Cog); err != nil {
+		return fmt.Errorf("encode failed for CogSogRapidUpdate-Cog: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.01, nullableUnitValue(val.Sog, units.MetersPerSecond, units.Velocity.Convert)); err != nil {
+		return fmt.Errorf("encode failed for CogSogRapidUpdate-Sog: %w", err)
+	}
+	stream.writeReserved(16)
+	return nil
+}
 type PositionDeltaRapidUpdate struct {
 	Info MessageInfo
 	Sid *uint8
@@ -46506,6 +55648,26 @@ func DecodePositionDeltaRapidUpdate(Info MessageInfo, stream *PGNDataStream) (an
 		}	
 	return val, nil
 }
+func EncodePositionDeltaRapidUpdate(p any, stream *PGNWriter) error {
+	val, ok := p.(PositionDeltaRapidUpdate)
+	if !ok {
+		return fmt.Errorf("EncodePositionDeltaRapidUpdate called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for PositionDeltaRapidUpdate-Sid: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.TimeDelta); err != nil {
+		return fmt.Errorf("encode failed for PositionDeltaRapidUpdate-TimeDelta: %w", err)
+	}
+	if err := stream.writeInt16(16, val.LatitudeDelta); err != nil {
+		return fmt.Errorf("encode failed for PositionDeltaRapidUpdate-LatitudeDelta: %w", err)
+	}
+	if err := stream.writeInt16(16, val.LongitudeDelta); err != nil {
+		return fmt.Errorf("encode failed for PositionDeltaRapidUpdate-LongitudeDelta: %w", err)
+	}
+	stream.writeReserved(8)
+	return nil
+}
 type AltitudeDeltaRapidUpdate struct {
 	Info MessageInfo
 	Sid *uint8
@@ -46578,6 +55740,32 @@ func DecodeAltitudeDeltaRapidUpdate(Info MessageInfo, stream *PGNDataStream) (an
 	}	
 	return val, nil
 }
+func EncodeAltitudeDeltaRapidUpdate(p any, stream *PGNWriter) error {
+	val, ok := p.(AltitudeDeltaRapidUpdate)
+	if !ok {
+		return fmt.Errorf("EncodeAltitudeDeltaRapidUpdate called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for AltitudeDeltaRapidUpdate-Sid: %w", err)
+	}
+	if err := stream.writeInt16(16, val.TimeDelta); err != nil {
+		return fmt.Errorf("encode failed for AltitudeDeltaRapidUpdate-TimeDelta: %w", err)
+	}
+	if err := stream.writeUInt8(2, val.GnssQuality); err != nil {
+		return fmt.Errorf("encode failed for AltitudeDeltaRapidUpdate-GnssQuality: %w", err)
+	}
+	if err := stream.writeUInt8(2, val.Direction); err != nil {
+		return fmt.Errorf("encode failed for AltitudeDeltaRapidUpdate-Direction: %w", err)
+	}
+	stream.writeReserved(4)
+	if err := stream.writeUnsignedResolution(16, 0.0001, val.Cog); err != nil {
+		return fmt.Errorf("encode failed for AltitudeDeltaRapidUpdate-Cog: %w", err)
+	}
+	if err := stream.writeInt16(16, val.AltitudeDelta); err != nil {
+		return fmt.Errorf("encode failed for AltitudeDeltaRapidUpdate-AltitudeDelta: %w", err)
+	}
+	return nil
+}
 type GnssPositionData struct {
 	Info MessageInfo
 	Sid *uint8
@@ -46774,6 +55962,68 @@ func DecodeGnssPositionData(Info MessageInfo, stream *PGNDataStream) (any, error
 	}	
 	return val, nil
 }
+func EncodeGnssPositionData(p any, stream *PGNWriter) error {
+	val, ok := p.(GnssPositionData)
+	if !ok {
+		return fmt.Errorf("EncodeGnssPositionData called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for GnssPositionData-Sid: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.Date); err != nil {
+		return fmt.Errorf("encode failed for GnssPositionData-Date: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(32, 0.0001, val.Time); err != nil {
+		return fmt.Errorf("encode failed for GnssPositionData-Time: %w", err)
+	}
+	if err := stream.writeSignedResolution64Override(64, 1e-16, val.Latitude); err != nil {
+		return fmt.Errorf("encode failed for GnssPositionData-Latitude: %w", err)
+	}
+	if err := stream.writeSignedResolution64Override(64, 1e-16, val.Longitude); err != nil {
+		return fmt.Errorf("encode failed for GnssPositionData-Longitude: %w", err)
+	}
+	if err := stream.writeSignedResolution(64, 1e-06, nullableUnitValue(val.Altitude, units.Meter, units.Distance.Convert)); err != nil {
+		return fmt.Errorf("encode failed for GnssPositionData-Altitude: %w", err)
+	}
+	if err := stream.writeLookupField(4, uint64(val.GnssType)); err != nil {
+		return fmt.Errorf("encode failed for GnssPositionData-GnssType: %w", err)
+	}
+	if err := stream.writeLookupField(4, uint64(val.Method)); err != nil {
+		return fmt.Errorf("encode failed for GnssPositionData-Method: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Integrity)); err != nil {
+		return fmt.Errorf("encode failed for GnssPositionData-Integrity: %w", err)
+	}
+	stream.writeReserved(6)
+	if err := stream.writeUInt8(8, val.NumberOfSvs); err != nil {
+		return fmt.Errorf("encode failed for GnssPositionData-NumberOfSvs: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.01, val.Hdop); err != nil {
+		return fmt.Errorf("encode failed for GnssPositionData-Hdop: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.01, val.Pdop); err != nil {
+		return fmt.Errorf("encode failed for GnssPositionData-Pdop: %w", err)
+	}
+	if err := stream.writeSignedResolution(32, 0.01, nullableUnitValue(val.GeoidalSeparation, units.Meter, units.Distance.Convert)); err != nil {
+		return fmt.Errorf("encode failed for GnssPositionData-GeoidalSeparation: %w", err)
+	}
+	repeat1Count := uint8(len(val.Repeating1))
+	if err := stream.writeUInt8(8, &repeat1Count); err != nil {
+		return fmt.Errorf("encode failed for GnssPositionData-ReferenceStations: %w", err)
+	}
+	for _, rep := range val.Repeating1 {
+		if err := stream.writeLookupField(4, uint64(rep.ReferenceStationType)); err != nil {
+			return fmt.Errorf("encode failed for GnssPositionData-ReferenceStationType: %w", err)
+		}
+		if err := stream.writeUInt16(12, rep.ReferenceStationId); err != nil {
+			return fmt.Errorf("encode failed for GnssPositionData-ReferenceStationId: %w", err)
+		}
+		if err := stream.writeUnsignedResolution(16, 0.01, rep.AgeOfDgnssCorrections); err != nil {
+			return fmt.Errorf("encode failed for GnssPositionData-AgeOfDgnssCorrections: %w", err)
+		}
+	}
+	return nil
+}
 type TimeDate struct {
 	Info MessageInfo
 	Date *uint16
@@ -46812,6 +56062,22 @@ func DecodeTimeDate(Info MessageInfo, stream *PGNDataStream) (any, error) {
 	}	
 	return val, nil
 }
+func EncodeTimeDate(p any, stream *PGNWriter) error {
+	val, ok := p.(TimeDate)
+	if !ok {
+		return fmt.Errorf("EncodeTimeDate called with %T", p)
+	}
+	if err := stream.writeUInt16(16, val.Date); err != nil {
+		return fmt.Errorf("encode failed for TimeDate-Date: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(32, 0.0001, val.Time); err != nil {
+		return fmt.Errorf("encode failed for TimeDate-Time: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 60, val.LocalOffset); err != nil {
+		return fmt.Errorf("encode failed for TimeDate-LocalOffset: %w", err)
+	}
+	return nil
+}
 type AisClassAPositionReport struct {
 	Info MessageInfo
 	MessageId AisMessageIdConst
@@ -47002,6 +56268,67 @@ func DecodeAisClassAPositionReport(Info MessageInfo, stream *PGNDataStream) (any
 	}	
 	return val, nil
 }
+func EncodeAisClassAPositionReport(p any, stream *PGNWriter) error {
+	val, ok := p.(AisClassAPositionReport)
+	if !ok {
+		return fmt.Errorf("EncodeAisClassAPositionReport called with %T", p)
+	}
+	if err := stream.writeLookupField(6, uint64(val.MessageId)); err != nil {
+		return fmt.Errorf("encode failed for AisClassAPositionReport-MessageId: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.RepeatIndicator)); err != nil {
+		return fmt.Errorf("encode failed for AisClassAPositionReport-RepeatIndicator: %w", err)
+	}
+	if err := stream.writeUInt32(32, val.UserId); err != nil {
+		return fmt.Errorf("encode failed for AisClassAPositionReport-UserId: %w", err)
+	}
+	if err := stream.writeSignedResolution64Override(32, 1e-07, val.Longitude); err != nil {
+		return fmt.Errorf("encode failed for AisClassAPositionReport-Longitude: %w", err)
+	}
+	if err := stream.writeSignedResolution64Override(32, 1e-07, val.Latitude); err != nil {
+		return fmt.Errorf("encode failed for AisClassAPositionReport-Latitude: %w", err)
+	}
+	if err := stream.writeLookupField(1, uint64(val.PositionAccuracy)); err != nil {
+		return fmt.Errorf("encode failed for AisClassAPositionReport-PositionAccuracy: %w", err)
+	}
+	if err := stream.writeLookupField(1, uint64(val.Raim)); err != nil {
+		return fmt.Errorf("encode failed for AisClassAPositionReport-Raim: %w", err)
+	}
+	if err := stream.writeLookupField(6, uint64(val.TimeStamp)); err != nil {
+		return fmt.Errorf("encode failed for AisClassAPositionReport-TimeStamp: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.0001, val.Cog); err != nil {
+		return fmt.Errorf("encode failed for AisClassAPositionReport-Cog: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.01, nullableUnitValue(val.Sog, units.MetersPerSecond, units.Velocity.Convert)); err != nil {
+		return fmt.Errorf("encode failed for AisClassAPositionReport-Sog: %w", err)
+	}
+	if err := stream.writeBinaryData(19, val.CommunicationState); err != nil {
+		return fmt.Errorf("encode failed for AisClassAPositionReport-CommunicationState: %w", err)
+	}
+	if err := stream.writeLookupField(5, uint64(val.AisTransceiverInformation)); err != nil {
+		return fmt.Errorf("encode failed for AisClassAPositionReport-AisTransceiverInformation: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.0001, val.Heading); err != nil {
+		return fmt.Errorf("encode failed for AisClassAPositionReport-Heading: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 3.125e-05, val.RateOfTurn); err != nil {
+		return fmt.Errorf("encode failed for AisClassAPositionReport-RateOfTurn: %w", err)
+	}
+	if err := stream.writeLookupField(4, uint64(val.NavStatus)); err != nil {
+		return fmt.Errorf("encode failed for AisClassAPositionReport-NavStatus: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.SpecialManeuverIndicator)); err != nil {
+		return fmt.Errorf("encode failed for AisClassAPositionReport-SpecialManeuverIndicator: %w", err)
+	}
+	stream.writeReserved(2)
+	stream.writeReserved(3)
+	stream.writeReserved(5)
+	if err := stream.writeUInt8(8, val.SequenceId); err != nil {
+		return fmt.Errorf("encode failed for AisClassAPositionReport-SequenceId: %w", err)
+	}
+	return nil
+}
 type AisClassBPositionReport struct {
 	Info MessageInfo
 	MessageId AisMessageIdConst
@@ -47222,6 +56549,76 @@ func DecodeAisClassBPositionReport(Info MessageInfo, stream *PGNDataStream) (any
 		}	
 	return val, nil
 }
+func EncodeAisClassBPositionReport(p any, stream *PGNWriter) error {
+	val, ok := p.(AisClassBPositionReport)
+	if !ok {
+		return fmt.Errorf("EncodeAisClassBPositionReport called with %T", p)
+	}
+	if err := stream.writeLookupField(6, uint64(val.MessageId)); err != nil {
+		return fmt.Errorf("encode failed for AisClassBPositionReport-MessageId: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.RepeatIndicator)); err != nil {
+		return fmt.Errorf("encode failed for AisClassBPositionReport-RepeatIndicator: %w", err)
+	}
+	if err := stream.writeUInt32(32, val.UserId); err != nil {
+		return fmt.Errorf("encode failed for AisClassBPositionReport-UserId: %w", err)
+	}
+	if err := stream.writeSignedResolution64Override(32, 1e-07, val.Longitude); err != nil {
+		return fmt.Errorf("encode failed for AisClassBPositionReport-Longitude: %w", err)
+	}
+	if err := stream.writeSignedResolution64Override(32, 1e-07, val.Latitude); err != nil {
+		return fmt.Errorf("encode failed for AisClassBPositionReport-Latitude: %w", err)
+	}
+	if err := stream.writeLookupField(1, uint64(val.PositionAccuracy)); err != nil {
+		return fmt.Errorf("encode failed for AisClassBPositionReport-PositionAccuracy: %w", err)
+	}
+	if err := stream.writeLookupField(1, uint64(val.Raim)); err != nil {
+		return fmt.Errorf("encode failed for AisClassBPositionReport-Raim: %w", err)
+	}
+	if err := stream.writeLookupField(6, uint64(val.TimeStamp)); err != nil {
+		return fmt.Errorf("encode failed for AisClassBPositionReport-TimeStamp: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.0001, val.Cog); err != nil {
+		return fmt.Errorf("encode failed for AisClassBPositionReport-Cog: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.01, nullableUnitValue(val.Sog, units.MetersPerSecond, units.Velocity.Convert)); err != nil {
+		return fmt.Errorf("encode failed for AisClassBPositionReport-Sog: %w", err)
+	}
+	if err := stream.writeBinaryData(19, val.CommunicationState); err != nil {
+		return fmt.Errorf("encode failed for AisClassBPositionReport-CommunicationState: %w", err)
+	}
+	if err := stream.writeLookupField(5, uint64(val.AisTransceiverInformation)); err != nil {
+		return fmt.Errorf("encode failed for AisClassBPositionReport-AisTransceiverInformation: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.0001, val.Heading); err != nil {
+		return fmt.Errorf("encode failed for AisClassBPositionReport-Heading: %w", err)
+	}
+	stream.writeReserved(8)
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(1, uint64(val.UnitType)); err != nil {
+		return fmt.Errorf("encode failed for AisClassBPositionReport-UnitType: %w", err)
+	}
+	if err := stream.writeLookupField(1, uint64(val.IntegratedDisplay)); err != nil {
+		return fmt.Errorf("encode failed for AisClassBPositionReport-IntegratedDisplay: %w", err)
+	}
+	if err := stream.writeLookupField(1, uint64(val.Dsc)); err != nil {
+		return fmt.Errorf("encode failed for AisClassBPositionReport-Dsc: %w", err)
+	}
+	if err := stream.writeLookupField(1, uint64(val.Band)); err != nil {
+		return fmt.Errorf("encode failed for AisClassBPositionReport-Band: %w", err)
+	}
+	if err := stream.writeLookupField(1, uint64(val.CanHandleMsg22)); err != nil {
+		return fmt.Errorf("encode failed for AisClassBPositionReport-CanHandleMsg22: %w", err)
+	}
+	if err := stream.writeLookupField(1, uint64(val.AisMode)); err != nil {
+		return fmt.Errorf("encode failed for AisClassBPositionReport-AisMode: %w", err)
+	}
+	if err := stream.writeLookupField(1, uint64(val.AisCommunicationState)); err != nil {
+		return fmt.Errorf("encode failed for AisClassBPositionReport-AisCommunicationState: %w", err)
+	}
+	stream.writeReserved(15)
+	return nil
+}
 type AisClassBExtendedPositionReport struct {
 	Info MessageInfo
 	MessageId AisMessageIdConst
@@ -47464,6 +56861,82 @@ func DecodeAisClassBExtendedPositionReport(Info MessageInfo, stream *PGNDataStre
 		}	
 	return val, nil
 }
+func EncodeAisClassBExtendedPositionReport(p any, stream *PGNWriter) error {
+	val, ok := p.(AisClassBExtendedPositionReport)
+	if !ok {
+		return fmt.Errorf("EncodeAisClassBExtendedPositionReport called with %T", p)
+	}
+	if err := stream.writeLookupField(6, uint64(val.MessageId)); err != nil {
+		return fmt.Errorf("encode failed for AisClassBExtendedPositionReport-MessageId: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.RepeatIndicator)); err != nil {
+		return fmt.Errorf("encode failed for AisClassBExtendedPositionReport-RepeatIndicator: %w", err)
+	}
+	if err := stream.writeUInt32(32, val.UserId); err != nil {
+		return fmt.Errorf("encode failed for AisClassBExtendedPositionReport-UserId: %w", err)
+	}
+	if err := stream.writeSignedResolution64Override(32, 1e-07, val.Longitude); err != nil {
+		return fmt.Errorf("encode failed for AisClassBExtendedPositionReport-Longitude: %w", err)
+	}
+	if err := stream.writeSignedResolution64Override(32, 1e-07, val.Latitude); err != nil {
+		return fmt.Errorf("encode failed for AisClassBExtendedPositionReport-Latitude: %w", err)
+	}
+	if err := stream.writeLookupField(1, uint64(val.PositionAccuracy)); err != nil {
+		return fmt.Errorf("encode failed for AisClassBExtendedPositionReport-PositionAccuracy: %w", err)
+	}
+	if err := stream.writeLookupField(1, uint64(val.Raim)); err != nil {
+		return fmt.Errorf("encode failed for AisClassBExtendedPositionReport-Raim: %w", err)
+	}
+	if err := stream.writeLookupField(6, uint64(val.TimeStamp)); err != nil {
+		return fmt.Errorf("encode failed for AisClassBExtendedPositionReport-TimeStamp: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.0001, val.Cog); err != nil {
+		return fmt.Errorf("encode failed for AisClassBExtendedPositionReport-Cog: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.01, nullableUnitValue(val.Sog, units.MetersPerSecond, units.Velocity.Convert)); err != nil {
+		return fmt.Errorf("encode failed for AisClassBExtendedPositionReport-Sog: %w", err)
+	}
+	stream.writeReserved(8)
+	stream.writeReserved(4)
+	stream.writeReserved(4)
+	if err := stream.writeLookupField(8, uint64(val.TypeOfShip)); err != nil {
+		return fmt.Errorf("encode failed for AisClassBExtendedPositionReport-TypeOfShip: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.0001, val.TrueHeading); err != nil {
+		return fmt.Errorf("encode failed for AisClassBExtendedPositionReport-TrueHeading: %w", err)
+	}
+	stream.writeReserved(4)
+	if err := stream.writeLookupField(4, uint64(val.GnssType)); err != nil {
+		return fmt.Errorf("encode failed for AisClassBExtendedPositionReport-GnssType: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.1, nullableUnitValue(val.Length, units.Meter, units.Distance.Convert)); err != nil {
+		return fmt.Errorf("encode failed for AisClassBExtendedPositionReport-Length: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.1, nullableUnitValue(val.Beam, units.Meter, units.Distance.Convert)); err != nil {
+		return fmt.Errorf("encode failed for AisClassBExtendedPositionReport-Beam: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.1, nullableUnitValue(val.PositionReferenceFromStarboard, units.Meter, units.Distance.Convert)); err != nil {
+		return fmt.Errorf("encode failed for AisClassBExtendedPositionReport-PositionReferenceFromStarboard: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.1, nullableUnitValue(val.PositionReferenceFromBow, units.Meter, units.Distance.Convert)); err != nil {
+		return fmt.Errorf("encode failed for AisClassBExtendedPositionReport-PositionReferenceFromBow: %w", err)
+	}
+	if err := stream.writeFixedString(160, val.Name); err != nil {
+		return fmt.Errorf("encode failed for AisClassBExtendedPositionReport-Name: %w", err)
+	}
+	if err := stream.writeLookupField(1, uint64(val.Dte)); err != nil {
+		return fmt.Errorf("encode failed for AisClassBExtendedPositionReport-Dte: %w", err)
+	}
+	if err := stream.writeLookupField(1, uint64(val.AisMode)); err != nil {
+		return fmt.Errorf("encode failed for AisClassBExtendedPositionReport-AisMode: %w", err)
+	}
+	stream.writeReserved(4)
+	if err := stream.writeLookupField(5, uint64(val.AisTransceiverInformation)); err != nil {
+		return fmt.Errorf("encode failed for AisClassBExtendedPositionReport-AisTransceiverInformation: %w", err)
+	}
+	stream.writeReserved(5)
+	return nil
+}
 type AisAidsToNavigationAtonReport struct {
 	Info MessageInfo
 	MessageId AisMessageIdConst
@@ -47684,6 +57157,76 @@ func DecodeAisAidsToNavigationAtonReport(Info MessageInfo, stream *PGNDataStream
 	}	
 	return val, nil
 }
+func EncodeAisAidsToNavigationAtonReport(p any, stream *PGNWriter) error {
+	val, ok := p.(AisAidsToNavigationAtonReport)
+	if !ok {
+		return fmt.Errorf("EncodeAisAidsToNavigationAtonReport called with %T", p)
+	}
+	if err := stream.writeLookupField(6, uint64(val.MessageId)); err != nil {
+		return fmt.Errorf("encode failed for AisAidsToNavigationAtonReport-MessageId: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.RepeatIndicator)); err != nil {
+		return fmt.Errorf("encode failed for AisAidsToNavigationAtonReport-RepeatIndicator: %w", err)
+	}
+	if err := stream.writeUInt32(32, val.UserId); err != nil {
+		return fmt.Errorf("encode failed for AisAidsToNavigationAtonReport-UserId: %w", err)
+	}
+	if err := stream.writeSignedResolution64Override(32, 1e-07, val.Longitude); err != nil {
+		return fmt.Errorf("encode failed for AisAidsToNavigationAtonReport-Longitude: %w", err)
+	}
+	if err := stream.writeSignedResolution64Override(32, 1e-07, val.Latitude); err != nil {
+		return fmt.Errorf("encode failed for AisAidsToNavigationAtonReport-Latitude: %w", err)
+	}
+	if err := stream.writeLookupField(1, uint64(val.PositionAccuracy)); err != nil {
+		return fmt.Errorf("encode failed for AisAidsToNavigationAtonReport-PositionAccuracy: %w", err)
+	}
+	if err := stream.writeLookupField(1, uint64(val.Raim)); err != nil {
+		return fmt.Errorf("encode failed for AisAidsToNavigationAtonReport-Raim: %w", err)
+	}
+	if err := stream.writeLookupField(6, uint64(val.TimeStamp)); err != nil {
+		return fmt.Errorf("encode failed for AisAidsToNavigationAtonReport-TimeStamp: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.1, nullableUnitValue(val.LengthDiameter, units.Meter, units.Distance.Convert)); err != nil {
+		return fmt.Errorf("encode failed for AisAidsToNavigationAtonReport-LengthDiameter: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.1, nullableUnitValue(val.BeamDiameter, units.Meter, units.Distance.Convert)); err != nil {
+		return fmt.Errorf("encode failed for AisAidsToNavigationAtonReport-BeamDiameter: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.1, nullableUnitValue(val.PositionReferenceFromStarboardEdge, units.Meter, units.Distance.Convert)); err != nil {
+		return fmt.Errorf("encode failed for AisAidsToNavigationAtonReport-PositionReferenceFromStarboardEdge: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.1, nullableUnitValue(val.PositionReferenceFromTrueNorthFacingEdge, units.Meter, units.Distance.Convert)); err != nil {
+		return fmt.Errorf("encode failed for AisAidsToNavigationAtonReport-PositionReferenceFromTrueNorthFacingEdge: %w", err)
+	}
+	if err := stream.writeLookupField(5, uint64(val.AtonType)); err != nil {
+		return fmt.Errorf("encode failed for AisAidsToNavigationAtonReport-AtonType: %w", err)
+	}
+	if err := stream.writeLookupField(1, uint64(val.OffPositionIndicator)); err != nil {
+		return fmt.Errorf("encode failed for AisAidsToNavigationAtonReport-OffPositionIndicator: %w", err)
+	}
+	if err := stream.writeLookupField(1, uint64(val.VirtualAtonFlag)); err != nil {
+		return fmt.Errorf("encode failed for AisAidsToNavigationAtonReport-VirtualAtonFlag: %w", err)
+	}
+	if err := stream.writeLookupField(1, uint64(val.AssignedModeFlag)); err != nil {
+		return fmt.Errorf("encode failed for AisAidsToNavigationAtonReport-AssignedModeFlag: %w", err)
+	}
+	stream.writeReserved(1)
+	if err := stream.writeLookupField(4, uint64(val.PositionFixingDeviceType)); err != nil {
+		return fmt.Errorf("encode failed for AisAidsToNavigationAtonReport-PositionFixingDeviceType: %w", err)
+	}
+	stream.writeReserved(3)
+	if err := stream.writeBinaryData(8, val.AtonStatus); err != nil {
+		return fmt.Errorf("encode failed for AisAidsToNavigationAtonReport-AtonStatus: %w", err)
+	}
+	if err := stream.writeLookupField(5, uint64(val.AisTransceiverInformation)); err != nil {
+		return fmt.Errorf("encode failed for AisAidsToNavigationAtonReport-AisTransceiverInformation: %w", err)
+	}
+	stream.writeReserved(3)
+	if err := stream.writeStringWithLengthAndControl(val.AtonName); err != nil {
+		return fmt.Errorf("encode failed for AisAidsToNavigationAtonReport-AtonName: %w", err)
+	}
+	return nil
+}
 type Datum struct {
 	Info MessageInfo
 	LocalDatum string
@@ -47742,6 +57285,28 @@ func DecodeDatum(Info MessageInfo, stream *PGNDataStream) (any, error) {
 	}	
 	return val, nil
 }
+func EncodeDatum(p any, stream *PGNWriter) error {
+	val, ok := p.(Datum)
+	if !ok {
+		return fmt.Errorf("EncodeDatum called with %T", p)
+	}
+	if err := stream.writeFixedString(32, val.LocalDatum); err != nil {
+		return fmt.Errorf("encode failed for Datum-LocalDatum: %w", err)
+	}
+	if err := stream.writeSignedResolution64Override(32, 1e-07, val.DeltaLatitude); err != nil {
+		return fmt.Errorf("encode failed for Datum-DeltaLatitude: %w", err)
+	}
+	if err := stream.writeSignedResolution64Override(32, 1e-07, val.DeltaLongitude); err != nil {
+		return fmt.Errorf("encode failed for Datum-DeltaLongitude: %w", err)
+	}
+	if err := stream.writeSignedResolution(32, 0.01, nullableUnitValue(val.DeltaAltitude, units.Meter, units.Distance.Convert)); err != nil {
+		return fmt.Errorf("encode failed for Datum-DeltaAltitude: %w", err)
+	}
+	if err := stream.writeFixedString(32, val.ReferenceDatum); err != nil {
+		return fmt.Errorf("encode failed for Datum-ReferenceDatum: %w", err)
+	}
+	return nil
+}
 type UserDatum struct {
 	Info MessageInfo
 	DeltaX *units.Distance
@@ -47850,6 +57415,43 @@ func DecodeUserDatum(Info MessageInfo, stream *PGNDataStream) (any, error) {
 	}	
 	return val, nil
 }
+func EncodeUserDatum(p any, stream *PGNWriter) error {
+	val, ok := p.(UserDatum)
+	if !ok {
+		return fmt.Errorf("EncodeUserDatum called with %T", p)
+	}
+	if err := stream.writeSignedResolution(32, 0.01, nullableUnitValue(val.DeltaX, units.Meter, units.Distance.Convert)); err != nil {
+		return fmt.Errorf("encode failed for UserDatum-DeltaX: %w", err)
+	}
+	if err := stream.writeSignedResolution(32, 0.01, nullableUnitValue(val.DeltaY, units.Meter, units.Distance.Convert)); err != nil {
+		return fmt.Errorf("encode failed for UserDatum-DeltaY: %w", err)
+	}
+	if err := stream.writeSignedResolution(32, 0.01, nullableUnitValue(val.DeltaZ, units.Meter, units.Distance.Convert)); err != nil {
+		return fmt.Errorf("encode failed for UserDatum-DeltaZ: %w", err)
+	}
+	if err := stream.writeFloat32(val.RotationInX); err != nil {
+		return fmt.Errorf("encode failed for UserDatum-RotationInX: %w", err)
+	}
+	if err := stream.writeFloat32(val.RotationInY); err != nil {
+		return fmt.Errorf("encode failed for UserDatum-RotationInY: %w", err)
+	}
+	if err := stream.writeFloat32(val.RotationInZ); err != nil {
+		return fmt.Errorf("encode failed for UserDatum-RotationInZ: %w", err)
+	}
+	if err := stream.writeFloat32(val.Scale); err != nil {
+		return fmt.Errorf("encode failed for UserDatum-Scale: %w", err)
+	}
+	if err := stream.writeSignedResolution(32, 0.01, nullableUnitValue(val.EllipsoidSemiMajorAxis, units.Meter, units.Distance.Convert)); err != nil {
+		return fmt.Errorf("encode failed for UserDatum-EllipsoidSemiMajorAxis: %w", err)
+	}
+	if err := stream.writeFloat32(val.EllipsoidFlatteningInverse); err != nil {
+		return fmt.Errorf("encode failed for UserDatum-EllipsoidFlatteningInverse: %w", err)
+	}
+	if err := stream.writeFixedString(32, val.DatumName); err != nil {
+		return fmt.Errorf("encode failed for UserDatum-DatumName: %w", err)
+	}
+	return nil
+}
 type CrossTrackError struct {
 	Info MessageInfo
 	Sid *uint8
@@ -47906,6 +57508,27 @@ func DecodeCrossTrackError(Info MessageInfo, stream *PGNDataStream) (any, error)
 		}	
 	return val, nil
 }
+func EncodeCrossTrackError(p any, stream *PGNWriter) error {
+	val, ok := p.(CrossTrackError)
+	if !ok {
+		return fmt.Errorf("EncodeCrossTrackError called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for CrossTrackError-Sid: %w", err)
+	}
+	if err := stream.writeLookupField(4, uint64(val.XteMode)); err != nil {
+		return fmt.Errorf("encode failed for CrossTrackError-XteMode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(2, uint64(val.NavigationTerminated)); err != nil {
+		return fmt.Errorf("encode failed for CrossTrackError-NavigationTerminated: %w", err)
+	}
+	if err := stream.writeSignedResolution(32, 0.01, nullableUnitValue(val.Xte, units.Meter, units.Distance.Convert)); err != nil {
+		return fmt.Errorf("encode failed for CrossTrackError-Xte: %w", err)
+	}
+	stream.writeReserved(16)
+	return nil
+}
 type NavigationData struct {
 	Info MessageInfo
 	Sid *uint8
@@ -48064,6 +57687,58 @@ func DecodeNavigationData(Info MessageInfo, stream *PGNDataStream) (any, error)
 	}	
 	return val, nil
 }
+func EncodeNavigationData(p any, stream *PGNWriter) error {
+	val, ok := p.(NavigationData)
+	if !ok {
+		return fmt.Errorf("EncodeNavigationData called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for NavigationData-Sid: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(32, 0.01, nullableUnitValue(val.DistanceToWaypoint, units.Meter, units.Distance.Convert)); err != nil {
+		return fmt.Errorf("encode failed for NavigationData-DistanceToWaypoint: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.CourseBearingReference)); err != nil {
+		return fmt.Errorf("encode failed for NavigationData-CourseBearingReference: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.PerpendicularCrossed)); err != nil {
+		return fmt.Errorf("encode failed for NavigationData-PerpendicularCrossed: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.ArrivalCircleEntered)); err != nil {
+		return fmt.Errorf("encode failed for NavigationData-ArrivalCircleEntered: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.CalculationType)); err != nil {
+		return fmt.Errorf("encode failed for NavigationData-CalculationType: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(32, 0.0001, val.EtaTime); err != nil {
+		return fmt.Errorf("encode failed for NavigationData-EtaTime: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.EtaDate); err != nil {
+		return fmt.Errorf("encode failed for NavigationData-EtaDate: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.0001, val.BearingOriginToDestinationWaypoint); err != nil {
+		return fmt.Errorf("encode failed for NavigationData-BearingOriginToDestinationWaypoint: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.0001, val.BearingPositionToDestinationWaypoint); err != nil {
+		return fmt.Errorf("encode failed for NavigationData-BearingPositionToDestinationWaypoint: %w", err)
+	}
+	if err := stream.writeUInt32(32, val.OriginWaypointNumber); err != nil {
+		return fmt.Errorf("encode failed for NavigationData-OriginWaypointNumber: %w", err)
+	}
+	if err := stream.writeUInt32(32, val.DestinationWaypointNumber); err != nil {
+		return fmt.Errorf("encode failed for NavigationData-DestinationWaypointNumber: %w", err)
+	}
+	if err := stream.writeSignedResolution64Override(32, 1e-07, val.DestinationLatitude); err != nil {
+		return fmt.Errorf("encode failed for NavigationData-DestinationLatitude: %w", err)
+	}
+	if err := stream.writeSignedResolution64Override(32, 1e-07, val.DestinationLongitude); err != nil {
+		return fmt.Errorf("encode failed for NavigationData-DestinationLongitude: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.01, nullableUnitValue(val.WaypointClosingVelocity, units.MetersPerSecond, units.Velocity.Convert)); err != nil {
+		return fmt.Errorf("encode failed for NavigationData-WaypointClosingVelocity: %w", err)
+	}
+	return nil
+}
 type NavigationRouteWpInformation struct {
 	Info MessageInfo
 	StartRps *uint16
@@ -48200,6 +57875,51 @@ func DecodeNavigationRouteWpInformation(Info MessageInfo, stream *PGNDataStream)
 	}	
 	return val, nil
 }
+func EncodeNavigationRouteWpInformation(p any, stream *PGNWriter) error {
+	val, ok := p.(NavigationRouteWpInformation)
+	if !ok {
+		return fmt.Errorf("EncodeNavigationRouteWpInformation called with %T", p)
+	}
+	if err := stream.writeUInt16(16, val.StartRps); err != nil {
+		return fmt.Errorf("encode failed for NavigationRouteWpInformation-StartRps: %w", err)
+	}
+	repeat1Count := uint16(len(val.Repeating1))
+	if err := stream.writeUInt16(16, &repeat1Count); err != nil {
+		return fmt.Errorf("encode failed for NavigationRouteWpInformation-Nitems: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.DatabaseId); err != nil {
+		return fmt.Errorf("encode failed for NavigationRouteWpInformation-DatabaseId: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.RouteId); err != nil {
+		return fmt.Errorf("encode failed for NavigationRouteWpInformation-RouteId: %w", err)
+	}
+	if err := stream.writeLookupField(3, uint64(val.NavigationDirectionInRoute)); err != nil {
+		return fmt.Errorf("encode failed for NavigationRouteWpInformation-NavigationDirectionInRoute: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.SupplementaryRouteWpDataAvailable)); err != nil {
+		return fmt.Errorf("encode failed for NavigationRouteWpInformation-SupplementaryRouteWpDataAvailable: %w", err)
+	}
+	stream.writeReserved(3)
+	if err := stream.writeStringWithLengthAndControl(val.RouteName); err != nil {
+		return fmt.Errorf("encode failed for NavigationRouteWpInformation-RouteName: %w", err)
+	}
+	stream.writeReserved(8)
+	for _, rep := range val.Repeating1 {
+		if err := stream.writeUInt16(16, rep.WpId); err != nil {
+			return fmt.Errorf("encode failed for NavigationRouteWpInformation-WpId: %w", err)
+		}
+		if err := stream.writeStringWithLengthAndControl(rep.WpName); err != nil {
+			return fmt.Errorf("encode failed for NavigationRouteWpInformation-WpName: %w", err)
+		}
+		if err := stream.writeSignedResolution64Override(32, 1e-07, rep.WpLatitude); err != nil {
+			return fmt.Errorf("encode failed for NavigationRouteWpInformation-WpLatitude: %w", err)
+		}
+		if err := stream.writeSignedResolution64Override(32, 1e-07, rep.WpLongitude); err != nil {
+			return fmt.Errorf("encode failed for NavigationRouteWpInformation-WpLongitude: %w", err)
+		}
+	}
+	return nil
+}
 type SetDriftRapidUpdate struct {
 	Info MessageInfo
 	Sid *uint8
@@ -48256,6 +57976,27 @@ func DecodeSetDriftRapidUpdate(Info MessageInfo, stream *PGNDataStream) (any, er
 		}	
 	return val, nil
 }
+func EncodeSetDriftRapidUpdate(p any, stream *PGNWriter) error {
+	val, ok := p.(SetDriftRapidUpdate)
+	if !ok {
+		return fmt.Errorf("EncodeSetDriftRapidUpdate called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for SetDriftRapidUpdate-Sid: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.SetReference)); err != nil {
+		return fmt.Errorf("encode failed for SetDriftRapidUpdate-SetReference: %w", err)
+	}
+	stream.writeReserved(6)
+	if err := stream.writeUnsignedResolution(16, 0.0001, val.Set); err != nil {
+		return fmt.Errorf("encode failed for SetDriftRapidUpdate-Set: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.01, nullableUnitValue(val.Drift, units.MetersPerSecond, units.Velocity.Convert)); err != nil {
+		return fmt.Errorf("encode failed for SetDriftRapidUpdate-Drift: %w", err)
+	}
+	stream.writeReserved(16)
+	return nil
+}
 type GnssDops struct {
 	Info MessageInfo
 	Sid *uint8
@@ -48328,6 +58069,32 @@ func DecodeGnssDops(Info MessageInfo, stream *PGNDataStream) (any, error) {
 	}	
 	return val, nil
 }
+func EncodeGnssDops(p any, stream *PGNWriter) error {
+	val, ok := p.(GnssDops)
+	if !ok {
+		return fmt.Errorf("EncodeGnssDops called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for GnssDops-Sid: %w", err)
+	}
+	if err := stream.writeLookupField(3, uint64(val.DesiredMode)); err != nil {
+		return fmt.Errorf("encode failed for GnssDops-DesiredMode: %w", err)
+	}
+	if err := stream.writeLookupField(3, uint64(val.ActualMode)); err != nil {
+		return fmt.Errorf("encode failed for GnssDops-ActualMode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeSignedResolution(16, 0.01, val.Hdop); err != nil {
+		return fmt.Errorf("encode failed for GnssDops-Hdop: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.01, val.Vdop); err != nil {
+		return fmt.Errorf("encode failed for GnssDops-Vdop: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.01, val.Tdop); err != nil {
+		return fmt.Errorf("encode failed for GnssDops-Tdop: %w", err)
+	}
+	return nil
+}
 type GnssSatsInView struct {
 	Info MessageInfo
 	Sid *uint8
@@ -48433,6 +58200,45 @@ func DecodeGnssSatsInView(Info MessageInfo, stream *PGNDataStream) (any, error)
 	}	
 	return val, nil
 }
+func EncodeGnssSatsInView(p any, stream *PGNWriter) error {
+	val, ok := p.(GnssSatsInView)
+	if !ok {
+		return fmt.Errorf("EncodeGnssSatsInView called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for GnssSatsInView-Sid: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.RangeResidualMode)); err != nil {
+		return fmt.Errorf("encode failed for GnssSatsInView-RangeResidualMode: %w", err)
+	}
+	stream.writeReserved(6)
+	repeat1Count := uint8(len(val.Repeating1))
+	if err := stream.writeUInt8(8, &repeat1Count); err != nil {
+		return fmt.Errorf("encode failed for GnssSatsInView-SatsInView: %w", err)
+	}
+	for _, rep := range val.Repeating1 {
+		if err := stream.writeUInt8(8, rep.Prn); err != nil {
+			return fmt.Errorf("encode failed for GnssSatsInView-Prn: %w", err)
+		}
+		if err := stream.writeSignedResolution(16, 0.0001, rep.Elevation); err != nil {
+			return fmt.Errorf("encode failed for GnssSatsInView-Elevation: %w", err)
+		}
+		if err := stream.writeUnsignedResolution(16, 0.0001, rep.Azimuth); err != nil {
+			return fmt.Errorf("encode failed for GnssSatsInView-Azimuth: %w", err)
+		}
+		if err := stream.writeUnsignedResolution(16, 0.01, rep.Snr); err != nil {
+			return fmt.Errorf("encode failed for GnssSatsInView-Snr: %w", err)
+		}
+		if err := stream.writeInt32(32, rep.RangeResiduals); err != nil {
+			return fmt.Errorf("encode failed for GnssSatsInView-RangeResiduals: %w", err)
+		}
+		if err := stream.writeLookupField(4, uint64(rep.Status)); err != nil {
+			return fmt.Errorf("encode failed for GnssSatsInView-Status: %w", err)
+		}
+		stream.writeReserved(4)
+	}
+	return nil
+}
 type GpsAlmanacData struct {
 	Info MessageInfo
 	Prn *uint8
@@ -48575,6 +58381,53 @@ func DecodeGpsAlmanacData(Info MessageInfo, stream *PGNDataStream) (any, error)
 		}	
 	return val, nil
 }
+func EncodeGpsAlmanacData(p any, stream *PGNWriter) error {
+	val, ok := p.(GpsAlmanacData)
+	if !ok {
+		return fmt.Errorf("EncodeGpsAlmanacData called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Prn); err != nil {
+		return fmt.Errorf("encode failed for GpsAlmanacData-Prn: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.GpsWeekNumber); err != nil {
+		return fmt.Errorf("encode failed for GpsAlmanacData-GpsWeekNumber: %w", err)
+	}
+	if err := stream.writeBinaryData(8, val.SvHealthBits); err != nil {
+		return fmt.Errorf("encode failed for GpsAlmanacData-SvHealthBits: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 4.76837e-07, val.Eccentricity); err != nil {
+		return fmt.Errorf("encode failed for GpsAlmanacData-Eccentricity: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(8, 4096, val.AlmanacReferenceTime); err != nil {
+		return fmt.Errorf("encode failed for GpsAlmanacData-AlmanacReferenceTime: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 1.90735e-06, val.InclinationAngle); err != nil {
+		return fmt.Errorf("encode failed for GpsAlmanacData-InclinationAngle: %w", err)
+	}
+	if err := stream.writeSignedResolution64Override(16, 3.63798e-12, val.RateOfRightAscension); err != nil {
+		return fmt.Errorf("encode failed for GpsAlmanacData-RateOfRightAscension: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(24, 0.000488281, val.RootOfSemiMajorAxis); err != nil {
+		return fmt.Errorf("encode failed for GpsAlmanacData-RootOfSemiMajorAxis: %w", err)
+	}
+	if err := stream.writeSignedResolution(24, 1.19209e-07, val.ArgumentOfPerigee); err != nil {
+		return fmt.Errorf("encode failed for GpsAlmanacData-ArgumentOfPerigee: %w", err)
+	}
+	if err := stream.writeSignedResolution(24, 1.19209e-07, val.LongitudeOfAscensionNode); err != nil {
+		return fmt.Errorf("encode failed for GpsAlmanacData-LongitudeOfAscensionNode: %w", err)
+	}
+	if err := stream.writeSignedResolution(24, 1.19209e-07, val.MeanAnomaly); err != nil {
+		return fmt.Errorf("encode failed for GpsAlmanacData-MeanAnomaly: %w", err)
+	}
+	if err := stream.writeSignedResolution(11, 9.53674e-07, val.ClockParameter1); err != nil {
+		return fmt.Errorf("encode failed for GpsAlmanacData-ClockParameter1: %w", err)
+	}
+	if err := stream.writeSignedResolution64Override(11, 3.63798e-12, val.ClockParameter2); err != nil {
+		return fmt.Errorf("encode failed for GpsAlmanacData-ClockParameter2: %w", err)
+	}
+	stream.writeReserved(2)
+	return nil
+}
 type AisUtcAndDateReport struct {
 	Info MessageInfo
 	MessageId AisMessageIdConst
@@ -48715,6 +58568,52 @@ func DecodeAisUtcAndDateReport(Info MessageInfo, stream *PGNDataStream) (any, er
 		}	
 	return val, nil
 }
+func EncodeAisUtcAndDateReport(p any, stream *PGNWriter) error {
+	val, ok := p.(AisUtcAndDateReport)
+	if !ok {
+		return fmt.Errorf("EncodeAisUtcAndDateReport called with %T", p)
+	}
+	if err := stream.writeLookupField(6, uint64(val.MessageId)); err != nil {
+		return fmt.Errorf("encode failed for AisUtcAndDateReport-MessageId: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.RepeatIndicator)); err != nil {
+		return fmt.Errorf("encode failed for AisUtcAndDateReport-RepeatIndicator: %w", err)
+	}
+	if err := stream.writeUInt32(32, val.UserId); err != nil {
+		return fmt.Errorf("encode failed for AisUtcAndDateReport-UserId: %w", err)
+	}
+	if err := stream.writeSignedResolution64Override(32, 1e-07, val.Longitude); err != nil {
+		return fmt.Errorf("encode failed for AisUtcAndDateReport-Longitude: %w", err)
+	}
+	if err := stream.writeSignedResolution64Override(32, 1e-07, val.Latitude); err != nil {
+		return fmt.Errorf("encode failed for AisUtcAndDateReport-Latitude: %w", err)
+	}
+	if err := stream.writeLookupField(1, uint64(val.PositionAccuracy)); err != nil {
+		return fmt.Errorf("encode failed for AisUtcAndDateReport-PositionAccuracy: %w", err)
+	}
+	if err := stream.writeLookupField(1, uint64(val.Raim)); err != nil {
+		return fmt.Errorf("encode failed for AisUtcAndDateReport-Raim: %w", err)
+	}
+	stream.writeReserved(6)
+	if err := stream.writeUnsignedResolution(32, 0.0001, val.PositionTime); err != nil {
+		return fmt.Errorf("encode failed for AisUtcAndDateReport-PositionTime: %w", err)
+	}
+	if err := stream.writeBinaryData(19, val.CommunicationState); err != nil {
+		return fmt.Errorf("encode failed for AisUtcAndDateReport-CommunicationState: %w", err)
+	}
+	if err := stream.writeLookupField(5, uint64(val.AisTransceiverInformation)); err != nil {
+		return fmt.Errorf("encode failed for AisUtcAndDateReport-AisTransceiverInformation: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.PositionDate); err != nil {
+		return fmt.Errorf("encode failed for AisUtcAndDateReport-PositionDate: %w", err)
+	}
+	stream.writeReserved(4)
+	if err := stream.writeLookupField(4, uint64(val.GnssType)); err != nil {
+		return fmt.Errorf("encode failed for AisUtcAndDateReport-GnssType: %w", err)
+	}
+	stream.writeReserved(8)
+	return nil
+}
 type AisClassAStaticAndVoyageRelatedData struct {
 	Info MessageInfo
 	MessageId AisMessageIdConst
@@ -48921,6 +58820,72 @@ func DecodeAisClassAStaticAndVoyageRelatedData(Info MessageInfo, stream *PGNData
 		}	
 	return val, nil
 }
+func EncodeAisClassAStaticAndVoyageRelatedData(p any, stream *PGNWriter) error {
+	val, ok := p.(AisClassAStaticAndVoyageRelatedData)
+	if !ok {
+		return fmt.Errorf("EncodeAisClassAStaticAndVoyageRelatedData called with %T", p)
+	}
+	if err := stream.writeLookupField(6, uint64(val.MessageId)); err != nil {
+		return fmt.Errorf("encode failed for AisClassAStaticAndVoyageRelatedData-MessageId: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.RepeatIndicator)); err != nil {
+		return fmt.Errorf("encode failed for AisClassAStaticAndVoyageRelatedData-RepeatIndicator: %w", err)
+	}
+	if err := stream.writeUInt32(32, val.UserId); err != nil {
+		return fmt.Errorf("encode failed for AisClassAStaticAndVoyageRelatedData-UserId: %w", err)
+	}
+	if err := stream.writeUInt32(32, val.ImoNumber); err != nil {
+		return fmt.Errorf("encode failed for AisClassAStaticAndVoyageRelatedData-ImoNumber: %w", err)
+	}
+	if err := stream.writeFixedString(56, val.Callsign); err != nil {
+		return fmt.Errorf("encode failed for AisClassAStaticAndVoyageRelatedData-Callsign: %w", err)
+	}
+	if err := stream.writeFixedString(160, val.Name); err != nil {
+		return fmt.Errorf("encode failed for AisClassAStaticAndVoyageRelatedData-Name: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.TypeOfShip)); err != nil {
+		return fmt.Errorf("encode failed for AisClassAStaticAndVoyageRelatedData-TypeOfShip: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.1, nullableUnitValue(val.Length, units.Meter, units.Distance.Convert)); err != nil {
+		return fmt.Errorf("encode failed for AisClassAStaticAndVoyageRelatedData-Length: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.1, nullableUnitValue(val.Beam, units.Meter, units.Distance.Convert)); err != nil {
+		return fmt.Errorf("encode failed for AisClassAStaticAndVoyageRelatedData-Beam: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.1, nullableUnitValue(val.PositionReferenceFromStarboard, units.Meter, units.Distance.Convert)); err != nil {
+		return fmt.Errorf("encode failed for AisClassAStaticAndVoyageRelatedData-PositionReferenceFromStarboard: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.1, nullableUnitValue(val.PositionReferenceFromBow, units.Meter, units.Distance.Convert)); err != nil {
+		return fmt.Errorf("encode failed for AisClassAStaticAndVoyageRelatedData-PositionReferenceFromBow: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.EtaDate); err != nil {
+		return fmt.Errorf("encode failed for AisClassAStaticAndVoyageRelatedData-EtaDate: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(32, 0.0001, val.EtaTime); err != nil {
+		return fmt.Errorf("encode failed for AisClassAStaticAndVoyageRelatedData-EtaTime: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.01, nullableUnitValue(val.Draft, units.Meter, units.Distance.Convert)); err != nil {
+		return fmt.Errorf("encode failed for AisClassAStaticAndVoyageRelatedData-Draft: %w", err)
+	}
+	if err := stream.writeFixedString(160, val.Destination); err != nil {
+		return fmt.Errorf("encode failed for AisClassAStaticAndVoyageRelatedData-Destination: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.AisVersionIndicator)); err != nil {
+		return fmt.Errorf("encode failed for AisClassAStaticAndVoyageRelatedData-AisVersionIndicator: %w", err)
+	}
+	if err := stream.writeLookupField(4, uint64(val.GnssType)); err != nil {
+		return fmt.Errorf("encode failed for AisClassAStaticAndVoyageRelatedData-GnssType: %w", err)
+	}
+	if err := stream.writeLookupField(1, uint64(val.Dte)); err != nil {
+		return fmt.Errorf("encode failed for AisClassAStaticAndVoyageRelatedData-Dte: %w", err)
+	}
+	stream.writeReserved(1)
+	if err := stream.writeLookupField(5, uint64(val.AisTransceiverInformation)); err != nil {
+		return fmt.Errorf("encode failed for AisClassAStaticAndVoyageRelatedData-AisTransceiverInformation: %w", err)
+	}
+	stream.writeReserved(3)
+	return nil
+}
 type AisAddressedBinaryMessage struct {
 	Info MessageInfo
 	MessageId AisMessageIdConst
@@ -49035,6 +59000,47 @@ func DecodeAisAddressedBinaryMessage(Info MessageInfo, stream *PGNDataStream) (a
 	}	
 	return val, nil
 }
+func EncodeAisAddressedBinaryMessage(p any, stream *PGNWriter) error {
+	val, ok := p.(AisAddressedBinaryMessage)
+	if !ok {
+		return fmt.Errorf("EncodeAisAddressedBinaryMessage called with %T", p)
+	}
+		var binaryLength uint16 = 0
+	if err := stream.writeLookupField(6, uint64(val.MessageId)); err != nil {
+		return fmt.Errorf("encode failed for AisAddressedBinaryMessage-MessageId: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.RepeatIndicator)); err != nil {
+		return fmt.Errorf("encode failed for AisAddressedBinaryMessage-RepeatIndicator: %w", err)
+	}
+	if err := stream.writeUInt32(32, val.SourceId); err != nil {
+		return fmt.Errorf("encode failed for AisAddressedBinaryMessage-SourceId: %w", err)
+	}
+	stream.writeReserved(1)
+	if err := stream.writeLookupField(5, uint64(val.AisTransceiverInformation)); err != nil {
+		return fmt.Errorf("encode failed for AisAddressedBinaryMessage-AisTransceiverInformation: %w", err)
+	}
+	if err := stream.writeUInt8(2, val.SequenceNumber); err != nil {
+		return fmt.Errorf("encode failed for AisAddressedBinaryMessage-SequenceNumber: %w", err)
+	}
+	if err := stream.writeUInt32(32, val.DestinationId); err != nil {
+		return fmt.Errorf("encode failed for AisAddressedBinaryMessage-DestinationId: %w", err)
+	}
+	stream.writeReserved(6)
+	if err := stream.writeUInt8(1, val.RetransmitFlag); err != nil {
+		return fmt.Errorf("encode failed for AisAddressedBinaryMessage-RetransmitFlag: %w", err)
+	}
+	stream.writeReserved(1)
+	if err := stream.writeUInt16(16, val.NumberOfBitsInBinaryDataField); err != nil {
+		return fmt.Errorf("encode failed for AisAddressedBinaryMessage-NumberOfBitsInBinaryDataField: %w", err)
+	}
+	if val.NumberOfBitsInBinaryDataField != nil {
+		binaryLength = uint16(*val.NumberOfBitsInBinaryDataField)
+	}
+	if err := stream.writeBinaryData(binaryLength, val.BinaryData); err != nil {
+		return fmt.Errorf("encode failed for AisAddressedBinaryMessage-BinaryData: %w", err)
+	}
+	return nil
+}
 type AisAcknowledge struct {
 	Info MessageInfo
 	MessageId AisMessageIdConst
@@ -49129,6 +59135,38 @@ func DecodeAisAcknowledge(Info MessageInfo, stream *PGNDataStream) (any, error)
 		}	
 	return val, nil
 }
+func EncodeAisAcknowledge(p any, stream *PGNWriter) error {
+	val, ok := p.(AisAcknowledge)
+	if !ok {
+		return fmt.Errorf("EncodeAisAcknowledge called with %T", p)
+	}
+	if err := stream.writeLookupField(6, uint64(val.MessageId)); err != nil {
+		return fmt.Errorf("encode failed for AisAcknowledge-MessageId: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.RepeatIndicator)); err != nil {
+		return fmt.Errorf("encode failed for AisAcknowledge-RepeatIndicator: %w", err)
+	}
+	if err := stream.writeUInt32(32, val.SourceId); err != nil {
+		return fmt.Errorf("encode failed for AisAcknowledge-SourceId: %w", err)
+	}
+	stream.writeReserved(1)
+	if err := stream.writeLookupField(5, uint64(val.AisTransceiverInformation)); err != nil {
+		return fmt.Errorf("encode failed for AisAcknowledge-AisTransceiverInformation: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeUInt32(32, val.DestinationId1); err != nil {
+		return fmt.Errorf("encode failed for AisAcknowledge-DestinationId1: %w", err)
+	}
+	if err := stream.writeBinaryData(2, val.SequenceNumberForId1); err != nil {
+		return fmt.Errorf("encode failed for AisAcknowledge-SequenceNumberForId1: %w", err)
+	}
+	stream.writeReserved(6)
+	if err := stream.writeBinaryData(2, val.SequenceNumberForIdN); err != nil {
+		return fmt.Errorf("encode failed for AisAcknowledge-SequenceNumberForIdN: %w", err)
+	}
+	stream.writeReserved(6)
+	return nil
+}
 type AisBinaryBroadcastMessage struct {
 	Info MessageInfo
 	MessageId AisMessageIdConst
@@ -49209,6 +59247,37 @@ func DecodeAisBinaryBroadcastMessage(Info MessageInfo, stream *PGNDataStream) (a
 	}	
 	return val, nil
 }
+func EncodeAisBinaryBroadcastMessage(p any, stream *PGNWriter) error {
+	val, ok := p.(AisBinaryBroadcastMessage)
+	if !ok {
+		return fmt.Errorf("EncodeAisBinaryBroadcastMessage called with %T", p)
+	}
+		var binaryLength uint16 = 0
+	if err := stream.writeLookupField(6, uint64(val.MessageId)); err != nil {
+		return fmt.Errorf("encode failed for AisBinaryBroadcastMessage-MessageId: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.RepeatIndicator)); err != nil {
+		return fmt.Errorf("encode failed for AisBinaryBroadcastMessage-RepeatIndicator: %w", err)
+	}
+	if err := stream.writeUInt32(32, val.SourceId); err != nil {
+		return fmt.Errorf("encode failed for AisBinaryBroadcastMessage-SourceId: %w", err)
+	}
+	stream.writeReserved(1)
+	if err := stream.writeLookupField(5, uint64(val.AisTransceiverInformation)); err != nil {
+		return fmt.Errorf("encode failed for AisBinaryBroadcastMessage-AisTransceiverInformation: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeUInt16(16, val.NumberOfBitsInBinaryDataField); err != nil {
+		return fmt.Errorf("encode failed for AisBinaryBroadcastMessage-NumberOfBitsInBinaryDataField: %w", err)
+	}
+	if val.NumberOfBitsInBinaryDataField != nil {
+		binaryLength = uint16(*val.NumberOfBitsInBinaryDataField)
+	}
+	if err := stream.writeBinaryData(binaryLength, val.BinaryData); err != nil {
+		return fmt.Errorf("encode failed for AisBinaryBroadcastMessage-BinaryData: %w", err)
+	}
+	return nil
+}
 type RadioFrequencyModePower struct {
 	Info MessageInfo
 	RxFrequency *float32
@@ -49277,6 +59346,31 @@ func DecodeRadioFrequencyModePower(Info MessageInfo, stream *PGNDataStream) (any
 	}	
 	return val, nil
 }
+func EncodeRadioFrequencyModePower(p any, stream *PGNWriter) error {
+	val, ok := p.(RadioFrequencyModePower)
+	if !ok {
+		return fmt.Errorf("EncodeRadioFrequencyModePower called with %T", p)
+	}
+	if err := stream.writeUnsignedResolution(32, 10, val.RxFrequency); err != nil {
+		return fmt.Errorf("encode failed for RadioFrequencyModePower-RxFrequency: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(32, 10, val.TxFrequency); err != nil {
+		return fmt.Errorf("encode failed for RadioFrequencyModePower-TxFrequency: %w", err)
+	}
+	if err := stream.writeFixedString(48, val.RadioChannel); err != nil {
+		return fmt.Errorf("encode failed for RadioFrequencyModePower-RadioChannel: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.TxPower); err != nil {
+		return fmt.Errorf("encode failed for RadioFrequencyModePower-TxPower: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.Mode); err != nil {
+		return fmt.Errorf("encode failed for RadioFrequencyModePower-Mode: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.ChannelBandwidth); err != nil {
+		return fmt.Errorf("encode failed for RadioFrequencyModePower-ChannelBandwidth: %w", err)
+	}
+	return nil
+}
 type AisUtcDateInquiry struct {
 	Info MessageInfo
 	MessageId AisMessageIdConst
@@ -49339,6 +59433,29 @@ func DecodeAisUtcDateInquiry(Info MessageInfo, stream *PGNDataStream) (any, erro
 	}	
 	return val, nil
 }
+func EncodeAisUtcDateInquiry(p any, stream *PGNWriter) error {
+	val, ok := p.(AisUtcDateInquiry)
+	if !ok {
+		return fmt.Errorf("EncodeAisUtcDateInquiry called with %T", p)
+	}
+	if err := stream.writeLookupField(6, uint64(val.MessageId)); err != nil {
+		return fmt.Errorf("encode failed for AisUtcDateInquiry-MessageId: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.RepeatIndicator)); err != nil {
+		return fmt.Errorf("encode failed for AisUtcDateInquiry-RepeatIndicator: %w", err)
+	}
+	if err := stream.writeUInt32(32, val.SourceId); err != nil {
+		return fmt.Errorf("encode failed for AisUtcDateInquiry-SourceId: %w", err)
+	}
+	if err := stream.writeLookupField(5, uint64(val.AisTransceiverInformation)); err != nil {
+		return fmt.Errorf("encode failed for AisUtcDateInquiry-AisTransceiverInformation: %w", err)
+	}
+	stream.writeReserved(3)
+	if err := stream.writeUInt32(32, val.DestinationId); err != nil {
+		return fmt.Errorf("encode failed for AisUtcDateInquiry-DestinationId: %w", err)
+	}
+	return nil
+}
 type AisAddressedSafetyRelatedMessage struct {
 	Info MessageInfo
 	MessageId AisMessageIdConst
@@ -49435,6 +59552,39 @@ func DecodeAisAddressedSafetyRelatedMessage(Info MessageInfo, stream *PGNDataStr
 	}	
 	return val, nil
 }
+func EncodeAisAddressedSafetyRelatedMessage(p any, stream *PGNWriter) error {
+	val, ok := p.(AisAddressedSafetyRelatedMessage)
+	if !ok {
+		return fmt.Errorf("EncodeAisAddressedSafetyRelatedMessage called with %T", p)
+	}
+	if err := stream.writeLookupField(6, uint64(val.MessageId)); err != nil {
+		return fmt.Errorf("encode failed for AisAddressedSafetyRelatedMessage-MessageId: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.RepeatIndicator)); err != nil {
+		return fmt.Errorf("encode failed for AisAddressedSafetyRelatedMessage-RepeatIndicator: %w", err)
+	}
+	if err := stream.writeUInt32(32, val.SourceId); err != nil {
+		return fmt.Errorf("encode failed for AisAddressedSafetyRelatedMessage-SourceId: %w", err)
+	}
+	if err := stream.writeLookupField(5, uint64(val.AisTransceiverInformation)); err != nil {
+		return fmt.Errorf("encode failed for AisAddressedSafetyRelatedMessage-AisTransceiverInformation: %w", err)
+	}
+	if err := stream.writeUInt8(2, val.SequenceNumber); err != nil {
+		return fmt.Errorf("encode failed for AisAddressedSafetyRelatedMessage-SequenceNumber: %w", err)
+	}
+	stream.writeReserved(1)
+	if err := stream.writeUInt32(32, val.DestinationId); err != nil {
+		return fmt.Errorf("encode failed for AisAddressedSafetyRelatedMessage-DestinationId: %w", err)
+	}
+	if err := stream.writeUInt8(1, val.RetransmitFlag); err != nil {
+		return fmt.Errorf("encode failed for AisAddressedSafetyRelatedMessage-RetransmitFlag: %w", err)
+	}
+	stream.writeReserved(7)
+	if err := stream.writeFixedString(936, val.SafetyRelatedText); err != nil {
+		return fmt.Errorf("encode failed for AisAddressedSafetyRelatedMessage-SafetyRelatedText: %w", err)
+	}
+	return nil
+}
 type AisSafetyRelatedBroadcastMessage struct {
 	Info MessageInfo
 	MessageId AisMessageIdConst
@@ -49497,6 +59647,29 @@ func DecodeAisSafetyRelatedBroadcastMessage(Info MessageInfo, stream *PGNDataStr
 	}	
 	return val, nil
 }
+func EncodeAisSafetyRelatedBroadcastMessage(p any, stream *PGNWriter) error {
+	val, ok := p.(AisSafetyRelatedBroadcastMessage)
+	if !ok {
+		return fmt.Errorf("EncodeAisSafetyRelatedBroadcastMessage called with %T", p)
+	}
+	if err := stream.writeLookupField(6, uint64(val.MessageId)); err != nil {
+		return fmt.Errorf("encode failed for AisSafetyRelatedBroadcastMessage-MessageId: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.RepeatIndicator)); err != nil {
+		return fmt.Errorf("encode failed for AisSafetyRelatedBroadcastMessage-RepeatIndicator: %w", err)
+	}
+	if err := stream.writeUInt32(32, val.SourceId); err != nil {
+		return fmt.Errorf("encode failed for AisSafetyRelatedBroadcastMessage-SourceId: %w", err)
+	}
+	if err := stream.writeLookupField(5, uint64(val.AisTransceiverInformation)); err != nil {
+		return fmt.Errorf("encode failed for AisSafetyRelatedBroadcastMessage-AisTransceiverInformation: %w", err)
+	}
+	stream.writeReserved(3)
+	if err := stream.writeFixedString(1296, val.SafetyRelatedText); err != nil {
+		return fmt.Errorf("encode failed for AisSafetyRelatedBroadcastMessage-SafetyRelatedText: %w", err)
+	}
+	return nil
+}
 type AisInterrogation struct {
 	Info MessageInfo
 	MessageId AisMessageIdConst
@@ -49659,6 +59832,58 @@ func DecodeAisInterrogation(Info MessageInfo, stream *PGNDataStream) (any, error
 	}	
 	return val, nil
 }
+func EncodeAisInterrogation(p any, stream *PGNWriter) error {
+	val, ok := p.(AisInterrogation)
+	if !ok {
+		return fmt.Errorf("EncodeAisInterrogation called with %T", p)
+	}
+	if err := stream.writeLookupField(6, uint64(val.MessageId)); err != nil {
+		return fmt.Errorf("encode failed for AisInterrogation-MessageId: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.RepeatIndicator)); err != nil {
+		return fmt.Errorf("encode failed for AisInterrogation-RepeatIndicator: %w", err)
+	}
+	if err := stream.writeUInt32(32, val.SourceId); err != nil {
+		return fmt.Errorf("encode failed for AisInterrogation-SourceId: %w", err)
+	}
+	stream.writeReserved(1)
+	if err := stream.writeLookupField(5, uint64(val.AisTransceiverInformation)); err != nil {
+		return fmt.Errorf("encode failed for AisInterrogation-AisTransceiverInformation: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeUInt32(32, val.DestinationId1); err != nil {
+		return fmt.Errorf("encode failed for AisInterrogation-DestinationId1: %w", err)
+	}
+	if err := stream.writeLookupField(6, uint64(val.MessageId11)); err != nil {
+		return fmt.Errorf("encode failed for AisInterrogation-MessageId11: %w", err)
+	}
+	if err := stream.writeUInt16(12, val.SlotOffset11); err != nil {
+		return fmt.Errorf("encode failed for AisInterrogation-SlotOffset11: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(6, uint64(val.MessageId12)); err != nil {
+		return fmt.Errorf("encode failed for AisInterrogation-MessageId12: %w", err)
+	}
+	if err := stream.writeUInt16(12, val.SlotOffset12); err != nil {
+		return fmt.Errorf("encode failed for AisInterrogation-SlotOffset12: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeUInt32(32, val.DestinationId2); err != nil {
+		return fmt.Errorf("encode failed for AisInterrogation-DestinationId2: %w", err)
+	}
+	if err := stream.writeLookupField(6, uint64(val.MessageId21)); err != nil {
+		return fmt.Errorf("encode failed for AisInterrogation-MessageId21: %w", err)
+	}
+	if err := stream.writeUInt16(12, val.SlotOffset21); err != nil {
+		return fmt.Errorf("encode failed for AisInterrogation-SlotOffset21: %w", err)
+	}
+	stream.writeReserved(2)
+	stream.writeReserved(4)
+	if err := stream.writeUInt8(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for AisInterrogation-Sid: %w", err)
+	}
+	return nil
+}
 type AisDataLinkManagementMessage struct {
 	Info MessageInfo
 	MessageId AisMessageIdConst
@@ -49755,6 +59980,40 @@ func DecodeAisDataLinkManagementMessage(Info MessageInfo, stream *PGNDataStream)
 	}	
 	return val, nil
 }
+func EncodeAisDataLinkManagementMessage(p any, stream *PGNWriter) error {
+	val, ok := p.(AisDataLinkManagementMessage)
+	if !ok {
+		return fmt.Errorf("EncodeAisDataLinkManagementMessage called with %T", p)
+	}
+	if err := stream.writeLookupField(6, uint64(val.MessageId)); err != nil {
+		return fmt.Errorf("encode failed for AisDataLinkManagementMessage-MessageId: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.RepeatIndicator)); err != nil {
+		return fmt.Errorf("encode failed for AisDataLinkManagementMessage-RepeatIndicator: %w", err)
+	}
+	if err := stream.writeUInt32(32, val.SourceId); err != nil {
+		return fmt.Errorf("encode failed for AisDataLinkManagementMessage-SourceId: %w", err)
+	}
+	if err := stream.writeLookupField(5, uint64(val.AisTransceiverInformation)); err != nil {
+		return fmt.Errorf("encode failed for AisDataLinkManagementMessage-AisTransceiverInformation: %w", err)
+	}
+	stream.writeReserved(3)
+	for _, rep := range val.Repeating1 {
+		if err := stream.writeUInt16(16, rep.Offset); err != nil {
+			return fmt.Errorf("encode failed for AisDataLinkManagementMessage-Offset: %w", err)
+		}
+		if err := stream.writeUInt8(8, rep.NumberOfSlots); err != nil {
+			return fmt.Errorf("encode failed for AisDataLinkManagementMessage-NumberOfSlots: %w", err)
+		}
+		if err := stream.writeUInt8(8, rep.Timeout); err != nil {
+			return fmt.Errorf("encode failed for AisDataLinkManagementMessage-Timeout: %w", err)
+		}
+		if err := stream.writeUInt16(16, rep.Increment); err != nil {
+			return fmt.Errorf("encode failed for AisDataLinkManagementMessage-Increment: %w", err)
+		}
+	}
+	return nil
+}
 type AisChannelManagement struct {
 	Info MessageInfo
 	MessageId AisMessageIdConst
@@ -49939,6 +60198,65 @@ func DecodeAisChannelManagement(Info MessageInfo, stream *PGNDataStream) (any, e
 	}	
 	return val, nil
 }
+func EncodeAisChannelManagement(p any, stream *PGNWriter) error {
+	val, ok := p.(AisChannelManagement)
+	if !ok {
+		return fmt.Errorf("EncodeAisChannelManagement called with %T", p)
+	}
+	if err := stream.writeLookupField(6, uint64(val.MessageId)); err != nil {
+		return fmt.Errorf("encode failed for AisChannelManagement-MessageId: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.RepeatIndicator)); err != nil {
+		return fmt.Errorf("encode failed for AisChannelManagement-RepeatIndicator: %w", err)
+	}
+	if err := stream.writeUInt32(32, val.SourceId); err != nil {
+		return fmt.Errorf("encode failed for AisChannelManagement-SourceId: %w", err)
+	}
+	if err := stream.writeLookupField(5, uint64(val.AisTransceiverInformation)); err != nil {
+		return fmt.Errorf("encode failed for AisChannelManagement-AisTransceiverInformation: %w", err)
+	}
+	stream.writeReserved(3)
+	if err := stream.writeUInt8(7, val.ChannelA); err != nil {
+		return fmt.Errorf("encode failed for AisChannelManagement-ChannelA: %w", err)
+	}
+	if err := stream.writeUInt8(7, val.ChannelB); err != nil {
+		return fmt.Errorf("encode failed for AisChannelManagement-ChannelB: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeUInt8(8, val.Power); err != nil {
+		return fmt.Errorf("encode failed for AisChannelManagement-Power: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.TxRxMode); err != nil {
+		return fmt.Errorf("encode failed for AisChannelManagement-TxRxMode: %w", err)
+	}
+	if err := stream.writeSignedResolution64Override(32, 1e-07, val.NorthEastLongitudeCorner1); err != nil {
+		return fmt.Errorf("encode failed for AisChannelManagement-NorthEastLongitudeCorner1: %w", err)
+	}
+	if err := stream.writeSignedResolution64Override(32, 1e-07, val.NorthEastLatitudeCorner1); err != nil {
+		return fmt.Errorf("encode failed for AisChannelManagement-NorthEastLatitudeCorner1: %w", err)
+	}
+	if err := stream.writeSignedResolution64Override(32, 1e-07, val.SouthWestLongitudeCorner1); err != nil {
+		return fmt.Errorf("encode failed for AisChannelManagement-SouthWestLongitudeCorner1: %w", err)
+	}
+	if err := stream.writeSignedResolution64Override(32, 1e-07, val.SouthWestLatitudeCorner2); err != nil {
+		return fmt.Errorf("encode failed for AisChannelManagement-SouthWestLatitudeCorner2: %w", err)
+	}
+	stream.writeReserved(6)
+	if err := stream.writeUInt8(2, val.AddressedOrBroadcastMessageIndicator); err != nil {
+		return fmt.Errorf("encode failed for AisChannelManagement-AddressedOrBroadcastMessageIndicator: %w", err)
+	}
+	if err := stream.writeUInt8(7, val.ChannelABandwidth); err != nil {
+		return fmt.Errorf("encode failed for AisChannelManagement-ChannelABandwidth: %w", err)
+	}
+	if err := stream.writeUInt8(7, val.ChannelBBandwidth); err != nil {
+		return fmt.Errorf("encode failed for AisChannelManagement-ChannelBBandwidth: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeUInt8(8, val.TransitionalZoneSize); err != nil {
+		return fmt.Errorf("encode failed for AisChannelManagement-TransitionalZoneSize: %w", err)
+	}
+	return nil
+}
 type AisClassBStaticDataMsg24PartA struct {
 	Info MessageInfo
 	MessageId AisMessageIdConst
@@ -50011,6 +60329,32 @@ func DecodeAisClassBStaticDataMsg24PartA(Info MessageInfo, stream *PGNDataStream
 	}	
 	return val, nil
 }
+func EncodeAisClassBStaticDataMsg24PartA(p any, stream *PGNWriter) error {
+	val, ok := p.(AisClassBStaticDataMsg24PartA)
+	if !ok {
+		return fmt.Errorf("EncodeAisClassBStaticDataMsg24PartA called with %T", p)
+	}
+	if err := stream.writeLookupField(6, uint64(val.MessageId)); err != nil {
+		return fmt.Errorf("encode failed for AisClassBStaticDataMsg24PartA-MessageId: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.RepeatIndicator)); err != nil {
+		return fmt.Errorf("encode failed for AisClassBStaticDataMsg24PartA-RepeatIndicator: %w", err)
+	}
+	if err := stream.writeUInt32(32, val.UserId); err != nil {
+		return fmt.Errorf("encode failed for AisClassBStaticDataMsg24PartA-UserId: %w", err)
+	}
+	if err := stream.writeFixedString(160, val.Name); err != nil {
+		return fmt.Errorf("encode failed for AisClassBStaticDataMsg24PartA-Name: %w", err)
+	}
+	if err := stream.writeLookupField(5, uint64(val.AisTransceiverInformation)); err != nil {
+		return fmt.Errorf("encode failed for AisClassBStaticDataMsg24PartA-AisTransceiverInformation: %w", err)
+	}
+	stream.writeReserved(3)
+	if err := stream.writeUInt8(8, val.SequenceId); err != nil {
+		return fmt.Errorf("encode failed for AisClassBStaticDataMsg24PartA-SequenceId: %w", err)
+	}
+	return nil
+}
 type AisClassBStaticDataMsg24PartB struct {
 	Info MessageInfo
 	MessageId AisMessageIdConst
@@ -50161,6 +60505,55 @@ func DecodeAisClassBStaticDataMsg24PartB(Info MessageInfo, stream *PGNDataStream
 	}	
 	return val, nil
 }
+func EncodeAisClassBStaticDataMsg24PartB(p any, stream *PGNWriter) error {
+	val, ok := p.(AisClassBStaticDataMsg24PartB)
+	if !ok {
+		return fmt.Errorf("EncodeAisClassBStaticDataMsg24PartB called with %T", p)
+	}
+	if err := stream.writeLookupField(6, uint64(val.MessageId)); err != nil {
+		return fmt.Errorf("encode failed for AisClassBStaticDataMsg24PartB-MessageId: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.RepeatIndicator)); err != nil {
+		return fmt.Errorf("encode failed for AisClassBStaticDataMsg24PartB-RepeatIndicator: %w", err)
+	}
+	if err := stream.writeUInt32(32, val.UserId); err != nil {
+		return fmt.Errorf("encode failed for AisClassBStaticDataMsg24PartB-UserId: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.TypeOfShip)); err != nil {
+		return fmt.Errorf("encode failed for AisClassBStaticDataMsg24PartB-TypeOfShip: %w", err)
+	}
+	if err := stream.writeFixedString(56, val.VendorId); err != nil {
+		return fmt.Errorf("encode failed for AisClassBStaticDataMsg24PartB-VendorId: %w", err)
+	}
+	if err := stream.writeFixedString(56, val.Callsign); err != nil {
+		return fmt.Errorf("encode failed for AisClassBStaticDataMsg24PartB-Callsign: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.1, nullableUnitValue(val.Length, units.Meter, units.Distance.Convert)); err != nil {
+		return fmt.Errorf("encode failed for AisClassBStaticDataMsg24PartB-Length: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.1, nullableUnitValue(val.Beam, units.Meter, units.Distance.Convert)); err != nil {
+		return fmt.Errorf("encode failed for AisClassBStaticDataMsg24PartB-Beam: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.1, nullableUnitValue(val.PositionReferenceFromStarboard, units.Meter, units.Distance.Convert)); err != nil {
+		return fmt.Errorf("encode failed for AisClassBStaticDataMsg24PartB-PositionReferenceFromStarboard: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.1, nullableUnitValue(val.PositionReferenceFromBow, units.Meter, units.Distance.Convert)); err != nil {
+		return fmt.Errorf("encode failed for AisClassBStaticDataMsg24PartB-PositionReferenceFromBow: %w", err)
+	}
+	if err := stream.writeUInt32(32, val.MothershipUserId); err != nil {
+		return fmt.Errorf("encode failed for AisClassBStaticDataMsg24PartB-MothershipUserId: %w", err)
+	}
+	stream.writeReserved(2)
+	stream.writeReserved(6)
+	if err := stream.writeLookupField(5, uint64(val.AisTransceiverInformation)); err != nil {
+		return fmt.Errorf("encode failed for AisClassBStaticDataMsg24PartB-AisTransceiverInformation: %w", err)
+	}
+	stream.writeReserved(3)
+	if err := stream.writeUInt8(8, val.SequenceId); err != nil {
+		return fmt.Errorf("encode failed for AisClassBStaticDataMsg24PartB-SequenceId: %w", err)
+	}
+	return nil
+}
 type WindData struct {
 	Info MessageInfo
 	Sid *uint8
@@ -50213,6 +60606,26 @@ func DecodeWindData(Info MessageInfo, stream *PGNDataStream) (any, error) {
 		}	
 	return val, nil
 }
+func EncodeWindData(p any, stream *PGNWriter) error {
+	val, ok := p.(WindData)
+	if !ok {
+		return fmt.Errorf("EncodeWindData called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for WindData-Sid: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.01, nullableUnitValue(val.WindSpeed, units.MetersPerSecond, units.Velocity.Convert)); err != nil {
+		return fmt.Errorf("encode failed for WindData-WindSpeed: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.0001, val.WindAngle); err != nil {
+		return fmt.Errorf("encode failed for WindData-WindAngle: %w", err)
+	}
+	if err := stream.writeLookupField(3, uint64(val.Reference)); err != nil {
+		return fmt.Errorf("encode failed for WindData-Reference: %w", err)
+	}
+	stream.writeReserved(21)
+	return nil
+}
 type EnvironmentalParametersObsolete struct {
 	Info MessageInfo
 	Sid *uint8
@@ -50265,6 +60678,26 @@ func DecodeEnvironmentalParametersObsolete(Info MessageInfo, stream *PGNDataStre
 		}	
 	return val, nil
 }
+func EncodeEnvironmentalParametersObsolete(p any, stream *PGNWriter) error {
+	val, ok := p.(EnvironmentalParametersObsolete)
+	if !ok {
+		return fmt.Errorf("EncodeEnvironmentalParametersObsolete called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for EnvironmentalParametersObsolete-Sid: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.01, nullableUnitValue(val.WaterTemperature, units.Kelvin, units.Temperature.Convert)); err != nil {
+		return fmt.Errorf("encode failed for EnvironmentalParametersObsolete-WaterTemperature: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.01, nullableUnitValue(val.OutsideAmbientAirTemperature, units.Kelvin, units.Temperature.Convert)); err != nil {
+		return fmt.Errorf("encode failed for EnvironmentalParametersObsolete-OutsideAmbientAirTemperature: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 100, nullableUnitValue(val.AtmosphericPressure, units.Pa, units.Pressure.Convert)); err != nil {
+		return fmt.Errorf("encode failed for EnvironmentalParametersObsolete-AtmosphericPressure: %w", err)
+	}
+	stream.writeReserved(8)
+	return nil
+}
 type EnvironmentalParameters struct {
 	Info MessageInfo
 	Sid *uint8
@@ -50333,6 +60766,31 @@ func DecodeEnvironmentalParameters(Info MessageInfo, stream *PGNDataStream) (any
 	}	
 	return val, nil
 }
+func EncodeEnvironmentalParameters(p any, stream *PGNWriter) error {
+	val, ok := p.(EnvironmentalParameters)
+	if !ok {
+		return fmt.Errorf("EncodeEnvironmentalParameters called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for EnvironmentalParameters-Sid: %w", err)
+	}
+	if err := stream.writeLookupField(6, uint64(val.TemperatureSource)); err != nil {
+		return fmt.Errorf("encode failed for EnvironmentalParameters-TemperatureSource: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.HumiditySource)); err != nil {
+		return fmt.Errorf("encode failed for EnvironmentalParameters-HumiditySource: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.01, nullableUnitValue(val.Temperature, units.Kelvin, units.Temperature.Convert)); err != nil {
+		return fmt.Errorf("encode failed for EnvironmentalParameters-Temperature: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.004, val.Humidity); err != nil {
+		return fmt.Errorf("encode failed for EnvironmentalParameters-Humidity: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 100, nullableUnitValue(val.AtmosphericPressure, units.Pa, units.Pressure.Convert)); err != nil {
+		return fmt.Errorf("encode failed for EnvironmentalParameters-AtmosphericPressure: %w", err)
+	}
+	return nil
+}
 type Temperature struct {
 	Info MessageInfo
 	Sid *uint8
@@ -50395,6 +60853,29 @@ func DecodeTemperature(Info MessageInfo, stream *PGNDataStream) (any, error) {
 		}	
 	return val, nil
 }
+func EncodeTemperature(p any, stream *PGNWriter) error {
+	val, ok := p.(Temperature)
+	if !ok {
+		return fmt.Errorf("EncodeTemperature called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for Temperature-Sid: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Instance); err != nil {
+		return fmt.Errorf("encode failed for Temperature-Instance: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Source)); err != nil {
+		return fmt.Errorf("encode failed for Temperature-Source: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.01, nullableUnitValue(val.ActualTemperature, units.Kelvin, units.Temperature.Convert)); err != nil {
+		return fmt.Errorf("encode failed for Temperature-ActualTemperature: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.01, nullableUnitValue(val.SetTemperature, units.Kelvin, units.Temperature.Convert)); err != nil {
+		return fmt.Errorf("encode failed for Temperature-SetTemperature: %w", err)
+	}
+	stream.writeReserved(8)
+	return nil
+}
 type Humidity struct {
 	Info MessageInfo
 	Sid *uint8
@@ -50457,6 +60938,29 @@ func DecodeHumidity(Info MessageInfo, stream *PGNDataStream) (any, error) {
 		}	
 	return val, nil
 }
+func EncodeHumidity(p any, stream *PGNWriter) error {
+	val, ok := p.(Humidity)
+	if !ok {
+		return fmt.Errorf("EncodeHumidity called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for Humidity-Sid: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Instance); err != nil {
+		return fmt.Errorf("encode failed for Humidity-Instance: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Source)); err != nil {
+		return fmt.Errorf("encode failed for Humidity-Source: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.004, val.ActualHumidity); err != nil {
+		return fmt.Errorf("encode failed for Humidity-ActualHumidity: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.004, val.SetHumidity); err != nil {
+		return fmt.Errorf("encode failed for Humidity-SetHumidity: %w", err)
+	}
+	stream.writeReserved(8)
+	return nil
+}
 type ActualPressure struct {
 	Info MessageInfo
 	Sid *uint8
@@ -50509,6 +61013,26 @@ func DecodeActualPressure(Info MessageInfo, stream *PGNDataStream) (any, error)
 		}	
 	return val, nil
 }
+func EncodeActualPressure(p any, stream *PGNWriter) error {
+	val, ok := p.(ActualPressure)
+	if !ok {
+		return fmt.Errorf("EncodeActualPressure called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for ActualPressure-Sid: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Instance); err != nil {
+		return fmt.Errorf("encode failed for ActualPressure-Instance: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Source)); err != nil {
+		return fmt.Errorf("encode failed for ActualPressure-Source: %w", err)
+	}
+	if err := stream.writeSignedResolution(32, 0.1, nullableUnitValue(val.Pressure, units.Pa, units.Pressure.Convert)); err != nil {
+		return fmt.Errorf("encode failed for ActualPressure-Pressure: %w", err)
+	}
+	stream.writeReserved(8)
+	return nil
+}
 type SetPressure struct {
 	Info MessageInfo
 	Sid *uint8
@@ -50561,6 +61085,26 @@ func DecodeSetPressure(Info MessageInfo, stream *PGNDataStream) (any, error) {
 		}	
 	return val, nil
 }
+func EncodeSetPressure(p any, stream *PGNWriter) error {
+	val, ok := p.(SetPressure)
+	if !ok {
+		return fmt.Errorf("EncodeSetPressure called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for SetPressure-Sid: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Instance); err != nil {
+		return fmt.Errorf("encode failed for SetPressure-Instance: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Source)); err != nil {
+		return fmt.Errorf("encode failed for SetPressure-Source: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(32, 0.1, nullableUnitValue(val.Pressure, units.Pa, units.Pressure.Convert)); err != nil {
+		return fmt.Errorf("encode failed for SetPressure-Pressure: %w", err)
+	}
+	stream.writeReserved(8)
+	return nil
+}
 type TemperatureExtendedRange struct {
 	Info MessageInfo
 	Sid *uint8
@@ -50619,6 +61163,28 @@ func DecodeTemperatureExtendedRange(Info MessageInfo, stream *PGNDataStream) (an
 	}	
 	return val, nil
 }
+func EncodeTemperatureExtendedRange(p any, stream *PGNWriter) error {
+	val, ok := p.(TemperatureExtendedRange)
+	if !ok {
+		return fmt.Errorf("EncodeTemperatureExtendedRange called with %T", p)
+	}
+	if err := stream.writeUInt8(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for TemperatureExtendedRange-Sid: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Instance); err != nil {
+		return fmt.Errorf("encode failed for TemperatureExtendedRange-Instance: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Source)); err != nil {
+		return fmt.Errorf("encode failed for TemperatureExtendedRange-Source: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(24, 0.001, nullableUnitValue(val.Temperature, units.Kelvin, units.Temperature.Convert)); err != nil {
+		return fmt.Errorf("encode failed for TemperatureExtendedRange-Temperature: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.1, nullableUnitValue(val.SetTemperature, units.Kelvin, units.Temperature.Convert)); err != nil {
+		return fmt.Errorf("encode failed for TemperatureExtendedRange-SetTemperature: %w", err)
+	}
+	return nil
+}
 type TideStationData struct {
 	Info MessageInfo
 	Mode ResidualModeConst
@@ -50731,6 +61297,44 @@ func DecodeTideStationData(Info MessageInfo, stream *PGNDataStream) (any, error)
 	}	
 	return val, nil
 }
+func EncodeTideStationData(p any, stream *PGNWriter) error {
+	val, ok := p.(TideStationData)
+	if !ok {
+		return fmt.Errorf("EncodeTideStationData called with %T", p)
+	}
+	if err := stream.writeLookupField(4, uint64(val.Mode)); err != nil {
+		return fmt.Errorf("encode failed for TideStationData-Mode: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.TideTendency)); err != nil {
+		return fmt.Errorf("encode failed for TideStationData-TideTendency: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeUInt16(16, val.MeasurementDate); err != nil {
+		return fmt.Errorf("encode failed for TideStationData-MeasurementDate: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(32, 0.0001, val.MeasurementTime); err != nil {
+		return fmt.Errorf("encode failed for TideStationData-MeasurementTime: %w", err)
+	}
+	if err := stream.writeSignedResolution64Override(32, 1e-07, val.StationLatitude); err != nil {
+		return fmt.Errorf("encode failed for TideStationData-StationLatitude: %w", err)
+	}
+	if err := stream.writeSignedResolution64Override(32, 1e-07, val.StationLongitude); err != nil {
+		return fmt.Errorf("encode failed for TideStationData-StationLongitude: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.001, nullableUnitValue(val.TideLevel, units.Meter, units.Distance.Convert)); err != nil {
+		return fmt.Errorf("encode failed for TideStationData-TideLevel: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.01, nullableUnitValue(val.TideLevelStandardDeviation, units.Meter, units.Distance.Convert)); err != nil {
+		return fmt.Errorf("encode failed for TideStationData-TideLevelStandardDeviation: %w", err)
+	}
+	if err := stream.writeStringWithLengthAndControl(val.StationId); err != nil {
+		return fmt.Errorf("encode failed for TideStationData-StationId: %w", err)
+	}
+	if err := stream.writeStringWithLengthAndControl(val.StationName); err != nil {
+		return fmt.Errorf("encode failed for TideStationData-StationName: %w", err)
+	}
+	return nil
+}
 type SalinityStationData struct {
 	Info MessageInfo
 	Mode ResidualModeConst
@@ -50833,6 +61437,41 @@ func DecodeSalinityStationData(Info MessageInfo, stream *PGNDataStream) (any, er
 	}	
 	return val, nil
 }
+func EncodeSalinityStationData(p any, stream *PGNWriter) error {
+	val, ok := p.(SalinityStationData)
+	if !ok {
+		return fmt.Errorf("EncodeSalinityStationData called with %T", p)
+	}
+	if err := stream.writeLookupField(4, uint64(val.Mode)); err != nil {
+		return fmt.Errorf("encode failed for SalinityStationData-Mode: %w", err)
+	}
+	stream.writeReserved(4)
+	if err := stream.writeUInt16(16, val.MeasurementDate); err != nil {
+		return fmt.Errorf("encode failed for SalinityStationData-MeasurementDate: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(32, 0.0001, val.MeasurementTime); err != nil {
+		return fmt.Errorf("encode failed for SalinityStationData-MeasurementTime: %w", err)
+	}
+	if err := stream.writeSignedResolution64Override(32, 1e-07, val.StationLatitude); err != nil {
+		return fmt.Errorf("encode failed for SalinityStationData-StationLatitude: %w", err)
+	}
+	if err := stream.writeSignedResolution64Override(32, 1e-07, val.StationLongitude); err != nil {
+		return fmt.Errorf("encode failed for SalinityStationData-StationLongitude: %w", err)
+	}
+	if err := stream.writeFloat32(val.Salinity); err != nil {
+		return fmt.Errorf("encode failed for SalinityStationData-Salinity: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.01, nullableUnitValue(val.WaterTemperature, units.Kelvin, units.Temperature.Convert)); err != nil {
+		return fmt.Errorf("encode failed for SalinityStationData-WaterTemperature: %w", err)
+	}
+	if err := stream.writeStringWithLengthAndControl(val.StationId); err != nil {
+		return fmt.Errorf("encode failed for SalinityStationData-StationId: %w", err)
+	}
+	if err := stream.writeStringWithLengthAndControl(val.StationName); err != nil {
+		return fmt.Errorf("encode failed for SalinityStationData-StationName: %w", err)
+	}
+	return nil
+}
 type WatermakerInputSettingAndStatus struct {
 	Info MessageInfo
 	WatermakerOperatingState WatermakerStateConst
@@ -51065,6 +61704,80 @@ func DecodeWatermakerInputSettingAndStatus(Info MessageInfo, stream *PGNDataStre
 	}	
 	return val, nil
 }
+func EncodeWatermakerInputSettingAndStatus(p any, stream *PGNWriter) error {
+	val, ok := p.(WatermakerInputSettingAndStatus)
+	if !ok {
+		return fmt.Errorf("EncodeWatermakerInputSettingAndStatus called with %T", p)
+	}
+	if err := stream.writeLookupField(6, uint64(val.WatermakerOperatingState)); err != nil {
+		return fmt.Errorf("encode failed for WatermakerInputSettingAndStatus-WatermakerOperatingState: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.ProductionStartStop)); err != nil {
+		return fmt.Errorf("encode failed for WatermakerInputSettingAndStatus-ProductionStartStop: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.RinseStartStop)); err != nil {
+		return fmt.Errorf("encode failed for WatermakerInputSettingAndStatus-RinseStartStop: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.LowPressurePumpStatus)); err != nil {
+		return fmt.Errorf("encode failed for WatermakerInputSettingAndStatus-LowPressurePumpStatus: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.HighPressurePumpStatus)); err != nil {
+		return fmt.Errorf("encode failed for WatermakerInputSettingAndStatus-HighPressurePumpStatus: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.EmergencyStop)); err != nil {
+		return fmt.Errorf("encode failed for WatermakerInputSettingAndStatus-EmergencyStop: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.ProductSolenoidValveStatus)); err != nil {
+		return fmt.Errorf("encode failed for WatermakerInputSettingAndStatus-ProductSolenoidValveStatus: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.FlushModeStatus)); err != nil {
+		return fmt.Errorf("encode failed for WatermakerInputSettingAndStatus-FlushModeStatus: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.SalinityStatus)); err != nil {
+		return fmt.Errorf("encode failed for WatermakerInputSettingAndStatus-SalinityStatus: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.SensorStatus)); err != nil {
+		return fmt.Errorf("encode failed for WatermakerInputSettingAndStatus-SensorStatus: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.OilChangeIndicatorStatus)); err != nil {
+		return fmt.Errorf("encode failed for WatermakerInputSettingAndStatus-OilChangeIndicatorStatus: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.FilterStatus)); err != nil {
+		return fmt.Errorf("encode failed for WatermakerInputSettingAndStatus-FilterStatus: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.SystemStatus)); err != nil {
+		return fmt.Errorf("encode failed for WatermakerInputSettingAndStatus-SystemStatus: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeUInt16(16, val.Salinity); err != nil {
+		return fmt.Errorf("encode failed for WatermakerInputSettingAndStatus-Salinity: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.01, nullableUnitValue(val.ProductWaterTemperature, units.Kelvin, units.Temperature.Convert)); err != nil {
+		return fmt.Errorf("encode failed for WatermakerInputSettingAndStatus-ProductWaterTemperature: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 100, nullableUnitValue(val.PreFilterPressure, units.Pa, units.Pressure.Convert)); err != nil {
+		return fmt.Errorf("encode failed for WatermakerInputSettingAndStatus-PreFilterPressure: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 100, nullableUnitValue(val.PostFilterPressure, units.Pa, units.Pressure.Convert)); err != nil {
+		return fmt.Errorf("encode failed for WatermakerInputSettingAndStatus-PostFilterPressure: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 1000, nullableUnitValue(val.FeedPressure, units.Pa, units.Pressure.Convert)); err != nil {
+		return fmt.Errorf("encode failed for WatermakerInputSettingAndStatus-FeedPressure: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 1000, nullableUnitValue(val.SystemHighPressure, units.Pa, units.Pressure.Convert)); err != nil {
+		return fmt.Errorf("encode failed for WatermakerInputSettingAndStatus-SystemHighPressure: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.1, nullableUnitValue(val.ProductWaterFlow, units.LitersPerHour, units.Flow.Convert)); err != nil {
+		return fmt.Errorf("encode failed for WatermakerInputSettingAndStatus-ProductWaterFlow: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.1, nullableUnitValue(val.BrineWaterFlow, units.LitersPerHour, units.Flow.Convert)); err != nil {
+		return fmt.Errorf("encode failed for WatermakerInputSettingAndStatus-BrineWaterFlow: %w", err)
+	}
+	if err := stream.writeUInt32(32, val.RunTime); err != nil {
+		return fmt.Errorf("encode failed for WatermakerInputSettingAndStatus-RunTime: %w", err)
+	}
+	return nil
+}
 type SmallCraftStatus struct {
 	Info MessageInfo
 	PortTrimTab *int8
@@ -51097,6 +61810,20 @@ func DecodeSmallCraftStatus(Info MessageInfo, stream *PGNDataStream) (any, error
 		}	
 	return val, nil
 }
+func EncodeSmallCraftStatus(p any, stream *PGNWriter) error {
+	val, ok := p.(SmallCraftStatus)
+	if !ok {
+		return fmt.Errorf("EncodeSmallCraftStatus called with %T", p)
+	}
+	if err := stream.writeInt8(8, val.PortTrimTab); err != nil {
+		return fmt.Errorf("encode failed for SmallCraftStatus-PortTrimTab: %w", err)
+	}
+	if err := stream.writeInt8(8, val.StarboardTrimTab); err != nil {
+		return fmt.Errorf("encode failed for SmallCraftStatus-StarboardTrimTab: %w", err)
+	}
+	stream.writeReserved(48)
+	return nil
+}
 type VesselSpeedComponents struct {
 	Info MessageInfo
 	LongitudinalSpeedWaterReferenced *units.Velocity
@@ -51165,6 +61892,31 @@ func DecodeVesselSpeedComponents(Info MessageInfo, stream *PGNDataStream) (any,
 	}	
 	return val, nil
 }
+func EncodeVesselSpeedComponents(p any, stream *PGNWriter) error {
+	val, ok := p.(VesselSpeedComponents)
+	if !ok {
+		return fmt.Errorf("EncodeVesselSpeedComponents called with %T", p)
+	}
+	if err := stream.writeSignedResolution(16, 0.001, nullableUnitValue(val.LongitudinalSpeedWaterReferenced, units.MetersPerSecond, units.Velocity.Convert)); err != nil {
+		return fmt.Errorf("encode failed for VesselSpeedComponents-LongitudinalSpeedWaterReferenced: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.001, nullableUnitValue(val.TransverseSpeedWaterReferenced, units.MetersPerSecond, units.Velocity.Convert)); err != nil {
+		return fmt.Errorf("encode failed for VesselSpeedComponents-TransverseSpeedWaterReferenced: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.001, nullableUnitValue(val.LongitudinalSpeedGroundReferenced, units.MetersPerSecond, units.Velocity.Convert)); err != nil {
+		return fmt.Errorf("encode failed for VesselSpeedComponents-LongitudinalSpeedGroundReferenced: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.001, nullableUnitValue(val.TransverseSpeedGroundReferenced, units.MetersPerSecond, units.Velocity.Convert)); err != nil {
+		return fmt.Errorf("encode failed for VesselSpeedComponents-TransverseSpeedGroundReferenced: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.001, nullableUnitValue(val.SternSpeedWaterReferenced, units.MetersPerSecond, units.Velocity.Convert)); err != nil {
+		return fmt.Errorf("encode failed for VesselSpeedComponents-SternSpeedWaterReferenced: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.001, nullableUnitValue(val.SternSpeedGroundReferenced, units.MetersPerSecond, units.Velocity.Convert)); err != nil {
+		return fmt.Errorf("encode failed for VesselSpeedComponents-SternSpeedGroundReferenced: %w", err)
+	}
+	return nil
+}
 type SonichubZoneInfo struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -51240,6 +61992,30 @@ func DecodeSonichubZoneInfo(Info MessageInfo, stream *PGNDataStream) (any, error
 	}	
 	return val, nil
 }
+func EncodeSonichubZoneInfo(p any, stream *PGNWriter) error {
+	val, ok := p.(SonichubZoneInfo)
+	if !ok {
+		return fmt.Errorf("EncodeSonichubZoneInfo called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 275); err != nil {
+		return fmt.Errorf("encode failed for SonichubZoneInfo-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SonichubZoneInfo-IndustryCode: %w", err)
+	}
+	stream.writeReserved(8)
+	if err := stream.writeLookupField(8, 5); err != nil {
+		return fmt.Errorf("encode failed for SonichubZoneInfo-ProprietaryId: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Control)); err != nil {
+		return fmt.Errorf("encode failed for SonichubZoneInfo-Control: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Zone); err != nil {
+		return fmt.Errorf("encode failed for SonichubZoneInfo-Zone: %w", err)
+	}
+	return nil
+}
 type SonichubSource struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -51315,6 +62091,30 @@ func DecodeSonichubSource(Info MessageInfo, stream *PGNDataStream) (any, error)
 	}	
 	return val, nil
 }
+func EncodeSonichubSource(p any, stream *PGNWriter) error {
+	val, ok := p.(SonichubSource)
+	if !ok {
+		return fmt.Errorf("EncodeSonichubSource called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 275); err != nil {
+		return fmt.Errorf("encode failed for SonichubSource-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SonichubSource-IndustryCode: %w", err)
+	}
+	stream.writeReserved(8)
+	if err := stream.writeLookupField(8, 6); err != nil {
+		return fmt.Errorf("encode failed for SonichubSource-ProprietaryId: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Control)); err != nil {
+		return fmt.Errorf("encode failed for SonichubSource-Control: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Source)); err != nil {
+		return fmt.Errorf("encode failed for SonichubSource-Source: %w", err)
+	}
+	return nil
+}
 type SonichubSourceList struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -51410,6 +62210,36 @@ func DecodeSonichubSourceList(Info MessageInfo, stream *PGNDataStream) (any, err
 	}	
 	return val, nil
 }
+func EncodeSonichubSourceList(p any, stream *PGNWriter) error {
+	val, ok := p.(SonichubSourceList)
+	if !ok {
+		return fmt.Errorf("EncodeSonichubSourceList called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 275); err != nil {
+		return fmt.Errorf("encode failed for SonichubSourceList-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SonichubSourceList-IndustryCode: %w", err)
+	}
+	stream.writeReserved(8)
+	if err := stream.writeLookupField(8, 8); err != nil {
+		return fmt.Errorf("encode failed for SonichubSourceList-ProprietaryId: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Control)); err != nil {
+		return fmt.Errorf("encode failed for SonichubSourceList-Control: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.SourceId); err != nil {
+		return fmt.Errorf("encode failed for SonichubSourceList-SourceId: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.A); err != nil {
+		return fmt.Errorf("encode failed for SonichubSourceList-A: %w", err)
+	}
+	if err := stream.writeStringWithLength(val.Text); err != nil {
+		return fmt.Errorf("encode failed for SonichubSourceList-Text: %w", err)
+	}
+	return nil
+}
 type SonichubControl struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -51485,6 +62315,30 @@ func DecodeSonichubControl(Info MessageInfo, stream *PGNDataStream) (any, error)
 	}	
 	return val, nil
 }
+func EncodeSonichubControl(p any, stream *PGNWriter) error {
+	val, ok := p.(SonichubControl)
+	if !ok {
+		return fmt.Errorf("EncodeSonichubControl called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 275); err != nil {
+		return fmt.Errorf("encode failed for SonichubControl-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SonichubControl-IndustryCode: %w", err)
+	}
+	stream.writeReserved(8)
+	if err := stream.writeLookupField(8, 9); err != nil {
+		return fmt.Errorf("encode failed for SonichubControl-ProprietaryId: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Control)); err != nil {
+		return fmt.Errorf("encode failed for SonichubControl-Control: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Item)); err != nil {
+		return fmt.Errorf("encode failed for SonichubControl-Item: %w", err)
+	}
+	return nil
+}
 type SonichubFmRadio struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -51604,6 +62458,43 @@ func DecodeSonichubFmRadio(Info MessageInfo, stream *PGNDataStream) (any, error)
 	}	
 	return val, nil
 }
+func EncodeSonichubFmRadio(p any, stream *PGNWriter) error {
+	val, ok := p.(SonichubFmRadio)
+	if !ok {
+		return fmt.Errorf("EncodeSonichubFmRadio called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 275); err != nil {
+		return fmt.Errorf("encode failed for SonichubFmRadio-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SonichubFmRadio-IndustryCode: %w", err)
+	}
+	stream.writeReserved(8)
+	if err := stream.writeLookupField(8, 12); err != nil {
+		return fmt.Errorf("encode failed for SonichubFmRadio-ProprietaryId: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Control)); err != nil {
+		return fmt.Errorf("encode failed for SonichubFmRadio-Control: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Item)); err != nil {
+		return fmt.Errorf("encode failed for SonichubFmRadio-Item: %w", err)
+	}
+	if err := stream.writeUInt32(32, val.Frequency); err != nil {
+		return fmt.Errorf("encode failed for SonichubFmRadio-Frequency: %w", err)
+	}
+	if err := stream.writeUInt8(2, val.NoiseLevel); err != nil {
+		return fmt.Errorf("encode failed for SonichubFmRadio-NoiseLevel: %w", err)
+	}
+	if err := stream.writeUInt8(4, val.SignalLevel); err != nil {
+		return fmt.Errorf("encode failed for SonichubFmRadio-SignalLevel: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeStringWithLength(val.Text); err != nil {
+		return fmt.Errorf("encode failed for SonichubFmRadio-Text: %w", err)
+	}
+	return nil
+}
 type SonichubPlaylist struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -51729,6 +62620,45 @@ func DecodeSonichubPlaylist(Info MessageInfo, stream *PGNDataStream) (any, error
 	}	
 	return val, nil
 }
+func EncodeSonichubPlaylist(p any, stream *PGNWriter) error {
+	val, ok := p.(SonichubPlaylist)
+	if !ok {
+		return fmt.Errorf("EncodeSonichubPlaylist called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 275); err != nil {
+		return fmt.Errorf("encode failed for SonichubPlaylist-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SonichubPlaylist-IndustryCode: %w", err)
+	}
+	stream.writeReserved(8)
+	if err := stream.writeLookupField(8, 13); err != nil {
+		return fmt.Errorf("encode failed for SonichubPlaylist-ProprietaryId: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Control)); err != nil {
+		return fmt.Errorf("encode failed for SonichubPlaylist-Control: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Item)); err != nil {
+		return fmt.Errorf("encode failed for SonichubPlaylist-Item: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.A); err != nil {
+		return fmt.Errorf("encode failed for SonichubPlaylist-A: %w", err)
+	}
+	if err := stream.writeUInt32(32, val.CurrentTrack); err != nil {
+		return fmt.Errorf("encode failed for SonichubPlaylist-CurrentTrack: %w", err)
+	}
+	if err := stream.writeUInt32(32, val.Tracks); err != nil {
+		return fmt.Errorf("encode failed for SonichubPlaylist-Tracks: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(32, 0.001, val.Length); err != nil {
+		return fmt.Errorf("encode failed for SonichubPlaylist-Length: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(32, 0.001, val.PositionInTrack); err != nil {
+		return fmt.Errorf("encode failed for SonichubPlaylist-PositionInTrack: %w", err)
+	}
+	return nil
+}
 type SonichubTrack struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -51814,6 +62744,33 @@ func DecodeSonichubTrack(Info MessageInfo, stream *PGNDataStream) (any, error) {
 	}	
 	return val, nil
 }
+func EncodeSonichubTrack(p any, stream *PGNWriter) error {
+	val, ok := p.(SonichubTrack)
+	if !ok {
+		return fmt.Errorf("EncodeSonichubTrack called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 275); err != nil {
+		return fmt.Errorf("encode failed for SonichubTrack-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SonichubTrack-IndustryCode: %w", err)
+	}
+	stream.writeReserved(8)
+	if err := stream.writeLookupField(8, 14); err != nil {
+		return fmt.Errorf("encode failed for SonichubTrack-ProprietaryId: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Control)); err != nil {
+		return fmt.Errorf("encode failed for SonichubTrack-Control: %w", err)
+	}
+	if err := stream.writeUInt32(32, val.Item); err != nil {
+		return fmt.Errorf("encode failed for SonichubTrack-Item: %w", err)
+	}
+	if err := stream.writeStringWithLength(val.Text); err != nil {
+		return fmt.Errorf("encode failed for SonichubTrack-Text: %w", err)
+	}
+	return nil
+}
 type SonichubArtist struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -51899,6 +62856,33 @@ func DecodeSonichubArtist(Info MessageInfo, stream *PGNDataStream) (any, error)
 	}	
 	return val, nil
 }
+func EncodeSonichubArtist(p any, stream *PGNWriter) error {
+	val, ok := p.(SonichubArtist)
+	if !ok {
+		return fmt.Errorf("EncodeSonichubArtist called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 275); err != nil {
+		return fmt.Errorf("encode failed for SonichubArtist-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SonichubArtist-IndustryCode: %w", err)
+	}
+	stream.writeReserved(8)
+	if err := stream.writeLookupField(8, 15); err != nil {
+		return fmt.Errorf("encode failed for SonichubArtist-ProprietaryId: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Control)); err != nil {
+		return fmt.Errorf("encode failed for SonichubArtist-Control: %w", err)
+	}
+	if err := stream.writeUInt32(32, val.Item); err != nil {
+		return fmt.Errorf("encode failed for SonichubArtist-Item: %w", err)
+	}
+	if err := stream.writeStringWithLength(val.Text); err != nil {
+		return fmt.Errorf("encode failed for SonichubArtist-Text: %w", err)
+	}
+	return nil
+}
 type SonichubAlbum struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -51984,6 +62968,33 @@ func DecodeSonichubAlbum(Info MessageInfo, stream *PGNDataStream) (any, error) {
 	}	
 	return val, nil
 }
+func EncodeSonichubAlbum(p any, stream *PGNWriter) error {
+	val, ok := p.(SonichubAlbum)
+	if !ok {
+		return fmt.Errorf("EncodeSonichubAlbum called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 275); err != nil {
+		return fmt.Errorf("encode failed for SonichubAlbum-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SonichubAlbum-IndustryCode: %w", err)
+	}
+	stream.writeReserved(8)
+	if err := stream.writeLookupField(8, 16); err != nil {
+		return fmt.Errorf("encode failed for SonichubAlbum-ProprietaryId: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Control)); err != nil {
+		return fmt.Errorf("encode failed for SonichubAlbum-Control: %w", err)
+	}
+	if err := stream.writeUInt32(32, val.Item); err != nil {
+		return fmt.Errorf("encode failed for SonichubAlbum-Item: %w", err)
+	}
+	if err := stream.writeStringWithLength(val.Text); err != nil {
+		return fmt.Errorf("encode failed for SonichubAlbum-Text: %w", err)
+	}
+	return nil
+}
 type SonichubMenuItem struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -52099,6 +63110,42 @@ func DecodeSonichubMenuItem(Info MessageInfo, stream *PGNDataStream) (any, error
 	}	
 	return val, nil
 }
+func EncodeSonichubMenuItem(p any, stream *PGNWriter) error {
+	val, ok := p.(SonichubMenuItem)
+	if !ok {
+		return fmt.Errorf("EncodeSonichubMenuItem called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 275); err != nil {
+		return fmt.Errorf("encode failed for SonichubMenuItem-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SonichubMenuItem-IndustryCode: %w", err)
+	}
+	stream.writeReserved(8)
+	if err := stream.writeLookupField(8, 19); err != nil {
+		return fmt.Errorf("encode failed for SonichubMenuItem-ProprietaryId: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Control)); err != nil {
+		return fmt.Errorf("encode failed for SonichubMenuItem-Control: %w", err)
+	}
+	if err := stream.writeUInt32(32, val.Item); err != nil {
+		return fmt.Errorf("encode failed for SonichubMenuItem-Item: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.C); err != nil {
+		return fmt.Errorf("encode failed for SonichubMenuItem-C: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.D); err != nil {
+		return fmt.Errorf("encode failed for SonichubMenuItem-D: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.E); err != nil {
+		return fmt.Errorf("encode failed for SonichubMenuItem-E: %w", err)
+	}
+	if err := stream.writeStringWithLength(val.Text); err != nil {
+		return fmt.Errorf("encode failed for SonichubMenuItem-Text: %w", err)
+	}
+	return nil
+}
 type SonichubZones struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -52174,6 +63221,30 @@ func DecodeSonichubZones(Info MessageInfo, stream *PGNDataStream) (any, error) {
 	}	
 	return val, nil
 }
+func EncodeSonichubZones(p any, stream *PGNWriter) error {
+	val, ok := p.(SonichubZones)
+	if !ok {
+		return fmt.Errorf("EncodeSonichubZones called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 275); err != nil {
+		return fmt.Errorf("encode failed for SonichubZones-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SonichubZones-IndustryCode: %w", err)
+	}
+	stream.writeReserved(8)
+	if err := stream.writeLookupField(8, 20); err != nil {
+		return fmt.Errorf("encode failed for SonichubZones-ProprietaryId: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Control)); err != nil {
+		return fmt.Errorf("encode failed for SonichubZones-Control: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Zones); err != nil {
+		return fmt.Errorf("encode failed for SonichubZones-Zones: %w", err)
+	}
+	return nil
+}
 type SonichubMaxVolume struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -52259,6 +63330,33 @@ func DecodeSonichubMaxVolume(Info MessageInfo, stream *PGNDataStream) (any, erro
 	}	
 	return val, nil
 }
+func EncodeSonichubMaxVolume(p any, stream *PGNWriter) error {
+	val, ok := p.(SonichubMaxVolume)
+	if !ok {
+		return fmt.Errorf("EncodeSonichubMaxVolume called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 275); err != nil {
+		return fmt.Errorf("encode failed for SonichubMaxVolume-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SonichubMaxVolume-IndustryCode: %w", err)
+	}
+	stream.writeReserved(8)
+	if err := stream.writeLookupField(8, 23); err != nil {
+		return fmt.Errorf("encode failed for SonichubMaxVolume-ProprietaryId: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Control)); err != nil {
+		return fmt.Errorf("encode failed for SonichubMaxVolume-Control: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Zone); err != nil {
+		return fmt.Errorf("encode failed for SonichubMaxVolume-Zone: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Level); err != nil {
+		return fmt.Errorf("encode failed for SonichubMaxVolume-Level: %w", err)
+	}
+	return nil
+}
 type SonichubVolume struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -52344,6 +63442,33 @@ func DecodeSonichubVolume(Info MessageInfo, stream *PGNDataStream) (any, error)
 	}	
 	return val, nil
 }
+func EncodeSonichubVolume(p any, stream *PGNWriter) error {
+	val, ok := p.(SonichubVolume)
+	if !ok {
+		return fmt.Errorf("EncodeSonichubVolume called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 275); err != nil {
+		return fmt.Errorf("encode failed for SonichubVolume-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SonichubVolume-IndustryCode: %w", err)
+	}
+	stream.writeReserved(8)
+	if err := stream.writeLookupField(8, 24); err != nil {
+		return fmt.Errorf("encode failed for SonichubVolume-ProprietaryId: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Control)); err != nil {
+		return fmt.Errorf("encode failed for SonichubVolume-Control: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Zone); err != nil {
+		return fmt.Errorf("encode failed for SonichubVolume-Zone: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Level); err != nil {
+		return fmt.Errorf("encode failed for SonichubVolume-Level: %w", err)
+	}
+	return nil
+}
 type SonichubInit1 struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -52409,6 +63534,27 @@ func DecodeSonichubInit1(Info MessageInfo, stream *PGNDataStream) (any, error) {
 	}	
 	return val, nil
 }
+func EncodeSonichubInit1(p any, stream *PGNWriter) error {
+	val, ok := p.(SonichubInit1)
+	if !ok {
+		return fmt.Errorf("EncodeSonichubInit1 called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 275); err != nil {
+		return fmt.Errorf("encode failed for SonichubInit1-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SonichubInit1-IndustryCode: %w", err)
+	}
+	stream.writeReserved(8)
+	if err := stream.writeLookupField(8, 25); err != nil {
+		return fmt.Errorf("encode failed for SonichubInit1-ProprietaryId: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Control)); err != nil {
+		return fmt.Errorf("encode failed for SonichubInit1-Control: %w", err)
+	}
+	return nil
+}
 type SonichubPosition struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -52484,6 +63630,30 @@ func DecodeSonichubPosition(Info MessageInfo, stream *PGNDataStream) (any, error
 	}	
 	return val, nil
 }
+func EncodeSonichubPosition(p any, stream *PGNWriter) error {
+	val, ok := p.(SonichubPosition)
+	if !ok {
+		return fmt.Errorf("EncodeSonichubPosition called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 275); err != nil {
+		return fmt.Errorf("encode failed for SonichubPosition-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SonichubPosition-IndustryCode: %w", err)
+	}
+	stream.writeReserved(8)
+	if err := stream.writeLookupField(8, 48); err != nil {
+		return fmt.Errorf("encode failed for SonichubPosition-ProprietaryId: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Control)); err != nil {
+		return fmt.Errorf("encode failed for SonichubPosition-Control: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(32, 0.001, val.Position); err != nil {
+		return fmt.Errorf("encode failed for SonichubPosition-Position: %w", err)
+	}
+	return nil
+}
 type SimradTextMessage struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -52599,6 +63769,42 @@ func DecodeSimradTextMessage(Info MessageInfo, stream *PGNDataStream) (any, erro
 	}	
 	return val, nil
 }
+func EncodeSimradTextMessage(p any, stream *PGNWriter) error {
+	val, ok := p.(SimradTextMessage)
+	if !ok {
+		return fmt.Errorf("EncodeSimradTextMessage called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 1857); err != nil {
+		return fmt.Errorf("encode failed for SimradTextMessage-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SimradTextMessage-IndustryCode: %w", err)
+	}
+	stream.writeReserved(8)
+	if err := stream.writeLookupField(8, 50); err != nil {
+		return fmt.Errorf("encode failed for SimradTextMessage-ProprietaryId: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.A); err != nil {
+		return fmt.Errorf("encode failed for SimradTextMessage-A: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.B); err != nil {
+		return fmt.Errorf("encode failed for SimradTextMessage-B: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.C); err != nil {
+		return fmt.Errorf("encode failed for SimradTextMessage-C: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for SimradTextMessage-Sid: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Prio); err != nil {
+		return fmt.Errorf("encode failed for SimradTextMessage-Prio: %w", err)
+	}
+	if err := stream.writeFixedString(256, val.Text); err != nil {
+		return fmt.Errorf("encode failed for SimradTextMessage-Text: %w", err)
+	}
+	return nil
+}
 type NavicoProductInformation struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -52717,6 +63923,44 @@ func DecodeNavicoProductInformation(Info MessageInfo, stream *PGNDataStream) (an
 	}	
 	return val, nil
 }
+func EncodeNavicoProductInformation(p any, stream *PGNWriter) error {
+	val, ok := p.(NavicoProductInformation)
+	if !ok {
+		return fmt.Errorf("EncodeNavicoProductInformation called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 275); err != nil {
+		return fmt.Errorf("encode failed for NavicoProductInformation-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for NavicoProductInformation-IndustryCode: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.ProductCode); err != nil {
+		return fmt.Errorf("encode failed for NavicoProductInformation-ProductCode: %w", err)
+	}
+	if err := stream.writeFixedString(256, val.Model); err != nil {
+		return fmt.Errorf("encode failed for NavicoProductInformation-Model: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.A); err != nil {
+		return fmt.Errorf("encode failed for NavicoProductInformation-A: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.B); err != nil {
+		return fmt.Errorf("encode failed for NavicoProductInformation-B: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.C); err != nil {
+		return fmt.Errorf("encode failed for NavicoProductInformation-C: %w", err)
+	}
+	if err := stream.writeFixedString(80, val.FirmwareVersion); err != nil {
+		return fmt.Errorf("encode failed for NavicoProductInformation-FirmwareVersion: %w", err)
+	}
+	if err := stream.writeFixedString(256, val.FirmwareDate); err != nil {
+		return fmt.Errorf("encode failed for NavicoProductInformation-FirmwareDate: %w", err)
+	}
+	if err := stream.writeFixedString(256, val.FirmwareTime); err != nil {
+		return fmt.Errorf("encode failed for NavicoProductInformation-FirmwareTime: %w", err)
+	}
+	return nil
+}
 type LowranceProductInformation struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -52835,6 +64079,44 @@ func DecodeLowranceProductInformation(Info MessageInfo, stream *PGNDataStream) (
 	}	
 	return val, nil
 }
+func EncodeLowranceProductInformation(p any, stream *PGNWriter) error {
+	val, ok := p.(LowranceProductInformation)
+	if !ok {
+		return fmt.Errorf("EncodeLowranceProductInformation called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 140); err != nil {
+		return fmt.Errorf("encode failed for LowranceProductInformation-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for LowranceProductInformation-IndustryCode: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.ProductCode); err != nil {
+		return fmt.Errorf("encode failed for LowranceProductInformation-ProductCode: %w", err)
+	}
+	if err := stream.writeFixedString(256, val.Model); err != nil {
+		return fmt.Errorf("encode failed for LowranceProductInformation-Model: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.A); err != nil {
+		return fmt.Errorf("encode failed for LowranceProductInformation-A: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.B); err != nil {
+		return fmt.Errorf("encode failed for LowranceProductInformation-B: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.C); err != nil {
+		return fmt.Errorf("encode failed for LowranceProductInformation-C: %w", err)
+	}
+	if err := stream.writeFixedString(80, val.FirmwareVersion); err != nil {
+		return fmt.Errorf("encode failed for LowranceProductInformation-FirmwareVersion: %w", err)
+	}
+	if err := stream.writeFixedString(256, val.FirmwareDate); err != nil {
+		return fmt.Errorf("encode failed for LowranceProductInformation-FirmwareDate: %w", err)
+	}
+	if err := stream.writeFixedString(256, val.FirmwareTime); err != nil {
+		return fmt.Errorf("encode failed for LowranceProductInformation-FirmwareTime: %w", err)
+	}
+	return nil
+}
 type SimnetReprogramData struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -52903,6 +64185,29 @@ func DecodeSimnetReprogramData(Info MessageInfo, stream *PGNDataStream) (any, er
 	}	
 	return val, nil
 }
+func EncodeSimnetReprogramData(p any, stream *PGNWriter) error {
+	val, ok := p.(SimnetReprogramData)
+	if !ok {
+		return fmt.Errorf("EncodeSimnetReprogramData called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 1857); err != nil {
+		return fmt.Errorf("encode failed for SimnetReprogramData-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SimnetReprogramData-IndustryCode: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.Version); err != nil {
+		return fmt.Errorf("encode failed for SimnetReprogramData-Version: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.Sequence); err != nil {
+		return fmt.Errorf("encode failed for SimnetReprogramData-Sequence: %w", err)
+	}
+	if err := stream.writeBinaryData(1736, val.Data); err != nil {
+		return fmt.Errorf("encode failed for SimnetReprogramData-Data: %w", err)
+	}
+	return nil
+}
 type FurunoUnknown130820 struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -52991,6 +64296,35 @@ func DecodeFurunoUnknown130820(Info MessageInfo, stream *PGNDataStream) (any, er
 	}	
 	return val, nil
 }
+func EncodeFurunoUnknown130820(p any, stream *PGNWriter) error {
+	val, ok := p.(FurunoUnknown130820)
+	if !ok {
+		return fmt.Errorf("EncodeFurunoUnknown130820 called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 1855); err != nil {
+		return fmt.Errorf("encode failed for FurunoUnknown130820-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for FurunoUnknown130820-IndustryCode: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.A); err != nil {
+		return fmt.Errorf("encode failed for FurunoUnknown130820-A: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.B); err != nil {
+		return fmt.Errorf("encode failed for FurunoUnknown130820-B: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.C); err != nil {
+		return fmt.Errorf("encode failed for FurunoUnknown130820-C: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.D); err != nil {
+		return fmt.Errorf("encode failed for FurunoUnknown130820-D: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.E); err != nil {
+		return fmt.Errorf("encode failed for FurunoUnknown130820-E: %w", err)
+	}
+	return nil
+}
 type FusionSourceName struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -53102,6 +64436,41 @@ func DecodeFusionSourceName(Info MessageInfo, stream *PGNDataStream) (any, error
 	}	
 	return val, nil
 }
+func EncodeFusionSourceName(p any, stream *PGNWriter) error {
+	val, ok := p.(FusionSourceName)
+	if !ok {
+		return fmt.Errorf("EncodeFusionSourceName called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 419); err != nil {
+		return fmt.Errorf("encode failed for FusionSourceName-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for FusionSourceName-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(8, 2); err != nil {
+		return fmt.Errorf("encode failed for FusionSourceName-MessageId: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.A); err != nil {
+		return fmt.Errorf("encode failed for FusionSourceName-A: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.SourceId); err != nil {
+		return fmt.Errorf("encode failed for FusionSourceName-SourceId: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.CurrentSourceId); err != nil {
+		return fmt.Errorf("encode failed for FusionSourceName-CurrentSourceId: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.D); err != nil {
+		return fmt.Errorf("encode failed for FusionSourceName-D: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.E); err != nil {
+		return fmt.Errorf("encode failed for FusionSourceName-E: %w", err)
+	}
+	if err := stream.writeStringWithLength(val.Source); err != nil {
+		return fmt.Errorf("encode failed for FusionSourceName-Source: %w", err)
+	}
+	return nil
+}
 type FusionTrackInfo struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -53263,6 +64632,56 @@ func DecodeFusionTrackInfo(Info MessageInfo, stream *PGNDataStream) (any, error)
 	}	
 	return val, nil
 }
+func EncodeFusionTrackInfo(p any, stream *PGNWriter) error {
+	val, ok := p.(FusionTrackInfo)
+	if !ok {
+		return fmt.Errorf("EncodeFusionTrackInfo called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 419); err != nil {
+		return fmt.Errorf("encode failed for FusionTrackInfo-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for FusionTrackInfo-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(8, 4); err != nil {
+		return fmt.Errorf("encode failed for FusionTrackInfo-MessageId: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.A); err != nil {
+		return fmt.Errorf("encode failed for FusionTrackInfo-A: %w", err)
+	}
+	if err := stream.writeLookupField(4, uint64(val.Transport)); err != nil {
+		return fmt.Errorf("encode failed for FusionTrackInfo-Transport: %w", err)
+	}
+	if err := stream.writeUInt8(4, val.X); err != nil {
+		return fmt.Errorf("encode failed for FusionTrackInfo-X: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.B); err != nil {
+		return fmt.Errorf("encode failed for FusionTrackInfo-B: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.Track); err != nil {
+		return fmt.Errorf("encode failed for FusionTrackInfo-Track: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.C); err != nil {
+		return fmt.Errorf("encode failed for FusionTrackInfo-C: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.TrackCount); err != nil {
+		return fmt.Errorf("encode failed for FusionTrackInfo-TrackCount: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.E); err != nil {
+		return fmt.Errorf("encode failed for FusionTrackInfo-E: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(24, 0.001, val.Length); err != nil {
+		return fmt.Errorf("encode failed for FusionTrackInfo-Length: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(24, 0.001, val.PositionInTrack); err != nil {
+		return fmt.Errorf("encode failed for FusionTrackInfo-PositionInTrack: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.H); err != nil {
+		return fmt.Errorf("encode failed for FusionTrackInfo-H: %w", err)
+	}
+	return nil
+}
 type FusionTrack struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -53344,6 +64763,32 @@ func DecodeFusionTrack(Info MessageInfo, stream *PGNDataStream) (any, error) {
 	}	
 	return val, nil
 }
+func EncodeFusionTrack(p any, stream *PGNWriter) error {
+	val, ok := p.(FusionTrack)
+	if !ok {
+		return fmt.Errorf("EncodeFusionTrack called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 419); err != nil {
+		return fmt.Errorf("encode failed for FusionTrack-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for FusionTrack-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(8, 5); err != nil {
+		return fmt.Errorf("encode failed for FusionTrack-MessageId: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.A); err != nil {
+		return fmt.Errorf("encode failed for FusionTrack-A: %w", err)
+	}
+	if err := stream.writeUInt64(40, val.B); err != nil {
+		return fmt.Errorf("encode failed for FusionTrack-B: %w", err)
+	}
+	if err := stream.writeStringWithLength(val.Track); err != nil {
+		return fmt.Errorf("encode failed for FusionTrack-Track: %w", err)
+	}
+	return nil
+}
 type FusionArtist struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -53425,6 +64870,32 @@ func DecodeFusionArtist(Info MessageInfo, stream *PGNDataStream) (any, error) {
 	}	
 	return val, nil
 }
+func EncodeFusionArtist(p any, stream *PGNWriter) error {
+	val, ok := p.(FusionArtist)
+	if !ok {
+		return fmt.Errorf("EncodeFusionArtist called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 419); err != nil {
+		return fmt.Errorf("encode failed for FusionArtist-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for FusionArtist-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(8, 6); err != nil {
+		return fmt.Errorf("encode failed for FusionArtist-MessageId: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.A); err != nil {
+		return fmt.Errorf("encode failed for FusionArtist-A: %w", err)
+	}
+	if err := stream.writeUInt64(40, val.B); err != nil {
+		return fmt.Errorf("encode failed for FusionArtist-B: %w", err)
+	}
+	if err := stream.writeStringWithLength(val.Artist); err != nil {
+		return fmt.Errorf("encode failed for FusionArtist-Artist: %w", err)
+	}
+	return nil
+}
 type FusionAlbum struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -53506,6 +64977,32 @@ func DecodeFusionAlbum(Info MessageInfo, stream *PGNDataStream) (any, error) {
 	}	
 	return val, nil
 }
+func EncodeFusionAlbum(p any, stream *PGNWriter) error {
+	val, ok := p.(FusionAlbum)
+	if !ok {
+		return fmt.Errorf("EncodeFusionAlbum called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 419); err != nil {
+		return fmt.Errorf("encode failed for FusionAlbum-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for FusionAlbum-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(8, 7); err != nil {
+		return fmt.Errorf("encode failed for FusionAlbum-MessageId: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.A); err != nil {
+		return fmt.Errorf("encode failed for FusionAlbum-A: %w", err)
+	}
+	if err := stream.writeUInt64(40, val.B); err != nil {
+		return fmt.Errorf("encode failed for FusionAlbum-B: %w", err)
+	}
+	if err := stream.writeStringWithLength(val.Album); err != nil {
+		return fmt.Errorf("encode failed for FusionAlbum-Album: %w", err)
+	}
+	return nil
+}
 type FusionUnitName struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -53577,6 +65074,29 @@ func DecodeFusionUnitName(Info MessageInfo, stream *PGNDataStream) (any, error)
 	}	
 	return val, nil
 }
+func EncodeFusionUnitName(p any, stream *PGNWriter) error {
+	val, ok := p.(FusionUnitName)
+	if !ok {
+		return fmt.Errorf("EncodeFusionUnitName called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 419); err != nil {
+		return fmt.Errorf("encode failed for FusionUnitName-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for FusionUnitName-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(8, 33); err != nil {
+		return fmt.Errorf("encode failed for FusionUnitName-MessageId: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.A); err != nil {
+		return fmt.Errorf("encode failed for FusionUnitName-A: %w", err)
+	}
+	if err := stream.writeStringWithLength(val.Name); err != nil {
+		return fmt.Errorf("encode failed for FusionUnitName-Name: %w", err)
+	}
+	return nil
+}
 type FusionZoneName struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -53658,6 +65178,32 @@ func DecodeFusionZoneName(Info MessageInfo, stream *PGNDataStream) (any, error)
 	}	
 	return val, nil
 }
+func EncodeFusionZoneName(p any, stream *PGNWriter) error {
+	val, ok := p.(FusionZoneName)
+	if !ok {
+		return fmt.Errorf("EncodeFusionZoneName called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 419); err != nil {
+		return fmt.Errorf("encode failed for FusionZoneName-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for FusionZoneName-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(8, 45); err != nil {
+		return fmt.Errorf("encode failed for FusionZoneName-MessageId: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.A); err != nil {
+		return fmt.Errorf("encode failed for FusionZoneName-A: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Number); err != nil {
+		return fmt.Errorf("encode failed for FusionZoneName-Number: %w", err)
+	}
+	if err := stream.writeStringWithLength(val.Name); err != nil {
+		return fmt.Errorf("encode failed for FusionZoneName-Name: %w", err)
+	}
+	return nil
+}
 type FusionPlayProgress struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -53739,6 +65285,32 @@ func DecodeFusionPlayProgress(Info MessageInfo, stream *PGNDataStream) (any, err
 	}	
 	return val, nil
 }
+func EncodeFusionPlayProgress(p any, stream *PGNWriter) error {
+	val, ok := p.(FusionPlayProgress)
+	if !ok {
+		return fmt.Errorf("EncodeFusionPlayProgress called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 419); err != nil {
+		return fmt.Errorf("encode failed for FusionPlayProgress-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for FusionPlayProgress-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(8, 9); err != nil {
+		return fmt.Errorf("encode failed for FusionPlayProgress-MessageId: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.A); err != nil {
+		return fmt.Errorf("encode failed for FusionPlayProgress-A: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.B); err != nil {
+		return fmt.Errorf("encode failed for FusionPlayProgress-B: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(24, 0.001, val.Progress); err != nil {
+		return fmt.Errorf("encode failed for FusionPlayProgress-Progress: %w", err)
+	}
+	return nil
+}
 type FusionAmFmStation struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -53850,6 +65422,41 @@ func DecodeFusionAmFmStation(Info MessageInfo, stream *PGNDataStream) (any, erro
 	}	
 	return val, nil
 }
+func EncodeFusionAmFmStation(p any, stream *PGNWriter) error {
+	val, ok := p.(FusionAmFmStation)
+	if !ok {
+		return fmt.Errorf("EncodeFusionAmFmStation called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 419); err != nil {
+		return fmt.Errorf("encode failed for FusionAmFmStation-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for FusionAmFmStation-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(8, 11); err != nil {
+		return fmt.Errorf("encode failed for FusionAmFmStation-MessageId: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.A); err != nil {
+		return fmt.Errorf("encode failed for FusionAmFmStation-A: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.AmFm)); err != nil {
+		return fmt.Errorf("encode failed for FusionAmFmStation-AmFm: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.B); err != nil {
+		return fmt.Errorf("encode failed for FusionAmFmStation-B: %w", err)
+	}
+	if err := stream.writeUInt32(32, val.Frequency); err != nil {
+		return fmt.Errorf("encode failed for FusionAmFmStation-Frequency: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.C); err != nil {
+		return fmt.Errorf("encode failed for FusionAmFmStation-C: %w", err)
+	}
+	if err := stream.writeStringWithLength(val.Track); err != nil {
+		return fmt.Errorf("encode failed for FusionAmFmStation-Track: %w", err)
+	}
+	return nil
+}
 type FusionVhf struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -53941,6 +65548,35 @@ func DecodeFusionVhf(Info MessageInfo, stream *PGNDataStream) (any, error) {
 	}	
 	return val, nil
 }
+func EncodeFusionVhf(p any, stream *PGNWriter) error {
+	val, ok := p.(FusionVhf)
+	if !ok {
+		return fmt.Errorf("EncodeFusionVhf called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 419); err != nil {
+		return fmt.Errorf("encode failed for FusionVhf-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for FusionVhf-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(8, 12); err != nil {
+		return fmt.Errorf("encode failed for FusionVhf-MessageId: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.A); err != nil {
+		return fmt.Errorf("encode failed for FusionVhf-A: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.B); err != nil {
+		return fmt.Errorf("encode failed for FusionVhf-B: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Channel); err != nil {
+		return fmt.Errorf("encode failed for FusionVhf-Channel: %w", err)
+	}
+	if err := stream.writeUInt32(24, val.D); err != nil {
+		return fmt.Errorf("encode failed for FusionVhf-D: %w", err)
+	}
+	return nil
+}
 type FusionSquelch struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -54022,6 +65658,32 @@ func DecodeFusionSquelch(Info MessageInfo, stream *PGNDataStream) (any, error) {
 	}	
 	return val, nil
 }
+func EncodeFusionSquelch(p any, stream *PGNWriter) error {
+	val, ok := p.(FusionSquelch)
+	if !ok {
+		return fmt.Errorf("EncodeFusionSquelch called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 419); err != nil {
+		return fmt.Errorf("encode failed for FusionSquelch-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for FusionSquelch-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(8, 13); err != nil {
+		return fmt.Errorf("encode failed for FusionSquelch-MessageId: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.A); err != nil {
+		return fmt.Errorf("encode failed for FusionSquelch-A: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.B); err != nil {
+		return fmt.Errorf("encode failed for FusionSquelch-B: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Squelch); err != nil {
+		return fmt.Errorf("encode failed for FusionSquelch-Squelch: %w", err)
+	}
+	return nil
+}
 type FusionScan struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -54113,6 +65775,35 @@ func DecodeFusionScan(Info MessageInfo, stream *PGNDataStream) (any, error) {
 	}	
 	return val, nil
 }
+func EncodeFusionScan(p any, stream *PGNWriter) error {
+	val, ok := p.(FusionScan)
+	if !ok {
+		return fmt.Errorf("EncodeFusionScan called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 419); err != nil {
+		return fmt.Errorf("encode failed for FusionScan-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for FusionScan-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(8, 14); err != nil {
+		return fmt.Errorf("encode failed for FusionScan-MessageId: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.A); err != nil {
+		return fmt.Errorf("encode failed for FusionScan-A: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.B); err != nil {
+		return fmt.Errorf("encode failed for FusionScan-B: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.Scan)); err != nil {
+		return fmt.Errorf("encode failed for FusionScan-Scan: %w", err)
+	}
+	if err := stream.writeUInt8(6, val.C); err != nil {
+		return fmt.Errorf("encode failed for FusionScan-C: %w", err)
+	}
+	return nil
+}
 type FusionMenuItem struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -54254,6 +65945,50 @@ func DecodeFusionMenuItem(Info MessageInfo, stream *PGNDataStream) (any, error)
 	}	
 	return val, nil
 }
+func EncodeFusionMenuItem(p any, stream *PGNWriter) error {
+	val, ok := p.(FusionMenuItem)
+	if !ok {
+		return fmt.Errorf("EncodeFusionMenuItem called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 419); err != nil {
+		return fmt.Errorf("encode failed for FusionMenuItem-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for FusionMenuItem-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(8, 17); err != nil {
+		return fmt.Errorf("encode failed for FusionMenuItem-MessageId: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.A); err != nil {
+		return fmt.Errorf("encode failed for FusionMenuItem-A: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.B); err != nil {
+		return fmt.Errorf("encode failed for FusionMenuItem-B: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Line); err != nil {
+		return fmt.Errorf("encode failed for FusionMenuItem-Line: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.E); err != nil {
+		return fmt.Errorf("encode failed for FusionMenuItem-E: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.F); err != nil {
+		return fmt.Errorf("encode failed for FusionMenuItem-F: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.G); err != nil {
+		return fmt.Errorf("encode failed for FusionMenuItem-G: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.H); err != nil {
+		return fmt.Errorf("encode failed for FusionMenuItem-H: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.I); err != nil {
+		return fmt.Errorf("encode failed for FusionMenuItem-I: %w", err)
+	}
+	if err := stream.writeStringWithLength(val.Text); err != nil {
+		return fmt.Errorf("encode failed for FusionMenuItem-Text: %w", err)
+	}
+	return nil
+}
 type FusionReplay struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -54395,6 +66130,50 @@ func DecodeFusionReplay(Info MessageInfo, stream *PGNDataStream) (any, error) {
 	}	
 	return val, nil
 }
+func EncodeFusionReplay(p any, stream *PGNWriter) error {
+	val, ok := p.(FusionReplay)
+	if !ok {
+		return fmt.Errorf("EncodeFusionReplay called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 419); err != nil {
+		return fmt.Errorf("encode failed for FusionReplay-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for FusionReplay-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(8, 20); err != nil {
+		return fmt.Errorf("encode failed for FusionReplay-MessageId: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.A); err != nil {
+		return fmt.Errorf("encode failed for FusionReplay-A: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Mode)); err != nil {
+		return fmt.Errorf("encode failed for FusionReplay-Mode: %w", err)
+	}
+	if err := stream.writeUInt32(24, val.C); err != nil {
+		return fmt.Errorf("encode failed for FusionReplay-C: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.D); err != nil {
+		return fmt.Errorf("encode failed for FusionReplay-D: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.E); err != nil {
+		return fmt.Errorf("encode failed for FusionReplay-E: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Status)); err != nil {
+		return fmt.Errorf("encode failed for FusionReplay-Status: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.H); err != nil {
+		return fmt.Errorf("encode failed for FusionReplay-H: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.I); err != nil {
+		return fmt.Errorf("encode failed for FusionReplay-I: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.J); err != nil {
+		return fmt.Errorf("encode failed for FusionReplay-J: %w", err)
+	}
+	return nil
+}
 type FusionMute struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -54466,6 +66245,29 @@ func DecodeFusionMute(Info MessageInfo, stream *PGNDataStream) (any, error) {
 	}	
 	return val, nil
 }
+func EncodeFusionMute(p any, stream *PGNWriter) error {
+	val, ok := p.(FusionMute)
+	if !ok {
+		return fmt.Errorf("EncodeFusionMute called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 419); err != nil {
+		return fmt.Errorf("encode failed for FusionMute-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for FusionMute-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(8, 23); err != nil {
+		return fmt.Errorf("encode failed for FusionMute-MessageId: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.A); err != nil {
+		return fmt.Errorf("encode failed for FusionMute-A: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Mute)); err != nil {
+		return fmt.Errorf("encode failed for FusionMute-Mute: %w", err)
+	}
+	return nil
+}
 type FusionSubVolume struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -54567,6 +66369,38 @@ func DecodeFusionSubVolume(Info MessageInfo, stream *PGNDataStream) (any, error)
 	}	
 	return val, nil
 }
+func EncodeFusionSubVolume(p any, stream *PGNWriter) error {
+	val, ok := p.(FusionSubVolume)
+	if !ok {
+		return fmt.Errorf("EncodeFusionSubVolume called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 419); err != nil {
+		return fmt.Errorf("encode failed for FusionSubVolume-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for FusionSubVolume-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(8, 26); err != nil {
+		return fmt.Errorf("encode failed for FusionSubVolume-MessageId: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.A); err != nil {
+		return fmt.Errorf("encode failed for FusionSubVolume-A: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Zone1); err != nil {
+		return fmt.Errorf("encode failed for FusionSubVolume-Zone1: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Zone2); err != nil {
+		return fmt.Errorf("encode failed for FusionSubVolume-Zone2: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Zone3); err != nil {
+		return fmt.Errorf("encode failed for FusionSubVolume-Zone3: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Zone4); err != nil {
+		return fmt.Errorf("encode failed for FusionSubVolume-Zone4: %w", err)
+	}
+	return nil
+}
 type NavicoAsciiData struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -54625,6 +66459,26 @@ func DecodeNavicoAsciiData(Info MessageInfo, stream *PGNDataStream) (any, error)
 	}	
 	return val, nil
 }
+func EncodeNavicoAsciiData(p any, stream *PGNWriter) error {
+	val, ok := p.(NavicoAsciiData)
+	if !ok {
+		return fmt.Errorf("EncodeNavicoAsciiData called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 275); err != nil {
+		return fmt.Errorf("encode failed for NavicoAsciiData-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for NavicoAsciiData-IndustryCode: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.A); err != nil {
+		return fmt.Errorf("encode failed for NavicoAsciiData-A: %w", err)
+	}
+	if err := stream.writeFixedString(2048, val.Message); err != nil {
+		return fmt.Errorf("encode failed for NavicoAsciiData-Message: %w", err)
+	}
+	return nil
+}
 type FurunoUnknown130821 struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -54763,6 +66617,50 @@ func DecodeFurunoUnknown130821(Info MessageInfo, stream *PGNDataStream) (any, er
 	}	
 	return val, nil
 }
+func EncodeFurunoUnknown130821(p any, stream *PGNWriter) error {
+	val, ok := p.(FurunoUnknown130821)
+	if !ok {
+		return fmt.Errorf("EncodeFurunoUnknown130821 called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 1855); err != nil {
+		return fmt.Errorf("encode failed for FurunoUnknown130821-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for FurunoUnknown130821-IndustryCode: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for FurunoUnknown130821-Sid: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.A); err != nil {
+		return fmt.Errorf("encode failed for FurunoUnknown130821-A: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.B); err != nil {
+		return fmt.Errorf("encode failed for FurunoUnknown130821-B: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.C); err != nil {
+		return fmt.Errorf("encode failed for FurunoUnknown130821-C: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.D); err != nil {
+		return fmt.Errorf("encode failed for FurunoUnknown130821-D: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.E); err != nil {
+		return fmt.Errorf("encode failed for FurunoUnknown130821-E: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.F); err != nil {
+		return fmt.Errorf("encode failed for FurunoUnknown130821-F: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.G); err != nil {
+		return fmt.Errorf("encode failed for FurunoUnknown130821-G: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.H); err != nil {
+		return fmt.Errorf("encode failed for FurunoUnknown130821-H: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.I); err != nil {
+		return fmt.Errorf("encode failed for FurunoUnknown130821-I: %w", err)
+	}
+	return nil
+}
 type NavicoUnknown1 struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -54811,6 +66709,23 @@ func DecodeNavicoUnknown1(Info MessageInfo, stream *PGNDataStream) (any, error)
 	}	
 	return val, nil
 }
+func EncodeNavicoUnknown1(p any, stream *PGNWriter) error {
+	val, ok := p.(NavicoUnknown1)
+	if !ok {
+		return fmt.Errorf("EncodeNavicoUnknown1 called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 275); err != nil {
+		return fmt.Errorf("encode failed for NavicoUnknown1-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for NavicoUnknown1-IndustryCode: %w", err)
+	}
+	if err := stream.writeBinaryData(1848, val.Data); err != nil {
+		return fmt.Errorf("encode failed for NavicoUnknown1-Data: %w", err)
+	}
+	return nil
+}
 type MaretronProprietaryTemperatureHighRange struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -54899,6 +66814,35 @@ func DecodeMaretronProprietaryTemperatureHighRange(Info MessageInfo, stream *PGN
 	}	
 	return val, nil
 }
+func EncodeMaretronProprietaryTemperatureHighRange(p any, stream *PGNWriter) error {
+	val, ok := p.(MaretronProprietaryTemperatureHighRange)
+	if !ok {
+		return fmt.Errorf("EncodeMaretronProprietaryTemperatureHighRange called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 137); err != nil {
+		return fmt.Errorf("encode failed for MaretronProprietaryTemperatureHighRange-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for MaretronProprietaryTemperatureHighRange-IndustryCode: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Sid); err != nil {
+		return fmt.Errorf("encode failed for MaretronProprietaryTemperatureHighRange-Sid: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Instance); err != nil {
+		return fmt.Errorf("encode failed for MaretronProprietaryTemperatureHighRange-Instance: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Source)); err != nil {
+		return fmt.Errorf("encode failed for MaretronProprietaryTemperatureHighRange-Source: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.1, nullableUnitValue(val.ActualTemperature, units.Kelvin, units.Temperature.Convert)); err != nil {
+		return fmt.Errorf("encode failed for MaretronProprietaryTemperatureHighRange-ActualTemperature: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.1, nullableUnitValue(val.SetTemperature, units.Kelvin, units.Temperature.Convert)); err != nil {
+		return fmt.Errorf("encode failed for MaretronProprietaryTemperatureHighRange-SetTemperature: %w", err)
+	}
+	return nil
+}
 type BGKeyValueData struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -54981,6 +66925,35 @@ func DecodeBGKeyValueData(Info MessageInfo, stream *PGNDataStream) (any, error)
 	}	
 	return val, nil
 }
+func EncodeBGKeyValueData(p any, stream *PGNWriter) error {
+	val, ok := p.(BGKeyValueData)
+	if !ok {
+		return fmt.Errorf("EncodeBGKeyValueData called with %T", p)
+	}
+		var valueLength uint16
+	if err := stream.writeLookupField(11, 381); err != nil {
+		return fmt.Errorf("encode failed for BGKeyValueData-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for BGKeyValueData-IndustryCode: %w", err)
+	}
+	for _, rep := range val.Repeating1 {
+		if err := stream.writeLookupField(12, uint64(rep.Key)); err != nil {
+			return fmt.Errorf("encode failed for BGKeyValueData-Key: %w", err)
+		}
+		if err := stream.writeUInt8(4, rep.Length); err != nil {
+			return fmt.Errorf("encode failed for BGKeyValueData-Length: %w", err)
+		}
+		if rep.Length != nil {
+			valueLength = uint16(*rep.Length) * 8
+		}
+		if err := stream.writeBinaryData(valueLength, rep.Value); err != nil {
+			return fmt.Errorf("encode failed for BGKeyValueData-Value: %w", err)
+		}
+	}
+	return nil
+}
 type MaretronAnnunciator struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -55069,6 +67042,35 @@ func DecodeMaretronAnnunciator(Info MessageInfo, stream *PGNDataStream) (any, er
 	}	
 	return val, nil
 }
+func EncodeMaretronAnnunciator(p any, stream *PGNWriter) error {
+	val, ok := p.(MaretronAnnunciator)
+	if !ok {
+		return fmt.Errorf("EncodeMaretronAnnunciator called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 137); err != nil {
+		return fmt.Errorf("encode failed for MaretronAnnunciator-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for MaretronAnnunciator-IndustryCode: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Field4); err != nil {
+		return fmt.Errorf("encode failed for MaretronAnnunciator-Field4: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Field5); err != nil {
+		return fmt.Errorf("encode failed for MaretronAnnunciator-Field5: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.Field6); err != nil {
+		return fmt.Errorf("encode failed for MaretronAnnunciator-Field6: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Field7); err != nil {
+		return fmt.Errorf("encode failed for MaretronAnnunciator-Field7: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.Field8); err != nil {
+		return fmt.Errorf("encode failed for MaretronAnnunciator-Field8: %w", err)
+	}
+	return nil
+}
 type NavicoUnknown2 struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -55117,6 +67119,23 @@ func DecodeNavicoUnknown2(Info MessageInfo, stream *PGNDataStream) (any, error)
 	}	
 	return val, nil
 }
+func EncodeNavicoUnknown2(p any, stream *PGNWriter) error {
+	val, ok := p.(NavicoUnknown2)
+	if !ok {
+		return fmt.Errorf("EncodeNavicoUnknown2 called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 275); err != nil {
+		return fmt.Errorf("encode failed for NavicoUnknown2-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for NavicoUnknown2-IndustryCode: %w", err)
+	}
+	if err := stream.writeBinaryData(80, val.Data); err != nil {
+		return fmt.Errorf("encode failed for NavicoUnknown2-Data: %w", err)
+	}
+	return nil
+}
 type BGUserAndRemoteRename struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -55209,6 +67228,36 @@ func DecodeBGUserAndRemoteRename(Info MessageInfo, stream *PGNDataStream) (any,
 	}	
 	return val, nil
 }
+func EncodeBGUserAndRemoteRename(p any, stream *PGNWriter) error {
+	val, ok := p.(BGUserAndRemoteRename)
+	if !ok {
+		return fmt.Errorf("EncodeBGUserAndRemoteRename called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 381); err != nil {
+		return fmt.Errorf("encode failed for BGUserAndRemoteRename-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for BGUserAndRemoteRename-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(12, uint64(val.DataType)); err != nil {
+		return fmt.Errorf("encode failed for BGUserAndRemoteRename-DataType: %w", err)
+	}
+	if err := stream.writeUInt8(4, val.Length); err != nil {
+		return fmt.Errorf("encode failed for BGUserAndRemoteRename-Length: %w", err)
+	}
+	stream.writeReserved(8)
+	if err := stream.writeLookupField(8, uint64(val.Decimals)); err != nil {
+		return fmt.Errorf("encode failed for BGUserAndRemoteRename-Decimals: %w", err)
+	}
+	if err := stream.writeFixedString(64, val.ShortName); err != nil {
+		return fmt.Errorf("encode failed for BGUserAndRemoteRename-ShortName: %w", err)
+	}
+	if err := stream.writeFixedString(128, val.LongName); err != nil {
+		return fmt.Errorf("encode failed for BGUserAndRemoteRename-LongName: %w", err)
+	}
+	return nil
+}
 type SimnetFluidLevelSensorConfiguration struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -55337,6 +67386,47 @@ func DecodeSimnetFluidLevelSensorConfiguration(Info MessageInfo, stream *PGNData
 	}	
 	return val, nil
 }
+func EncodeSimnetFluidLevelSensorConfiguration(p any, stream *PGNWriter) error {
+	val, ok := p.(SimnetFluidLevelSensorConfiguration)
+	if !ok {
+		return fmt.Errorf("EncodeSimnetFluidLevelSensorConfiguration called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 1857); err != nil {
+		return fmt.Errorf("encode failed for SimnetFluidLevelSensorConfiguration-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SimnetFluidLevelSensorConfiguration-IndustryCode: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.C); err != nil {
+		return fmt.Errorf("encode failed for SimnetFluidLevelSensorConfiguration-C: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Device); err != nil {
+		return fmt.Errorf("encode failed for SimnetFluidLevelSensorConfiguration-Device: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Instance); err != nil {
+		return fmt.Errorf("encode failed for SimnetFluidLevelSensorConfiguration-Instance: %w", err)
+	}
+	if err := stream.writeUInt8(4, val.F); err != nil {
+		return fmt.Errorf("encode failed for SimnetFluidLevelSensorConfiguration-F: %w", err)
+	}
+	if err := stream.writeLookupField(4, uint64(val.TankType)); err != nil {
+		return fmt.Errorf("encode failed for SimnetFluidLevelSensorConfiguration-TankType: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(32, 0.1, nullableUnitValue(val.Capacity, units.Liter, units.Volume.Convert)); err != nil {
+		return fmt.Errorf("encode failed for SimnetFluidLevelSensorConfiguration-Capacity: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.G); err != nil {
+		return fmt.Errorf("encode failed for SimnetFluidLevelSensorConfiguration-G: %w", err)
+	}
+	if err := stream.writeInt16(16, val.H); err != nil {
+		return fmt.Errorf("encode failed for SimnetFluidLevelSensorConfiguration-H: %w", err)
+	}
+	if err := stream.writeInt8(8, val.I); err != nil {
+		return fmt.Errorf("encode failed for SimnetFluidLevelSensorConfiguration-I: %w", err)
+	}
+	return nil
+}
 type MaretronSwitchStatusCounter struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -55459,6 +67549,45 @@ func DecodeMaretronSwitchStatusCounter(Info MessageInfo, stream *PGNDataStream)
 		}	
 	return val, nil
 }
+func EncodeMaretronSwitchStatusCounter(p any, stream *PGNWriter) error {
+	val, ok := p.(MaretronSwitchStatusCounter)
+	if !ok {
+		return fmt.Errorf("EncodeMaretronSwitchStatusCounter called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 137); err != nil {
+		return fmt.Errorf("encode failed for MaretronSwitchStatusCounter-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for MaretronSwitchStatusCounter-IndustryCode: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Instance); err != nil {
+		return fmt.Errorf("encode failed for MaretronSwitchStatusCounter-Instance: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.IndicatorNumber); err != nil {
+		return fmt.Errorf("encode failed for MaretronSwitchStatusCounter-IndicatorNumber: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.StartDate); err != nil {
+		return fmt.Errorf("encode failed for MaretronSwitchStatusCounter-StartDate: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(32, 0.0001, val.StartTime); err != nil {
+		return fmt.Errorf("encode failed for MaretronSwitchStatusCounter-StartTime: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.OffCounter); err != nil {
+		return fmt.Errorf("encode failed for MaretronSwitchStatusCounter-OffCounter: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.OnCounter); err != nil {
+		return fmt.Errorf("encode failed for MaretronSwitchStatusCounter-OnCounter: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.ErrorCounter); err != nil {
+		return fmt.Errorf("encode failed for MaretronSwitchStatusCounter-ErrorCounter: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.SwitchStatus)); err != nil {
+		return fmt.Errorf("encode failed for MaretronSwitchStatusCounter-SwitchStatus: %w", err)
+	}
+	stream.writeReserved(6)
+	return nil
+}
 type MaretronSwitchStatusTimer struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -55581,6 +67710,45 @@ func DecodeMaretronSwitchStatusTimer(Info MessageInfo, stream *PGNDataStream) (a
 		}	
 	return val, nil
 }
+func EncodeMaretronSwitchStatusTimer(p any, stream *PGNWriter) error {
+	val, ok := p.(MaretronSwitchStatusTimer)
+	if !ok {
+		return fmt.Errorf("EncodeMaretronSwitchStatusTimer called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 137); err != nil {
+		return fmt.Errorf("encode failed for MaretronSwitchStatusTimer-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for MaretronSwitchStatusTimer-IndustryCode: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Instance); err != nil {
+		return fmt.Errorf("encode failed for MaretronSwitchStatusTimer-Instance: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.IndicatorNumber); err != nil {
+		return fmt.Errorf("encode failed for MaretronSwitchStatusTimer-IndicatorNumber: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.StartDate); err != nil {
+		return fmt.Errorf("encode failed for MaretronSwitchStatusTimer-StartDate: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(32, 0.0001, val.StartTime); err != nil {
+		return fmt.Errorf("encode failed for MaretronSwitchStatusTimer-StartTime: %w", err)
+	}
+	if err := stream.writeUInt32(32, val.AccumulatedOffPeriod); err != nil {
+		return fmt.Errorf("encode failed for MaretronSwitchStatusTimer-AccumulatedOffPeriod: %w", err)
+	}
+	if err := stream.writeUInt32(32, val.AccumulatedOnPeriod); err != nil {
+		return fmt.Errorf("encode failed for MaretronSwitchStatusTimer-AccumulatedOnPeriod: %w", err)
+	}
+	if err := stream.writeUInt32(32, val.AccumulatedErrorPeriod); err != nil {
+		return fmt.Errorf("encode failed for MaretronSwitchStatusTimer-AccumulatedErrorPeriod: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.SwitchStatus)); err != nil {
+		return fmt.Errorf("encode failed for MaretronSwitchStatusTimer-SwitchStatus: %w", err)
+	}
+	stream.writeReserved(6)
+	return nil
+}
 type FurunoSixDegreesOfFreedomMovement struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -55709,6 +67877,47 @@ func DecodeFurunoSixDegreesOfFreedomMovement(Info MessageInfo, stream *PGNDataSt
 	}	
 	return val, nil
 }
+func EncodeFurunoSixDegreesOfFreedomMovement(p any, stream *PGNWriter) error {
+	val, ok := p.(FurunoSixDegreesOfFreedomMovement)
+	if !ok {
+		return fmt.Errorf("EncodeFurunoSixDegreesOfFreedomMovement called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 1855); err != nil {
+		return fmt.Errorf("encode failed for FurunoSixDegreesOfFreedomMovement-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for FurunoSixDegreesOfFreedomMovement-IndustryCode: %w", err)
+	}
+	if err := stream.writeInt32(32, val.A); err != nil {
+		return fmt.Errorf("encode failed for FurunoSixDegreesOfFreedomMovement-A: %w", err)
+	}
+	if err := stream.writeInt32(32, val.B); err != nil {
+		return fmt.Errorf("encode failed for FurunoSixDegreesOfFreedomMovement-B: %w", err)
+	}
+	if err := stream.writeInt32(32, val.C); err != nil {
+		return fmt.Errorf("encode failed for FurunoSixDegreesOfFreedomMovement-C: %w", err)
+	}
+	if err := stream.writeInt8(8, val.D); err != nil {
+		return fmt.Errorf("encode failed for FurunoSixDegreesOfFreedomMovement-D: %w", err)
+	}
+	if err := stream.writeInt32(32, val.E); err != nil {
+		return fmt.Errorf("encode failed for FurunoSixDegreesOfFreedomMovement-E: %w", err)
+	}
+	if err := stream.writeInt32(32, val.F); err != nil {
+		return fmt.Errorf("encode failed for FurunoSixDegreesOfFreedomMovement-F: %w", err)
+	}
+	if err := stream.writeInt16(16, val.G); err != nil {
+		return fmt.Errorf("encode failed for FurunoSixDegreesOfFreedomMovement-G: %w", err)
+	}
+	if err := stream.writeInt16(16, val.H); err != nil {
+		return fmt.Errorf("encode failed for FurunoSixDegreesOfFreedomMovement-H: %w", err)
+	}
+	if err := stream.writeInt16(16, val.I); err != nil {
+		return fmt.Errorf("encode failed for FurunoSixDegreesOfFreedomMovement-I: %w", err)
+	}
+	return nil
+}
 type SimnetAisClassBStaticDataMsg24PartB struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -55888,6 +68097,61 @@ func DecodeSimnetAisClassBStaticDataMsg24PartB(Info MessageInfo, stream *PGNData
 		}	
 	return val, nil
 }
+func EncodeSimnetAisClassBStaticDataMsg24PartB(p any, stream *PGNWriter) error {
+	val, ok := p.(SimnetAisClassBStaticDataMsg24PartB)
+	if !ok {
+		return fmt.Errorf("EncodeSimnetAisClassBStaticDataMsg24PartB called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 1857); err != nil {
+		return fmt.Errorf("encode failed for SimnetAisClassBStaticDataMsg24PartB-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SimnetAisClassBStaticDataMsg24PartB-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(6, 1); err != nil {
+		return fmt.Errorf("encode failed for SimnetAisClassBStaticDataMsg24PartB-MessageId: %w", err)
+	}
+	if err := stream.writeLookupField(2, uint64(val.RepeatIndicator)); err != nil {
+		return fmt.Errorf("encode failed for SimnetAisClassBStaticDataMsg24PartB-RepeatIndicator: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.D); err != nil {
+		return fmt.Errorf("encode failed for SimnetAisClassBStaticDataMsg24PartB-D: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.E); err != nil {
+		return fmt.Errorf("encode failed for SimnetAisClassBStaticDataMsg24PartB-E: %w", err)
+	}
+	if err := stream.writeUInt32(32, val.UserId); err != nil {
+		return fmt.Errorf("encode failed for SimnetAisClassBStaticDataMsg24PartB-UserId: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.TypeOfShip)); err != nil {
+		return fmt.Errorf("encode failed for SimnetAisClassBStaticDataMsg24PartB-TypeOfShip: %w", err)
+	}
+	if err := stream.writeFixedString(56, val.VendorId); err != nil {
+		return fmt.Errorf("encode failed for SimnetAisClassBStaticDataMsg24PartB-VendorId: %w", err)
+	}
+	if err := stream.writeFixedString(56, val.Callsign); err != nil {
+		return fmt.Errorf("encode failed for SimnetAisClassBStaticDataMsg24PartB-Callsign: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.1, nullableUnitValue(val.Length, units.Meter, units.Distance.Convert)); err != nil {
+		return fmt.Errorf("encode failed for SimnetAisClassBStaticDataMsg24PartB-Length: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.1, nullableUnitValue(val.Beam, units.Meter, units.Distance.Convert)); err != nil {
+		return fmt.Errorf("encode failed for SimnetAisClassBStaticDataMsg24PartB-Beam: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.1, nullableUnitValue(val.PositionReferenceFromStarboard, units.Meter, units.Distance.Convert)); err != nil {
+		return fmt.Errorf("encode failed for SimnetAisClassBStaticDataMsg24PartB-PositionReferenceFromStarboard: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.1, nullableUnitValue(val.PositionReferenceFromBow, units.Meter, units.Distance.Convert)); err != nil {
+		return fmt.Errorf("encode failed for SimnetAisClassBStaticDataMsg24PartB-PositionReferenceFromBow: %w", err)
+	}
+	if err := stream.writeUInt32(32, val.MothershipUserId); err != nil {
+		return fmt.Errorf("encode failed for SimnetAisClassBStaticDataMsg24PartB-MothershipUserId: %w", err)
+	}
+	stream.writeReserved(6)
+	stream.writeReserved(2)
+	return nil
+}
 type FurunoHeelAngleRollInformation struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -55976,6 +68240,35 @@ func DecodeFurunoHeelAngleRollInformation(Info MessageInfo, stream *PGNDataStrea
 	}	
 	return val, nil
 }
+func EncodeFurunoHeelAngleRollInformation(p any, stream *PGNWriter) error {
+	val, ok := p.(FurunoHeelAngleRollInformation)
+	if !ok {
+		return fmt.Errorf("EncodeFurunoHeelAngleRollInformation called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 1855); err != nil {
+		return fmt.Errorf("encode failed for FurunoHeelAngleRollInformation-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for FurunoHeelAngleRollInformation-IndustryCode: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.A); err != nil {
+		return fmt.Errorf("encode failed for FurunoHeelAngleRollInformation-A: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.B); err != nil {
+		return fmt.Errorf("encode failed for FurunoHeelAngleRollInformation-B: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.0001, val.Yaw); err != nil {
+		return fmt.Errorf("encode failed for FurunoHeelAngleRollInformation-Yaw: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.0001, val.Pitch); err != nil {
+		return fmt.Errorf("encode failed for FurunoHeelAngleRollInformation-Pitch: %w", err)
+	}
+	if err := stream.writeSignedResolution(16, 0.0001, val.Roll); err != nil {
+		return fmt.Errorf("encode failed for FurunoHeelAngleRollInformation-Roll: %w", err)
+	}
+	return nil
+}
 type FurunoMultiSatsInViewExtended struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -56014,6 +68307,19 @@ func DecodeFurunoMultiSatsInViewExtended(Info MessageInfo, stream *PGNDataStream
 	}	
 	return val, nil
 }
+func EncodeFurunoMultiSatsInViewExtended(p any, stream *PGNWriter) error {
+	if _, ok := p.(FurunoMultiSatsInViewExtended); !ok {
+		return fmt.Errorf("EncodeFurunoMultiSatsInViewExtended called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 1855); err != nil {
+		return fmt.Errorf("encode failed for FurunoMultiSatsInViewExtended-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for FurunoMultiSatsInViewExtended-IndustryCode: %w", err)
+	}
+	return nil
+}
 type SimnetKeyValue struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -56126,6 +68432,44 @@ func DecodeSimnetKeyValue(Info MessageInfo, stream *PGNDataStream) (any, error)
 	}	
 	return val, nil
 }
+func EncodeSimnetKeyValue(p any, stream *PGNWriter) error {
+	val, ok := p.(SimnetKeyValue)
+	if !ok {
+		return fmt.Errorf("EncodeSimnetKeyValue called with %T", p)
+	}
+		var valueLength uint16
+	if err := stream.writeLookupField(11, 1857); err != nil {
+		return fmt.Errorf("encode failed for SimnetKeyValue-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SimnetKeyValue-IndustryCode: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Address); err != nil {
+		return fmt.Errorf("encode failed for SimnetKeyValue-Address: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.RepeatIndicator)); err != nil {
+		return fmt.Errorf("encode failed for SimnetKeyValue-RepeatIndicator: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.DisplayGroup)); err != nil {
+		return fmt.Errorf("encode failed for SimnetKeyValue-DisplayGroup: %w", err)
+	}
+	stream.writeReserved(8)
+	if err := stream.writeLookupField(16, uint64(val.Key)); err != nil {
+		return fmt.Errorf("encode failed for SimnetKeyValue-Key: %w", err)
+	}
+	stream.writeReserved(8)
+	if err := stream.writeUInt8(8, val.Minlength); err != nil {
+		return fmt.Errorf("encode failed for SimnetKeyValue-Minlength: %w", err)
+	}
+	if val.Minlength != nil {
+		valueLength = uint16(*val.Minlength) * 8
+	}
+	if err := stream.writeBinaryData(valueLength, val.Value); err != nil {
+		return fmt.Errorf("encode failed for SimnetKeyValue-Value: %w", err)
+	}
+	return nil
+}
 type SimnetParameterSet struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -56240,6 +68584,43 @@ func DecodeSimnetParameterSet(Info MessageInfo, stream *PGNDataStream) (any, err
 	}	
 	return val, nil
 }
+func EncodeSimnetParameterSet(p any, stream *PGNWriter) error {
+	val, ok := p.(SimnetParameterSet)
+	if !ok {
+		return fmt.Errorf("EncodeSimnetParameterSet called with %T", p)
+	}
+		var valueLength uint16
+	if err := stream.writeLookupField(11, 1857); err != nil {
+		return fmt.Errorf("encode failed for SimnetParameterSet-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SimnetParameterSet-IndustryCode: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Address); err != nil {
+		return fmt.Errorf("encode failed for SimnetParameterSet-Address: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.B); err != nil {
+		return fmt.Errorf("encode failed for SimnetParameterSet-B: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.DisplayGroup)); err != nil {
+		return fmt.Errorf("encode failed for SimnetParameterSet-DisplayGroup: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.D); err != nil {
+		return fmt.Errorf("encode failed for SimnetParameterSet-D: %w", err)
+	}
+	if err := stream.writeLookupField(16, uint64(val.Key)); err != nil {
+		return fmt.Errorf("encode failed for SimnetParameterSet-Key: %w", err)
+	}
+	stream.writeReserved(8)
+	if err := stream.writeUInt8(8, val.Length); err != nil {
+		return fmt.Errorf("encode failed for SimnetParameterSet-Length: %w", err)
+	}
+	if err := stream.writeBinaryData(valueLength, val.Value); err != nil {
+		return fmt.Errorf("encode failed for SimnetParameterSet-Value: %w", err)
+	}
+	return nil
+}
 type FurunoMotionSensorStatusExtended struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -56278,6 +68659,19 @@ func DecodeFurunoMotionSensorStatusExtended(Info MessageInfo, stream *PGNDataStr
 	}	
 	return val, nil
 }
+func EncodeFurunoMotionSensorStatusExtended(p any, stream *PGNWriter) error {
+	if _, ok := p.(FurunoMotionSensorStatusExtended); !ok {
+		return fmt.Errorf("EncodeFurunoMotionSensorStatusExtended called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 1855); err != nil {
+		return fmt.Errorf("encode failed for FurunoMotionSensorStatusExtended-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for FurunoMotionSensorStatusExtended-IndustryCode: %w", err)
+	}
+	return nil
+}
 type SimnetApCommand struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -56387,6 +68781,40 @@ func DecodeSimnetApCommand(Info MessageInfo, stream *PGNDataStream) (any, error)
 	}	
 	return val, nil
 }
+func EncodeSimnetApCommand(p any, stream *PGNWriter) error {
+	val, ok := p.(SimnetApCommand)
+	if !ok {
+		return fmt.Errorf("EncodeSimnetApCommand called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 1857); err != nil {
+		return fmt.Errorf("encode failed for SimnetApCommand-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SimnetApCommand-IndustryCode: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Address); err != nil {
+		return fmt.Errorf("encode failed for SimnetApCommand-Address: %w", err)
+	}
+	stream.writeReserved(8)
+	if err := stream.writeLookupField(8, 255); err != nil {
+		return fmt.Errorf("encode failed for SimnetApCommand-ProprietaryId: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.ApStatus)); err != nil {
+		return fmt.Errorf("encode failed for SimnetApCommand-ApStatus: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.ApCommand)); err != nil {
+		return fmt.Errorf("encode failed for SimnetApCommand-ApCommand: %w", err)
+	}
+	stream.writeReserved(8)
+	if err := stream.writeLookupField(8, uint64(val.Direction)); err != nil {
+		return fmt.Errorf("encode failed for SimnetApCommand-Direction: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.0001, val.Angle); err != nil {
+		return fmt.Errorf("encode failed for SimnetApCommand-Angle: %w", err)
+	}
+	return nil
+}
 type SimnetEventCommandApCommand struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -56508,6 +68936,44 @@ func DecodeSimnetEventCommandApCommand(Info MessageInfo, stream *PGNDataStream)
 	}	
 	return val, nil
 }
+func EncodeSimnetEventCommandApCommand(p any, stream *PGNWriter) error {
+	val, ok := p.(SimnetEventCommandApCommand)
+	if !ok {
+		return fmt.Errorf("EncodeSimnetEventCommandApCommand called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 1857); err != nil {
+		return fmt.Errorf("encode failed for SimnetEventCommandApCommand-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SimnetEventCommandApCommand-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(8, 2); err != nil {
+		return fmt.Errorf("encode failed for SimnetEventCommandApCommand-ProprietaryId: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.UnusedA); err != nil {
+		return fmt.Errorf("encode failed for SimnetEventCommandApCommand-UnusedA: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.ControllingDevice); err != nil {
+		return fmt.Errorf("encode failed for SimnetEventCommandApCommand-ControllingDevice: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Event)); err != nil {
+		return fmt.Errorf("encode failed for SimnetEventCommandApCommand-Event: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.UnusedB); err != nil {
+		return fmt.Errorf("encode failed for SimnetEventCommandApCommand-UnusedB: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Direction)); err != nil {
+		return fmt.Errorf("encode failed for SimnetEventCommandApCommand-Direction: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.0001, val.Angle); err != nil {
+		return fmt.Errorf("encode failed for SimnetEventCommandApCommand-Angle: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.UnusedC); err != nil {
+		return fmt.Errorf("encode failed for SimnetEventCommandApCommand-UnusedC: %w", err)
+	}
+	return nil
+}
 type SimnetAlarm struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -56617,6 +69083,40 @@ func DecodeSimnetAlarm(Info MessageInfo, stream *PGNDataStream) (any, error) {
 	}	
 	return val, nil
 }
+func EncodeSimnetAlarm(p any, stream *PGNWriter) error {
+	val, ok := p.(SimnetAlarm)
+	if !ok {
+		return fmt.Errorf("EncodeSimnetAlarm called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 1857); err != nil {
+		return fmt.Errorf("encode failed for SimnetAlarm-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SimnetAlarm-IndustryCode: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Address); err != nil {
+		return fmt.Errorf("encode failed for SimnetAlarm-Address: %w", err)
+	}
+	stream.writeReserved(8)
+	if err := stream.writeLookupField(8, 1); err != nil {
+		return fmt.Errorf("encode failed for SimnetAlarm-ProprietaryId: %w", err)
+	}
+	stream.writeReserved(8)
+	if err := stream.writeLookupField(16, uint64(val.Alarm)); err != nil {
+		return fmt.Errorf("encode failed for SimnetAlarm-Alarm: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.MessageId); err != nil {
+		return fmt.Errorf("encode failed for SimnetAlarm-MessageId: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.F); err != nil {
+		return fmt.Errorf("encode failed for SimnetAlarm-F: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.G); err != nil {
+		return fmt.Errorf("encode failed for SimnetAlarm-G: %w", err)
+	}
+	return nil
+}
 type SimnetEventReplyApCommand struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -56738,6 +69238,44 @@ func DecodeSimnetEventReplyApCommand(Info MessageInfo, stream *PGNDataStream) (a
 	}	
 	return val, nil
 }
+func EncodeSimnetEventReplyApCommand(p any, stream *PGNWriter) error {
+	val, ok := p.(SimnetEventReplyApCommand)
+	if !ok {
+		return fmt.Errorf("EncodeSimnetEventReplyApCommand called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 1857); err != nil {
+		return fmt.Errorf("encode failed for SimnetEventReplyApCommand-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SimnetEventReplyApCommand-IndustryCode: %w", err)
+	}
+	if err := stream.writeLookupField(8, 2); err != nil {
+		return fmt.Errorf("encode failed for SimnetEventReplyApCommand-ProprietaryId: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.B); err != nil {
+		return fmt.Errorf("encode failed for SimnetEventReplyApCommand-B: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.Address); err != nil {
+		return fmt.Errorf("encode failed for SimnetEventReplyApCommand-Address: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Event)); err != nil {
+		return fmt.Errorf("encode failed for SimnetEventReplyApCommand-Event: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.C); err != nil {
+		return fmt.Errorf("encode failed for SimnetEventReplyApCommand-C: %w", err)
+	}
+	if err := stream.writeLookupField(8, uint64(val.Direction)); err != nil {
+		return fmt.Errorf("encode failed for SimnetEventReplyApCommand-Direction: %w", err)
+	}
+	if err := stream.writeUnsignedResolution(16, 0.0001, val.Angle); err != nil {
+		return fmt.Errorf("encode failed for SimnetEventReplyApCommand-Angle: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.G); err != nil {
+		return fmt.Errorf("encode failed for SimnetEventReplyApCommand-G: %w", err)
+	}
+	return nil
+}
 type SimnetAlarmMessage struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -56816,6 +69354,32 @@ func DecodeSimnetAlarmMessage(Info MessageInfo, stream *PGNDataStream) (any, err
 	}	
 	return val, nil
 }
+func EncodeSimnetAlarmMessage(p any, stream *PGNWriter) error {
+	val, ok := p.(SimnetAlarmMessage)
+	if !ok {
+		return fmt.Errorf("EncodeSimnetAlarmMessage called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 1857); err != nil {
+		return fmt.Errorf("encode failed for SimnetAlarmMessage-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SimnetAlarmMessage-IndustryCode: %w", err)
+	}
+	if err := stream.writeUInt16(16, val.MessageId); err != nil {
+		return fmt.Errorf("encode failed for SimnetAlarmMessage-MessageId: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.B); err != nil {
+		return fmt.Errorf("encode failed for SimnetAlarmMessage-B: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.C); err != nil {
+		return fmt.Errorf("encode failed for SimnetAlarmMessage-C: %w", err)
+	}
+	if err := stream.writeFixedString(1784, val.Text); err != nil {
+		return fmt.Errorf("encode failed for SimnetAlarmMessage-Text: %w", err)
+	}
+	return nil
+}
 type SimnetApUnknown4 struct {
 	Info MessageInfo
 	ManufacturerCode ManufacturerCodeConst
@@ -56914,3 +69478,35 @@ func DecodeSimnetApUnknown4(Info MessageInfo, stream *PGNDataStream) (any, error
 	}	
 	return val, nil
 }
+func EncodeSimnetApUnknown4(p any, stream *PGNWriter) error {
+	val, ok := p.(SimnetApUnknown4)
+	if !ok {
+		return fmt.Errorf("EncodeSimnetApUnknown4 called with %T", p)
+	}
+	if err := stream.writeLookupField(11, 1857); err != nil {
+		return fmt.Errorf("encode failed for SimnetApUnknown4-ManufacturerCode: %w", err)
+	}
+	stream.writeReserved(2)
+	if err := stream.writeLookupField(3, 4); err != nil {
+		return fmt.Errorf("encode failed for SimnetApUnknown4-IndustryCode: %w", err)
+	}
+	if err := stream.writeUInt8(8, val.A); err != nil {
+		return fmt.Errorf("encode failed for SimnetApUnknown4-A: %w", err)
+	}
+	if err := stream.writeInt32(32, val.B); err != nil {
+		return fmt.Errorf("encode failed for SimnetApUnknown4-B: %w", err)
+	}
+	if err := stream.writeInt32(32, val.C); err != nil {
+		return fmt.Errorf("encode failed for SimnetApUnknown4-C: %w", err)
+	}
+	if err := stream.writeUInt32(32, val.D); err != nil {
+		return fmt.Errorf("encode failed for SimnetApUnknown4-D: %w", err)
+	}
+	if err := stream.writeInt32(32, val.E); err != nil {
+		return fmt.Errorf("encode failed for SimnetApUnknown4-E: %w", err)
+	}
+	if err := stream.writeUInt32(32, val.F); err != nil {
+		return fmt.Errorf("encode failed for SimnetApUnknown4-F: %w", err)
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package pgn
 import (
 	"testing"
 
+	"github.com/boatkit-io/tugboat/pkg/units"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -36,3 +37,75 @@ func TestDecoding(t *testing.T) {
 	assert.Nil(t, longDecode.RippleVoltage)
 	assert.NotNil(t, longDecode.RemainingCapacity)
 }
+
+func TestEncoding(t *testing.T) {
+	info := MessageInfo{}
+
+	// Round trip the decoding tests
+	tests := []struct {
+		decoder func(MessageInfo, *PGNDataStream) (any, error)
+		encoder func(any, *PGNWriter) error
+		data    []uint8
+	}{
+		{DecodeRateOfTurn, EncodeRateOfTurn, []uint8{0xff, 0xd4, 0xee, 0xff, 0xff, 0xff, 0xff, 0xff}},
+		{DecodeGnssSatsInView, EncodeGnssSatsInView, []byte{0xe3, 0xff, 0xc, 0x1, 0x68, 0x3, 0xcd, 0xba, 0x4, 0x10, 0xff, 0xff, 0xff, 0x7f, 0xf5, 0x3, 0x68, 0x12, 0xb5, 0xd4, 0xcc, 0x10, 0xff, 0xff, 0xff, 0x7f, 0xf5, 0xa, 0x22, 0x6, 0x43, 0x75, 0xf8, 0x11, 0xff, 0xff, 0xff, 0x7f, 0xf5, 0xc, 0xc5, 0x4, 0x39, 0x19, 0xf8, 0x11, 0xff, 0xff, 0xff, 0x7f, 0xf5, 0x16, 0x38, 0x37, 0x50, 0x2c, 0x4, 0x10, 0xff, 0xff, 0xff, 0x7f, 0xf5, 0x19, 0xa2, 0x1c, 0x2d, 0x26, 0xa0, 0xf, 0xff, 0xff, 0xff, 0x7f, 0xf5, 0x1a, 0xd0, 0x15, 0xfc, 0x86, 0xf8, 0x11, 0xff, 0xff, 0xff, 0x7f, 0xf5, 0x1d, 0xdc, 0x8, 0x95, 0x47, 0xcc, 0x10, 0xff, 0xff, 0xff, 0x7f, 0xf5, 0x1f, 0x73, 0x32, 0xc1, 0xc7, 0x68, 0x10, 0xff, 0xff, 0xff, 0x7f, 0xf5, 0x20, 0xdc, 0x26, 0xb8, 0x4d, 0x5c, 0x12, 0xff, 0xff, 0xff, 0x7f, 0xf5, 0x2e, 0xdc, 0x8, 0x13, 0xa9, 0x4, 0x10, 0xff, 0xff, 0xff, 0x7f, 0xf1, 0x30, 0xe8, 0xa, 0x59, 0xa6, 0xcc, 0x10, 0xff, 0xff, 0xff, 0x7f, 0xf1}},
+		{DecodeDcDetailedStatus, EncodeDcDetailedStatus, []uint8{213, 0, 0, 53, 255, 100, 4, 255, 255, 10, 20}},
+		{DecodeWaterDepth, EncodeWaterDepth, []uint8{0x01, 0x2c, 0x01, 0x00, 0x00, 0xf4, 0x01, 0xff}},
+	}
+	for _, tst := range tests {
+		v, err := tst.decoder(info, NewPgnDataStream(tst.data))
+		assert.NoError(t, err)
+		stream := NewPgnWriter()
+		assert.NoError(t, tst.encoder(v, stream))
+		assert.Equal(t, tst.data, stream.GetData())
+	}
+
+	// nil fields are encoded as "not available", matched fields as their match value
+	sid := uint8(4)
+	mInfo, data, err := Encode(WaterDepth{Info: MessageInfo{Priority: 3}, Sid: &sid})
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(128267), mInfo.PGN)
+	assert.Equal(t, uint8(3), mInfo.Priority)
+	assert.Equal(t, []uint8{4, 0xff, 0xff, 0xff, 0xff, 0xff, 0x7f, 0xff}, data)
+
+	_, data, err = Encode(AirmarBootStateRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, []uint8{135, 0x98, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, data)
+
+	// wrong type, and unknown type
+	assert.Error(t, EncodeRateOfTurn(WaterDepth{}, NewPgnWriter()))
+	_, _, err = Encode(struct{ Info MessageInfo }{})
+	assert.Error(t, err)
+}
+
+func TestInfoOf(t *testing.T) {
+	wd := WaterDepth{Info: MessageInfo{PGN: 128267, SourceId: 3}}
+	info, ok := InfoOf(wd)
+	assert.True(t, ok)
+	assert.Equal(t, wd.Info, info)
+	info, ok = InfoOf(&wd)
+	assert.True(t, ok)
+	assert.Equal(t, wd.Info, info)
+
+	for _, p := range []any{nil, (*WaterDepth)(nil), 42, struct{ Info int }{}} {
+		_, ok = InfoOf(p)
+		assert.False(t, ok, "%T", p)
+	}
+}
+
+func TestFieldValues(t *testing.T) {
+	assert.Equal(t, "MARIA", CleanString("MARIA  @@@@"))
+	assert.Equal(t, uint8(0), InstanceOf(nil))
+	instance := uint8(2)
+	assert.Equal(t, uint8(2), InstanceOf(&instance))
+
+	assert.Nil(t, Float64(nil))
+	assert.Nil(t, Meters(nil))
+	assert.Nil(t, MetersPerSecond(nil))
+	f := float32(0.5)
+	assert.Equal(t, 0.5, *Float64(&f))
+	d := units.NewDistance(units.Foot, 10)
+	assert.InDelta(t, 3.048, *Meters(&d), 0.001)
+	v := units.NewVelocity(units.Knots, 1)
+	assert.InDelta(t, 0.5144, *MetersPerSecond(&v), 0.001)
+}
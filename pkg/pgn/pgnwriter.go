@@ -0,0 +1,366 @@
+package pgn
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// PGNWriter instances provide methods to write data types to a stream, mirroring PGNDataStream's read methods.
+// byteOffset and bitOffset combine to act as the write "cursor".
+// The low level write functions update the cursor, growing the data as needed.
+type PGNWriter struct {
+	data []uint8
+
+	byteOffset uint16
+	bitOffset  uint8
+}
+
+// NewPgnWriter returns a new, empty PGNWriter.
+func NewPgnWriter() *PGNWriter {
+	return &PGNWriter{}
+}
+
+// GetData method returns the data written. After encoding it contains the PGN's message data.
+func (w *PGNWriter) GetData() []uint8 {
+	return w.data
+}
+
+// writeReserved method writes the specified number of bits, all set to 1, as required for reserved fields.
+func (w *PGNWriter) writeReserved(bitLength uint16) {
+	for bitLength > 0 {
+		num := uint16(64)
+		if bitLength < 64 {
+			num = bitLength
+		}
+		w.putNumberRaw(math.MaxUint64, num)
+		bitLength -= num
+	}
+}
+
+// writeLookupField method writes the specified length (max 64) data.
+func (w *PGNWriter) writeLookupField(bitLength uint16, v uint64) error {
+	if bitLength > 64 {
+		return fmt.Errorf("requested %d bitLength in WriteLookupField", bitLength)
+	}
+	if bitLength < 64 && v >= 1<<bitLength {
+		return fmt.Errorf("value %d too large for %d bitLength in WriteLookupField", v, bitLength)
+	}
+
+	w.putNumberRaw(v, bitLength)
+	return nil
+}
+
+// writeSignedResolution method scales the value (if not nil) and writes it with the specified length.
+func (w *PGNWriter) writeSignedResolution(bitLength uint16, multiplyBy float32, v *float32) error {
+	if bitLength > 64 {
+		return fmt.Errorf("requested %d bitLength in WriteSignedResolution", bitLength)
+	}
+	if v == nil {
+		return w.putSignedNullableNumber(bitLength, nil)
+	}
+
+	vo := int64(math.Round(float64(*v) / float64(multiplyBy)))
+	return w.putSignedNullableNumber(bitLength, &vo)
+}
+
+// writeSignedResolution64Override method scales the *float64 value (if not nil) and writes it with the specified length.
+func (w *PGNWriter) writeSignedResolution64Override(bitLength uint16, multiplyBy float64, v *float64) error {
+	if bitLength > 64 {
+		return fmt.Errorf("requested %d bitLength in WriteSignedResolution", bitLength)
+	}
+	if v == nil {
+		return w.putSignedNullableNumber(bitLength, nil)
+	}
+
+	vo := int64(math.Round(*v / multiplyBy))
+	return w.putSignedNullableNumber(bitLength, &vo)
+}
+
+// writeUnsignedResolution method scales the value (if not nil) and writes it as an unsigned number.
+func (w *PGNWriter) writeUnsignedResolution(bitLength uint16, multiplyBy float32, v *float32) error {
+	if bitLength > 64 {
+		return fmt.Errorf("requested %d bitLength in WriteUnsignedResolution", bitLength)
+	}
+	if v == nil {
+		return w.putUnsignedNullableNumber(bitLength, nil)
+	}
+
+	vf := math.Round(float64(*v) / float64(multiplyBy))
+	if vf < 0 {
+		return fmt.Errorf("negative value %g in WriteUnsignedResolution", *v)
+	}
+	vo := uint64(vf)
+	return w.putUnsignedNullableNumber(bitLength, &vo)
+}
+
+// writeUInt64 method writes a *uint64
+func (w *PGNWriter) writeUInt64(bitLength uint16, v *uint64) error {
+	if bitLength > 64 {
+		return fmt.Errorf("requested %d bitLength in WriteUInt64", bitLength)
+	}
+
+	return w.putUnsignedNullableNumber(bitLength, v)
+}
+
+// writeUInt32 method writes a *uint32
+func (w *PGNWriter) writeUInt32(bitLength uint16, v *uint32) error {
+	if bitLength > 32 {
+		return fmt.Errorf("requested %d bitLength in WriteUInt32", bitLength)
+	}
+	if v == nil {
+		return w.putUnsignedNullableNumber(bitLength, nil)
+	}
+
+	vo := uint64(*v)
+	return w.putUnsignedNullableNumber(bitLength, &vo)
+}
+
+// writeUInt16 method writes a *uint16
+func (w *PGNWriter) writeUInt16(bitLength uint16, v *uint16) error {
+	if bitLength > 16 {
+		return fmt.Errorf("requested %d bitLength in WriteUInt16", bitLength)
+	}
+	if v == nil {
+		return w.putUnsignedNullableNumber(bitLength, nil)
+	}
+
+	vo := uint64(*v)
+	return w.putUnsignedNullableNumber(bitLength, &vo)
+}
+
+// writeUInt8 method writes a *uint8
+func (w *PGNWriter) writeUInt8(bitLength uint16, v *uint8) error {
+	if bitLength > 8 {
+		return fmt.Errorf("requested %d bitLength in WriteUInt8", bitLength)
+	}
+	if v == nil {
+		return w.putUnsignedNullableNumber(bitLength, nil)
+	}
+
+	vo := uint64(*v)
+	return w.putUnsignedNullableNumber(bitLength, &vo)
+}
+
+// writeInt64 method writes a *int64
+func (w *PGNWriter) writeInt64(bitLength uint16, v *int64) error {
+	if bitLength > 64 {
+		return fmt.Errorf("requested %d bitLength in WriteInt64", bitLength)
+	}
+
+	return w.putSignedNullableNumber(bitLength, v)
+}
+
+// writeInt32 method writes a *int32
+func (w *PGNWriter) writeInt32(bitLength uint16, v *int32) error {
+	if bitLength > 32 {
+		return fmt.Errorf("requested %d bitLength in WriteInt32", bitLength)
+	}
+	if v == nil {
+		return w.putSignedNullableNumber(bitLength, nil)
+	}
+
+	vo := int64(*v)
+	return w.putSignedNullableNumber(bitLength, &vo)
+}
+
+// writeInt16 method writes a *int16
+func (w *PGNWriter) writeInt16(bitLength uint16, v *int16) error {
+	if bitLength > 16 {
+		return fmt.Errorf("requested %d bitLength in WriteInt16", bitLength)
+	}
+	if v == nil {
+		return w.putSignedNullableNumber(bitLength, nil)
+	}
+
+	vo := int64(*v)
+	return w.putSignedNullableNumber(bitLength, &vo)
+}
+
+// writeInt8 method writes a *int8
+func (w *PGNWriter) writeInt8(bitLength uint16, v *int8) error {
+	if bitLength > 8 {
+		return fmt.Errorf("requested %d bitLength in WriteInt8", bitLength)
+	}
+	if v == nil {
+		return w.putSignedNullableNumber(bitLength, nil)
+	}
+
+	vo := int64(*v)
+	return w.putSignedNullableNumber(bitLength, &vo)
+}
+
+// writeFloat32 method writes a *float32
+func (w *PGNWriter) writeFloat32(v *float32) error {
+	if v == nil {
+		return w.writeUInt32(32, nil)
+	}
+
+	vo := math.Float32bits(*v)
+	w.putNumberRaw(uint64(vo), 32)
+	return nil
+}
+
+// writeBinaryData method writes the specified length of data from a uint8 slice.
+// If the slice is shorter than the length the remainder is filled with 1s.
+func (w *PGNWriter) writeBinaryData(bitLength uint16, data []uint8) error {
+	numBytes := int(math.Ceil(float64(bitLength) / 8))
+	if len(data) > numBytes {
+		return fmt.Errorf("data length (%d) too long for %d bitLength in WriteBinaryData", len(data), bitLength)
+	}
+
+	for i := 0; bitLength > 0; i++ {
+		num := uint16(8)
+		if bitLength < 8 {
+			num = bitLength
+		}
+		b := uint8(0xFF)
+		if i < len(data) {
+			b = data[i]
+		}
+		w.putNumberRaw(uint64(b), num)
+		bitLength -= num
+	}
+
+	return nil
+}
+
+// writeStringWithLengthAndControl method writes a string with length and control byte.
+// Length includes the len/control bytes. The control byte is written as 1 (ASCII).
+// The string is written as is, so include a terminating zero if desired.
+func (w *PGNWriter) writeStringWithLengthAndControl(str string) error {
+	if len(str)+2 > math.MaxUint8 {
+		return fmt.Errorf("string length (%d) too long in WriteStringWithLengthAndControl", len(str))
+	}
+
+	w.putNumberRaw(uint64(len(str)+2), 8)
+	w.putNumberRaw(1, 8)
+	return w.writeBinaryData(uint16(len(str))*8, []uint8(str))
+}
+
+// writeStringStartStopByte method writes a string with start (0x02) and stop (0x01) bytes, mirroring readStringStartStopByte.
+// An empty string is written as a lone 0x01.
+func (w *PGNWriter) writeStringStartStopByte(str string) error {
+	if len(str) == 0 {
+		w.putNumberRaw(1, 8)
+		return nil
+	}
+	if strings.IndexByte(str, 1) != -1 {
+		return fmt.Errorf("string can't contain the stop byte in WriteStringStartStopByte")
+	}
+
+	w.putNumberRaw(2, 8)
+	if err := w.writeBinaryData(uint16(len(str))*8, []uint8(str)); err != nil {
+		return err
+	}
+	w.putNumberRaw(1, 8)
+	return nil
+}
+
+// writeStringWithLength method writes a string with leading length byte
+// Canboat format "STRING_LZ"
+// Length does not include the length byte
+func (w *PGNWriter) writeStringWithLength(str string) error {
+	if len(str) >= math.MaxUint8 {
+		return fmt.Errorf("string length (%d) too long in WriteStringWithLength", len(str))
+	}
+
+	w.putNumberRaw(uint64(len(str)), 8)
+	return w.writeBinaryData(uint16(len(str))*8, []uint8(str))
+}
+
+// writeFixedString method writes a string of fixed length, padded on the end by 0xFF.
+func (w *PGNWriter) writeFixedString(bitLength uint16, str string) error {
+	if len(str)*8 > int(bitLength) {
+		return fmt.Errorf("string length (%d) too long for %d bitLength in WriteFixedString", len(str), bitLength)
+	}
+
+	return w.writeBinaryData(bitLength, []uint8(str))
+}
+
+// putNumberRaw method writes up to 64 bits to the stream, LSB first, mirroring getNumberRaw.
+// Bytes added to the end of the stream start with all bits set, so unwritten trailing bits read as 1s.
+func (w *PGNWriter) putNumberRaw(v uint64, bitLength uint16) {
+	for bitLength > 0 {
+		if int(w.byteOffset) >= len(w.data) {
+			w.data = append(w.data, 0xFF)
+		}
+
+		bitsToPut := 8 - w.bitOffset
+		if bitLength < uint16(bitsToPut) {
+			bitsToPut = uint8(bitLength)
+		}
+
+		mask := uint8(0xFF>>uint8(8-bitsToPut)) << w.bitOffset
+		b := uint8(v) << w.bitOffset
+		w.data[w.byteOffset] = (w.data[w.byteOffset] &^ mask) | (b & mask)
+		v >>= uint64(bitsToPut)
+		bitLength -= uint16(bitsToPut)
+		w.bitOffset += bitsToPut
+		if w.bitOffset >= 8 {
+			w.bitOffset -= 8
+			w.byteOffset++
+		}
+	}
+}
+
+// putNullableNumberRaw method writes the specified length, writing maxvalue if v is nil.
+// It returns an error if the value doesn't fit (or collides with the "not available" maxvalue).
+func (w *PGNWriter) putNullableNumberRaw(bitLength uint16, v *uint64, signed bool) error {
+	maxVal := uint64(0xFFFFFFFFFFFFFFFF)
+	maxVal >>= 64 - bitLength
+	if signed {
+		maxVal >>= 1
+	}
+	if v == nil {
+		w.putNumberRaw(maxVal, bitLength)
+		return nil
+	}
+	if *v >= maxVal {
+		return fmt.Errorf("value %d out of range for %d bitLength", *v, bitLength)
+	}
+
+	w.putNumberRaw(*v, bitLength)
+	return nil
+}
+
+// putUnsignedNullableNumber method writes a *uint64, or the null value if nil
+func (w *PGNWriter) putUnsignedNullableNumber(bitLength uint16, v *uint64) error {
+	return w.putNullableNumberRaw(bitLength, v, false)
+}
+
+// putSignedNullableNumber method writes a *int64 as two's complement, or the null value if nil
+func (w *PGNWriter) putSignedNullableNumber(bitLength uint16, v *int64) error {
+	if v == nil {
+		return w.putNullableNumberRaw(bitLength, nil, true)
+	}
+
+	mask := uint64(1 << (bitLength - 1))
+	if *v >= 0 {
+		vo := uint64(*v)
+		return w.putNullableNumberRaw(bitLength, &vo, true)
+	}
+	if *v < -int64(mask) {
+		return fmt.Errorf("value %d out of range for %d bitLength", *v, bitLength)
+	}
+
+	// negative, so set the max bit and write the remaining bits directly (they can't collide with maxvalue)
+	vo := uint64(*v+int64(mask)) | mask
+	w.putNumberRaw(vo, bitLength)
+	return nil
+}
+
+// writeVariableData method writes data as the value of pgn.fieldIndex, mirroring readVariableData
+func (w *PGNWriter) writeVariableData(pgn uint32, manID ManufacturerCodeConst, fieldIndex uint8, data []uint8) error {
+	field, err := GetFieldDescriptor(pgn, manID, fieldIndex)
+	if err != nil {
+		return err
+	}
+	if field.BitLengthVariable {
+		if field.CanboatType == "STRING_LAU" {
+			return w.writeStringWithLengthAndControl(string(data))
+		}
+	}
+	len := (field.BitLength + 7) &^ 0x7
+	return w.writeBinaryData(len, data)
+}
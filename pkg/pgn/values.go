@@ -0,0 +1,47 @@
+package pgn
+
+import (
+	"strings"
+
+	"github.com/boatkit-io/tugboat/pkg/units"
+)
+
+// CleanString returns a string field without its padding, including the '@' padding of AIS strings.
+func CleanString(s string) string {
+	return strings.TrimSpace(strings.TrimRight(s, "@\x00"))
+}
+
+// InstanceOf returns an instance field's value, or 0 if it's missing.
+func InstanceOf(i *uint8) uint8 {
+	if i == nil {
+		return 0
+	}
+	return *i
+}
+
+// Float64 returns a float field's value as a float64, or nil if it's missing.
+func Float64(f *float32) *float64 {
+	if f == nil {
+		return nil
+	}
+	v := float64(*f)
+	return &v
+}
+
+// MetersPerSecond returns a velocity field's value in m/s, or nil if it's missing.
+func MetersPerSecond(v *units.Velocity) *float64 {
+	if v == nil {
+		return nil
+	}
+	converted := v.Convert(units.MetersPerSecond)
+	return Float64(&converted.Value)
+}
+
+// Meters returns a distance field's value in meters, or nil if it's missing.
+func Meters(d *units.Distance) *float64 {
+	if d == nil {
+		return nil
+	}
+	converted := d.Convert(units.Meter)
+	return Float64(&converted.Value)
+}
@@ -0,0 +1,322 @@
+// Package signalk converts decoded PGN structs to Signal K delta messages, described at
+// https://signalk.org/specification/latest/doc/data_model.html.
+package signalk
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/boatkit-io/n2k/pkg/pgn"
+	"github.com/boatkit-io/n2k/pkg/subscribe"
+)
+
+// SelfContext is the context of deltas about own vessel.
+const SelfContext = "vessels.self"
+
+// Delta is a Signal K delta message.
+type Delta struct {
+	Context string   `json:"context"`
+	Updates []Update `json:"updates"`
+}
+
+// Update is a set of values from a source at a time.
+type Update struct {
+	// SourceRef is the source's id, "<label>.<NAME>", or "<label>.<address>" if its NAME isn't known.
+	SourceRef string      `json:"$source"`
+	Source    Source      `json:"source"`
+	Timestamp string      `json:"timestamp"`
+	Values    []PathValue `json:"values"`
+}
+
+// Source describes the device that sent an update.
+type Source struct {
+	Label   string `json:"label"`
+	Type    string `json:"type"`
+	PGN     uint32 `json:"pgn"`
+	Src     string `json:"src"`
+	CanName string `json:"canName,omitempty"` // the device's NAME in hex, if known
+}
+
+// PathValue is a value at a Signal K path, in SI units: angles in radians, speeds in m/s, temperatures in Kelvin,
+// pressures in Pa, volumes in m³, and ratios from 0 to 1.
+type PathValue struct {
+	Path  string `json:"path"`
+	Value any    `json:"value"`
+}
+
+// Position is the value of navigation.position.
+type Position struct {
+	Latitude  float64  `json:"latitude"`
+	Longitude float64  `json:"longitude"`
+	Altitude  *float64 `json:"altitude,omitempty"`
+}
+
+// Converter converts PGN structs to deltas.
+// Feed it structs, either by subscribing it (see Subscribe) or calling HandleStruct, to get deltas through SetOutput,
+// or call Convert directly.
+type Converter struct {
+	label    string
+	resolver subscribe.NameResolver
+
+	mu      sync.Mutex
+	handler func(Delta)
+}
+
+// NewConverter returns a Converter, labelling sources with label, for example "n2k" or "can0".
+// resolver finds devices' NAMEs for the sources, and can be nil; a devices.Registry is a NameResolver.
+func NewConverter(label string, resolver subscribe.NameResolver) *Converter {
+	return &Converter{
+		label:    label,
+		resolver: resolver,
+	}
+}
+
+// SetOutput assigns a callback for the deltas of structs handled.
+func (c *Converter) SetOutput(handler func(Delta)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.handler = handler
+}
+
+// Subscribe subscribes the converter to all structs from subs.
+func (c *Converter) Subscribe(subs *subscribe.SubscribeManager) (subscribe.SubscriptionId, error) {
+	return subs.SubscribeToAllStructs(c.HandleStruct)
+}
+
+// HandleStruct converts a struct, passing on its delta if it has any values.
+func (c *Converter) HandleStruct(p any) {
+	delta, ok := c.Convert(p)
+	if !ok {
+		return
+	}
+	c.mu.Lock()
+	handler := c.handler
+	c.mu.Unlock()
+	if handler != nil {
+		handler(delta)
+	}
+}
+
+// Convert returns the delta for a PGN struct, and false if it's not a struct the converter knows or has no values.
+func (c *Converter) Convert(p any) (Delta, bool) {
+	context, info, values := convert(p)
+	if len(values) == 0 {
+		return Delta{}, false
+	}
+
+	source := Source{
+		Label: c.label,
+		Type:  "NMEA2000",
+		PGN:   info.PGN,
+		Src:   strconv.Itoa(int(info.SourceId)),
+	}
+	ref := c.label + "." + source.Src
+	if c.resolver != nil {
+		if name, ok := c.resolver.NameFor(info); ok {
+			source.CanName = fmt.Sprintf("%016x", uint64(name))
+			ref = c.label + "." + source.CanName
+		}
+	}
+	timestamp := info.Timestamp
+	if timestamp.IsZero() {
+		timestamp = time.Now()
+	}
+
+	return Delta{
+		Context: context,
+		Updates: []Update{{
+			SourceRef: ref,
+			Source:    source,
+			Timestamp: timestamp.UTC().Format("2006-01-02T15:04:05.000Z"),
+			Values:    values,
+		}},
+	}, true
+}
+
+// convert returns the context, MessageInfo and values of a PGN struct.
+func convert(p any) (string, pgn.MessageInfo, []PathValue) {
+	v := &values{}
+	context := SelfContext
+	var info pgn.MessageInfo
+	switch m := p.(type) {
+	case pgn.PositionRapidUpdate:
+		info = m.Info
+		v.position("navigation.position", m.Latitude, m.Longitude, nil)
+	case pgn.GnssPositionData:
+		info = m.Info
+		v.position("navigation.position", m.Latitude, m.Longitude, pgn.Meters(m.Altitude))
+		v.add("navigation.gnss.satellites", integer(m.NumberOfSvs))
+		v.add("navigation.gnss.horizontalDilution", pgn.Float64(m.Hdop))
+		v.add("navigation.gnss.positionDilution", pgn.Float64(m.Pdop))
+		v.add("navigation.gnss.geoidalSeparation", pgn.Meters(m.GeoidalSeparation))
+	case pgn.CogSogRapidUpdate:
+		info = m.Info
+		v.add("navigation.courseOverGround"+reference(m.CogReference), pgn.Float64(m.Cog))
+		v.add("navigation.speedOverGround", pgn.MetersPerSecond(m.Sog))
+	case pgn.VesselHeading:
+		info = m.Info
+		v.add("navigation.heading"+reference(m.Reference), pgn.Float64(m.Heading))
+		v.add("navigation.magneticDeviation", pgn.Float64(m.Deviation))
+		v.add("navigation.magneticVariation", pgn.Float64(m.Variation))
+	case pgn.RateOfTurn:
+		info = m.Info
+		v.add("navigation.rateOfTurn", m.Rate)
+	case pgn.Attitude:
+		info = m.Info
+		attitude := make(map[string]float64)
+		for name, f := range map[string]*float32{"yaw": m.Yaw, "pitch": m.Pitch, "roll": m.Roll} {
+			if f != nil {
+				attitude[name] = float64(*f)
+			}
+		}
+		if len(attitude) > 0 {
+			v.add("navigation.attitude", attitude)
+		}
+	case pgn.Speed:
+		info = m.Info
+		v.add("navigation.speedThroughWater", pgn.MetersPerSecond(m.SpeedWaterReferenced))
+	case pgn.DistanceLog:
+		info = m.Info
+		v.add("navigation.log", pgn.Meters(m.Log))
+		v.add("navigation.trip.log", pgn.Meters(m.TripLog))
+	case pgn.WaterDepth:
+		info = m.Info
+		depth := pgn.Meters(m.Depth)
+		v.add("environment.depth.belowTransducer", depth)
+		// a positive offset is from the surface down to the transducer, a negative one from the transducer to the keel
+		if offset := pgn.Meters(m.Offset); depth != nil && offset != nil {
+			switch {
+			case *offset > 0:
+				v.add("environment.depth.surfaceToTransducer", *offset)
+				v.add("environment.depth.belowSurface", *depth+*offset)
+			case *offset < 0:
+				v.add("environment.depth.transducerToKeel", -*offset)
+				v.add("environment.depth.belowKeel", *depth+*offset)
+			}
+		}
+	case pgn.WindData:
+		info = m.Info
+		speed, angle := pgn.MetersPerSecond(m.WindSpeed), pgn.Float64(m.WindAngle)
+		switch m.Reference {
+		case pgn.Apparent:
+			v.add("environment.wind.speedApparent", speed)
+			v.add("environment.wind.angleApparent", relative(angle))
+		case pgn.TrueBoatReferenced:
+			v.add("environment.wind.speedOverGround", speed)
+			v.add("environment.wind.angleTrueGround", relative(angle))
+		case pgn.TrueWaterReferenced:
+			v.add("environment.wind.speedTrue", speed)
+			v.add("environment.wind.angleTrueWater", relative(angle))
+		case pgn.TrueGroundReferencedToNorth:
+			v.add("environment.wind.speedOverGround", speed)
+			v.add("environment.wind.directionTrue", angle)
+		case pgn.MagneticGroundReferencedToMagneticNorth:
+			v.add("environment.wind.speedOverGround", speed)
+			v.add("environment.wind.directionMagnetic", angle)
+		}
+	case pgn.EnvironmentalParametersObsolete:
+		info = m.Info
+		v.add("environment.water.temperature", temperature(m.WaterTemperature))
+		v.add("environment.outside.temperature", temperature(m.OutsideAmbientAirTemperature))
+		v.add("environment.outside.pressure", pressure(m.AtmosphericPressure))
+	case pgn.EnvironmentalParameters:
+		info = m.Info
+		v.add(temperaturePath(m.TemperatureSource, 0), temperature(m.Temperature))
+		switch m.HumiditySource {
+		case pgn.Inside:
+			v.add("environment.inside.relativeHumidity", ratio(pgn.Float64(m.Humidity)))
+		case pgn.Outside:
+			v.add("environment.outside.relativeHumidity", ratio(pgn.Float64(m.Humidity)))
+		}
+		v.add("environment.outside.pressure", pressure(m.AtmosphericPressure))
+	case pgn.Temperature:
+		info = m.Info
+		v.add(temperaturePath(m.Source, pgn.InstanceOf(m.Instance)), temperature(m.ActualTemperature))
+	case pgn.TemperatureExtendedRange:
+		info = m.Info
+		v.add(temperaturePath(m.Source, pgn.InstanceOf(m.Instance)), temperature(m.Temperature))
+	case pgn.BatteryStatus:
+		info = m.Info
+		path := fmt.Sprintf("electrical.batteries.%d.", pgn.InstanceOf(m.Instance))
+		v.add(path+"voltage", pgn.Float64(m.Voltage))
+		v.add(path+"current", pgn.Float64(m.Current))
+		v.add(path+"temperature", temperature(m.Temperature))
+	case pgn.DcDetailedStatus:
+		info = m.Info
+		path := fmt.Sprintf("electrical.batteries.%d.capacity.", pgn.InstanceOf(m.Instance))
+		v.add(path+"stateOfCharge", ratio(integer(m.StateOfCharge)))
+		v.add(path+"stateOfHealth", ratio(integer(m.StateOfHealth)))
+		v.add(path+"timeRemaining", pgn.Float64(m.TimeRemaining))
+	case pgn.EngineParametersRapidUpdate:
+		info = m.Info
+		path := "propulsion." + engine(m.Instance) + "."
+		if rpm := pgn.Float64(m.Speed); rpm != nil {
+			v.add(path+"revolutions", *rpm/60)
+		}
+		v.add(path+"boostPressure", pressure(m.BoostPressure))
+		if m.TiltTrim != nil {
+			v.add(path+"drive.trimState", float64(*m.TiltTrim)/100)
+		}
+	case pgn.EngineParametersDynamic:
+		info = m.Info
+		path := "propulsion." + engine(m.Instance) + "."
+		v.add(path+"oilPressure", pressure(m.OilPressure))
+		v.add(path+"oilTemperature", temperature(m.OilTemperature))
+		v.add(path+"temperature", temperature(m.Temperature))
+		v.add(path+"alternatorVoltage", pgn.Float64(m.AlternatorPotential))
+		v.add(path+"fuel.rate", flow(m.FuelRate))
+		v.add(path+"runTime", integer(m.TotalEngineHours))
+		v.add(path+"coolantPressure", pressure(m.CoolantPressure))
+		v.add(path+"fuel.pressure", pressure(m.FuelPressure))
+		if m.EngineLoad != nil {
+			v.add(path+"engineLoad", float64(*m.EngineLoad)/100)
+		}
+		if m.EngineTorque != nil {
+			v.add(path+"engineTorque", float64(*m.EngineTorque)/100)
+		}
+	case pgn.FluidLevel:
+		info = m.Info
+		path := fmt.Sprintf("tanks.%s.%d.", tankType(m.Type), pgn.InstanceOf(m.Instance))
+		v.add(path+"currentLevel", ratio(pgn.Float64(m.Level)))
+		v.add(path+"capacity", volume(m.Capacity))
+	case pgn.Rudder:
+		info = m.Info
+		v.add("steering.rudderAngle", pgn.Float64(m.Position))
+	case pgn.AisClassAPositionReport:
+		info = m.Info
+		context = aisContext(m.UserId)
+		v.ais(m.Latitude, m.Longitude, m.Cog, m.Sog, m.Heading)
+		if status := navState(m.NavStatus); status != "" {
+			v.add("navigation.state", status)
+		}
+	case pgn.AisClassBPositionReport:
+		info = m.Info
+		context = aisContext(m.UserId)
+		v.ais(m.Latitude, m.Longitude, m.Cog, m.Sog, m.Heading)
+	case pgn.AisClassBExtendedPositionReport:
+		info = m.Info
+		context = aisContext(m.UserId)
+		v.ais(m.Latitude, m.Longitude, m.Cog, m.Sog, m.TrueHeading)
+		v.name(m.Name)
+	case pgn.AisClassAStaticAndVoyageRelatedData:
+		info = m.Info
+		context = aisContext(m.UserId)
+		v.name(m.Name)
+		v.add("design.length", overall(pgn.Meters(m.Length)))
+		v.add("design.beam", pgn.Meters(m.Beam))
+		v.add("design.draft", maximum(pgn.Meters(m.Draft)))
+		if dest := pgn.CleanString(m.Destination); dest != "" {
+			v.add("navigation.destination.commonName", dest)
+		}
+	case pgn.AisClassBStaticDataMsg24PartA:
+		info = m.Info
+		context = aisContext(m.UserId)
+		v.name(m.Name)
+	}
+	if context == "" {
+		return "", info, nil
+	}
+	return context, info, v.list
+}
@@ -0,0 +1,173 @@
+package signalk
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/boatkit-io/tugboat/pkg/units"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/boatkit-io/n2k/internal/testutil"
+	"github.com/boatkit-io/n2k/pkg/pgn"
+	"github.com/boatkit-io/n2k/pkg/subscribe"
+)
+
+// testTime is when the test messages were sent, with milliseconds as Signal K timestamps have them.
+var testTime = testutil.Time.Add(250 * time.Millisecond)
+
+// info returns a MessageInfo for pgnNum from source.
+func info(pgnNum uint32, source uint8) pgn.MessageInfo {
+	return pgn.MessageInfo{PGN: pgnNum, SourceId: source, Timestamp: testTime}
+}
+
+func TestDelta(t *testing.T) {
+	c := NewConverter("can0", testutil.NameResolver{3: 0xc0788400e7e0cc3f})
+	var deltas []Delta
+	c.SetOutput(func(d Delta) { deltas = append(deltas, d) })
+	subs := subscribe.New()
+	_, err := c.Subscribe(subs)
+	assert.NoError(t, err)
+
+	subs.HandleStruct(pgn.PositionRapidUpdate{Info: info(129025, 3), Latitude: testutil.Ptr(52.5),
+		Longitude: testutil.Ptr(4.25)})
+	subs.HandleStruct(pgn.PositionRapidUpdate{Info: info(129025, 4), Latitude: testutil.Ptr(52.5)})
+	subs.HandleStruct(pgn.IsoRequest{Info: info(59904, 4)})
+	subs.HandleStruct(pgn.WindData{Info: info(130306, 4),
+		WindSpeed: testutil.Ptr(units.NewVelocity(units.MetersPerSecond, 6)),
+		WindAngle: testutil.Ptr(float32(3 * math.Pi / 2)), Reference: pgn.Apparent})
+	assert.Len(t, deltas, 2)
+
+	b, err := json.Marshal(deltas[0])
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"context":"vessels.self","updates":[{
+		"$source":"can0.c0788400e7e0cc3f",
+		"source":{"label":"can0","type":"NMEA2000","pgn":129025,"src":"3","canName":"c0788400e7e0cc3f"},
+		"timestamp":"2024-06-01T12:00:00.250Z",
+		"values":[{"path":"navigation.position","value":{"latitude":52.5,"longitude":4.25}}]}]}`, string(b))
+
+	update := deltas[1].Updates[0]
+	assert.Equal(t, "can0.4", update.SourceRef)
+	assert.Equal(t, "", update.Source.CanName)
+	assert.Equal(t, "environment.wind.speedApparent", update.Values[0].Path)
+	assert.InDelta(t, 6, update.Values[0].Value, 0.0001)
+	assert.Equal(t, "environment.wind.angleApparent", update.Values[1].Path)
+	assert.InDelta(t, -math.Pi/2, update.Values[1].Value, 0.0001)
+}
+
+// paths returns the values of a struct's delta by path.
+func paths(t *testing.T, p any) map[string]any {
+	d, ok := NewConverter("n2k", nil).Convert(p)
+	assert.True(t, ok, "%T", p)
+	ret := make(map[string]any)
+	for _, v := range d.Updates[0].Values {
+		ret[v.Path] = v.Value
+	}
+	return ret
+}
+
+func TestPaths(t *testing.T) {
+	values := paths(t, pgn.CogSogRapidUpdate{Info: info(129026, 1), CogReference: pgn.True,
+		Cog: testutil.Ptr(float32(1.5)),
+		Sog: testutil.Ptr(units.NewVelocity(units.Knots, 10))})
+	assert.InDelta(t, 1.5, values["navigation.courseOverGroundTrue"], 0.0001)
+	assert.InDelta(t, 5.144, values["navigation.speedOverGround"], 0.001)
+
+	values = paths(t, pgn.VesselHeading{Info: info(127250, 1), Heading: testutil.Ptr(float32(0.5)),
+		Variation: testutil.Ptr(float32(-0.02)),
+		Reference: pgn.Magnetic})
+	assert.Len(t, values, 2)
+	assert.InDelta(t, 0.5, values["navigation.headingMagnetic"], 0.0001)
+	assert.InDelta(t, -0.02, values["navigation.magneticVariation"], 0.0001)
+
+	values = paths(t, pgn.WaterDepth{Info: info(128267, 1), Depth: testutil.Ptr(units.NewDistance(units.Meter, 4.5)),
+		Offset: testutil.Ptr(units.NewDistance(units.Meter, -0.5))})
+	assert.Len(t, values, 3)
+	assert.InDelta(t, 4.5, values["environment.depth.belowTransducer"], 0.0001)
+	assert.InDelta(t, 0.5, values["environment.depth.transducerToKeel"], 0.0001)
+	assert.InDelta(t, 4, values["environment.depth.belowKeel"], 0.0001)
+
+	values = paths(t, pgn.WaterDepth{Info: info(128267, 1), Depth: testutil.Ptr(units.NewDistance(units.Meter, 4.5)),
+		Offset: testutil.Ptr(units.NewDistance(units.Meter, 1.2))})
+	assert.Len(t, values, 3)
+	assert.InDelta(t, 1.2, values["environment.depth.surfaceToTransducer"], 0.0001)
+	assert.InDelta(t, 5.7, values["environment.depth.belowSurface"], 0.0001)
+
+	values = paths(t, pgn.WindData{Info: info(130306, 1),
+		WindSpeed: testutil.Ptr(units.NewVelocity(units.MetersPerSecond, 6)),
+		WindAngle: testutil.Ptr(float32(4.0)), Reference: pgn.TrueBoatReferenced})
+	assert.Len(t, values, 2)
+	assert.InDelta(t, 6, values["environment.wind.speedOverGround"], 0.0001)
+	assert.InDelta(t, 4-2*math.Pi, values["environment.wind.angleTrueGround"], 0.0001)
+
+	values = paths(t, pgn.Temperature{Info: info(130312, 1), Instance: testutil.Ptr(uint8(2)),
+		Source:            pgn.LiveWellTemperature,
+		ActualTemperature: testutil.Ptr(units.NewTemperature(units.Celsius, 20))})
+	assert.InDelta(t, 293.15, values["tanks.liveWell.2.temperature"], 0.01)
+
+	values = paths(t, pgn.EnvironmentalParameters{Info: info(130311, 1), TemperatureSource: pgn.SeaTemperature,
+		HumiditySource: pgn.Outside, Temperature: testutil.Ptr(units.NewTemperature(units.Kelvin, 290)),
+		Humidity:            testutil.Ptr(float32(65)),
+		AtmosphericPressure: testutil.Ptr(units.NewPressure(units.Hpa, 1013))})
+	assert.InDelta(t, 290, values["environment.water.temperature"], 0.01)
+	assert.InDelta(t, 0.65, values["environment.outside.relativeHumidity"], 0.0001)
+	assert.InDelta(t, 101300, values["environment.outside.pressure"], 1)
+
+	values = paths(t, pgn.BatteryStatus{Info: info(127508, 1), Instance: testutil.Ptr(uint8(1)),
+		Voltage: testutil.Ptr(float32(12.5)),
+		Current: testutil.Ptr(float32(-3.2))})
+	assert.InDelta(t, 12.5, values["electrical.batteries.1.voltage"], 0.0001)
+	assert.InDelta(t, -3.2, values["electrical.batteries.1.current"], 0.0001)
+
+	values = paths(t, pgn.DcDetailedStatus{Info: info(127506, 1), Instance: testutil.Ptr(uint8(1)),
+		StateOfCharge: testutil.Ptr(uint8(85))})
+	assert.InDelta(t, 0.85, values["electrical.batteries.1.capacity.stateOfCharge"], 0.0001)
+
+	values = paths(t, pgn.EngineParametersRapidUpdate{Info: info(127488, 1), Instance: pgn.DualEngineStarboard,
+		Speed: testutil.Ptr(float32(1800)), TiltTrim: testutil.Ptr(int8(-10))})
+	assert.InDelta(t, 30, values["propulsion.starboard.revolutions"], 0.0001)
+	assert.InDelta(t, -0.1, values["propulsion.starboard.drive.trimState"], 0.0001)
+
+	values = paths(t, pgn.EngineParametersDynamic{Info: info(127489, 1), Instance: pgn.SingleEngineOrDualEnginePort,
+		FuelRate: testutil.Ptr(units.NewFlow(units.LitersPerHour, 36)), TotalEngineHours: testutil.Ptr(uint32(3600))})
+	assert.InDelta(t, 0.00001, values["propulsion.port.fuel.rate"], 1e-9)
+	assert.InDelta(t, 3600, values["propulsion.port.runTime"], 0.0001)
+
+	values = paths(t, pgn.FluidLevel{Info: info(127505, 1), Instance: testutil.Ptr(uint8(0)), Type: pgn.GrayWater,
+		Level: testutil.Ptr(float32(40)), Capacity: testutil.Ptr(units.NewVolume(units.Liter, 100))})
+	assert.InDelta(t, 0.4, values["tanks.wasteWater.0.currentLevel"], 0.0001)
+	assert.InDelta(t, 0.1, values["tanks.wasteWater.0.capacity"], 0.0001)
+
+	values = paths(t, pgn.Attitude{Info: info(127257, 1), Pitch: testutil.Ptr(float32(0.1))})
+	assert.Equal(t, map[string]float64{"pitch": float64(float32(0.1))}, values["navigation.attitude"])
+}
+
+func TestAIS(t *testing.T) {
+	c := NewConverter("n2k", nil)
+	d, ok := c.Convert(pgn.AisClassAPositionReport{Info: info(129038, 43), UserId: testutil.Ptr(uint32(244060807)),
+		Latitude: testutil.Ptr(52.0), Longitude: testutil.Ptr(4.0),
+		Sog:       testutil.Ptr(units.NewVelocity(units.MetersPerSecond, 5)),
+		NavStatus: pgn.AtAnchor})
+	assert.True(t, ok)
+	assert.Equal(t, "vessels.urn:mrn:imo:mmsi:244060807", d.Context)
+	assert.Equal(t, []PathValue{
+		{Path: "navigation.position", Value: Position{Latitude: 52, Longitude: 4}},
+		{Path: "navigation.speedOverGround", Value: 5.0},
+		{Path: "navigation.state", Value: "anchored"},
+	}, d.Updates[0].Values)
+
+	d, ok = c.Convert(pgn.AisClassAStaticAndVoyageRelatedData{Info: info(129794, 43),
+		UserId: testutil.Ptr(uint32(244060807)),
+		Name:   "NORTHERN STAR@@@", Length: testutil.Ptr(units.NewDistance(units.Meter, 120))})
+	assert.True(t, ok)
+	assert.Equal(t, []PathValue{
+		{Path: "", Value: map[string]string{"name": "NORTHERN STAR"}},
+		{Path: "design.length", Value: map[string]float64{"overall": 120}},
+	}, d.Updates[0].Values)
+
+	// without an MMSI
+	_, ok = c.Convert(pgn.AisClassBPositionReport{Info: info(129039, 43), Latitude: testutil.Ptr(52.0),
+		Longitude: testutil.Ptr(4.0)})
+	assert.False(t, ok)
+}
@@ -0,0 +1,245 @@
+package signalk
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/boatkit-io/tugboat/pkg/units"
+
+	"github.com/boatkit-io/n2k/pkg/pgn"
+)
+
+// values collects the values of a delta.
+type values struct {
+	list []PathValue
+}
+
+// add method adds a value at path, unless it's a nil pointer.
+func (v *values) add(path string, value any) {
+	switch f := value.(type) {
+	case *float64:
+		if f == nil {
+			return
+		}
+		value = *f
+	case nil:
+		return
+	}
+	v.list = append(v.list, PathValue{Path: path, Value: value})
+}
+
+// position method adds a position, if both its latitude and longitude are known.
+func (v *values) position(path string, latitude, longitude, altitude *float64) {
+	if latitude != nil && longitude != nil {
+		v.add(path, Position{Latitude: *latitude, Longitude: *longitude, Altitude: altitude})
+	}
+}
+
+// ais method adds the values of an AIS position report.
+func (v *values) ais(latitude, longitude *float64, cog *float32, sog *units.Velocity, heading *float32) {
+	v.position("navigation.position", latitude, longitude, nil)
+	v.add("navigation.courseOverGroundTrue", pgn.Float64(cog))
+	v.add("navigation.speedOverGround", pgn.MetersPerSecond(sog))
+	v.add("navigation.headingTrue", pgn.Float64(heading))
+}
+
+// name method adds a vessel's name, which is a property of the vessel itself rather than at a path.
+func (v *values) name(name string) {
+	if name = pgn.CleanString(name); name != "" {
+		v.add("", map[string]string{"name": name})
+	}
+}
+
+// aisContext returns the context of the vessel with MMSI mmsi, or "" if it's missing.
+func aisContext(mmsi *uint32) string {
+	if mmsi == nil {
+		return ""
+	}
+	return fmt.Sprintf("vessels.urn:mrn:imo:mmsi:%09d", *mmsi)
+}
+
+// reference returns the suffix of paths with a direction reference.
+func reference(r pgn.DirectionReferenceConst) string {
+	if r == pgn.Magnetic {
+		return "Magnetic"
+	}
+	return "True"
+}
+
+// temperaturePath returns the path of a temperature from source.
+func temperaturePath(source pgn.TemperatureSourceConst, instance uint8) string {
+	switch source {
+	case pgn.SeaTemperature:
+		return "environment.water.temperature"
+	case pgn.OutsideTemperature:
+		return "environment.outside.temperature"
+	case pgn.InsideTemperature:
+		return "environment.inside.temperature"
+	case pgn.EngineRoomTemperature:
+		return "environment.inside.engineRoom.temperature"
+	case pgn.MainCabinTemperature:
+		return "environment.inside.mainCabin.temperature"
+	case pgn.LiveWellTemperature:
+		return fmt.Sprintf("tanks.liveWell.%d.temperature", instance)
+	case pgn.BaitWellTemperature:
+		return fmt.Sprintf("tanks.baitWell.%d.temperature", instance)
+	case pgn.RefrigerationTemperature:
+		return "environment.inside.refrigerator.temperature"
+	case pgn.HeatingSystemTemperature:
+		return "environment.inside.heating.temperature"
+	case pgn.DewPointTemperature:
+		return "environment.outside.dewPointTemperature"
+	case pgn.ApparentWindChillTemperature:
+		return "environment.outside.apparentWindChillTemperature"
+	case pgn.TheoreticalWindChillTemperature:
+		return "environment.outside.theoreticalWindChillTemperature"
+	case pgn.HeatIndexTemperature:
+		return "environment.outside.heatIndexTemperature"
+	case pgn.FreezerTemperature:
+		return "environment.inside.freezer.temperature"
+	case pgn.ExhaustGasTemperature:
+		return fmt.Sprintf("propulsion.%d.exhaustTemperature", instance)
+	default:
+		return fmt.Sprintf("environment.temperature.%d", int(source))
+	}
+}
+
+// tankType returns the Signal K name of a tank's fluid.
+func tankType(t pgn.TankTypeConst) string {
+	switch t {
+	case pgn.Fuel_2:
+		return "fuel"
+	case pgn.Water_2:
+		return "freshWater"
+	case pgn.GrayWater:
+		return "wasteWater"
+	case pgn.LiveWell:
+		return "liveWell"
+	case pgn.Oil_2:
+		return "lubrication"
+	case pgn.BlackWater:
+		return "blackWater"
+	default:
+		return fmt.Sprintf("unknown%d", int(t))
+	}
+}
+
+// engine returns the Signal K id of an engine.
+func engine(i pgn.EngineInstanceConst) string {
+	switch i {
+	case pgn.SingleEngineOrDualEnginePort:
+		return "port"
+	case pgn.DualEngineStarboard:
+		return "starboard"
+	default:
+		return fmt.Sprint(int(i))
+	}
+}
+
+// navState returns the Signal K navigation.state of an AIS navigational status, or "" if it doesn't have one.
+func navState(s pgn.NavStatusConst) string {
+	switch s {
+	case pgn.UnderWayUsingEngine:
+		return "motoring"
+	case pgn.AtAnchor:
+		return "anchored"
+	case pgn.NotUnderCommand:
+		return "not under command"
+	case pgn.RestrictedManeuverability:
+		return "restricted manouverability"
+	case pgn.ConstrainedByHerDraught:
+		return "constrained by draft"
+	case pgn.Moored:
+		return "moored"
+	case pgn.Aground:
+		return "aground"
+	case pgn.EngagedInFishing:
+		return "fishing"
+	case pgn.UnderWaySailing:
+		return "sailing"
+	case pgn.AISSART:
+		return "ais-sart"
+	default:
+		return ""
+	}
+}
+
+// relative returns an angle relative to the bow from -π to π, rather than from 0 to 2π.
+func relative(angle *float64) *float64 {
+	if angle == nil {
+		return nil
+	}
+	a := math.Remainder(*angle, 2*math.Pi)
+	return &a
+}
+
+// ratio returns a percentage as a ratio.
+func ratio(percent *float64) *float64 {
+	if percent == nil {
+		return nil
+	}
+	r := *percent / 100
+	return &r
+}
+
+// overall returns a length as design.length's value.
+func overall(length *float64) any {
+	if length == nil {
+		return nil
+	}
+	return map[string]float64{"overall": *length}
+}
+
+// maximum returns a draft as design.draft's value.
+func maximum(draft *float64) any {
+	if draft == nil {
+		return nil
+	}
+	return map[string]float64{"maximum": *draft}
+}
+
+// integer returns an integer field's value as a float64, or nil if it's missing.
+func integer[T uint8 | uint16 | uint32](i *T) *float64 {
+	if i == nil {
+		return nil
+	}
+	f := float64(*i)
+	return &f
+}
+
+// temperature returns a field's value in Kelvin, or nil if it's missing.
+func temperature(t *units.Temperature) *float64 {
+	if t == nil {
+		return nil
+	}
+	converted := t.Convert(units.Kelvin)
+	return pgn.Float64(&converted.Value)
+}
+
+// pressure returns a field's value in Pa, or nil if it's missing.
+func pressure(p *units.Pressure) *float64 {
+	if p == nil {
+		return nil
+	}
+	converted := p.Convert(units.Pa)
+	return pgn.Float64(&converted.Value)
+}
+
+// volume returns a field's value in m³, or nil if it's missing.
+func volume(v *units.Volume) *float64 {
+	if v == nil {
+		return nil
+	}
+	converted := v.Convert(units.MetersCubed)
+	return pgn.Float64(&converted.Value)
+}
+
+// flow returns a field's value in m³/s, or nil if it's missing.
+func flow(f *units.Flow) *float64 {
+	if f == nil {
+		return nil
+	}
+	converted := f.Convert(units.LitersPerHour)
+	v := float64(converted.Value) / 1000 / 3600
+	return &v
+}
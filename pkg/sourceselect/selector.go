@@ -0,0 +1,249 @@
+// Package sourceselect chooses one source for each kind of data when several devices send it, like two GPS units.
+package sourceselect
+
+import (
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/boatkit-io/n2k/pkg/addressclaim"
+	"github.com/boatkit-io/n2k/pkg/pgn"
+	"github.com/boatkit-io/n2k/pkg/pkt"
+	"github.com/boatkit-io/n2k/pkg/subscribe"
+)
+
+// DefaultTimeout is how long a source can go quiet before the Selector fails over, unless set with SetTimeout.
+const DefaultTimeout = 3 * time.Second
+
+// Source identifies a device sending data, by its NAME if it's known and otherwise its address.
+type Source struct {
+	Name    addressclaim.Name // 0 if unknown
+	Address uint8
+}
+
+// ByAddress returns the Source for a device at address.
+func ByAddress(address uint8) Source {
+	return Source{Address: address}
+}
+
+// ByName returns the Source for the device with NAME name, which follows it if its address changes.
+// It needs the Selector's NameResolver.
+func ByName(name addressclaim.Name) Source {
+	return Source{Name: name}
+}
+
+// Change describes the Selector switching the active source of a data type.
+type Change struct {
+	DataType  string
+	Previous  Source
+	HadSource bool // false if there wasn't a previous source
+	Active    Source
+	Timestamp time.Time
+}
+
+// dataState tracks the sources of a data type.
+type dataState struct {
+	lastSeen  map[uint8]time.Time
+	active    uint8
+	hasActive bool
+}
+
+// Selector passes on structs of each configured data type from only one source: the highest priority source that
+// isn't stale, or if none of those are sending, any other source. A source is stale once it's sent nothing of the data
+// type for the timeout, measured by message timestamps. Structs of other types are all passed on.
+// Put it between the PacketStruct and the subscribers: packetStruct.SetOutput(selector); selector.SetOutput(subs).
+type Selector struct {
+	mu         sync.Mutex
+	resolver   subscribe.NameResolver
+	timeout    time.Duration
+	types      map[string]string   // struct name to data type
+	priorities map[string][]Source // by data type
+	data       map[string]*dataState
+	names      map[uint8]addressclaim.Name // each address's NAME when it last sent a selected struct
+	handler    pkt.StructHandler
+	onChange   func(Change)
+}
+
+// NewSelector returns a new Selector. resolver finds devices' NAMEs, for sources chosen by name, and can be nil.
+// A devices.Registry is a NameResolver.
+func NewSelector(resolver subscribe.NameResolver) *Selector {
+	return &Selector{
+		resolver:   resolver,
+		timeout:    DefaultTimeout,
+		types:      make(map[string]string),
+		priorities: make(map[string][]Source),
+		data:       make(map[string]*dataState),
+		names:      make(map[uint8]addressclaim.Name),
+	}
+}
+
+// SetOutput assigns the handler for the structs passed on.
+func (s *Selector) SetOutput(sh pkt.StructHandler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handler = sh
+}
+
+// SetChangeHandler assigns a callback for when the active source of a data type changes.
+func (s *Selector) SetChangeHandler(handler func(Change)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.onChange = handler
+}
+
+// SetTimeout sets how long a source can go quiet before it's stale.
+func (s *Selector) SetTimeout(timeout time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.timeout = timeout
+}
+
+// Group makes a data type from several structs, which are sent by the same source. For example a GPS:
+//
+//	selector.Group("gps", pgn.PositionRapidUpdate{}, pgn.CogSogRapidUpdate{}, pgn.GnssPositionData{})
+//
+// Otherwise each struct is its own data type, named for the struct.
+func (s *Selector) Group(dataType string, structs ...any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, p := range structs {
+		s.types[reflect.TypeOf(p).Name()] = dataType
+	}
+	if _, exists := s.priorities[dataType]; !exists {
+		s.priorities[dataType] = nil
+	}
+}
+
+// SetPriority selects a source for a data type (a Group or a struct name like "VesselHeading"), preferring sources
+// in the order given. Sources that aren't listed are used when none of the listed ones are sending.
+func (s *Selector) SetPriority(dataType string, sources ...Source) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.priorities[dataType] = append([]Source(nil), sources...)
+}
+
+// Active returns the source currently passed on for a data type.
+func (s *Selector) Active(dataType string) (Source, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ds := s.data[dataType]
+	if ds == nil || !ds.hasActive {
+		return Source{}, false
+	}
+	return s.sourceFor(ds.active), true
+}
+
+// HandleStruct passes on the struct if it's from the active source of its data type, or isn't of a selected type.
+func (s *Selector) HandleStruct(p any) {
+	// the resolver has its own lock, so the sender's NAME is looked up before taking ours
+	info, hasInfo := pgn.InfoOf(p)
+	var name addressclaim.Name
+	if hasInfo && s.resolver != nil {
+		if n, ok := s.resolver.NameFor(info); ok {
+			name = addressclaim.Name(n)
+		}
+	}
+
+	s.mu.Lock()
+	handler := s.handler
+	onChange := s.onChange
+	drop := false
+	var change *Change
+	if dataType, selected := s.dataTypeFor(p); selected {
+		if hasInfo {
+			if name != 0 {
+				s.names[info.SourceId] = name
+			} else {
+				delete(s.names, info.SourceId)
+			}
+			var active uint8
+			active, change = s.update(dataType, info)
+			drop = active != info.SourceId
+		}
+	}
+	s.mu.Unlock()
+
+	if change != nil && onChange != nil {
+		onChange(*change)
+	}
+	if !drop && handler != nil {
+		handler.HandleStruct(p)
+	}
+}
+
+// dataTypeFor returns the data type of p, and true if its source is selected. The mutex must be held.
+func (s *Selector) dataTypeFor(p any) (string, bool) {
+	t := reflect.TypeOf(p)
+	if t == nil {
+		return "", false
+	}
+	name := t.Name()
+	if dataType, ok := s.types[name]; ok {
+		return dataType, true
+	}
+	_, ok := s.priorities[name]
+	return name, ok
+}
+
+// update records a message of a data type, and returns the active source, and the change if it's a new one.
+// The mutex must be held.
+func (s *Selector) update(dataType string, info pgn.MessageInfo) (uint8, *Change) {
+	ds := s.data[dataType]
+	if ds == nil {
+		ds = &dataState{lastSeen: make(map[uint8]time.Time)}
+		s.data[dataType] = ds
+	}
+	ds.lastSeen[info.SourceId] = info.Timestamp
+
+	// order by priority, then keep the active source among equals so it doesn't flap, then by address
+	order := func(address uint8) int {
+		order := s.rank(dataType, address) << 9
+		if !ds.hasActive || address != ds.active {
+			order |= 1 << 8
+		}
+		return order | int(address)
+	}
+	best, bestOrder := info.SourceId, -1
+	for address, seen := range ds.lastSeen {
+		if info.Timestamp.Sub(seen) > s.timeout {
+			continue
+		}
+		if o := order(address); bestOrder < 0 || o < bestOrder {
+			best, bestOrder = address, o
+		}
+	}
+
+	if ds.hasActive && best == ds.active {
+		return best, nil
+	}
+	change := &Change{
+		DataType:  dataType,
+		HadSource: ds.hasActive,
+		Active:    s.sourceFor(best),
+		Timestamp: info.Timestamp,
+	}
+	if ds.hasActive {
+		change.Previous = s.sourceFor(ds.active)
+	}
+	ds.active, ds.hasActive = best, true
+	return best, change
+}
+
+// rank returns the position of the source at address in the data type's priorities, after them if it's not listed.
+func (s *Selector) rank(dataType string, address uint8) int {
+	priorities := s.priorities[dataType]
+	source := s.sourceFor(address)
+	for i, p := range priorities {
+		if (p.Name != 0 && p.Name == source.Name) || (p.Name == 0 && p.Address == address) {
+			return i
+		}
+	}
+	return len(priorities)
+}
+
+// sourceFor returns the Source at address, with its NAME if the resolver knew it when the address last sent a
+// selected struct. The mutex must be held.
+func (s *Selector) sourceFor(address uint8) Source {
+	return Source{Address: address, Name: s.names[address]}
+}
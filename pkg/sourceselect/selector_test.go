@@ -0,0 +1,140 @@
+package sourceselect
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/boatkit-io/n2k/internal/testutil"
+	"github.com/boatkit-io/n2k/pkg/pgn"
+)
+
+// sources returns the source addresses of the structs c received, and forgets them.
+func sources(c *testutil.StructCollector) []uint8 {
+	var ret []uint8
+	for _, p := range c.Structs {
+		info, _ := pgn.InfoOf(p)
+		ret = append(ret, info.SourceId)
+	}
+	c.Structs = nil
+	return ret
+}
+
+// heading returns a VesselHeading from source, sent at seconds after testutil.Time.
+func heading(source uint8, seconds int) pgn.VesselHeading {
+	return pgn.VesselHeading{Info: pgn.MessageInfo{SourceId: source, Timestamp: testutil.Time.Add(time.Duration(seconds) * time.Second)}}
+}
+
+func TestFailover(t *testing.T) {
+	s := NewSelector(nil)
+	c := &testutil.StructCollector{}
+	s.SetOutput(c)
+	var changes []Change
+	s.SetChangeHandler(func(ch Change) { changes = append(changes, ch) })
+	s.SetPriority("VesselHeading", ByAddress(2), ByAddress(1))
+
+	// the first source is used until the preferred one arrives
+	s.HandleStruct(heading(1, 0))
+	s.HandleStruct(heading(2, 0))
+	s.HandleStruct(heading(1, 1))
+	s.HandleStruct(heading(2, 1))
+	// other structs pass
+	s.HandleStruct(pgn.IsoRequest{Info: pgn.MessageInfo{SourceId: 1}})
+	assert.Equal(t, []uint8{1, 2, 2, 1}, sources(c))
+	active, ok := s.Active("VesselHeading")
+	assert.True(t, ok)
+	assert.Equal(t, ByAddress(2), active)
+
+	// the preferred source goes quiet
+	s.HandleStruct(heading(1, 3))
+	s.HandleStruct(heading(1, 5))
+	s.HandleStruct(heading(1, 6))
+	assert.Equal(t, []uint8{1, 1}, sources(c))
+
+	// an unlisted source is only used when the listed ones are stale
+	s.HandleStruct(heading(3, 7))
+	s.HandleStruct(heading(1, 7))
+	assert.Equal(t, []uint8{1}, sources(c))
+
+	// and it comes back
+	s.HandleStruct(heading(2, 8))
+	s.HandleStruct(heading(1, 8))
+	assert.Equal(t, []uint8{2}, sources(c))
+
+	assert.Len(t, changes, 4)
+	assert.Equal(t, Change{DataType: "VesselHeading", Active: ByAddress(1), Timestamp: testutil.Time}, changes[0])
+	assert.Equal(t, ByAddress(2), changes[2].Previous)
+	assert.Equal(t, ByAddress(1), changes[2].Active)
+	assert.Equal(t, testutil.Time.Add(5*time.Second), changes[2].Timestamp)
+	assert.Equal(t, ByAddress(2), changes[3].Active)
+
+	_, ok = s.Active("WindData")
+	assert.False(t, ok)
+}
+
+func TestGroupByName(t *testing.T) {
+	s := NewSelector(testutil.NameResolver{10: 0x1000, 11: 0x2000})
+	c := &testutil.StructCollector{}
+	s.SetOutput(c)
+	s.SetTimeout(time.Second)
+	s.Group("gps", pgn.PositionRapidUpdate{}, pgn.CogSogRapidUpdate{})
+	s.SetPriority("gps", ByName(0x2000))
+
+	position := func(source uint8, seconds int) pgn.PositionRapidUpdate {
+		return pgn.PositionRapidUpdate{Info: pgn.MessageInfo{SourceId: source, Timestamp: testutil.Time.Add(time.Duration(seconds) * time.Second)}}
+	}
+	cogSog := func(source uint8, seconds int) pgn.CogSogRapidUpdate {
+		return pgn.CogSogRapidUpdate{Info: pgn.MessageInfo{SourceId: source, Timestamp: testutil.Time.Add(time.Duration(seconds) * time.Second)}}
+	}
+
+	s.HandleStruct(position(11, 0))
+	s.HandleStruct(position(10, 0))
+	s.HandleStruct(cogSog(10, 0))
+	s.HandleStruct(cogSog(11, 0))
+	assert.Equal(t, []uint8{11, 11}, sources(c))
+	active, _ := s.Active("gps")
+	assert.Equal(t, Source{Name: 0x2000, Address: 11}, active)
+
+	// the group fails over together
+	s.HandleStruct(position(10, 2))
+	s.HandleStruct(cogSog(10, 2))
+	assert.Equal(t, []uint8{10, 10}, sources(c))
+
+	// structs that aren't selected all pass
+	s.HandleStruct(heading(10, 2))
+	s.HandleStruct(heading(11, 2))
+	assert.Equal(t, []uint8{10, 11}, sources(c))
+}
+
+// lockingResolver is a NameResolver that calls back into the Selector, as a resolver with its own lock might
+type lockingResolver struct {
+	s *Selector
+}
+
+// NameFor method returns the name for the message's source, after checking the Selector isn't locked
+func (r *lockingResolver) NameFor(info pgn.MessageInfo) (uint64, bool) {
+	r.s.Active("gps")
+	return 0x1000 + uint64(info.SourceId), true
+}
+
+func TestResolveUnlocked(t *testing.T) {
+	r := &lockingResolver{}
+	s := NewSelector(r)
+	r.s = s
+	s.Group("gps", pgn.PositionRapidUpdate{})
+	s.SetPriority("gps", ByName(0x100b))
+
+	done := make(chan struct{})
+	go func() {
+		s.HandleStruct(pgn.PositionRapidUpdate{Info: pgn.MessageInfo{PGN: 129025, SourceId: 11, Timestamp: testutil.Time}})
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("HandleStruct called the resolver with the Selector locked")
+	}
+	active, _ := s.Active("gps")
+	assert.Equal(t, Source{Name: 0x100b, Address: 11}, active)
+}
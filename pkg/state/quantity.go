@@ -0,0 +1,171 @@
+package state
+
+import (
+	"strings"
+
+	"github.com/boatkit-io/tugboat/pkg/units"
+
+	"github.com/boatkit-io/n2k/pkg/pgn"
+)
+
+// Quantity names a kind of value the Store keeps.
+type Quantity string
+
+// Quantities, and their units. Angles are in radians.
+const (
+	// Latitude is in degrees, north positive.
+	Latitude Quantity = "latitude"
+	// Longitude is in degrees, east positive.
+	Longitude Quantity = "longitude"
+	// COGTrue is the course over ground, referenced to true north.
+	COGTrue Quantity = "cog.true"
+	// COGMagnetic is the course over ground, referenced to magnetic north.
+	COGMagnetic Quantity = "cog.magnetic"
+	// SOG is the speed over ground in m/s.
+	SOG Quantity = "sog"
+	// HeadingTrue is the vessel's heading, referenced to true north.
+	HeadingTrue Quantity = "heading.true"
+	// HeadingMagnetic is the vessel's heading, referenced to magnetic north.
+	HeadingMagnetic Quantity = "heading.magnetic"
+	// Depth is the depth of water below the transducer in meters.
+	Depth Quantity = "depth"
+	// ApparentWindSpeed is in m/s.
+	ApparentWindSpeed Quantity = "wind.apparent.speed"
+	// ApparentWindAngle is relative to the bow.
+	ApparentWindAngle Quantity = "wind.apparent.angle"
+	// TrueWindSpeed is in m/s, referenced to the boat or the water.
+	TrueWindSpeed Quantity = "wind.true.speed"
+	// TrueWindAngle is relative to the bow.
+	TrueWindAngle Quantity = "wind.true.angle"
+	// WaterTemperature is in Kelvin; the same as Temperature(pgn.SeaTemperature).
+	WaterTemperature Quantity = "temperature.sea"
+	// BatteryVoltage is in volts.
+	BatteryVoltage Quantity = "battery.voltage"
+	// BatteryCurrent is in amps, positive when charging.
+	BatteryCurrent Quantity = "battery.current"
+	// BatteryTemperature is in Kelvin.
+	BatteryTemperature Quantity = "battery.temperature"
+)
+
+// Temperature returns the Quantity for temperatures, in Kelvin, from source, like "temperature.engine_room".
+func Temperature(source pgn.TemperatureSourceConst) Quantity {
+	return Quantity("temperature." + nameFor(strings.TrimSuffix(source.String(), " Temperature")))
+}
+
+// TankLevel returns the Quantity for the levels, in percent, of tanks of fluid, like "tank.gray_water.level".
+func TankLevel(fluid pgn.TankTypeConst) Quantity {
+	return Quantity("tank." + nameFor(fluid.String()) + ".level")
+}
+
+// TankCapacity returns the Quantity for the capacities, in liters, of tanks of fluid, like "tank.fuel.capacity".
+func TankCapacity(fluid pgn.TankTypeConst) Quantity {
+	return Quantity("tank." + nameFor(fluid.String()) + ".capacity")
+}
+
+// nameFor returns a lookup's name in the style of a Quantity.
+func nameFor(label string) string {
+	return strings.ReplaceAll(strings.ToLower(label), " ", "_")
+}
+
+// valuesFor returns the values in a PGN struct, or nil if it doesn't have any.
+func valuesFor(p any) []Value {
+	var values []Value
+	var info pgn.MessageInfo
+	add := func(q Quantity, instance uint8, value any) {
+		f, ok := toFloat(value)
+		if ok {
+			values = append(values, Value{
+				Key:       Key{Quantity: q, Instance: instance, Source: info.SourceId},
+				Value:     f,
+				Timestamp: info.Timestamp,
+			})
+		}
+	}
+
+	switch m := p.(type) {
+	case pgn.PositionRapidUpdate:
+		info = m.Info
+		add(Latitude, 0, m.Latitude)
+		add(Longitude, 0, m.Longitude)
+	case pgn.CogSogRapidUpdate:
+		info = m.Info
+		switch m.CogReference {
+		case pgn.True:
+			add(COGTrue, 0, m.Cog)
+		case pgn.Magnetic:
+			add(COGMagnetic, 0, m.Cog)
+		}
+		add(SOG, 0, m.Sog)
+	case pgn.VesselHeading:
+		info = m.Info
+		switch m.Reference {
+		case pgn.True:
+			add(HeadingTrue, 0, m.Heading)
+		case pgn.Magnetic:
+			add(HeadingMagnetic, 0, m.Heading)
+		}
+	case pgn.WaterDepth:
+		info = m.Info
+		add(Depth, 0, m.Depth)
+	case pgn.WindData:
+		info = m.Info
+		switch m.Reference {
+		case pgn.Apparent:
+			add(ApparentWindSpeed, 0, m.WindSpeed)
+			add(ApparentWindAngle, 0, m.WindAngle)
+		case pgn.TrueBoatReferenced, pgn.TrueWaterReferenced:
+			add(TrueWindSpeed, 0, m.WindSpeed)
+			add(TrueWindAngle, 0, m.WindAngle)
+		}
+	case pgn.Temperature:
+		info = m.Info
+		add(Temperature(m.Source), pgn.InstanceOf(m.Instance), m.ActualTemperature)
+	case pgn.TemperatureExtendedRange:
+		info = m.Info
+		add(Temperature(m.Source), pgn.InstanceOf(m.Instance), m.Temperature)
+	case pgn.EnvironmentalParametersObsolete:
+		info = m.Info
+		add(WaterTemperature, 0, m.WaterTemperature)
+	case pgn.BatteryStatus:
+		info = m.Info
+		add(BatteryVoltage, pgn.InstanceOf(m.Instance), m.Voltage)
+		add(BatteryCurrent, pgn.InstanceOf(m.Instance), m.Current)
+		add(BatteryTemperature, pgn.InstanceOf(m.Instance), m.Temperature)
+	case pgn.FluidLevel:
+		info = m.Info
+		add(TankLevel(m.Type), pgn.InstanceOf(m.Instance), m.Level)
+		add(TankCapacity(m.Type), pgn.InstanceOf(m.Instance), m.Capacity)
+	}
+	return values
+}
+
+// toFloat returns a field's value in the Quantity's unit, and false if it's missing.
+func toFloat(value any) (float64, bool) {
+	switch v := value.(type) {
+	case *float64:
+		if v != nil {
+			return *v, true
+		}
+	case *float32:
+		if v != nil {
+			return float64(*v), true
+		}
+	case *units.Distance:
+		if v != nil {
+			return float64(v.Convert(units.Meter).Value), true
+		}
+	case *units.Velocity:
+		if v != nil {
+			return float64(v.Convert(units.MetersPerSecond).Value), true
+		}
+	case *units.Temperature:
+		if v != nil {
+			return float64(v.Convert(units.Kelvin).Value), true
+		}
+	case *units.Volume:
+		if v != nil {
+			return float64(v.Convert(units.Liter).Value), true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,214 @@
+// Package state keeps the latest value of each quantity the vessel's instruments report, like its position, heading,
+// depth and battery voltages.
+package state
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/boatkit-io/n2k/pkg/subscribe"
+)
+
+// DefaultMaxAge is how long a value is kept before it's stale, unless set with SetMaxAge.
+const DefaultMaxAge = 10 * time.Second
+
+// Key identifies a value: what it is, which instance of it (for example which battery), and the source address of
+// the device that reported it.
+type Key struct {
+	Quantity Quantity
+	Instance uint8
+	Source   uint8
+}
+
+// Value is a reported value, in the units described for its Quantity.
+type Value struct {
+	Key
+	Value     float64
+	Timestamp time.Time // from the message it was in
+}
+
+// Store keeps the latest Value for each Key from the structs it receives.
+// Feed it all structs, either by subscribing it (see Subscribe) or calling HandleStruct directly.
+// Values are stale, and no longer returned, once they're older than their maximum age.
+type Store struct {
+	mu      sync.Mutex
+	values  map[Key]Value
+	maxAge  time.Duration
+	maxAges map[Quantity]time.Duration
+	now     func() time.Time
+	handler func(Value)
+}
+
+// NewStore returns a new, empty Store.
+func NewStore() *Store {
+	return &Store{
+		values:  make(map[Key]Value),
+		maxAge:  DefaultMaxAge,
+		maxAges: make(map[Quantity]time.Duration),
+		now:     time.Now,
+	}
+}
+
+// SetMaxAge sets how long values are kept before they're stale, for all quantities without their own maximum age.
+func (s *Store) SetMaxAge(maxAge time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.maxAge = maxAge
+}
+
+// SetQuantityMaxAge sets how long values of a quantity are kept before they're stale, for example longer for tank
+// levels, which are sent less often.
+func (s *Store) SetQuantityMaxAge(q Quantity, maxAge time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.maxAges[q] = maxAge
+}
+
+// SetClock replaces the clock values' ages are measured by, which is time.Now by default.
+// Replays should use a clock that follows the replay.
+func (s *Store) SetClock(now func() time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.now = now
+}
+
+// SetOutput assigns a callback for when a value is added or changes.
+// It's not called when a value is received again unchanged.
+func (s *Store) SetOutput(handler func(Value)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handler = handler
+}
+
+// Subscribe subscribes the store to all structs from subs.
+func (s *Store) Subscribe(subs *subscribe.SubscribeManager) (subscribe.SubscriptionId, error) {
+	return subs.SubscribeToAllStructs(s.HandleStruct)
+}
+
+// HandleStruct updates the store from a struct received from the network.
+func (s *Store) HandleStruct(p any) {
+	if values := valuesFor(p); len(values) > 0 {
+		s.Set(values...)
+	}
+}
+
+// Set stores values directly, for example ones calculated from others.
+func (s *Store) Set(values ...Value) {
+	s.mu.Lock()
+	var changed []Value
+	for _, v := range values {
+		old, exists := s.values[v.Key]
+		s.values[v.Key] = v
+		if !exists || old.Value != v.Value || s.stale(old) {
+			changed = append(changed, v)
+		}
+	}
+	handler := s.handler
+	s.mu.Unlock()
+
+	if handler != nil {
+		for _, v := range changed {
+			handler(v)
+		}
+	}
+}
+
+// Get returns the value for key, if it's not stale.
+func (s *Store) Get(key Key) (Value, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	v, ok := s.values[key]
+	if !ok || s.stale(v) {
+		return Value{}, false
+	}
+	return v, true
+}
+
+// Latest returns the most recent value of an instance of a quantity from any source, if one's not stale.
+func (s *Store) Latest(q Quantity, instance uint8) (Value, bool) {
+	return s.Snapshot().Latest(q, instance)
+}
+
+// Snapshot returns the values that aren't stale.
+func (s *Store) Snapshot() Snapshot {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	snap := Snapshot{
+		Time:   s.now(),
+		Values: make(map[Key]Value, len(s.values)),
+	}
+	for k, v := range s.values {
+		if !s.stale(v) {
+			snap.Values[k] = v
+		}
+	}
+	return snap
+}
+
+// Prune removes stale values, to free their memory. They're not returned either way.
+func (s *Store) Prune() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for k, v := range s.values {
+		if s.stale(v) {
+			delete(s.values, k)
+		}
+	}
+}
+
+// stale returns true if v is older than its quantity's maximum age. The mutex must be held.
+func (s *Store) stale(v Value) bool {
+	maxAge, ok := s.maxAges[v.Quantity]
+	if !ok {
+		maxAge = s.maxAge
+	}
+	return s.now().Sub(v.Timestamp) > maxAge
+}
+
+// Snapshot holds the values in a Store at a point in time.
+type Snapshot struct {
+	Time   time.Time
+	Values map[Key]Value
+}
+
+// Get method returns the value for key.
+func (s Snapshot) Get(key Key) (Value, bool) {
+	v, ok := s.Values[key]
+	return v, ok
+}
+
+// Latest method returns the most recent value of an instance of a quantity from any source.
+func (s Snapshot) Latest(q Quantity, instance uint8) (Value, bool) {
+	var latest Value
+	found := false
+	for k, v := range s.Values {
+		if k.Quantity == q && k.Instance == instance && (!found || v.Timestamp.After(latest.Timestamp)) {
+			latest = v
+			found = true
+		}
+	}
+	return latest, found
+}
+
+// Sorted method returns the values ordered by Quantity, Instance and Source.
+func (s Snapshot) Sorted() []Value {
+	ret := make([]Value, 0, len(s.Values))
+	for _, v := range s.Values {
+		ret = append(ret, v)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		a, b := ret[i].Key, ret[j].Key
+		if a.Quantity != b.Quantity {
+			return a.Quantity < b.Quantity
+		}
+		if a.Instance != b.Instance {
+			return a.Instance < b.Instance
+		}
+		return a.Source < b.Source
+	})
+	return ret
+}
@@ -0,0 +1,115 @@
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/boatkit-io/tugboat/pkg/units"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/boatkit-io/n2k/internal/testutil"
+	"github.com/boatkit-io/n2k/pkg/pgn"
+	"github.com/boatkit-io/n2k/pkg/subscribe"
+)
+
+func TestQuantities(t *testing.T) {
+	assert.Equal(t, WaterTemperature, Temperature(pgn.SeaTemperature))
+	assert.Equal(t, Quantity("temperature.engine_room"), Temperature(pgn.EngineRoomTemperature))
+	assert.Equal(t, Quantity("tank.gray_water.level"), TankLevel(pgn.GrayWater))
+	assert.Equal(t, Quantity("tank.fuel.capacity"), TankCapacity(pgn.Fuel_2))
+}
+
+func TestStore(t *testing.T) {
+	s := NewStore()
+	now := testutil.Time
+	s.SetClock(func() time.Time { return now })
+	var changes []Value
+	s.SetOutput(func(v Value) { changes = append(changes, v) })
+
+	subs := subscribe.New()
+	_, err := s.Subscribe(subs)
+	assert.NoError(t, err)
+
+	subs.HandleStruct(pgn.PositionRapidUpdate{Info: testutil.Info(1, 0), Latitude: testutil.Ptr(52.5),
+		Longitude: testutil.Ptr(4.25)})
+	subs.HandleStruct(pgn.CogSogRapidUpdate{Info: testutil.Info(1, 0), CogReference: pgn.True,
+		Cog: testutil.Ptr(float32(1.5)), Sog: testutil.Ptr(units.NewVelocity(units.Knots, 10))})
+	subs.HandleStruct(pgn.VesselHeading{Info: testutil.Info(2, 0), Heading: testutil.Ptr(float32(0.5)),
+		Reference: pgn.Magnetic})
+	subs.HandleStruct(pgn.WaterDepth{Info: testutil.Info(3, 0),
+		Depth: testutil.Ptr(units.NewDistance(units.Meter, 4.5))})
+	subs.HandleStruct(pgn.WindData{Info: testutil.Info(4, 0),
+		WindSpeed: testutil.Ptr(units.NewVelocity(units.MetersPerSecond, 6)), WindAngle: testutil.Ptr(float32(0.75)),
+		Reference: pgn.Apparent})
+	subs.HandleStruct(pgn.Temperature{Info: testutil.Info(5, 0), Instance: testutil.Ptr(uint8(0)),
+		Source: pgn.SeaTemperature, ActualTemperature: testutil.Ptr(units.NewTemperature(units.Celsius, 20))})
+	subs.HandleStruct(pgn.BatteryStatus{Info: testutil.Info(6, 0), Instance: testutil.Ptr(uint8(1)),
+		Voltage: testutil.Ptr(float32(12.5))})
+	subs.HandleStruct(pgn.FluidLevel{Info: testutil.Info(7, 0), Instance: testutil.Ptr(uint8(0)), Type: pgn.Fuel_2,
+		Level: testutil.Ptr(float32(80)), Capacity: testutil.Ptr(units.NewVolume(units.Liter, 200))})
+	subs.HandleStruct(pgn.IsoRequest{Info: testutil.Info(7, 0)})
+
+	expected := map[Key]float64{
+		{Latitude, 0, 1}:                 52.5,
+		{Longitude, 0, 1}:                4.25,
+		{COGTrue, 0, 1}:                  1.5,
+		{SOG, 0, 1}:                      5.144,
+		{HeadingMagnetic, 0, 2}:          0.5,
+		{Depth, 0, 3}:                    4.5,
+		{ApparentWindSpeed, 0, 4}:        6,
+		{ApparentWindAngle, 0, 4}:        0.75,
+		{WaterTemperature, 0, 5}:         293.15,
+		{BatteryVoltage, 1, 6}:           12.5,
+		{TankLevel(pgn.Fuel_2), 0, 7}:    80,
+		{TankCapacity(pgn.Fuel_2), 0, 7}: 200,
+	}
+	snap := s.Snapshot()
+	assert.Len(t, snap.Values, len(expected))
+	for k, value := range expected {
+		v, ok := snap.Get(k)
+		assert.True(t, ok, k)
+		assert.InDelta(t, value, v.Value, 0.01, k)
+		assert.Equal(t, testutil.Time, v.Timestamp)
+	}
+	assert.Len(t, changes, len(expected))
+	sorted := snap.Sorted()
+	assert.Equal(t, BatteryVoltage, sorted[0].Quantity)
+	assert.Equal(t, ApparentWindSpeed, sorted[len(sorted)-1].Quantity)
+
+	// unchanged values don't notify
+	changes = nil
+	subs.HandleStruct(pgn.BatteryStatus{Info: testutil.Info(6, time.Second), Instance: testutil.Ptr(uint8(1)),
+		Voltage: testutil.Ptr(float32(12.5))})
+	assert.Empty(t, changes)
+	subs.HandleStruct(pgn.BatteryStatus{Info: testutil.Info(6, 2*time.Second), Instance: testutil.Ptr(uint8(1)),
+		Voltage: testutil.Ptr(float32(12.25))})
+	assert.Len(t, changes, 1)
+	assert.Equal(t, 12.25, changes[0].Value)
+
+	// the latest value from any source
+	subs.HandleStruct(pgn.VesselHeading{Info: testutil.Info(8, time.Second), Heading: testutil.Ptr(float32(0.25)),
+		Reference: pgn.Magnetic})
+	v, ok := s.Latest(HeadingMagnetic, 0)
+	assert.True(t, ok)
+	assert.Equal(t, uint8(8), v.Source)
+
+	// values expire
+	s.SetQuantityMaxAge(BatteryVoltage, time.Minute)
+	now = testutil.Time.Add(15 * time.Second)
+	_, ok = s.Get(Key{Latitude, 0, 1})
+	assert.False(t, ok)
+	_, ok = s.Get(Key{BatteryVoltage, 1, 6})
+	assert.True(t, ok)
+	assert.Len(t, s.Snapshot().Values, 1)
+
+	// a stale value notifies when it's received again
+	changes = nil
+	subs.HandleStruct(pgn.PositionRapidUpdate{Info: testutil.Info(1, 15*time.Second), Latitude: testutil.Ptr(52.5),
+		Longitude: testutil.Ptr(4.25)})
+	assert.Len(t, changes, 2)
+
+	s.SetMaxAge(time.Second)
+	now = testutil.Time.Add(2 * time.Minute)
+	s.Prune()
+	assert.Empty(t, s.values)
+}
@@ -0,0 +1,174 @@
+package subscribe
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"sync/atomic"
+)
+
+// OverflowPolicy decides what a channel subscription does with a struct when its buffer is full.
+type OverflowPolicy int
+
+const (
+	// DropOldest discards the oldest buffered struct to make room, so the receiver sees the most recent data.
+	DropOldest OverflowPolicy = iota
+	// DropNewest discards the new struct, keeping what's buffered.
+	DropNewest
+	// Block waits for the receiver, which stalls HandleStruct (and so the endpoint) until there's room.
+	Block
+)
+
+// String method returns the policy's name.
+func (p OverflowPolicy) String() string {
+	switch p {
+	case DropOldest:
+		return "DropOldest"
+	case DropNewest:
+		return "DropNewest"
+	case Block:
+		return "Block"
+	default:
+		return fmt.Sprintf("OverflowPolicy(%d)", int(p))
+	}
+}
+
+// ChanSubscription delivers structs on a buffered channel, so a slow receiver doesn't run on (and hold up)
+// the goroutine calling HandleStruct. Create one with ToChan.
+type ChanSubscription[T any] struct {
+	// C receives the structs. It's closed when the subscription is closed.
+	C <-chan T
+
+	c      chan T
+	subs   *SubscribeManager
+	id     SubscriptionId
+	policy OverflowPolicy
+
+	// mu is held for reading while sending, and for writing to close c, so it's never closed during a send
+	mu        sync.RWMutex
+	done      chan struct{} // closed before c, to unblock Block sends
+	closeOnce sync.Once
+
+	sent    atomic.Uint64
+	dropped atomic.Uint64
+}
+
+// ToChan subscribes a channel with a buffer of size to structs of type T, for example:
+//
+//	sub, err := subscribe.ToChan[pgn.WindData](ctx, subs, 16, subscribe.DropOldest)
+//	for w := range sub.C { ... }
+//
+// Use ToChan[any] to receive all structs. The subscription is closed, and C with it, when ctx is done or Close is
+// called. The filters are as for To.
+func ToChan[T any](ctx context.Context, s *SubscribeManager, size int, policy OverflowPolicy, filters ...Filter) (*ChanSubscription[T], error) {
+	if size < 0 || (size == 0 && policy != Block) {
+		return nil, fmt.Errorf("subscribe.ToChan called with buffer size %d for %s", size, policy)
+	}
+	if policy < DropOldest || policy > Block {
+		return nil, fmt.Errorf("subscribe.ToChan called with unknown policy: %s", policy)
+	}
+
+	c := make(chan T, size)
+	cs := &ChanSubscription[T]{
+		C:      c,
+		c:      c,
+		subs:   s,
+		policy: policy,
+		done:   make(chan struct{}),
+	}
+
+	var err error
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	if t.Kind() == reflect.Interface {
+		cs.id, err = s.addSubscription(nil, filtered(func(p any) {
+			if v, ok := p.(T); ok {
+				cs.send(v)
+			}
+		}, filters))
+	} else {
+		cs.id, err = To(s, cs.send, filters...)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			cs.Close()
+		case <-cs.done:
+		}
+	}()
+	return cs, nil
+}
+
+// Id method returns the subscription's id.
+func (cs *ChanSubscription[T]) Id() SubscriptionId {
+	return cs.id
+}
+
+// Sent method returns the number of structs sent on C, including any DropOldest later discarded.
+func (cs *ChanSubscription[T]) Sent() uint64 {
+	return cs.sent.Load()
+}
+
+// Dropped method returns the number of structs discarded because the buffer was full.
+func (cs *ChanSubscription[T]) Dropped() uint64 {
+	return cs.dropped.Load()
+}
+
+// Close method unsubscribes and closes C, after any structs already buffered. It's safe to call more than once.
+func (cs *ChanSubscription[T]) Close() {
+	cs.closeOnce.Do(func() {
+		_ = cs.subs.Unsubscribe(cs.id)
+		close(cs.done)
+		cs.mu.Lock()
+		defer cs.mu.Unlock()
+		close(cs.c)
+	})
+}
+
+// send method delivers v according to the policy.
+func (cs *ChanSubscription[T]) send(v T) {
+	cs.mu.RLock()
+	defer cs.mu.RUnlock()
+	select {
+	case <-cs.done:
+		return
+	default:
+	}
+
+	select {
+	case cs.c <- v:
+		cs.sent.Add(1)
+		return
+	default:
+	}
+
+	switch cs.policy {
+	case Block:
+		select {
+		case cs.c <- v:
+			cs.sent.Add(1)
+		case <-cs.done:
+		}
+	case DropOldest:
+		for {
+			select {
+			case <-cs.c:
+				cs.dropped.Add(1)
+			default:
+			}
+			select {
+			case cs.c <- v:
+				cs.sent.Add(1)
+				return
+			default:
+				// another sender filled the space, so drop again
+			}
+		}
+	default:
+		cs.dropped.Add(1)
+	}
+}
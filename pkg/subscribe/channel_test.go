@@ -0,0 +1,121 @@
+package subscribe
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// sendAll sends test1 structs with field1 of 1 to count.
+func sendAll(s *SubscribeManager, count int) {
+	for i := 1; i <= count; i++ {
+		s.HandleStruct(test1{field1: makeFloat(float32(i))})
+	}
+}
+
+// received returns the field1 values of the structs buffered in c.
+func received(c <-chan test1) []float32 {
+	var ret []float32
+	for {
+		select {
+		case p, ok := <-c:
+			if !ok {
+				return ret
+			}
+			ret = append(ret, *p.field1)
+		default:
+			return ret
+		}
+	}
+}
+
+func TestChanPolicies(t *testing.T) {
+	s := New()
+	ctx := context.Background()
+
+	oldest, err := ToChan[test1](ctx, s, 2, DropOldest)
+	assert.NoError(t, err)
+	newest, err := ToChan[test1](ctx, s, 2, DropNewest)
+	assert.NoError(t, err)
+	all, err := ToChan[any](ctx, s, 5, DropNewest, FromSource(0))
+	assert.NoError(t, err)
+
+	sendAll(s, 5)
+	s.HandleStruct(test2{})
+
+	assert.Equal(t, []float32{4, 5}, received(oldest.C))
+	assert.Equal(t, uint64(5), oldest.Sent())
+	assert.Equal(t, uint64(3), oldest.Dropped())
+
+	assert.Equal(t, []float32{1, 2}, received(newest.C))
+	assert.Equal(t, uint64(2), newest.Sent())
+	assert.Equal(t, uint64(3), newest.Dropped())
+
+	assert.Len(t, all.C, 5)
+	assert.Equal(t, uint64(1), all.Dropped())
+
+	_, err = ToChan[test1](ctx, s, 0, DropOldest)
+	assert.Error(t, err)
+	_, err = ToChan[test1](ctx, s, 1, OverflowPolicy(7))
+	assert.Error(t, err)
+	_, err = ToChan[int](ctx, s, 1, Block)
+	assert.Error(t, err)
+}
+
+func TestChanBlock(t *testing.T) {
+	s := New()
+	sub, err := ToChan[test1](context.Background(), s, 1, Block)
+	assert.NoError(t, err)
+
+	done := make(chan struct{})
+	go func() {
+		sendAll(s, 3)
+		close(done)
+	}()
+
+	var values []float32
+	for p := range sub.C {
+		values = append(values, *p.field1)
+		if len(values) == 3 {
+			break
+		}
+	}
+	<-done
+	assert.Equal(t, []float32{1, 2, 3}, values)
+	assert.Equal(t, uint64(0), sub.Dropped())
+
+	// closing unblocks a blocked sender
+	sendAll(s, 1)
+	done = make(chan struct{})
+	go func() {
+		sendAll(s, 1)
+		close(done)
+	}()
+	time.Sleep(10 * time.Millisecond)
+	sub.Close()
+	<-done
+	sub.Close()
+}
+
+func TestChanContext(t *testing.T) {
+	s := New()
+	ctx, cancel := context.WithCancel(context.Background())
+	sub, err := ToChan[test1](ctx, s, 4, DropOldest)
+	assert.NoError(t, err)
+
+	sendAll(s, 2)
+	cancel()
+
+	// buffered structs are still received before the channel closes
+	var values []float32
+	for p := range sub.C {
+		values = append(values, *p.field1)
+	}
+	assert.Equal(t, []float32{1, 2}, values)
+	assert.Error(t, s.Unsubscribe(sub.Id()))
+
+	// sending after the close is ignored
+	sendAll(s, 1)
+}
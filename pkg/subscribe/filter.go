@@ -0,0 +1,111 @@
+package subscribe
+
+import (
+	"reflect"
+	"slices"
+
+	"github.com/boatkit-io/n2k/pkg/pgn"
+)
+
+// Filter limits a subscription to the structs it returns true for.
+// info is the struct's Info field, or empty if it doesn't have one.
+// A subscription with several filters only receives structs that match them all; use AnyOf to match any of them.
+type Filter func(p any, info pgn.MessageInfo) bool
+
+// NameResolver looks up the 64 bit NAME of a message's sender, like devices.Registry.
+// NAMEs are plain uint64s here, so that subscribe doesn't depend on the addressclaim package.
+type NameResolver interface {
+	NameFor(info pgn.MessageInfo) (uint64, bool)
+}
+
+// FromSource matches structs from any of the source addresses.
+func FromSource(addresses ...uint8) Filter {
+	return func(_ any, info pgn.MessageInfo) bool {
+		return slices.Contains(addresses, info.SourceId)
+	}
+}
+
+// FromName matches structs from any of the devices with the NAMEs, wherever they are on the bus.
+// Unlike FromSource it keeps working when a device changes address, but only once its address claim has been seen.
+func FromName(resolver NameResolver, names ...uint64) Filter {
+	return func(_ any, info pgn.MessageInfo) bool {
+		name, ok := resolver.NameFor(info)
+		return ok && slices.Contains(names, name)
+	}
+}
+
+// ToTarget matches structs sent to any of the target addresses.
+// PDU1 PGNs sent to all devices have TargetId 255, and PDU2 PGNs, which are always broadcast, have TargetId 0.
+func ToTarget(targets ...uint8) Filter {
+	return func(_ any, info pgn.MessageInfo) bool {
+		return slices.Contains(targets, info.TargetId)
+	}
+}
+
+// WithPriority matches structs sent with any of the priorities, from 0 (highest) to 7.
+func WithPriority(priorities ...uint8) Filter {
+	return func(_ any, info pgn.MessageInfo) bool {
+		return slices.Contains(priorities, info.Priority)
+	}
+}
+
+// PGNRange matches structs with a PGN from first to last, inclusive.
+func PGNRange(first, last uint32) Filter {
+	return func(_ any, info pgn.MessageInfo) bool {
+		return info.PGN >= first && info.PGN <= last
+	}
+}
+
+// Manufacturer matches proprietary structs (including UnknownPGNs) from any of the manufacturers.
+func Manufacturer(codes ...pgn.ManufacturerCodeConst) Filter {
+	return func(p any, _ pgn.MessageInfo) bool {
+		v := reflect.ValueOf(p)
+		if v.Kind() != reflect.Struct {
+			return false
+		}
+		f := v.FieldByName("ManufacturerCode")
+		if !f.IsValid() {
+			return false
+		}
+		code, ok := f.Interface().(pgn.ManufacturerCodeConst)
+		return ok && slices.Contains(codes, code)
+	}
+}
+
+// Where matches structs of type T that predicate returns true for, for example:
+//
+//	subscribe.Where(func(w pgn.WindData) bool { return w.Reference == pgn.Apparent })
+func Where[T any](predicate func(T) bool) Filter {
+	return func(p any, _ pgn.MessageInfo) bool {
+		t, ok := p.(T)
+		return ok && predicate(t)
+	}
+}
+
+// AnyOf matches structs that match any of the filters, for example several PGN ranges.
+func AnyOf(filters ...Filter) Filter {
+	return func(p any, info pgn.MessageInfo) bool {
+		for _, f := range filters {
+			if f(p, info) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// filtered returns call, only calling it for structs that match all the filters.
+func filtered(call func(any), filters []Filter) func(any) {
+	if len(filters) == 0 {
+		return call
+	}
+	return func(p any) {
+		info, _ := pgn.InfoOf(p)
+		for _, f := range filters {
+			if !f(p, info) {
+				return
+			}
+		}
+		call(p)
+	}
+}
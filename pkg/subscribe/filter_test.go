@@ -0,0 +1,81 @@
+package subscribe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/boatkit-io/n2k/internal/testutil"
+	"github.com/boatkit-io/n2k/pkg/pgn"
+)
+
+// infoOf returns the MessageInfo of a PGN struct, or an empty one for other structs.
+func infoOf(p any) pgn.MessageInfo {
+	info, _ := pgn.InfoOf(p)
+	return info
+}
+
+// position returns a PositionRapidUpdate from source.
+func position(source uint8) pgn.PositionRapidUpdate {
+	return pgn.PositionRapidUpdate{Info: pgn.MessageInfo{PGN: 129025, SourceId: source, Priority: 2}}
+}
+
+func TestFilters(t *testing.T) {
+	airmar := pgn.UnknownPGN{Info: pgn.MessageInfo{PGN: 130850, SourceId: 7}, ManufacturerCode: pgn.Airmar}
+	request := pgn.IsoRequest{Info: pgn.MessageInfo{PGN: 59904, SourceId: 7, TargetId: 12, Priority: 6}}
+
+	tests := []struct {
+		name    string
+		filter  Filter
+		matches []any
+		misses  []any
+	}{
+		{"source", FromSource(1, 2), []any{position(1), position(2)}, []any{position(3)}},
+		{"name", FromName(testutil.NameResolver{1: 0x1234, 2: 0x5678}, 0x5678), []any{position(2)}, []any{position(1),
+			position(3)}},
+		{"target", ToTarget(12), []any{request}, []any{position(1)}},
+		{"priority", WithPriority(2, 3), []any{position(1)}, []any{request}},
+		{"pgn range", PGNRange(129025, 129029), []any{position(1)}, []any{request, airmar}},
+		{"manufacturer", Manufacturer(pgn.Airmar), []any{airmar}, []any{position(1), 42}},
+		{"where", Where(func(p pgn.PositionRapidUpdate) bool { return p.Info.SourceId > 1 }), []any{position(2)},
+			[]any{position(1), request}},
+		{"any of", AnyOf(PGNRange(59904, 59904), PGNRange(130816, 131071)), []any{request, airmar}, []any{position(1)}},
+	}
+	for _, tt := range tests {
+		for _, p := range tt.matches {
+			assert.True(t, tt.filter(p, infoOf(p)), "%s: %+v", tt.name, p)
+		}
+		for _, p := range tt.misses {
+			assert.False(t, tt.filter(p, infoOf(p)), "%s: %+v", tt.name, p)
+		}
+	}
+}
+
+func TestFilteredSubscriptions(t *testing.T) {
+	s := New()
+
+	var sources []uint8
+	_, err := To(s, func(p pgn.PositionRapidUpdate) {
+		sources = append(sources, p.Info.SourceId)
+	}, FromSource(1, 2), WithPriority(2))
+	assert.NoError(t, err)
+	var all []any
+	_, err = s.SubscribeToAllStructs(func(p any) {
+		all = append(all, p)
+	}, FromSource(2))
+	assert.NoError(t, err)
+	var legacy int
+	_, err = s.SubscribeToStruct(pgn.PositionRapidUpdate{}, func(pgn.PositionRapidUpdate) {
+		legacy++
+	}, FromSource(3))
+	assert.NoError(t, err)
+
+	lowPriority := position(1)
+	lowPriority.Info.Priority = 7
+	for _, p := range []any{position(1), position(2), position(3), lowPriority, test1{}} {
+		s.HandleStruct(p)
+	}
+	assert.Equal(t, []uint8{1, 2}, sources)
+	assert.Equal(t, []any{position(2)}, all)
+	assert.Equal(t, 1, legacy)
+}
@@ -0,0 +1,89 @@
+package subscribe
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Group collects subscriptions so they can be dropped together, for example when a service stops.
+// Its subscriptions are dropped when its context is done, or when Unsubscribe is called.
+type Group struct {
+	subs *SubscribeManager
+
+	mu     sync.Mutex
+	ids    []SubscriptionId
+	closed bool
+	done   chan struct{}
+}
+
+// NewGroup returns a new, empty Group of subscriptions to s, that unsubscribes them all when ctx is done.
+// Use context.Background() to only drop them by calling Unsubscribe.
+func NewGroup(ctx context.Context, s *SubscribeManager) *Group {
+	g := &Group{
+		subs: s,
+		done: make(chan struct{}),
+	}
+	go func() {
+		select {
+		case <-ctx.Done():
+			g.Unsubscribe()
+		case <-g.done:
+		}
+	}()
+	return g
+}
+
+// Add method adds a subscription to the group. It takes the results of a subscribe call, to wrap it:
+//
+//	_, err := group.Add(subscribe.To(subs, handleWind))
+//
+// If the group has already been dropped, the subscription is dropped straight away and Add returns an error.
+func (g *Group) Add(id SubscriptionId, err error) (SubscriptionId, error) {
+	if err != nil {
+		return id, err
+	}
+
+	g.mu.Lock()
+	if !g.closed {
+		g.ids = append(g.ids, id)
+		g.mu.Unlock()
+		return id, nil
+	}
+	g.mu.Unlock()
+
+	_ = g.subs.Unsubscribe(id)
+	return 0, fmt.Errorf("subscription group already unsubscribed")
+}
+
+// Len method returns the number of subscriptions in the group.
+func (g *Group) Len() int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return len(g.ids)
+}
+
+// Unsubscribe method drops all the group's subscriptions, including any already dropped by hand.
+// The group can't be used for new subscriptions afterwards. It's safe to call more than once.
+func (g *Group) Unsubscribe() {
+	g.mu.Lock()
+	if g.closed {
+		g.mu.Unlock()
+		return
+	}
+	g.closed = true
+	ids := g.ids
+	g.ids = nil
+	g.mu.Unlock()
+
+	for _, id := range ids {
+		// it may have been unsubscribed already
+		_ = g.subs.Unsubscribe(id)
+	}
+	close(g.done)
+}
+
+// Done method returns a channel that's closed when the group's subscriptions have been dropped.
+func (g *Group) Done() <-chan struct{} {
+	return g.done
+}
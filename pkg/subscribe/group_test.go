@@ -0,0 +1,70 @@
+package subscribe
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroup(t *testing.T) {
+	s := New()
+	g := NewGroup(context.Background(), s)
+
+	calls := 0
+	id, err := g.Add(To(s, func(test1) { calls++ }))
+	assert.NoError(t, err)
+	_, err = g.Add(s.SubscribeToAllStructs(func(any) { calls++ }))
+	assert.NoError(t, err)
+	_, err = g.Add(To[int](s, func(int) {}))
+	assert.Error(t, err)
+	_, err = g.Add(0, errors.New("failed"))
+	assert.Error(t, err)
+	assert.Equal(t, 2, g.Len())
+
+	// a subscription outside the group
+	others := 0
+	_, err = To(s, func(test1) { others++ })
+	assert.NoError(t, err)
+
+	s.HandleStruct(test1{})
+	assert.Equal(t, 2, calls)
+
+	// dropping one by hand doesn't stop the group
+	assert.NoError(t, s.Unsubscribe(id))
+	g.Unsubscribe()
+	<-g.Done()
+	assert.Equal(t, 0, g.Len())
+	s.HandleStruct(test1{})
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, 2, others)
+
+	// new subscriptions are dropped straight away
+	_, err = g.Add(To(s, func(test1) { calls++ }))
+	assert.Error(t, err)
+	s.HandleStruct(test1{})
+	assert.Equal(t, 2, calls)
+	g.Unsubscribe()
+}
+
+func TestGroupContext(t *testing.T) {
+	s := New()
+	ctx, cancel := context.WithCancel(context.Background())
+	g := NewGroup(ctx, s)
+
+	calls := 0
+	_, err := g.Add(To(s, func(test1) { calls++ }))
+	assert.NoError(t, err)
+	s.HandleStruct(test1{})
+
+	cancel()
+	select {
+	case <-g.Done():
+	case <-time.After(time.Second):
+		assert.Fail(t, "group not unsubscribed")
+	}
+	s.HandleStruct(test1{})
+	assert.Equal(t, 1, calls)
+}
@@ -4,6 +4,7 @@ package subscribe
 import (
 	"fmt"
 	"reflect"
+	"slices"
 	"sync"
 )
 
@@ -12,9 +13,10 @@ type SubscribeManager struct {
 	subMutex sync.Mutex
 	// tracked subs by subscriber
 	subs map[SubscriptionId]*trackedSub
-	// subscriptions for specific stucts
-	singles map[string][]*trackedSub
-	// subscriptions for all structs
+	// subscriptions for specific structs, by type.
+	// The slices are replaced rather than changed in place, so HandleStruct can call them outside the mutex.
+	singles map[reflect.Type][]*trackedSub
+	// subscriptions for all structs, replaced like singles
 	all       []*trackedSub
 	lastSubId SubscriptionId
 }
@@ -25,9 +27,9 @@ type SubscriptionId uint
 // trackedSub connects a  subscriber with a function that fulfills a specific subscription.
 type trackedSub struct {
 	subId      SubscriptionId
-	structName string
-	// Will be either func(any) for global handler or func(specific struct) for a struct callback
-	callback any
+	structType reflect.Type // nil for subscriptions to all structs
+	// call passes a struct to the subscriber's callback
+	call func(any)
 }
 
 // New returns a pointer to a new SubscribeManager.
@@ -36,33 +38,29 @@ func New() *SubscribeManager {
 		lastSubId: 0,
 		subs:      make(map[SubscriptionId]*trackedSub),
 		all:       []*trackedSub{},
-		singles:   make(map[string][]*trackedSub),
+		singles:   make(map[reflect.Type][]*trackedSub),
 	}
 }
 
 // addSubscription adds a subscription. It's called internally by routines that validate its arguments.
-// Callback must be validated already
-func (s *SubscribeManager) addSubscription(structName string, callback any) (SubscriptionId, error) {
+// structType is nil to subscribe to all structs.
+func (s *SubscribeManager) addSubscription(structType reflect.Type, call func(any)) (SubscriptionId, error) {
 	s.subMutex.Lock()
 	defer s.subMutex.Unlock()
 
 	s.lastSubId++
 	ts := &trackedSub{
 		subId:      s.lastSubId,
-		structName: structName,
-		callback:   callback,
+		structType: structType,
+		call:       call,
 	}
 
 	s.subs[ts.subId] = ts
 
-	if structName == "" {
-		s.all = append(s.all, ts)
+	if structType == nil {
+		s.all = append(slices.Clip(s.all), ts)
 	} else {
-		arr := s.singles[ts.structName]
-		if arr == nil {
-			arr = make([]*trackedSub, 0)
-		}
-		s.singles[ts.structName] = append(arr, ts)
+		s.singles[structType] = append(slices.Clip(s.singles[structType]), ts)
 	}
 
 	return ts.subId, nil
@@ -77,42 +75,31 @@ func (s *SubscribeManager) Unsubscribe(subId SubscriptionId) error {
 	if !exists {
 		return fmt.Errorf("subscription %d not found", subId)
 	}
+	delete(s.subs, subId)
 
-	if ts.structName == "" {
+	if ts.structType == nil {
 		// global sub
-		found := false
-		for i, sub := range s.all {
-			if sub == ts {
-				found = true
-				s.all = append(s.all[0:i], s.all[i+1:]...)
-				break
-			}
-		}
-		if !found {
+		i := slices.Index(s.all, ts)
+		if i < 0 {
 			return fmt.Errorf("global subscription %d not tracked somehow", subId)
 		}
+		s.all = slices.Delete(slices.Clone(s.all), i, i+1)
 	} else {
-		// pgn sub
-		subs, exists := s.singles[ts.structName]
+		// struct sub
+		subs, exists := s.singles[ts.structType]
 		if !exists {
-			return fmt.Errorf("struct subscription %d somehow not found in %s", subId, ts.structName)
+			return fmt.Errorf("struct subscription %d somehow not found in %s", subId, ts.structType.Name())
 		}
 
-		found := false
-		for i, sub := range subs {
-			if sub == ts {
-				found = true
-				if len(subs) == 1 {
-					// now empty -- clean up struct sub list
-					delete(s.singles, ts.structName)
-				} else {
-					s.singles[ts.structName] = append(subs[0:i], subs[i+1:]...)
-				}
-				break
-			}
+		i := slices.Index(subs, ts)
+		if i < 0 {
+			return fmt.Errorf("struct subscription %d not tracked somehow in %s", subId, ts.structType.Name())
 		}
-		if !found {
-			return fmt.Errorf("struct subscription %d not tracked somehow in %s", subId, ts.structName)
+		if len(subs) == 1 {
+			// now empty -- clean up struct sub list
+			delete(s.singles, ts.structType)
+		} else {
+			s.singles[ts.structType] = slices.Delete(slices.Clone(subs), i, i+1)
 		}
 	}
 
@@ -122,44 +109,26 @@ func (s *SubscribeManager) Unsubscribe(subId SubscriptionId) error {
 // HandleStruct calls registered subscriber callbacks for a struct.
 // It calls all specific subscribers and all subscribers.
 func (s *SubscribeManager) HandleStruct(p any) {
-	pv := reflect.ValueOf(p)
-	sn := pv.Type().Name()
-
-	// Build a call list inside the mutex to call back outside of it, in case the callback unsubscribes
-	callList := []reflect.Value{}
-
+	// Take the call lists inside the mutex to call back outside of it, in case the callback unsubscribes.
+	// They're never changed in place, so they don't need copying.
 	s.subMutex.Lock()
-
-	if single, exists := s.singles[sn]; exists {
-		// Copy the single slice in case it changes while we're iterating
-		psc := make([]*trackedSub, len(single))
-		copy(psc, single)
-
-		for _, sub := range psc {
-			t := reflect.ValueOf(sub.callback)
-			callList = append(callList, t)
-		}
-	}
-
-	// Copy the globalSubs slice in case it changes while we're iterating
-	gsc := make([]*trackedSub, len(s.all))
-	copy(gsc, s.all)
-	for _, sub := range gsc {
-		t := reflect.ValueOf(sub.callback)
-		callList = append(callList, t)
-	}
-
+	single := s.singles[reflect.TypeOf(p)]
+	all := s.all
 	s.subMutex.Unlock()
 
-	callWith := []reflect.Value{pv}
-	for _, t := range callList {
-		t.Call(callWith)
+	for _, sub := range single {
+		sub.call(p)
+	}
+	for _, sub := range all {
+		sub.call(p)
 	}
 }
 
 // SubscribeToStruct registers a subscription to the specified struct.
 // It validates the callback is a function with a matching argument type.
-func (s *SubscribeManager) SubscribeToStruct(t any, callback any) (SubscriptionId, error) {
+// The callback is only called for structs that match all the filters.
+// To is faster, and checks the callback's type at compile time.
+func (s *SubscribeManager) SubscribeToStruct(t any, callback any, filters ...Filter) (SubscriptionId, error) {
 	e := reflect.ValueOf(t)
 	if e.Kind() != reflect.Struct {
 		return 0, fmt.Errorf("subscribeToPgn called with non-struct type: %+v", e.Kind())
@@ -169,23 +138,64 @@ func (s *SubscribeManager) SubscribeToStruct(t any, callback any) (SubscriptionI
 	if ce.Kind() != reflect.Func {
 		return 0, fmt.Errorf("subscribeToPgn called with non-func callback: %+v", ce.Kind())
 	}
-	if ce.Type().In(0) != e.Type() {
-		return 0, fmt.Errorf("subscribeToPgn called with callback type (%+v) not matching passed type (%+v)", ce.Type().In(0).Name(), e.Type().Name())
+	if ce.Type().NumIn() != 1 || ce.Type().In(0) != e.Type() {
+		return 0, fmt.Errorf("subscribeToPgn called with callback type (%+v) not matching passed type (%+v)", ce.Type(), e.Type().Name())
 	}
 
-	return s.addSubscription(e.Type().Name(), callback)
+	return s.addSubscription(e.Type(), filtered(func(p any) {
+		ce.Call([]reflect.Value{reflect.ValueOf(p)})
+	}, filters))
 }
 
 // SubscribeToAllStructs registers a subscription to all structs.
 // It validates the callback is a func with an any argument.
-func (s *SubscribeManager) SubscribeToAllStructs(callback any) (SubscriptionId, error) {
+// The callback is only called for structs that match all the filters.
+func (s *SubscribeManager) SubscribeToAllStructs(callback any, filters ...Filter) (SubscriptionId, error) {
+	if f, ok := callback.(func(any)); ok {
+		// the usual case, which doesn't need reflection to call
+		if f == nil {
+			return 0, fmt.Errorf("subscribeToAllStructs called with nil callback")
+		}
+		return s.addSubscription(nil, filtered(f, filters))
+	}
+
 	ce := reflect.ValueOf(callback)
 	if ce.Kind() != reflect.Func {
 		return 0, fmt.Errorf("subscribeToAllStructs called with non-func callback: %+v", ce.Kind())
 	}
-	if ce.Type().In(0).Kind() != reflect.Interface {
-		return 0, fmt.Errorf("subscribeToAllStructs called with non-any-taking callback type (%+v)", ce.Type().In(0).Kind())
+	if ce.Type().NumIn() != 1 || ce.Type().In(0).Kind() != reflect.Interface {
+		return 0, fmt.Errorf("subscribeToAllStructs called with non-any-taking callback type (%+v)", ce.Type())
+	}
+
+	in := ce.Type().In(0)
+	return s.addSubscription(nil, filtered(func(p any) {
+		// only pass on what the callback's interface accepts
+		if pv := reflect.ValueOf(p); pv.IsValid() && pv.Type().Implements(in) {
+			ce.Call([]reflect.Value{pv})
+		}
+	}, filters))
+}
+
+// To subscribes callback to structs of type T, for example:
+//
+//	subscribe.To(subs, func(w pgn.WindData) { ... })
+//
+// It's like SubscribeToStruct, but the callback's type is checked at compile time and it's called without reflection.
+// The callback is only called for structs that match all the filters, for example:
+//
+//	subscribe.To(subs, handleGPS, subscribe.FromSource(gpsAddress))
+//
+// Filters need the struct's Info, which is found with reflection.
+func To[T any](s *SubscribeManager, callback func(T), filters ...Filter) (SubscriptionId, error) {
+	if callback == nil {
+		return 0, fmt.Errorf("subscribe.To called with nil callback")
+	}
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	if t.Kind() != reflect.Struct {
+		return 0, fmt.Errorf("subscribe.To called with non-struct type: %+v", t.Kind())
 	}
 
-	return s.addSubscription("", callback)
+	return s.addSubscription(t, filtered(func(p any) {
+		callback(p.(T))
+	}, filters))
 }
@@ -119,3 +119,90 @@ func TestSubscriptionErrors(t *testing.T) {
 	// second time should break
 	assert.Error(t, s.Unsubscribe(subId))
 }
+
+func TestTo(t *testing.T) {
+	s := New()
+
+	var ov *test1
+	subId, err := To(s, func(s test1) {
+		ov = &s
+	})
+	assert.NoError(t, err)
+	var ov2 *test2
+	_, err = To(s, func(s test2) {
+		ov2 = &s
+	})
+	assert.NoError(t, err)
+
+	s.HandleStruct(test1{field1: makeFloat(1.0)})
+	assert.NotNil(t, ov)
+	assert.Equal(t, float32(1.0), *ov.field1)
+	assert.Nil(t, ov2)
+
+	// pointers aren't passed to struct subscribers
+	ov = nil
+	s.HandleStruct(&test1{field1: makeFloat(2.0)})
+	assert.Nil(t, ov)
+
+	assert.NoError(t, s.Unsubscribe(subId))
+	s.HandleStruct(test1{field1: makeFloat(3.0)})
+	assert.Nil(t, ov)
+
+	_, err = To[int](s, func(int) {})
+	assert.Error(t, err)
+	_, err = To[test1](s, nil)
+	assert.Error(t, err)
+}
+
+func TestUnsubscribeInCallback(t *testing.T) {
+	s := New()
+
+	calls := 0
+	var subId SubscriptionId
+	subId, _ = To(s, func(test1) {
+		calls++
+		assert.NoError(t, s.Unsubscribe(subId))
+	})
+	_, _ = To(s, func(test1) {
+		calls++
+	})
+
+	s.HandleStruct(test1{})
+	assert.Equal(t, 2, calls)
+	s.HandleStruct(test1{})
+	assert.Equal(t, 3, calls)
+}
+
+func BenchmarkSubscribeToStruct(b *testing.B) {
+	s := New()
+	count := 0
+	_, _ = s.SubscribeToStruct(test1{}, func(test1) { count++ })
+	benchmarkHandleStruct(b, s)
+}
+
+func BenchmarkTo(b *testing.B) {
+	s := New()
+	count := 0
+	_, _ = To(s, func(test1) { count++ })
+	benchmarkHandleStruct(b, s)
+}
+
+func BenchmarkSubscribeToAllStructs(b *testing.B) {
+	s := New()
+	count := 0
+	_, _ = s.SubscribeToAllStructs(func(any) { count++ })
+	benchmarkHandleStruct(b, s)
+}
+
+// benchmarkHandleStruct sends structs to s, with a few other subscriptions that don't match.
+func benchmarkHandleStruct(b *testing.B, s *SubscribeManager) {
+	for i := 0; i < 10; i++ {
+		_, _ = To(s, func(test2) {})
+	}
+	p := test1{field1: makeFloat(1.0)}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.HandleStruct(p)
+	}
+}